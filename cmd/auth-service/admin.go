@@ -0,0 +1,430 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+)
+
+const adminUsage = `usage:
+  auth-service users create --email EMAIL [--password PASSWORD]
+  auth-service users reset-password --user ID|EMAIL [--password PASSWORD]
+  auth-service users deactivate --user ID|EMAIL
+  auth-service users restore --user ID|EMAIL
+  auth-service sessions list --user ID|EMAIL
+  auth-service sessions revoke-all --user ID|EMAIL
+  auth-service attributes list --user ID|EMAIL
+  auth-service attributes set --user ID|EMAIL --name NAME --value JSON
+  auth-service attributes unset --user ID|EMAIL --name NAME
+  auth-service keys rotate
+  auth-service keys list
+
+Every command accepts --output json|table (default table). A password that is not
+given as a flag is read from the first line of stdin.`
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// adminServices are the use cases the operator commands run against, wired the same
+// way as in the server but without any transport or background workers.
+type adminServices struct {
+	auth       usecase.AuthService
+	admin      usecase.AdminService
+	attributes usecase.UserAttributeService
+	signingKey usecase.SigningKeyService
+}
+
+func isAdminCommand(name string) bool {
+	switch name {
+	case "users", "sessions", "attributes", "keys":
+		return true
+	default:
+		return false
+	}
+}
+
+// runAdmin implements the operator subcommands, e.g. "auth-service users create".
+func runAdmin(ctx context.Context, group string, args []string) error {
+	if len(args) == 0 {
+		return errors.New(adminUsage)
+	}
+	command := group + " " + args[0]
+
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	userRef := fs.String("user", "", "user id or email")
+	email := fs.String("email", "", "email of the new user")
+	password := fs.String("password", "", "password, read from stdin when empty")
+	name := fs.String("name", "", "attribute name")
+	value := fs.String("value", "", "attribute value as JSON")
+	output := fs.String("output", outputTable, "output format: json or table")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return fmt.Errorf("%s: %w\n%s", command, err, adminUsage)
+	}
+	if *output != outputTable && *output != outputJSON {
+		return fmt.Errorf("unknown output format %q\n%s", *output, adminUsage)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	if err := checkRetireGrace(cfg); err != nil {
+		return err
+	}
+
+	db, err := openDB(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	svc := newAdminServices(cfg, newStorage(cfg, db))
+	out := printer{w: os.Stdout, format: *output}
+
+	switch command {
+	case "users create":
+		if *email == "" {
+			return fmt.Errorf("%s: --email is required", command)
+		}
+		pw, err := passwordArg(*password)
+		if err != nil {
+			return err
+		}
+
+		u, err := svc.auth.Register(ctx, *email, pw)
+		if err != nil {
+			return fmt.Errorf("create user: %w", err)
+		}
+		return out.users([]domain.User{*u})
+	case "users reset-password":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+		pw, err := passwordArg(*password)
+		if err != nil {
+			return err
+		}
+
+		if err := svc.admin.ResetPassword(ctx, u.UserID, pw); err != nil {
+			return fmt.Errorf("reset password: %w", err)
+		}
+		return out.users([]domain.User{*u})
+	case "users deactivate":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+
+		if err := svc.admin.DeactivateUser(ctx, u.UserID); err != nil {
+			return fmt.Errorf("deactivate user: %w", err)
+		}
+		u.IsActive = false
+		return out.users([]domain.User{*u})
+	case "users restore":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+
+		restored, err := svc.admin.RestoreUser(ctx, u.UserID)
+		if err != nil {
+			return fmt.Errorf("restore user: %w", err)
+		}
+		return out.users([]domain.User{*restored})
+	case "sessions list":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+
+		sessions, err := svc.admin.ListSessions(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		return out.sessions(sessions)
+	case "sessions revoke-all":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+
+		n, err := svc.admin.RevokeAllSessions(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		return out.revoked(u, n)
+	case "attributes list":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+
+		attrs, err := svc.attributes.ListAttributes(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		return out.attributes(attrs)
+	case "attributes set":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+		if *name == "" || *value == "" {
+			return fmt.Errorf("%s: --name and --value are required", command)
+		}
+
+		if err := svc.attributes.SetAttribute(ctx, u.UserID, *name, json.RawMessage(*value)); err != nil {
+			return fmt.Errorf("set attribute: %w", err)
+		}
+		attrs, err := svc.attributes.ListAttributes(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		return out.attributes(attrs)
+	case "attributes unset":
+		u, err := findUser(ctx, svc, command, *userRef)
+		if err != nil {
+			return err
+		}
+		if *name == "" {
+			return fmt.Errorf("%s: --name is required", command)
+		}
+
+		if err := svc.attributes.DeleteAttribute(ctx, u.UserID, *name); err != nil {
+			return fmt.Errorf("unset attribute: %w", err)
+		}
+		attrs, err := svc.attributes.ListAttributes(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		return out.attributes(attrs)
+	case "keys rotate":
+		key, err := svc.signingKey.RotateSigningKey(ctx)
+		if err != nil {
+			return err
+		}
+		return out.signingKeys([]domain.SigningKey{*key})
+	case "keys list":
+		keys, err := svc.signingKey.ListSigningKeys(ctx)
+		if err != nil {
+			return err
+		}
+		return out.signingKeys(keys)
+	default:
+		return fmt.Errorf("unknown command %q\n%s", command, adminUsage)
+	}
+}
+
+func newAdminServices(cfg *config.Config, storage storage.Storage) *adminServices {
+	// Stdout carries the command output, so diagnostics go to stderr.
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+	tokenService := usecase.NewTokenService([]byte(cfg.JWTsecret), storage.Token(), tokenOptions(cfg))
+	auditService := usecase.NewAuditService(storage.Audit(), logger, time.Hour*24*time.Duration(cfg.Audit.RetentionDays))
+	revocationService := usecase.NewRevocationService(storage.Revocation(), revocationOptions(cfg))
+
+	return &adminServices{
+		auth:       usecase.NewAuthService(storage.Auth(), storage.Token(), tokenService, revocationService, auditService, storage.Outbox(), storage, logger, sessionOptions(cfg)),
+		admin:      usecase.NewAdminService(storage.Auth(), storage.Token(), revocationService, storage.Outbox(), storage),
+		attributes: usecase.NewUserAttributeService(storage.UserAttribute(), cfg.Claims.MaxBytes),
+		signingKey: usecase.NewSigningKeyService(storage.SigningKey(), tokenService, storage, time.Minute*time.Duration(cfg.SigningKeys.RetireGrace)),
+	}
+}
+
+func findUser(ctx context.Context, svc *adminServices, command string, ref string) (*domain.User, error) {
+	if ref == "" {
+		return nil, fmt.Errorf("%s: --user is required", command)
+	}
+
+	u, err := svc.admin.FindUser(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("find user %q: %w", ref, err)
+	}
+	return u, nil
+}
+
+// passwordArg returns the password flag or, when it is empty, the first line of
+// stdin, so passwords can be piped in instead of showing up in the shell history.
+func passwordArg(flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("read password: %w", err)
+	}
+
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", errors.New("password is empty")
+	}
+	return password, nil
+}
+
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+type userOutput struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type sessionOutput struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	StartedAt  time.Time `json:"started_at"`
+	RememberMe bool      `json:"remember_me"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type attributeOutput struct {
+	Name      string          `json:"name"`
+	Value     json.RawMessage `json:"value"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type signingKeyOutput struct {
+	KID       string     `json:"kid"`
+	CreatedAt time.Time  `json:"created_at"`
+	RetiredAt *time.Time `json:"retired_at,omitempty"`
+}
+
+type revokedOutput struct {
+	UserID  string `json:"user_id"`
+	Revoked int64  `json:"revoked"`
+}
+
+func (p printer) users(users []domain.User) error {
+	rows := make([]userOutput, 0, len(users))
+	for _, u := range users {
+		rows = append(rows, userOutput{
+			ID:        u.UserID.String(),
+			Email:     u.Email,
+			Role:      u.Role,
+			IsActive:  u.IsActive,
+			CreatedAt: u.CreatedAt,
+		})
+	}
+
+	return p.print(rows, len(rows), []string{"ID", "EMAIL", "ROLE", "ACTIVE", "CREATED"}, func(i int) []any {
+		r := rows[i]
+		return []any{r.ID, r.Email, r.Role, r.IsActive, formatTime(r.CreatedAt)}
+	})
+}
+
+func (p printer) sessions(sessions []domain.Session) error {
+	rows := make([]sessionOutput, 0, len(sessions))
+	for _, s := range sessions {
+		rows = append(rows, sessionOutput{
+			ID:         s.ID.String(),
+			UserID:     s.UserID.String(),
+			StartedAt:  s.SessionStartedAt,
+			RememberMe: s.RememberMe,
+			CreatedAt:  s.CreatedAt,
+			ExpiresAt:  s.ExpiresAt,
+		})
+	}
+
+	return p.print(rows, len(rows), []string{"ID", "USER", "STARTED", "REMEMBER", "CREATED", "EXPIRES"}, func(i int) []any {
+		r := rows[i]
+		return []any{r.ID, r.UserID, formatTime(r.StartedAt), r.RememberMe, formatTime(r.CreatedAt), formatTime(r.ExpiresAt)}
+	})
+}
+
+func (p printer) attributes(attrs []domain.UserAttribute) error {
+	rows := make([]attributeOutput, 0, len(attrs))
+	for _, a := range attrs {
+		rows = append(rows, attributeOutput{
+			Name:      a.Name,
+			Value:     a.Value,
+			UpdatedAt: a.UpdatedAt,
+		})
+	}
+
+	return p.print(rows, len(rows), []string{"NAME", "VALUE", "UPDATED"}, func(i int) []any {
+		r := rows[i]
+		return []any{r.Name, string(r.Value), formatTime(r.UpdatedAt)}
+	})
+}
+
+func (p printer) signingKeys(keys []domain.SigningKey) error {
+	rows := make([]signingKeyOutput, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, signingKeyOutput{
+			KID:       k.KID,
+			CreatedAt: k.CreatedAt,
+			RetiredAt: k.RetiredAt,
+		})
+	}
+
+	return p.print(rows, len(rows), []string{"KID", "CREATED", "RETIRED"}, func(i int) []any {
+		r := rows[i]
+		retired := "-"
+		if r.RetiredAt != nil {
+			retired = formatTime(*r.RetiredAt)
+		}
+		return []any{r.KID, formatTime(r.CreatedAt), retired}
+	})
+}
+
+func (p printer) revoked(u *domain.User, n int64) error {
+	rows := []revokedOutput{{UserID: u.UserID.String(), Revoked: n}}
+
+	return p.print(rows, len(rows), []string{"USER", "REVOKED"}, func(i int) []any {
+		return []any{rows[i].UserID, rows[i].Revoked}
+	})
+}
+
+// print writes rows as a JSON array or as an aligned table of n rows, calling cells
+// for the columns of each row.
+func (p printer) print(rows any, n int, header []string, cells func(i int) []any) error {
+	if p.format == outputJSON {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for i := range n {
+		values := cells(i)
+		strs := make([]string, len(values))
+		for j, v := range values {
+			strs[j] = fmt.Sprint(v)
+		}
+		fmt.Fprintln(tw, strings.Join(strs, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
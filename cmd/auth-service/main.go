@@ -2,29 +2,61 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"fmt"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	_ "github.com/lib/pq"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/claims"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/eventsink"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/notify"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/memory"
 	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/postgres"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/sqlite"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/webhook"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	grpcadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/grpc"
 	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
 	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
 	"github.com/vo1dFl0w/auth-service/internal/config"
 	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/gen/authv1"
 	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/tracing"
+	"github.com/vo1dFl0w/auth-service/migrations"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	if err := run(ctx); err != nil {
+	var err error
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = runMigrate(ctx, os.Args[2:])
+	} else if len(os.Args) > 1 && isAdminCommand(os.Args[1]) {
+		err = runAdmin(ctx, os.Args[1], os.Args[2:])
+	} else {
+		err = run(ctx)
+	}
+
+	if err != nil {
 		log.Println(ctx, "startup", "err", err)
 		os.Exit(1)
 	}
@@ -35,49 +67,280 @@ func run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("load config: %w", err)
 	}
+	if err := checkRetireGrace(cfg); err != nil {
+		return err
+	}
 
 	logger := logger.LoadLogger(cfg.Env)
 
-	databaseDSN := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.Username, cfg.Postgres.Password, cfg.Postgres.DBname, cfg.Postgres.Sslmode,
-	)
+	tracerProvider, shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("setup tracing: %w", err)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
 
-	db, err := sql.Open("postgres", databaseDSN)
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.Error("tracing shutdown failed", "error", err)
+		}
+	}()
+
+	storage, closeStorage, err := openStorage(ctx, cfg, logger)
 	if err != nil {
-		return fmt.Errorf("failed to open db: %w", err)
+		return err
 	}
-	defer db.Close()
+	defer closeStorage()
 
-	if err := db.Ping(); err != nil {
-		return fmt.Errorf("failed to ping db: %w", err)
+	expectedSchema, err := migrations.Latest()
+	if err != nil {
+		return fmt.Errorf("read embedded migrations: %w", err)
 	}
 
-	storage := postgres.New(db)
-	tokenService := usecase.NewTokenService([]byte(cfg.JWTsecret), storage.Token())
-	authService := usecase.NewAuthService(storage.Auth(), storage.Token(), tokenService)
+	tokenOpts := tokenOptions(cfg)
+	tokenOpts.ClaimsProviders = claimsProviders(cfg, storage)
+	tokenOpts.SigningKeys = storage.SigningKey()
+	tokenService := usecase.NewTokenService([]byte(cfg.JWTsecret), storage.Token(), tokenOpts)
+	signingKeyService := usecase.NewSigningKeyService(storage.SigningKey(), tokenService, storage, tokenOpts.RetireGrace)
+	if err := signingKeyService.ReloadSigningKeys(ctx); err != nil {
+		return fmt.Errorf("load signing keys: %w", err)
+	}
+	auditService := usecase.NewAuditService(storage.Audit(), logger, time.Hour*24*time.Duration(cfg.Audit.RetentionDays))
+	revocationService := usecase.NewRevocationService(storage.Revocation(), revocationOptions(cfg))
+	authService := usecase.NewAuthService(storage.Auth(), storage.Token(), tokenService, revocationService, auditService, storage.Outbox(), storage, logger, sessionOptions(cfg))
+	apiKeyService := usecase.NewAPIKeyService(storage.APIKey())
+	webhookService := usecase.NewWebhookService(storage.Webhook())
+	notifier := newNotifier(cfg)
+	emailChangeService := usecase.NewEmailChangeService(storage.Auth(), storage.EmailChange(), auditService, storage.Outbox(), storage, notifier, usecase.EmailChangeOptions{
+		TTL:        time.Second * time.Duration(cfg.EmailChange.TTL),
+		ConfirmURL: cfg.EmailChange.ConfirmURL,
+		CancelURL:  cfg.EmailChange.CancelURL,
+	})
+	accountService := usecase.NewAccountService(storage.Auth(), storage.Token(), storage.APIKey(), storage.UserAttribute(), storage.Audit(), storage.Outbox(), storage.Webhook(), revocationService, auditService, storage, usecase.AccountOptions{
+		DeletionGracePeriod: time.Second * time.Duration(cfg.Accounts.DeletionGracePeriod),
+	})
+	magicLinkService := usecase.NewMagicLinkService(storage.Auth(), storage.MagicLink(), storage.Token(), tokenService, auditService, notifier, logger, sessionOptions(cfg), usecase.MagicLinkOptions{
+		TTL:         time.Second * time.Duration(cfg.MagicLink.TTL),
+		LoginURL:    cfg.MagicLink.LoginURL,
+		BindBrowser: cfg.MagicLink.BindBrowser,
+		MaxLinks:    cfg.MagicLink.MaxLinks,
+		LinksWindow: time.Second * time.Duration(cfg.MagicLink.LinksWindow),
+	})
+	otpSenders, err := otpSenders(cfg, notifier)
+	if err != nil {
+		return err
+	}
+	otpService := usecase.NewOTPService(storage.Auth(), storage.OTP(), storage.UserAttribute(), storage.Token(), tokenService, auditService, otpSenders, sessionOptions(cfg), usecase.OTPOptions{
+		TTL:            time.Second * time.Duration(cfg.OTP.TTL),
+		Length:         cfg.OTP.Length,
+		MaxAttempts:    cfg.OTP.MaxAttempts,
+		ResendInterval: time.Second * time.Duration(cfg.OTP.ResendInterval),
+		MaxCodes:       cfg.OTP.MaxCodes,
+		CodesWindow:    time.Second * time.Duration(cfg.OTP.CodesWindow),
+		PhoneAttribute: cfg.OTP.PhoneAttribute,
+	})
+	deviceService := usecase.NewDeviceService(storage.Auth(), storage.DeviceCode(), storage.Token(), tokenService, auditService, sessionOptions(cfg), usecase.DeviceOptions{
+		TTL:             time.Second * time.Duration(cfg.Device.TTL),
+		Interval:        time.Second * time.Duration(cfg.Device.Interval),
+		VerificationURL: cfg.Device.VerificationURL,
+	})
+	exchangeService := usecase.NewTokenExchangeService(tokenService, revocationService, auditService, exchangeOptions(cfg))
+	clientAuthService := usecase.NewClientAuthService(clientAuthOptions(cfg))
+	dpopService := usecase.NewDPoPService(storage.DPoPProof(), usecase.DPoPOptions{
+		ProofLifetime: time.Second * time.Duration(cfg.DPoP.ProofLifetime),
+		Leeway:        time.Second * time.Duration(cfg.Tokens.Leeway),
+		RequireNonce:  cfg.DPoP.RequireNonce,
+		NonceTTL:      time.Second * time.Duration(cfg.DPoP.NonceTTL),
+		Secret:        []byte(cfg.JWTsecret),
+	})
+	healthService := usecase.NewHealthService(storage.Health(), tokenService, int64(expectedSchema))
 
-	handler := httpadapter.NewHandler(cfg, logger, authService)
-	secHandler := httpadapter.NewSecuredHandler(tokenService)
+	handler := httpadapter.NewHandler(cfg, logger, httpadapter.HandlerDeps{
+		AuthService:        authService,
+		APIKeyService:      apiKeyService,
+		AuditService:       auditService,
+		WebhookService:     webhookService,
+		EmailChangeService: emailChangeService,
+		AccountService:     accountService,
+		MagicLinkService:   magicLinkService,
+		OTPService:         otpService,
+		DeviceService:      deviceService,
+		ExchangeService:    exchangeService,
+		ClientAuthService:  clientAuthService,
+	})
+	secHandler := httpadapter.NewSecuredHandler(tokenService, revocationService, apiKeyService, authService)
 
-	server, err := gen.NewServer(handler, secHandler)
+	server, err := gen.NewServer(handler, secHandler, gen.WithErrorHandler(handler.ErrorHandler), gen.WithTracerProvider(tracerProvider))
 	if err != nil {
 		return fmt.Errorf("failed to generate new server: %w", err)
 	}
 
-	middlewares := handler.CorsMiddleware(
-		handler.RequestIDMiddleware(
-			handler.LoggerMiddleware(
-				handler.TimeoutMiddleware(server),
+	// Metrics wrap every other middleware, so requests rejected by client authentication
+	// or DPoP are counted, and the timeout covers the middlewares that call storage.
+	middlewares := handler.MetricsMiddleware(server, handler.TimeoutMiddleware(
+		handler.CorsMiddleware(
+			handler.TracingMiddleware(
+				handler.RequestIDMiddleware(
+					handler.RequestMetaMiddleware(
+						handler.LoggerMiddleware(
+							handler.ClientAuthMiddleware(
+								handler.DPoPMiddleware(dpopService, server),
+							),
+						),
+					),
+				),
 			),
 		),
-	)
+	))
+
+	healthHandler := httpadapter.NewHealthHandler(healthService)
+
+	mux := http.NewServeMux()
+	mux.Handle("/", middlewares)
+	mux.HandleFunc("GET /healthz", healthHandler.Healthz)
+	mux.HandleFunc("GET /readyz", healthHandler.Readyz)
+	if cfg.Metrics.Enabled {
+		mux.Handle(cfg.Metrics.Path, metrics.Handler())
+	}
 
 	srvAddr := fmt.Sprintf("%s%s", cfg.Server.Host, cfg.Server.Port)
 
 	srv := http.Server{
 		Addr:    srvAddr,
-		Handler: middlewares,
+		Handler: mux,
+	}
+	if cfg.Server.TLS.Enabled {
+		srv.TLSConfig, err = serverTLSConfig(cfg.Server.TLS)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Workers added to background are waited for on return, so a purge in progress
+	// stops before the database is closed.
+	var background sync.WaitGroup
+	bgCtx, stopBackground := context.WithCancel(ctx)
+	defer func() {
+		stopBackground()
+		background.Wait()
+	}()
+
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		purgeAuthEvents(bgCtx, logger, auditService)
+	}()
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		purgeDeletedAccounts(bgCtx, logger, accountService)
+	}()
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		reloadSigningKeys(bgCtx, logger, signingKeyService, time.Second*time.Duration(cfg.SigningKeys.ReloadInterval))
+	}()
+	if cfg.Janitor.Enabled {
+		janitor := usecase.NewTokenJanitor(storage, storage.Token(), storage.Revocation(), storage.DeviceCode(), storage.DPoPProof(), logger, usecase.TokenJanitorOptions{
+			Interval:  time.Second * time.Duration(cfg.Janitor.Interval),
+			BatchSize: cfg.Janitor.BatchSize,
+		})
+
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			janitor.Run(bgCtx)
+		}()
+	}
+	if cfg.Metrics.Enabled {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			reportActiveRefreshTokens(bgCtx, logger, storage.Token())
+		}()
+	}
+
+	if cfg.Outbox.Enabled {
+		sinks := outboxSinks(cfg)
+		if cfg.Webhooks.Enabled {
+			sinks = append(sinks, usecase.NewWebhookFanoutSink(storage.Webhook()))
+		}
+		if len(sinks) == 0 {
+			logger.Warn("outbox relay enabled without sinks, events will stay pending")
+		} else {
+			relay := usecase.NewOutboxRelay(storage, storage.Outbox(), sinks, logger, usecase.OutboxRelayOptions{
+				PollInterval: time.Second * time.Duration(cfg.Outbox.PollInterval),
+				BatchSize:    cfg.Outbox.BatchSize,
+				MaxAttempts:  cfg.Outbox.MaxAttempts,
+				BaseBackoff:  time.Second,
+				MaxBackoff:   time.Hour,
+				Retention:    time.Hour * 24 * time.Duration(cfg.Outbox.RetentionDays),
+			})
+			background.Add(2)
+			go func() {
+				defer background.Done()
+				relay.Run(bgCtx)
+			}()
+			go func() {
+				defer background.Done()
+				purgeOutboxEvents(bgCtx, logger, relay)
+			}()
+		}
+	}
+
+	if cfg.Webhooks.Enabled {
+		if !cfg.Outbox.Enabled {
+			logger.Warn("webhooks enabled without outbox relay, no new deliveries will be created")
+		}
+
+		dispatcher := usecase.NewWebhookDispatcher(storage, storage.Webhook(), webhook.NewClient(time.Second*time.Duration(cfg.Webhooks.Timeout)), logger, usecase.WebhookDispatcherOptions{
+			PollInterval: time.Second * time.Duration(cfg.Webhooks.PollInterval),
+			BatchSize:    cfg.Webhooks.BatchSize,
+			MaxAttempts:  cfg.Webhooks.MaxAttempts,
+			BaseBackoff:  time.Second * 5,
+			MaxBackoff:   time.Hour * 6,
+			Retention:    time.Hour * 24 * time.Duration(cfg.Webhooks.RetentionDays),
+		})
+		background.Add(2)
+		go func() {
+			defer background.Done()
+			dispatcher.Run(bgCtx)
+		}()
+		go func() {
+			defer background.Done()
+			purgeWebhookDeliveries(bgCtx, logger, dispatcher)
+		}()
+	}
+
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		lis, err := net.Listen("tcp", fmt.Sprintf("%s%s", cfg.Server.Host, cfg.GRPC.Port))
+		if err != nil {
+			return fmt.Errorf("failed to listen grpc: %w", err)
+		}
+
+		grpcOpts := []grpc.ServerOption{
+			grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(tracerProvider))),
+			grpc.ChainUnaryInterceptor(
+				grpcadapter.RequestMetaInterceptor(clientAuthService),
+				grpcadapter.LoggerInterceptor(logger),
+				grpcadapter.TimeoutInterceptor(time.Second*time.Duration(cfg.Server.RequestDuration)),
+			),
+		}
+		// gRPC is served with the certificate and client CAs of the HTTP server, so that
+		// tokens and client certificates are not sent in the clear on either port.
+		if srv.TLSConfig != nil {
+			grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(srv.TLSConfig.Clone())))
+		}
+		grpcServer = grpc.NewServer(grpcOpts...)
+		authv1.RegisterAuthServiceServer(grpcServer, grpcadapter.NewServer(logger, authService, tokenService, revocationService))
+
+		go func() {
+			logger.Info("grpc server started", "host", lis.Addr().String(), "tls", srv.TLSConfig != nil)
+			if err := grpcServer.Serve(lis); err != nil {
+				logger.Error("grpc server error", "error", err)
+			}
+		}()
 	}
 
 	sig := make(chan os.Signal, 1)
@@ -85,8 +348,8 @@ func run(ctx context.Context) error {
 
 	serverErr := make(chan error, 1)
 	go func() {
-		logger.Info("server started", "host", srv.Addr)
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Info("server started", "host", srv.Addr, "tls", srv.TLSConfig != nil)
+		if err := listenAndServe(&srv); err != nil && err != http.ErrServerClosed {
 			serverErr <- err
 		} else {
 			serverErr <- nil
@@ -98,9 +361,20 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("server error: %w", err)
 	case s := <-sig:
 		logger.Info("initialization gracefull shutdown", "signal", s)
+
+		healthService.Drain()
+		if d := time.Second * time.Duration(cfg.Health.DrainDelay); d > 0 {
+			logger.Info("draining before shutdown", "delay", d.String())
+			time.Sleep(d)
+		}
+
 		shutdownCtx, cancel := context.WithTimeout(ctx, time.Second*5)
 		defer cancel()
 
+		if grpcServer != nil {
+			stopGRPC(shutdownCtx, grpcServer)
+		}
+
 		if err := srv.Shutdown(shutdownCtx); err != nil {
 			return fmt.Errorf("shutdown error: %w", err)
 		}
@@ -108,3 +382,428 @@ func run(ctx context.Context) error {
 		return nil
 	}
 }
+
+// serverTLSConfig loads the certificate of the server and the CAs client certificates
+// are verified against. Clients are not required to present a certificate.
+func serverTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load tls certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read tls client ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in tls client ca %s", cfg.ClientCAFile)
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return tlsConfig, nil
+}
+
+// listenAndServe serves srv over TLS if it has a TLS config.
+func listenAndServe(srv *http.Server) error {
+	if srv.TLSConfig != nil {
+		return srv.ListenAndServeTLS("", "")
+	}
+	return srv.ListenAndServe()
+}
+
+// openStorage opens the storage adapter selected in the config. The returned func
+// releases its resources.
+func openStorage(ctx context.Context, cfg *config.Config, logger *slog.Logger) (storage.Storage, func() error, error) {
+	if cfg.Storage.Driver == "memory" {
+		logger.Warn("using in-memory storage, data will be lost on restart")
+		return memory.New(), func() error { return nil }, nil
+	}
+
+	db, err := openDB(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrate := cfg.Postgres.AutoMigrate
+	if cfg.Storage.Driver == "sqlite" {
+		migrate = cfg.Storage.SQLite.AutoMigrate
+	}
+
+	if migrate {
+		if err := autoMigrate(ctx, cfg, db, logger); err != nil {
+			db.Close()
+			return nil, nil, fmt.Errorf("auto migrate: %w", err)
+		}
+	}
+
+	return newStorage(cfg, db), db.Close, nil
+}
+
+// openDB opens the database of the configured SQL storage driver.
+func openDB(cfg *config.Config) (*sql.DB, error) {
+	switch cfg.Storage.Driver {
+	case "sqlite":
+		return sqlite.Open(cfg.Storage.SQLite.Path)
+	case "memory":
+		return nil, fmt.Errorf("storage driver %q has no database", cfg.Storage.Driver)
+	}
+
+	databaseDSN := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.Username, cfg.Postgres.Password, cfg.Postgres.DBname, cfg.Postgres.Sslmode,
+	)
+
+	db, err := sql.Open("postgres", databaseDSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open db: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping db: %w", err)
+	}
+
+	return db, nil
+}
+
+func newStorage(cfg *config.Config, db *sql.DB) storage.Storage {
+	if cfg.Storage.Driver == "sqlite" {
+		return sqlite.New(db)
+	}
+	return postgres.New(db)
+}
+
+// migrator is implemented by the Postgres and SQLite migrators.
+type migrator interface {
+	WithLock(ctx context.Context, fn func() error) error
+	Up() error
+	Down(steps int) error
+	Force(version int) error
+	Version() (uint, bool, error)
+	Close() error
+}
+
+func newMigrator(ctx context.Context, cfg *config.Config, db *sql.DB) (migrator, error) {
+	if cfg.Storage.Driver == "sqlite" {
+		return sqlite.NewMigrator(db)
+	}
+	return postgres.NewMigrator(ctx, db)
+}
+
+// autoMigrate applies pending migrations on start. Postgres replicas starting together
+// serialize on the migration advisory lock, so only the first one migrates.
+func autoMigrate(ctx context.Context, cfg *config.Config, db *sql.DB, logger *slog.Logger) error {
+	migrator, err := newMigrator(ctx, cfg, db)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	return migrator.WithLock(ctx, func() error {
+		if err := migrator.Up(); err != nil {
+			return err
+		}
+
+		version, _, err := migrator.Version()
+		if err != nil {
+			return err
+		}
+		logger.Info("migrations applied", "version", version)
+		return nil
+	})
+}
+
+// stopGRPC waits for in-flight RPCs to finish until ctx expires, then closes the
+// remaining connections.
+func stopGRPC(ctx context.Context, s *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.Stop()
+	}
+}
+
+func tokenOptions(cfg *config.Config) usecase.TokenOptions {
+	opts := usecase.TokenOptions{
+		Issuer:         cfg.Tokens.Issuer,
+		Audiences:      cfg.Tokens.Audiences,
+		AccessTTL:      time.Second * time.Duration(cfg.Tokens.AccessTTL),
+		RefreshTTL:     time.Second * time.Duration(cfg.Tokens.RefreshTTL),
+		Leeway:         time.Second * time.Duration(cfg.Tokens.Leeway),
+		Clients:        make(map[string]usecase.ClientTokenOptions, len(cfg.Tokens.Clients)),
+		MaxClaimsBytes: cfg.Claims.MaxBytes,
+		RetireGrace:    time.Minute * time.Duration(cfg.SigningKeys.RetireGrace),
+	}
+
+	for _, c := range cfg.Tokens.Clients {
+		opts.Clients[c.ID] = usecase.ClientTokenOptions{
+			Audiences:  c.Audiences,
+			AccessTTL:  time.Second * time.Duration(c.AccessTTL),
+			RefreshTTL: time.Second * time.Duration(c.RefreshTTL),
+		}
+	}
+
+	return opts
+}
+
+// checkRetireGrace rejects a retire grace shorter than the longest access token
+// lifetime, which would cut off tokens signed with a retired key before they expire.
+func checkRetireGrace(cfg *config.Config) error {
+	tokens := tokenOptions(cfg)
+	if tokens.RetireGrace < tokens.MaxAccessTTL() {
+		return fmt.Errorf("signing_keys.retire_grace of %s is shorter than the access token lifetime of %s", tokens.RetireGrace, tokens.MaxAccessTTL())
+	}
+	return nil
+}
+
+func exchangeOptions(cfg *config.Config) usecase.TokenExchangeOptions {
+	opts := usecase.TokenExchangeOptions{
+		Clients: make(map[string]usecase.TokenExchangePolicy, len(cfg.Exchange.Clients)),
+	}
+
+	for _, c := range cfg.Exchange.Clients {
+		opts.Clients[c.ID] = usecase.TokenExchangePolicy{
+			Audiences: c.Audiences,
+			Scopes:    c.Scopes,
+		}
+	}
+
+	return opts
+}
+
+func clientAuthOptions(cfg *config.Config) usecase.ClientAuthOptions {
+	opts := usecase.ClientAuthOptions{
+		Clients: make(map[string]usecase.ClientCredentials, len(cfg.ClientAuth.Clients)),
+	}
+
+	for _, c := range cfg.ClientAuth.Clients {
+		opts.Clients[c.ID] = usecase.ClientCredentials{
+			SecretHash: strings.ToLower(c.SecretHash),
+			SubjectDN:  c.SubjectDN,
+			DNSName:    c.DNSName,
+			URI:        c.URI,
+		}
+	}
+
+	return opts
+}
+
+// claimsProviders returns the enabled claims providers. The callback comes last, so it
+// can override attributes.
+func claimsProviders(cfg *config.Config, storage storage.Storage) []usecase.ClaimsProvider {
+	var providers []usecase.ClaimsProvider
+	if cfg.Claims.Attributes {
+		providers = append(providers, usecase.NewAttributeClaimsProvider(storage.UserAttribute()))
+	}
+	if cfg.Claims.CallbackURL != "" {
+		providers = append(providers, claims.NewHTTPProvider(cfg.Claims.CallbackURL, time.Second*time.Duration(cfg.Claims.CallbackTimeout)))
+	}
+	return providers
+}
+
+func revocationOptions(cfg *config.Config) usecase.RevocationOptions {
+	tokens := tokenOptions(cfg)
+
+	return usecase.RevocationOptions{
+		CacheTTL:  time.Second * time.Duration(cfg.Revocation.CacheTTL),
+		AccessTTL: tokens.MaxAccessTTL(),
+		Leeway:    tokens.Leeway,
+	}
+}
+
+func sessionOptions(cfg *config.Config) usecase.SessionOptions {
+	return usecase.SessionOptions{
+		Default: usecase.SessionPolicy{
+			AbsoluteTTL: time.Second * time.Duration(cfg.Sessions.AbsoluteTTL),
+			IdleTTL:     time.Second * time.Duration(cfg.Sessions.IdleTTL),
+		},
+		RememberMe: usecase.SessionPolicy{
+			AbsoluteTTL: time.Second * time.Duration(cfg.Sessions.RememberMe.AbsoluteTTL),
+			IdleTTL:     time.Second * time.Duration(cfg.Sessions.RememberMe.IdleTTL),
+		},
+	}
+}
+
+func newNotifier(cfg *config.Config) usecase.Notifier {
+	if cfg.Notify.Driver == "smtp" {
+		smtp := cfg.Notify.SMTP
+		return notify.NewSMTPNotifier(smtp.Host, smtp.Port, smtp.Username, smtp.Password, cfg.Notify.From, time.Second*time.Duration(smtp.Timeout))
+	}
+	return notify.NewStdoutNotifier()
+}
+
+// otpSenders returns the notifiers one-time codes are sent through, by channel. Codes
+// by email go through notifier; SMS is only offered if an SMS driver is configured.
+func otpSenders(cfg *config.Config, notifier usecase.Notifier) (map[string]usecase.Notifier, error) {
+	senders := map[string]usecase.Notifier{domain.OTPChannelEmail: notifier}
+
+	sms := cfg.Notify.SMS
+	switch sms.Driver {
+	case "stdout":
+		senders[domain.OTPChannelSMS] = notify.NewStdoutNotifier()
+	case "file":
+		n, err := notify.NewFileNotifier(sms.File)
+		if err != nil {
+			return nil, fmt.Errorf("sms notifier: %w", err)
+		}
+		senders[domain.OTPChannelSMS] = n
+	case "twilio":
+		senders[domain.OTPChannelSMS] = notify.NewTwilioNotifier(sms.Twilio.AccountSID, sms.Twilio.AuthToken, sms.Twilio.From, sms.Twilio.APIURL, time.Second*time.Duration(sms.Twilio.Timeout))
+	}
+
+	return senders, nil
+}
+
+func outboxSinks(cfg *config.Config) []usecase.EventSink {
+	var sinks []usecase.EventSink
+	if cfg.Outbox.Stdout {
+		sinks = append(sinks, eventsink.NewStdoutSink())
+	}
+	if cfg.Outbox.WebhookURL != "" {
+		sinks = append(sinks, eventsink.NewWebhookSink(cfg.Outbox.WebhookURL, time.Second*time.Duration(cfg.Outbox.WebhookTimeout)))
+	}
+	return sinks
+}
+
+func purgeAuthEvents(ctx context.Context, logger *slog.Logger, auditService usecase.AuditService) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		n, err := auditService.PurgeExpired(ctx)
+		if err != nil {
+			logger.Error("auth events purge failed", "error", err)
+		} else if n > 0 {
+			logger.Info("auth events purged", "rows", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeOutboxEvents deletes the outbox events whose retention period has passed since
+// they were published.
+func purgeOutboxEvents(ctx context.Context, logger *slog.Logger, relay usecase.OutboxRelay) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		n, err := relay.PurgePublished(ctx)
+		if err != nil {
+			logger.Error("outbox events purge failed", "error", err)
+		} else if n > 0 {
+			logger.Info("outbox events purged", "rows", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeWebhookDeliveries deletes the finished webhook deliveries whose retention
+// period has passed.
+func purgeWebhookDeliveries(ctx context.Context, logger *slog.Logger, dispatcher usecase.WebhookDispatcher) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		n, err := dispatcher.PurgeFinished(ctx)
+		if err != nil {
+			logger.Error("webhook deliveries purge failed", "error", err)
+		} else if n > 0 {
+			logger.Info("webhook deliveries purged", "rows", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeDeletedAccounts deletes the accounts whose deletion grace period has passed.
+func purgeDeletedAccounts(ctx context.Context, logger *slog.Logger, accountService usecase.AccountService) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		n, err := accountService.PurgeDeletedAccounts(ctx)
+		if err != nil {
+			logger.Error("deleted accounts purge failed", "error", err)
+		} else if n > 0 {
+			logger.Info("deleted accounts purged", "users", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reloadSigningKeys picks up keys rotated by "auth-service keys rotate", which may run
+// against another replica or from a separate process.
+func reloadSigningKeys(ctx context.Context, logger *slog.Logger, signingKeyService usecase.SigningKeyService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := signingKeyService.ReloadSigningKeys(ctx); err != nil {
+			logger.Error("signing keys reload failed", "error", err)
+		}
+	}
+}
+
+func reportActiveRefreshTokens(ctx context.Context, logger *slog.Logger, tokenRepo repository.TokenRepository) {
+	ticker := time.NewTicker(time.Second * 30)
+	defer ticker.Stop()
+
+	for {
+		n, err := tokenRepo.CountActiveRefreshTokens(ctx)
+		if err != nil {
+			logger.Error("count active refresh tokens failed", "error", err)
+		} else {
+			metrics.ActiveRefreshTokens.Set(float64(n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/vo1dFl0w/auth-service/internal/config"
+)
+
+const migrateUsage = "usage: auth-service migrate up | down [N] | version | force VERSION"
+
+// runMigrate implements "auth-service migrate" for the configured SQL storage driver.
+// Every subcommand except version runs under the migration lock.
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	db, err := openDB(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := newMigrator(ctx, cfg, db)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	switch args[0] {
+	case "up":
+		if err := migrator.WithLock(ctx, migrator.Up); err != nil {
+			return fmt.Errorf("migrate up: %w", err)
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q: %s", args[1], migrateUsage)
+			}
+		}
+
+		if err := migrator.WithLock(ctx, func() error { return migrator.Down(steps) }); err != nil {
+			return fmt.Errorf("migrate down: %w", err)
+		}
+	case "force":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %s", args[1], migrateUsage)
+		}
+
+		if err := migrator.WithLock(ctx, func() error { return migrator.Force(version) }); err != nil {
+			return fmt.Errorf("migrate force: %w", err)
+		}
+	case "version":
+	default:
+		return fmt.Errorf("unknown migrate command %q: %s", args[0], migrateUsage)
+	}
+
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return fmt.Errorf("read version: %w", err)
+	}
+
+	fmt.Printf("version: %d, dirty: %t\n", version, dirty)
+	return nil
+}
@@ -0,0 +1,27 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (user_id, name, key_prefix, key_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, name, key_prefix, scopes, created_at, expires_at, last_used_at;
+
+-- name: ListAPIKeysByUser :many
+SELECT id, user_id, name, key_prefix, scopes, created_at, expires_at, last_used_at
+FROM api_keys
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: FindAPIKeyByHash :one
+SELECT k.id, k.user_id, k.name, k.key_prefix, k.scopes, k.created_at, k.expires_at, k.last_used_at
+FROM api_keys k
+JOIN users u ON u.user_id = k.user_id
+WHERE k.key_hash = $1
+  AND u.is_active;
+
+-- name: TouchAPIKey :exec
+UPDATE api_keys
+SET last_used_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteAPIKey :one
+DELETE FROM api_keys
+WHERE id = $1 AND user_id = $2
+RETURNING id;
\ No newline at end of file
@@ -0,0 +1,25 @@
+-- name: CreateAuthEvent :exec
+INSERT INTO auth_events (event_type, user_id, ip, user_agent, outcome, reason, request_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListAuthEventsByUser :many
+SELECT id, event_type, user_id, ip, user_agent, outcome, reason, request_id, created_at
+FROM auth_events
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListAuthEvents :many
+SELECT id, event_type, user_id, ip, user_agent, outcome, reason, request_id, created_at
+FROM auth_events
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('event_type')::text IS NULL OR event_type = sqlc.narg('event_type'))
+  AND (sqlc.narg('outcome')::text IS NULL OR outcome = sqlc.narg('outcome'))
+  AND (sqlc.narg('since')::timestamptz IS NULL OR created_at >= sqlc.narg('since'))
+  AND (sqlc.narg('until')::timestamptz IS NULL OR created_at < sqlc.narg('until'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: DeleteAuthEventsBefore :execrows
+DELETE FROM auth_events
+WHERE created_at < $1;
\ No newline at end of file
@@ -1,14 +1,56 @@
 -- name: CreateUser :one
 INSERT INTO users (email, password_hash)
 VALUES($1, $2)
-RETURNING user_id, email, created_at, is_active;
+RETURNING user_id, email, created_at, is_active, role;
 
 -- name: GetUserInfo :one
-SELECT user_id, email, created_at, is_active
+SELECT user_id, email, created_at, is_active, role
 FROM users
 WHERE user_id = $1;
 
 -- name: FindUserByEmail :one
-SELECT user_id, email, password_hash, created_at, is_active
+SELECT user_id, email, password_hash, created_at, is_active, role
 FROM users
-WHERE email = $1;
\ No newline at end of file
+WHERE email = $1;
+
+-- name: UpdateUserPassword :execrows
+UPDATE users
+SET password_hash = $2
+WHERE user_id = $1;
+
+-- name: DeactivateUser :one
+UPDATE users
+SET is_active = FALSE
+WHERE user_id = $1
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: UpdateUserEmail :one
+UPDATE users
+SET email = $2
+WHERE user_id = $1
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: ScheduleUserDeletion :one
+UPDATE users
+SET is_active = FALSE, delete_after = $2
+WHERE user_id = $1
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: CancelUserDeletion :one
+UPDATE users
+SET is_active = TRUE, delete_after = NULL
+WHERE user_id = $1
+  AND delete_after IS NOT NULL
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: ListUsersDueForDeletion :many
+SELECT user_id
+FROM users
+WHERE delete_after <= sqlc.arg('now')
+ORDER BY delete_after
+LIMIT sqlc.arg('batch_size');
+
+-- name: DeleteScheduledUser :execrows
+DELETE FROM users
+WHERE user_id = $1
+  AND delete_after <= sqlc.arg('now');
\ No newline at end of file
@@ -0,0 +1,45 @@
+-- name: SaveDeviceCode :exec
+INSERT INTO device_codes (device_code_hash, user_code_hash, client_id, poll_interval, expires_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetDeviceCode :one
+SELECT device_code_hash, user_code_hash, client_id, status, user_id, poll_interval, last_polled_at, expires_at, created_at
+FROM device_codes
+WHERE device_code_hash = $1;
+
+-- name: GetDeviceCodeByUserCode :one
+SELECT device_code_hash, user_code_hash, client_id, status, user_id, poll_interval, last_polled_at, expires_at, created_at
+FROM device_codes
+WHERE user_code_hash = $1;
+
+-- name: DecideDeviceCode :execrows
+UPDATE device_codes
+SET status = $2,
+    user_id = $3
+WHERE user_code_hash = $1 AND status = 'pending';
+
+-- name: PollDeviceCode :execrows
+UPDATE device_codes
+SET last_polled_at = sqlc.arg('polled_at')
+WHERE device_code_hash = sqlc.arg('device_code_hash')
+  AND (last_polled_at IS NULL OR last_polled_at <= sqlc.arg('polled_before'));
+
+-- name: SlowDownDeviceCode :exec
+UPDATE device_codes
+SET poll_interval = poll_interval + sqlc.arg('step')
+WHERE device_code_hash = sqlc.arg('device_code_hash');
+
+-- name: DeleteDeviceCode :execrows
+DELETE FROM device_codes
+WHERE device_code_hash = $1;
+
+-- name: DeleteExpiredDeviceCodes :execrows
+DELETE FROM device_codes
+WHERE device_code_hash IN (
+    SELECT device_code_hash
+    FROM device_codes
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+);
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- name: SaveDPoPProof :execrows
+INSERT INTO dpop_proofs (proof_hash, expires_at)
+VALUES ($1, $2)
+ON CONFLICT (proof_hash) DO NOTHING;
+
+-- name: DeleteExpiredDPoPProofs :execrows
+DELETE FROM dpop_proofs
+WHERE proof_hash IN (
+    SELECT proof_hash
+    FROM dpop_proofs
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+);
\ No newline at end of file
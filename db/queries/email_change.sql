@@ -0,0 +1,19 @@
+-- name: SaveEmailChange :exec
+INSERT INTO email_changes (user_id, new_email, confirm_token_hash, cancel_token_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET new_email = EXCLUDED.new_email,
+    confirm_token_hash = EXCLUDED.confirm_token_hash,
+    cancel_token_hash = EXCLUDED.cancel_token_hash,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW();
+
+-- name: ConsumeEmailChange :one
+DELETE FROM email_changes
+WHERE confirm_token_hash = $1
+RETURNING user_id, new_email, expires_at, created_at;
+
+-- name: CancelEmailChange :one
+DELETE FROM email_changes
+WHERE cancel_token_hash = $1
+RETURNING user_id, new_email, expires_at, created_at;
\ No newline at end of file
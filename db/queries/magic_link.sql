@@ -0,0 +1,23 @@
+-- name: SaveMagicLink :exec
+INSERT INTO magic_links (user_id, token_hash, binding_hash, remember_me, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    binding_hash = EXCLUDED.binding_hash,
+    remember_me = EXCLUDED.remember_me,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW();
+
+-- name: ConsumeMagicLink :one
+DELETE FROM magic_links
+WHERE token_hash = $1
+RETURNING user_id, binding_hash, remember_me, expires_at, created_at;
+
+-- name: CountMagicLinkRequest :one
+INSERT INTO magic_link_sends (user_id, requests, window_started_at, window_ends_at)
+VALUES ($1, 1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET requests = CASE WHEN magic_link_sends.window_ends_at > EXCLUDED.window_started_at THEN magic_link_sends.requests + 1 ELSE 1 END,
+    window_started_at = CASE WHEN magic_link_sends.window_ends_at > EXCLUDED.window_started_at THEN magic_link_sends.window_started_at ELSE EXCLUDED.window_started_at END,
+    window_ends_at = CASE WHEN magic_link_sends.window_ends_at > EXCLUDED.window_started_at THEN magic_link_sends.window_ends_at ELSE EXCLUDED.window_ends_at END
+RETURNING requests;
\ No newline at end of file
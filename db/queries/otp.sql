@@ -0,0 +1,34 @@
+-- name: SaveOTP :exec
+INSERT INTO otp_codes (user_id, channel, code_hash, remember_me, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET channel = EXCLUDED.channel,
+    code_hash = EXCLUDED.code_hash,
+    attempts = 0,
+    remember_me = EXCLUDED.remember_me,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW();
+
+-- name: GetOTP :one
+SELECT user_id, channel, code_hash, attempts, remember_me, expires_at, created_at
+FROM otp_codes
+WHERE user_id = $1;
+
+-- name: AttemptOTP :one
+UPDATE otp_codes
+SET attempts = attempts + 1
+WHERE user_id = $1
+RETURNING user_id, channel, code_hash, attempts, remember_me, expires_at, created_at;
+
+-- name: DeleteOTP :execrows
+DELETE FROM otp_codes
+WHERE user_id = $1 AND code_hash = $2;
+
+-- name: CountOTPRequest :one
+INSERT INTO otp_requests (user_id, requests, window_started_at, window_ends_at)
+VALUES ($1, 1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET requests = CASE WHEN otp_requests.window_ends_at > EXCLUDED.window_started_at THEN otp_requests.requests + 1 ELSE 1 END,
+    window_started_at = CASE WHEN otp_requests.window_ends_at > EXCLUDED.window_started_at THEN otp_requests.window_started_at ELSE EXCLUDED.window_started_at END,
+    window_ends_at = CASE WHEN otp_requests.window_ends_at > EXCLUDED.window_started_at THEN otp_requests.window_ends_at ELSE EXCLUDED.window_ends_at END
+RETURNING requests;
\ No newline at end of file
@@ -0,0 +1,36 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (aggregate_id, event_type, payload)
+VALUES ($1, $2, $3);
+
+-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET next_attempt_at = sqlc.arg('lease_until')
+WHERE id IN (
+    SELECT e.id
+    FROM outbox_events e
+    WHERE e.published_at IS NULL
+      AND e.next_attempt_at <= NOW()
+      AND e.attempts < sqlc.arg('max_attempts')
+    ORDER BY e.created_at
+    LIMIT sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, aggregate_id, event_type, payload, created_at, attempts;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = NOW(), attempts = attempts + 1, last_error = ''
+WHERE id = $1;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+WHERE id = $1;
+
+-- name: DeleteOutboxEventsByAggregate :execrows
+DELETE FROM outbox_events
+WHERE aggregate_id = $1;
+
+-- name: DeletePublishedOutboxEventsBefore :execrows
+DELETE FROM outbox_events
+WHERE published_at < $1;
\ No newline at end of file
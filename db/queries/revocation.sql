@@ -0,0 +1,44 @@
+-- name: RevokeAccessToken :exec
+INSERT INTO revoked_access_tokens (jti, user_id, expires_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (jti) DO NOTHING;
+
+-- name: RevokeUserAccessTokens :exec
+INSERT INTO user_access_token_revocations (user_id, issued_before, expires_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET issued_before = GREATEST(user_access_token_revocations.issued_before, EXCLUDED.issued_before),
+    expires_at = GREATEST(user_access_token_revocations.expires_at, EXCLUDED.expires_at);
+
+-- name: IsAccessTokenRevoked :one
+SELECT EXISTS (
+    SELECT 1
+    FROM revoked_access_tokens r
+    WHERE r.jti = sqlc.arg(jti)
+) OR EXISTS (
+    SELECT 1
+    FROM user_access_token_revocations u
+    WHERE u.user_id = sqlc.arg(user_id) AND u.issued_before >= sqlc.arg(issued_at)
+) AS revoked;
+
+-- name: DeleteExpiredRevokedAccessTokens :execrows
+DELETE FROM revoked_access_tokens
+WHERE jti IN (
+    SELECT jti
+    FROM revoked_access_tokens
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+);
+
+-- name: DeleteExpiredUserAccessTokenRevocations :execrows
+DELETE FROM user_access_token_revocations
+WHERE user_id IN (
+    SELECT user_id
+    FROM user_access_token_revocations
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+);
\ No newline at end of file
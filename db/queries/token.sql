@@ -1,14 +1,40 @@
 -- name: FindRefreshToken :one
-SELECT id, user_id, refresh_token_hash, created_at, expires_at
+SELECT id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
 FROM tokens
 WHERE refresh_token_hash = $1;
 
 -- name: SaveHashedRefreshToken :one
-INSERT INTO tokens (user_id, refresh_token_hash, expires_at)
-VALUES ($1, $2, $3)
-RETURNING id, user_id, refresh_token_hash, created_at, expires_at;
+INSERT INTO tokens (user_id, refresh_token_hash, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t;
 
 -- name: DeleteRefreshToken :one
 DELETE FROM tokens
 WHERE refresh_token_hash = $1
-RETURNING user_id, refresh_token_hash, expires_at;
\ No newline at end of file
+RETURNING user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t;
+
+-- name: CountActiveRefreshTokens :one
+SELECT COUNT(*)
+FROM tokens
+WHERE expires_at > now();
+
+-- name: ListRefreshTokensByUser :many
+SELECT id, user_id, created_at, expires_at, session_started_at, remember_me
+FROM tokens
+WHERE user_id = $1 AND expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: DeleteRefreshTokensByUser :execrows
+DELETE FROM tokens
+WHERE user_id = $1;
+
+-- name: DeleteExpiredRefreshTokens :execrows
+DELETE FROM tokens
+WHERE id IN (
+    SELECT id
+    FROM tokens
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+);
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- name: ListUserAttributes :many
+SELECT name, value, updated_at
+FROM user_attributes
+WHERE user_id = $1
+ORDER BY name;
+
+-- name: SetUserAttribute :exec
+INSERT INTO user_attributes (user_id, name, value)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, name) DO UPDATE
+SET value = EXCLUDED.value, updated_at = NOW();
+
+-- name: DeleteUserAttribute :execrows
+DELETE FROM user_attributes
+WHERE user_id = $1 AND name = $2;
\ No newline at end of file
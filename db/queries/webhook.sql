@@ -0,0 +1,68 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, event_types)
+VALUES ($1, $2, $3)
+RETURNING id, url, secret, event_types, created_at;
+
+-- name: ListWebhookSubscriptions :many
+SELECT id, url, secret, event_types, created_at
+FROM webhook_subscriptions
+ORDER BY created_at;
+
+-- name: ListWebhookSubscriptionsByEvent :many
+SELECT id, url, secret, event_types, created_at
+FROM webhook_subscriptions
+WHERE sqlc.arg('event_type')::TEXT = ANY(event_types);
+
+-- name: DeleteWebhookSubscription :one
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+RETURNING id;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: ClaimWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg('lease_until')
+FROM webhook_subscriptions s
+WHERE s.id = d.subscription_id
+  AND d.id IN (
+    SELECT c.id
+    FROM webhook_deliveries c
+    WHERE c.status = 'pending'
+      AND c.next_attempt_at <= NOW()
+    ORDER BY c.next_attempt_at
+    LIMIT sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.attempts, d.created_at, s.url, s.secret;
+
+-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded', attempts = attempts + 1, last_status_code = $2, last_error = '', updated_at = NOW()
+WHERE id = $1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = $2, attempts = attempts + 1, next_attempt_at = $3, last_status_code = $4, last_error = $5, updated_at = NOW()
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, created_at, updated_at
+FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg('subscription_id')
+  AND (sqlc.narg('status')::TEXT IS NULL OR status = sqlc.narg('status'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+
+-- name: DeleteWebhookDeliveriesByAggregate :execrows
+DELETE FROM webhook_deliveries
+WHERE event_id IN (SELECT id FROM outbox_events WHERE aggregate_id = $1);
+
+-- name: DeleteFinishedWebhookDeliveriesBefore :execrows
+DELETE FROM webhook_deliveries
+WHERE status <> 'pending'
+  AND updated_at < $1;
\ No newline at end of file
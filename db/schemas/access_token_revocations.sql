@@ -0,0 +1,16 @@
+CREATE TABLE revoked_access_tokens (
+    jti TEXT PRIMARY KEY NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX revoked_access_tokens_expires_at_idx ON revoked_access_tokens (expires_at);
+
+CREATE TABLE user_access_token_revocations (
+    user_id UUID PRIMARY KEY NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    issued_before TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX user_access_token_revocations_expires_at_idx ON user_access_token_revocations (expires_at);
\ No newline at end of file
@@ -3,5 +3,9 @@ CREATE TABLE users (
     email TEXT UNIQUE NOT NULL,
     password_hash TEXT NOT NULL,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    is_active BOOLEAN NOT NULL DEFAULT TRUE
-);
\ No newline at end of file
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    role TEXT NOT NULL DEFAULT 'user',
+    delete_after TIMESTAMPTZ
+);
+
+CREATE INDEX users_delete_after_idx ON users(delete_after) WHERE delete_after IS NOT NULL;
\ No newline at end of file
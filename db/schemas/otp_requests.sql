@@ -0,0 +1,6 @@
+CREATE TABLE otp_requests (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    requests INTEGER NOT NULL DEFAULT 0,
+    window_started_at TIMESTAMPTZ NOT NULL,
+    window_ends_at TIMESTAMPTZ NOT NULL
+);
\ No newline at end of file
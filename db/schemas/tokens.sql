@@ -3,5 +3,12 @@ CREATE TABLE tokens (
     user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
     refresh_token_hash TEXT NOT NULL UNIQUE,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    expires_at TIMESTAMPTZ NOT NULL
-);
\ No newline at end of file
+    expires_at TIMESTAMPTZ NOT NULL,
+    session_started_at TIMESTAMPTZ NOT NULL,
+    remember_me BOOLEAN NOT NULL DEFAULT FALSE,
+    dpop_jkt TEXT NOT NULL DEFAULT '',
+    client_id TEXT NOT NULL DEFAULT '',
+    x5t TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX tokens_expires_at_idx ON tokens (expires_at);
\ No newline at end of file
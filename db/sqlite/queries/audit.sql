@@ -0,0 +1,25 @@
+-- name: CreateAuthEvent :exec
+INSERT INTO auth_events (id, event_type, user_id, ip, user_agent, outcome, reason, request_id, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListAuthEventsByUser :many
+SELECT id, event_type, user_id, ip, user_agent, outcome, reason, request_id, created_at
+FROM auth_events
+WHERE user_id = ?
+ORDER BY created_at DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListAuthEvents :many
+SELECT id, event_type, user_id, ip, user_agent, outcome, reason, request_id, created_at
+FROM auth_events
+WHERE (sqlc.narg('user_id') IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('event_type') IS NULL OR event_type = sqlc.narg('event_type'))
+  AND (sqlc.narg('outcome') IS NULL OR outcome = sqlc.narg('outcome'))
+  AND (sqlc.narg('since') IS NULL OR created_at >= sqlc.narg('since'))
+  AND (sqlc.narg('until') IS NULL OR created_at < sqlc.narg('until'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: DeleteAuthEventsBefore :execrows
+DELETE FROM auth_events
+WHERE created_at < ?;
\ No newline at end of file
@@ -0,0 +1,56 @@
+-- name: CreateUser :one
+INSERT INTO users (user_id, email, password_hash, created_at)
+VALUES (?, ?, ?, ?)
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: GetUserInfo :one
+SELECT user_id, email, created_at, is_active, role
+FROM users
+WHERE user_id = ?;
+
+-- name: FindUserByEmail :one
+SELECT user_id, email, password_hash, created_at, is_active, role
+FROM users
+WHERE email = ?;
+
+-- name: UpdateUserPassword :execrows
+UPDATE users
+SET password_hash = sqlc.arg('password_hash')
+WHERE user_id = sqlc.arg('user_id');
+
+-- name: DeactivateUser :one
+UPDATE users
+SET is_active = FALSE
+WHERE user_id = ?
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: UpdateUserEmail :one
+UPDATE users
+SET email = sqlc.arg('email')
+WHERE user_id = sqlc.arg('user_id')
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: ScheduleUserDeletion :one
+UPDATE users
+SET is_active = FALSE, delete_after = sqlc.arg('delete_after')
+WHERE user_id = sqlc.arg('user_id')
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: CancelUserDeletion :one
+UPDATE users
+SET is_active = TRUE, delete_after = NULL
+WHERE user_id = ?
+  AND delete_after IS NOT NULL
+RETURNING user_id, email, created_at, is_active, role;
+
+-- name: ListUsersDueForDeletion :many
+SELECT user_id
+FROM users
+WHERE delete_after <= sqlc.arg('now')
+ORDER BY delete_after
+LIMIT sqlc.arg('batch_size');
+
+-- name: DeleteScheduledUser :execrows
+DELETE FROM users
+WHERE user_id = sqlc.arg('user_id')
+  AND delete_after <= sqlc.arg('now');
\ No newline at end of file
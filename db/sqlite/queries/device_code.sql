@@ -0,0 +1,44 @@
+-- name: SaveDeviceCode :exec
+INSERT INTO device_codes (device_code_hash, user_code_hash, client_id, poll_interval, expires_at, created_at)
+VALUES (sqlc.arg('device_code_hash'), sqlc.arg('user_code_hash'), sqlc.arg('client_id'), sqlc.arg('poll_interval'), sqlc.arg('expires_at'), sqlc.arg('created_at'));
+
+-- name: GetDeviceCode :one
+SELECT device_code_hash, user_code_hash, client_id, status, user_id, poll_interval, last_polled_at, expires_at, created_at
+FROM device_codes
+WHERE device_code_hash = ?;
+
+-- name: GetDeviceCodeByUserCode :one
+SELECT device_code_hash, user_code_hash, client_id, status, user_id, poll_interval, last_polled_at, expires_at, created_at
+FROM device_codes
+WHERE user_code_hash = ?;
+
+-- name: DecideDeviceCode :execrows
+UPDATE device_codes
+SET status = sqlc.arg('status'),
+    user_id = sqlc.arg('user_id')
+WHERE user_code_hash = sqlc.arg('user_code_hash') AND status = 'pending';
+
+-- name: PollDeviceCode :execrows
+UPDATE device_codes
+SET last_polled_at = sqlc.arg('polled_at')
+WHERE device_code_hash = sqlc.arg('device_code_hash')
+  AND (last_polled_at IS NULL OR last_polled_at <= sqlc.arg('polled_before'));
+
+-- name: SlowDownDeviceCode :exec
+UPDATE device_codes
+SET poll_interval = poll_interval + sqlc.arg('step')
+WHERE device_code_hash = sqlc.arg('device_code_hash');
+
+-- name: DeleteDeviceCode :execrows
+DELETE FROM device_codes
+WHERE device_code_hash = ?;
+
+-- name: DeleteExpiredDeviceCodes :execrows
+DELETE FROM device_codes
+WHERE device_code_hash IN (
+    SELECT d.device_code_hash
+    FROM device_codes d
+    WHERE d.expires_at <= sqlc.arg('now')
+    ORDER BY d.expires_at
+    LIMIT sqlc.arg('limit')
+);
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- name: SaveDPoPProof :execrows
+INSERT INTO dpop_proofs (proof_hash, expires_at)
+VALUES (?, ?)
+ON CONFLICT (proof_hash) DO NOTHING;
+
+-- name: DeleteExpiredDPoPProofs :execrows
+DELETE FROM dpop_proofs
+WHERE proof_hash IN (
+    SELECT p.proof_hash
+    FROM dpop_proofs p
+    WHERE p.expires_at <= sqlc.arg('now')
+    ORDER BY p.expires_at
+    LIMIT sqlc.arg('limit')
+);
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- name: SaveEmailChange :exec
+INSERT INTO email_changes (user_id, new_email, confirm_token_hash, cancel_token_hash, expires_at, created_at)
+VALUES (sqlc.arg('user_id'), sqlc.arg('new_email'), sqlc.arg('confirm_token_hash'), sqlc.arg('cancel_token_hash'), sqlc.arg('expires_at'), sqlc.arg('created_at'))
+ON CONFLICT (user_id) DO UPDATE
+SET new_email = excluded.new_email,
+    confirm_token_hash = excluded.confirm_token_hash,
+    cancel_token_hash = excluded.cancel_token_hash,
+    expires_at = excluded.expires_at,
+    created_at = excluded.created_at;
+
+-- name: ConsumeEmailChange :one
+DELETE FROM email_changes
+WHERE confirm_token_hash = ?
+RETURNING user_id, new_email, expires_at, created_at;
+
+-- name: CancelEmailChange :one
+DELETE FROM email_changes
+WHERE cancel_token_hash = ?
+RETURNING user_id, new_email, expires_at, created_at;
\ No newline at end of file
@@ -0,0 +1,23 @@
+-- name: SaveMagicLink :exec
+INSERT INTO magic_links (user_id, token_hash, binding_hash, remember_me, expires_at, created_at)
+VALUES (sqlc.arg('user_id'), sqlc.arg('token_hash'), sqlc.arg('binding_hash'), sqlc.arg('remember_me'), sqlc.arg('expires_at'), sqlc.arg('created_at'))
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = excluded.token_hash,
+    binding_hash = excluded.binding_hash,
+    remember_me = excluded.remember_me,
+    expires_at = excluded.expires_at,
+    created_at = excluded.created_at;
+
+-- name: ConsumeMagicLink :one
+DELETE FROM magic_links
+WHERE token_hash = ?
+RETURNING user_id, binding_hash, remember_me, expires_at, created_at;
+
+-- name: CountMagicLinkRequest :one
+INSERT INTO magic_link_sends (user_id, requests, window_started_at, window_ends_at)
+VALUES (sqlc.arg('user_id'), 1, sqlc.arg('window_started_at'), sqlc.arg('window_ends_at'))
+ON CONFLICT (user_id) DO UPDATE
+SET requests = CASE WHEN magic_link_sends.window_ends_at > excluded.window_started_at THEN magic_link_sends.requests + 1 ELSE 1 END,
+    window_started_at = CASE WHEN magic_link_sends.window_ends_at > excluded.window_started_at THEN magic_link_sends.window_started_at ELSE excluded.window_started_at END,
+    window_ends_at = CASE WHEN magic_link_sends.window_ends_at > excluded.window_started_at THEN magic_link_sends.window_ends_at ELSE excluded.window_ends_at END
+RETURNING requests;
\ No newline at end of file
@@ -0,0 +1,34 @@
+-- name: SaveOTP :exec
+INSERT INTO otp_codes (user_id, channel, code_hash, remember_me, expires_at, created_at)
+VALUES (sqlc.arg('user_id'), sqlc.arg('channel'), sqlc.arg('code_hash'), sqlc.arg('remember_me'), sqlc.arg('expires_at'), sqlc.arg('created_at'))
+ON CONFLICT (user_id) DO UPDATE
+SET channel = excluded.channel,
+    code_hash = excluded.code_hash,
+    attempts = 0,
+    remember_me = excluded.remember_me,
+    expires_at = excluded.expires_at,
+    created_at = excluded.created_at;
+
+-- name: GetOTP :one
+SELECT user_id, channel, code_hash, attempts, remember_me, expires_at, created_at
+FROM otp_codes
+WHERE user_id = ?;
+
+-- name: AttemptOTP :one
+UPDATE otp_codes
+SET attempts = attempts + 1
+WHERE user_id = ?
+RETURNING user_id, channel, code_hash, attempts, remember_me, expires_at, created_at;
+
+-- name: DeleteOTP :execrows
+DELETE FROM otp_codes
+WHERE user_id = sqlc.arg('user_id') AND code_hash = sqlc.arg('code_hash');
+
+-- name: CountOTPRequest :one
+INSERT INTO otp_requests (user_id, requests, window_started_at, window_ends_at)
+VALUES (sqlc.arg('user_id'), 1, sqlc.arg('window_started_at'), sqlc.arg('window_ends_at'))
+ON CONFLICT (user_id) DO UPDATE
+SET requests = CASE WHEN otp_requests.window_ends_at > excluded.window_started_at THEN otp_requests.requests + 1 ELSE 1 END,
+    window_started_at = CASE WHEN otp_requests.window_ends_at > excluded.window_started_at THEN otp_requests.window_started_at ELSE excluded.window_started_at END,
+    window_ends_at = CASE WHEN otp_requests.window_ends_at > excluded.window_started_at THEN otp_requests.window_ends_at ELSE excluded.window_ends_at END
+RETURNING requests;
\ No newline at end of file
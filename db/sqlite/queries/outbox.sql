@@ -0,0 +1,35 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (id, aggregate_id, event_type, payload, created_at, next_attempt_at)
+VALUES (?, ?, ?, ?, sqlc.arg('now'), sqlc.arg('now'));
+
+-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET next_attempt_at = sqlc.arg('lease_until')
+WHERE id IN (
+    SELECT e.id
+    FROM outbox_events e
+    WHERE e.published_at IS NULL
+      AND e.next_attempt_at <= sqlc.arg('now')
+      AND e.attempts < sqlc.arg('max_attempts')
+    ORDER BY e.created_at
+    LIMIT sqlc.arg('batch_size')
+)
+RETURNING id, aggregate_id, event_type, payload, created_at, attempts;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = sqlc.arg('now'), attempts = attempts + 1, last_error = ''
+WHERE id = sqlc.arg('id');
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET attempts = attempts + 1, next_attempt_at = ?, last_error = ?
+WHERE id = ?;
+
+-- name: DeleteOutboxEventsByAggregate :execrows
+DELETE FROM outbox_events
+WHERE aggregate_id = ?;
+
+-- name: DeletePublishedOutboxEventsBefore :execrows
+DELETE FROM outbox_events
+WHERE published_at < sqlc.arg('before');
\ No newline at end of file
@@ -0,0 +1,42 @@
+-- name: RevokeAccessToken :exec
+INSERT INTO revoked_access_tokens (jti, user_id, revoked_at, expires_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (jti) DO NOTHING;
+
+-- name: RevokeUserAccessTokens :exec
+INSERT INTO user_access_token_revocations (user_id, issued_before, expires_at)
+VALUES (?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE
+SET issued_before = MAX(user_access_token_revocations.issued_before, excluded.issued_before),
+    expires_at = MAX(user_access_token_revocations.expires_at, excluded.expires_at);
+
+-- name: IsAccessTokenRevoked :one
+SELECT EXISTS (
+    SELECT 1
+    FROM revoked_access_tokens r
+    WHERE r.jti = sqlc.arg('jti')
+) OR EXISTS (
+    SELECT 1
+    FROM user_access_token_revocations u
+    WHERE u.user_id = sqlc.arg('user_id') AND u.issued_before >= sqlc.arg('issued_at')
+) AS revoked;
+
+-- name: DeleteExpiredRevokedAccessTokens :execrows
+DELETE FROM revoked_access_tokens
+WHERE jti IN (
+    SELECT r.jti
+    FROM revoked_access_tokens r
+    WHERE r.expires_at <= sqlc.arg('now')
+    ORDER BY r.expires_at
+    LIMIT sqlc.arg('limit')
+);
+
+-- name: DeleteExpiredUserAccessTokenRevocations :execrows
+DELETE FROM user_access_token_revocations
+WHERE user_id IN (
+    SELECT r.user_id
+    FROM user_access_token_revocations r
+    WHERE r.expires_at <= sqlc.arg('now')
+    ORDER BY r.expires_at
+    LIMIT sqlc.arg('limit')
+);
\ No newline at end of file
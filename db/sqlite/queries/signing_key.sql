@@ -0,0 +1,15 @@
+-- name: CreateSigningKey :one
+INSERT INTO signing_keys (kid, secret, created_at)
+VALUES (?, ?, ?)
+RETURNING kid, secret, created_at, retired_at;
+
+-- name: ListSigningKeys :many
+SELECT kid, secret, created_at, retired_at
+FROM signing_keys
+WHERE retired_at IS NULL OR retired_at > sqlc.arg('now')
+ORDER BY created_at DESC;
+
+-- name: RetireSigningKeys :execrows
+UPDATE signing_keys
+SET retired_at = sqlc.arg('retired_at')
+WHERE retired_at IS NULL AND kid <> sqlc.arg('kid');
\ No newline at end of file
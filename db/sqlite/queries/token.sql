@@ -0,0 +1,39 @@
+-- name: FindRefreshToken :one
+SELECT id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
+FROM tokens
+WHERE refresh_token_hash = ?;
+
+-- name: SaveHashedRefreshToken :one
+INSERT INTO tokens (id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t;
+
+-- name: DeleteRefreshToken :one
+DELETE FROM tokens
+WHERE refresh_token_hash = ?
+RETURNING user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t;
+
+-- name: CountActiveRefreshTokens :one
+SELECT COUNT(*)
+FROM tokens
+WHERE expires_at > sqlc.arg('now');
+
+-- name: ListRefreshTokensByUser :many
+SELECT id, user_id, created_at, expires_at, session_started_at, remember_me
+FROM tokens
+WHERE user_id = sqlc.arg('user_id') AND expires_at > sqlc.arg('now')
+ORDER BY created_at DESC;
+
+-- name: DeleteRefreshTokensByUser :execrows
+DELETE FROM tokens
+WHERE user_id = ?;
+
+-- name: DeleteExpiredRefreshTokens :execrows
+DELETE FROM tokens
+WHERE id IN (
+    SELECT t.id
+    FROM tokens t
+    WHERE t.expires_at <= sqlc.arg('now')
+    ORDER BY t.expires_at
+    LIMIT sqlc.arg('limit')
+);
\ No newline at end of file
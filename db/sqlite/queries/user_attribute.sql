@@ -0,0 +1,15 @@
+-- name: ListUserAttributes :many
+SELECT name, value, updated_at
+FROM user_attributes
+WHERE user_id = ?
+ORDER BY name;
+
+-- name: SetUserAttribute :exec
+INSERT INTO user_attributes (user_id, name, value, updated_at)
+VALUES (sqlc.arg('user_id'), sqlc.arg('name'), sqlc.arg('value'), sqlc.arg('updated_at'))
+ON CONFLICT (user_id, name) DO UPDATE
+SET value = excluded.value, updated_at = excluded.updated_at;
+
+-- name: DeleteUserAttribute :execrows
+DELETE FROM user_attributes
+WHERE user_id = ? AND name = ?;
\ No newline at end of file
@@ -0,0 +1,68 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (id, url, secret, event_types, created_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, url, secret, event_types, created_at;
+
+-- name: ListWebhookSubscriptions :many
+SELECT id, url, secret, event_types, created_at
+FROM webhook_subscriptions
+ORDER BY created_at;
+
+-- name: ListWebhookSubscriptionsByEvent :many
+SELECT id, url, secret, event_types, created_at
+FROM webhook_subscriptions
+WHERE instr(event_types, json_quote(sqlc.arg('event_type'))) > 0
+ORDER BY created_at;
+
+-- name: DeleteWebhookSubscription :one
+DELETE FROM webhook_subscriptions
+WHERE id = ?
+RETURNING id;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, next_attempt_at, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, sqlc.arg('now'), sqlc.arg('now'), sqlc.arg('now'))
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: ClaimWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = sqlc.arg('lease_until')
+WHERE id IN (
+    SELECT c.id
+    FROM webhook_deliveries c
+    JOIN webhook_subscriptions s ON s.id = c.subscription_id
+    WHERE c.status = 'pending'
+      AND c.next_attempt_at <= sqlc.arg('now')
+    ORDER BY c.next_attempt_at
+    LIMIT sqlc.arg('batch_size')
+)
+RETURNING id, subscription_id, event_id, event_type, payload, attempts, created_at,
+    (SELECT s.url FROM webhook_subscriptions s WHERE s.id = subscription_id) AS url,
+    (SELECT s.secret FROM webhook_subscriptions s WHERE s.id = subscription_id) AS secret;
+
+-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded', attempts = attempts + 1, last_status_code = sqlc.arg('last_status_code'), last_error = '', updated_at = sqlc.arg('now')
+WHERE id = sqlc.arg('id');
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = sqlc.arg('status'), attempts = attempts + 1, next_attempt_at = sqlc.arg('next_attempt_at'), last_status_code = sqlc.arg('last_status_code'), last_error = sqlc.arg('last_error'), updated_at = sqlc.arg('now')
+WHERE id = sqlc.arg('id');
+
+-- name: ListWebhookDeliveries :many
+SELECT id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, created_at, updated_at
+FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg('subscription_id')
+  AND (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: DeleteWebhookDeliveriesByAggregate :execrows
+DELETE FROM webhook_deliveries
+WHERE event_id IN (SELECT id FROM outbox_events WHERE aggregate_id = ?);
+
+-- name: DeleteFinishedWebhookDeliveriesBefore :execrows
+DELETE FROM webhook_deliveries
+WHERE status <> 'pending'
+  AND updated_at < sqlc.arg('before');
\ No newline at end of file
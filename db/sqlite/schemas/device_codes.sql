@@ -0,0 +1,13 @@
+CREATE TABLE device_codes (
+    device_code_hash TEXT PRIMARY KEY NOT NULL,
+    user_code_hash TEXT NOT NULL UNIQUE,
+    client_id TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    user_id TEXT REFERENCES users(user_id) ON DELETE CASCADE,
+    poll_interval INTEGER NOT NULL,
+    last_polled_at TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX device_codes_expires_at_idx ON device_codes(expires_at);
\ No newline at end of file
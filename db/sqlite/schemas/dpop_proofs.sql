@@ -0,0 +1,6 @@
+CREATE TABLE dpop_proofs (
+    proof_hash TEXT PRIMARY KEY NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX dpop_proofs_expires_at_idx ON dpop_proofs(expires_at);
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE magic_links (
+    user_id TEXT PRIMARY KEY NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    binding_hash TEXT NOT NULL DEFAULT '',
+    remember_me BOOLEAN NOT NULL DEFAULT FALSE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
\ No newline at end of file
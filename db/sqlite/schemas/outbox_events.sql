@@ -0,0 +1,14 @@
+CREATE TABLE outbox_events (
+    id TEXT PRIMARY KEY NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    published_at TIMESTAMP,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX outbox_events_pending_idx ON outbox_events(next_attempt_at) WHERE published_at IS NULL;
+CREATE INDEX outbox_events_published_idx ON outbox_events(published_at) WHERE published_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE signing_keys (
+    kid TEXT PRIMARY KEY NOT NULL,
+    secret BLOB NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    retired_at TIMESTAMP
+);
+
+CREATE INDEX signing_keys_retired_at_idx ON signing_keys (retired_at);
\ No newline at end of file
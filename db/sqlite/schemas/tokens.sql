@@ -0,0 +1,14 @@
+CREATE TABLE tokens (
+    id TEXT PRIMARY KEY NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    refresh_token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    session_started_at TIMESTAMP NOT NULL,
+    remember_me BOOLEAN NOT NULL DEFAULT FALSE,
+    dpop_jkt TEXT NOT NULL DEFAULT '',
+    client_id TEXT NOT NULL DEFAULT '',
+    x5t TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX tokens_expires_at_idx ON tokens (expires_at);
\ No newline at end of file
@@ -0,0 +1,80 @@
+// Package claims implements claims providers that get custom access token claims from
+// outside the service.
+package claims
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxResponseBytes bounds what is read from the callback. The claims themselves are
+// limited much further by the token service.
+const maxResponseBytes = 64 << 10
+
+type callbackRequest struct {
+	UserID   uuid.UUID `json:"user_id"`
+	ClientID string    `json:"client_id,omitempty"`
+}
+
+// HTTPProvider asks a local HTTP endpoint for the claims of a user. The endpoint gets
+// a JSON body with user_id and client_id and answers 200 with a JSON object of claims,
+// or 204 if it has none. Every other answer fails the token.
+type HTTPProvider struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPProvider(url string, timeout time.Duration) *HTTPProvider {
+	return &HTTPProvider{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *HTTPProvider) Name() string {
+	return "callback"
+}
+
+func (p *HTTPProvider) Claims(ctx context.Context, userID uuid.UUID, clientID string) (map[string]any, error) {
+	b, err := json.Marshal(callbackRequest{UserID: userID, ClientID: clientID})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil, nil
+	default:
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var claims map[string]any
+	dec := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes))
+	dec.UseNumber()
+	if err := dec.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("decode claims: %w", err)
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,66 @@
+package claims_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/claims"
+)
+
+func TestHTTPProvider_Claims(t *testing.T) {
+	userID := uuid.New()
+
+	var got struct {
+		UserID   uuid.UUID `json:"user_id"`
+		ClientID string    `json:"client_id"`
+	}
+	status := http.StatusOK
+	body := `{"plan":"pro","org_id":12345678901234567,"features":["beta"]}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(body))
+		}
+	}))
+	defer srv.Close()
+
+	provider := claims.NewHTTPProvider(srv.URL, time.Second)
+
+	res, err := provider.Claims(context.Background(), userID, "mobile")
+	assert.NoError(t, err)
+	assert.Equal(t, userID, got.UserID)
+	assert.Equal(t, "mobile", got.ClientID)
+	assert.Equal(t, "pro", res["plan"])
+	assert.Equal(t, json.Number("12345678901234567"), res["org_id"], "large numbers keep their precision")
+
+	b, err := json.Marshal(res)
+	assert.NoError(t, err)
+	assert.JSONEq(t, body, string(b))
+
+	status = http.StatusNoContent
+
+	res, err = provider.Claims(context.Background(), userID, "")
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	status = http.StatusOK
+	body = `["not", "an", "object"]`
+
+	_, err = provider.Claims(context.Background(), userID, "")
+	assert.Error(t, err)
+
+	status = http.StatusInternalServerError
+
+	_, err = provider.Claims(context.Background(), userID, "")
+	assert.Error(t, err)
+}
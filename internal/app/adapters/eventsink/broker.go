@@ -0,0 +1,54 @@
+package eventsink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// Publisher is the minimal subset of a message broker client (NATS, Kafka, ...)
+// needed by BrokerSink. Implementations should map HeaderIdempotencyKey onto the
+// broker's native deduplication mechanism, e.g. Nats-Msg-Id for JetStream.
+type Publisher interface {
+	Publish(ctx context.Context, subject string, data []byte, headers map[string]string) error
+}
+
+// BrokerSink publishes every event to "<prefix>.<event type>".
+type BrokerSink struct {
+	publisher Publisher
+	prefix    string
+}
+
+func NewBrokerSink(publisher Publisher, prefix string) *BrokerSink {
+	return &BrokerSink{
+		publisher: publisher,
+		prefix:    prefix,
+	}
+}
+
+func (s *BrokerSink) Name() string {
+	return "broker"
+}
+
+func (s *BrokerSink) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	b, err := marshalEnvelope(event)
+	if err != nil {
+		return fmt.Errorf("marshal envelope: %w", err)
+	}
+
+	subject := event.EventType
+	if s.prefix != "" {
+		subject = s.prefix + "." + event.EventType
+	}
+
+	headers := map[string]string{
+		HeaderIdempotencyKey: event.ID.String(),
+	}
+
+	if err := s.publisher.Publish(ctx, subject, b, headers); err != nil {
+		return fmt.Errorf("publish %s: %w", subject, err)
+	}
+
+	return nil
+}
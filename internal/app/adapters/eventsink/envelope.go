@@ -0,0 +1,13 @@
+package eventsink
+
+import (
+	"encoding/json"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+func marshalEnvelope(e domain.OutboxEvent) ([]byte, error) {
+	return json.Marshal(e.Envelope())
+}
@@ -0,0 +1,46 @@
+package eventsink
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// WriterSink writes every event as a single JSON line. It is meant for local
+// development and for piping events into log collectors.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+func NewStdoutSink() *WriterSink {
+	return NewWriterSink(os.Stdout)
+}
+
+func (s *WriterSink) Name() string {
+	return "stdout"
+}
+
+func (s *WriterSink) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	b, err := marshalEnvelope(event)
+	if err != nil {
+		return fmt.Errorf("marshal envelope: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.w.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	return nil
+}
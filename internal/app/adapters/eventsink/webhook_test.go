@@ -0,0 +1,50 @@
+package eventsink_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/eventsink"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+func TestWebhookSink_Publish(t *testing.T) {
+	event := domain.OutboxEvent{
+		ID:          uuid.New(),
+		AggregateID: uuid.New(),
+		EventType:   domain.EventUserRegistered,
+		Payload:     []byte(`{"email":"user@example.org"}`),
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	var got domain.EventEnvelope
+	status := http.StatusAccepted
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, event.ID.String(), r.Header.Get(eventsink.HeaderIdempotencyKey))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink := eventsink.NewWebhookSink(srv.URL, time.Second)
+
+	err := sink.Publish(context.Background(), event)
+	assert.NoError(t, err)
+	assert.Equal(t, event.ID, got.ID)
+	assert.Equal(t, event.EventType, got.Type)
+	assert.Equal(t, event.AggregateID, got.AggregateID)
+	assert.JSONEq(t, string(event.Payload), string(got.Data))
+
+	status = http.StatusServiceUnavailable
+
+	err = sink.Publish(context.Background(), event)
+	assert.Error(t, err)
+}
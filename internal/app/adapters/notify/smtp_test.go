@@ -0,0 +1,144 @@
+package notify_test
+
+import (
+	"context"
+	"io"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/notify"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+type smtpSession struct {
+	auth bool
+	from string
+	to   []string
+	data string
+}
+
+// serveSMTP accepts a single connection and plays a minimal SMTP server without
+// STARTTLS, which is enough for net/smtp on localhost.
+func serveSMTP(t *testing.T, ln net.Listener, rejectRcpt bool) <-chan smtpSession {
+	done := make(chan smtpSession, 1)
+
+	go func() {
+		var s smtpSession
+		defer func() { done <- s }()
+
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+
+			cmd, arg, _ := strings.Cut(line, " ")
+			switch strings.ToUpper(cmd) {
+			case "EHLO":
+				_ = tp.PrintfLine("250-localhost")
+				_ = tp.PrintfLine("250 AUTH PLAIN")
+			case "AUTH":
+				s.auth = true
+				_ = tp.PrintfLine("235 ok")
+			case "MAIL":
+				s.from = arg
+				_ = tp.PrintfLine("250 ok")
+			case "RCPT":
+				if rejectRcpt {
+					_ = tp.PrintfLine("550 no such user")
+					continue
+				}
+				s.to = append(s.to, arg)
+				_ = tp.PrintfLine("250 ok")
+			case "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				b, err := tp.ReadDotBytes()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				s.data = string(b)
+				_ = tp.PrintfLine("250 queued")
+			case "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+
+	return done
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer ln.Close()
+
+	done := serveSMTP(t, ln, false)
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+
+	n := notify.NewSMTPNotifier(host, port, "user", "secret", "no-reply@example.org", time.Second*5)
+	err = n.Notify(context.Background(), domain.Message{
+		To:      "user@example.org",
+		Subject: "Confirm your new email address",
+		Body:    "Use the link below.\n\nhttps://example.org/email/confirm?token=abc\n",
+	})
+	assert.NoError(t, err)
+
+	s := <-done
+	assert.True(t, s.auth, "credentials are sent to localhost")
+	assert.Equal(t, "FROM:<no-reply@example.org>", s.from)
+	assert.Equal(t, []string{"TO:<user@example.org>"}, s.to)
+
+	m, err := mail.ReadMessage(strings.NewReader(s.data))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "user@example.org", m.Header.Get("To"))
+	assert.Equal(t, "Confirm your new email address", m.Header.Get("Subject"))
+	assert.Equal(t, "quoted-printable", m.Header.Get("Content-Transfer-Encoding"))
+
+	body, err := io.ReadAll(quotedprintable.NewReader(m.Body))
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "https://example.org/email/confirm?token=abc")
+}
+
+func TestSMTPNotifier_NotifyRejected(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer ln.Close()
+
+	done := serveSMTP(t, ln, true)
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+
+	n := notify.NewSMTPNotifier(host, port, "", "", "no-reply@example.org", time.Second*5)
+	err = n.Notify(context.Background(), domain.Message{To: "unknown@example.org", Subject: "s", Body: "b"})
+	assert.Error(t, err)
+
+	s := <-done
+	assert.False(t, s.auth, "no credentials configured")
+	assert.Empty(t, s.data)
+
+	err = n.Notify(context.Background(), domain.Message{To: "user@example.org\r\nBcc: other@example.org", Subject: "s", Body: "b"})
+	assert.Error(t, err, "header injection through the recipient")
+}
@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+const defaultTwilioAPIURL = "https://api.twilio.com"
+
+// TwilioNotifier sends the body of messages as text messages through the Twilio
+// Messaging API. The subject is dropped, and the recipient has to be a phone number
+// in E.164 format.
+type TwilioNotifier struct {
+	accountSID string
+	authToken  string
+	from       string
+	apiURL     string
+	client     *http.Client
+}
+
+// NewTwilioNotifier returns a TwilioNotifier sending from the phone number from. An
+// empty apiURL uses the Twilio API; it is meant for tests and compatible services.
+func NewTwilioNotifier(accountSID string, authToken string, from string, apiURL string, timeout time.Duration) *TwilioNotifier {
+	if apiURL == "" {
+		apiURL = defaultTwilioAPIURL
+	}
+
+	return &TwilioNotifier{
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+		apiURL:     strings.TrimRight(apiURL, "/"),
+		client:     &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *TwilioNotifier) Name() string {
+	return "twilio"
+}
+
+func (n *TwilioNotifier) Notify(ctx context.Context, msg domain.Message) error {
+	form := url.Values{
+		"To":   {msg.To},
+		"From": {n.from},
+		"Body": {msg.Body},
+	}
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", n.apiURL, url.PathEscape(n.accountSID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+	req.SetBasicAuth(n.accountSID, n.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("send message: status %d: %s", resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package notify_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/notify"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+func TestTwilioNotifier_Notify(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		got = r
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	n := notify.NewTwilioNotifier("AC123", "secret", "+15005550006", srv.URL, time.Second*5)
+	err := n.Notify(context.Background(), domain.Message{
+		To:      "+15551234567",
+		Subject: "Your login code",
+		Body:    "Your login code is 123456.",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", got.URL.Path)
+	user, pass, ok := got.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "AC123", user)
+	assert.Equal(t, "secret", pass)
+	assert.Equal(t, "+15551234567", got.PostForm.Get("To"))
+	assert.Equal(t, "+15005550006", got.PostForm.Get("From"))
+	assert.Equal(t, "Your login code is 123456.", got.PostForm.Get("Body"))
+}
+
+func TestTwilioNotifier_NotifyRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":21211,"message":"Invalid 'To' Phone Number"}`))
+	}))
+	defer srv.Close()
+
+	n := notify.NewTwilioNotifier("AC123", "secret", "+15005550006", srv.URL, time.Second*5)
+	err := n.Notify(context.Background(), domain.Message{To: "not-a-number", Body: "b"})
+	assert.ErrorContains(t, err, "status 400")
+}
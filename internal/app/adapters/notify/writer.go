@@ -0,0 +1,71 @@
+// Package notify implements notifiers that deliver messages to users.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+type writtenMessage struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// WriterNotifier writes every message as a single JSON line instead of delivering
+// it. It stands in for a real notifier in local development and tests; the messages
+// carry secrets like confirm links, so it must not be used in production.
+type WriterNotifier struct {
+	mu   sync.Mutex
+	w    io.Writer
+	name string
+}
+
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w, name: "writer"}
+}
+
+func NewStdoutNotifier() *WriterNotifier {
+	return &WriterNotifier{w: os.Stdout, name: "stdout"}
+}
+
+// NewFileNotifier returns a WriterNotifier appending to the file at path, which is
+// created if it does not exist. The file stays open for the life of the process.
+func NewFileNotifier(path string) (*WriterNotifier, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+
+	return &WriterNotifier{w: f, name: "file"}, nil
+}
+
+func (n *WriterNotifier) Name() string {
+	return n.name
+}
+
+func (n *WriterNotifier) Notify(ctx context.Context, msg domain.Message) error {
+	b, err := json.Marshal(writtenMessage{
+		To:      msg.To,
+		Subject: msg.Subject,
+		Body:    msg.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, err := n.w.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type apiKey struct {
+	domain.APIKey
+	hash string
+}
+
+type MemoryAPIKeyRepo struct {
+	s *Storage
+}
+
+func (r *MemoryAPIKeyRepo) CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time) (*domain.APIKey, error) {
+	var res domain.APIKey
+
+	err := r.s.do(ctx, func(t *tables) error {
+		k := apiKey{
+			APIKey: domain.APIKey{
+				ID:        uuid.New(),
+				UserID:    userID,
+				Name:      name,
+				Prefix:    prefix,
+				Scopes:    slices.Clone(scopes),
+				CreatedAt: time.Now().UTC(),
+				ExpiresAt: expiresAt,
+			},
+			hash: keyHash,
+		}
+		t.apiKeys[k.ID] = k
+
+		res = k.APIKey
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *MemoryAPIKeyRepo) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	keys := []domain.APIKey{}
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for _, k := range t.apiKeys {
+			if k.UserID == userID {
+				keys = append(keys, k.APIKey)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(keys, func(a, b domain.APIKey) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func (r *MemoryAPIKeyRepo) FindAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	var res *domain.APIKey
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for _, k := range t.apiKeys {
+			if k.hash == keyHash && t.users[k.UserID].IsActive {
+				key := k.APIKey
+				res = &key
+				return nil
+			}
+		}
+		return repository.ErrNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryAPIKeyRepo) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	return r.s.do(ctx, func(t *tables) error {
+		k, ok := t.apiKeys[id]
+		if !ok {
+			return nil
+		}
+
+		now := time.Now().UTC()
+		k.LastUsedAt = &now
+		t.apiKeys[id] = k
+		return nil
+	})
+}
+
+func (r *MemoryAPIKeyRepo) DeleteAPIKey(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	return r.s.do(ctx, func(t *tables) error {
+		k, ok := t.apiKeys[id]
+		if !ok || k.UserID != userID {
+			return repository.ErrNoRowDeleted
+		}
+
+		delete(t.apiKeys, id)
+		return nil
+	})
+}
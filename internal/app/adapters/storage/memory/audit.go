@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+type MemoryAuditRepo struct {
+	s *Storage
+}
+
+func (r *MemoryAuditRepo) CreateAuthEvent(ctx context.Context, event *domain.AuthEvent) error {
+	return r.s.do(ctx, func(t *tables) error {
+		e := *event
+		e.ID = uuid.New()
+		e.CreatedAt = time.Now().UTC()
+
+		t.authEvents = append(t.authEvents, e)
+		return nil
+	})
+}
+
+func (r *MemoryAuditRepo) ListAuthEventsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error) {
+	return r.ListAuthEvents(ctx, domain.AuthEventFilter{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+func (r *MemoryAuditRepo) ListAuthEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error) {
+	events := []domain.AuthEvent{}
+
+	err := r.s.do(ctx, func(t *tables) error {
+		// Events are appended in creation order, so walking backwards lists the newest first.
+		skipped := 0
+		for i := len(t.authEvents) - 1; i >= 0 && len(events) < filter.Limit; i-- {
+			e := t.authEvents[i]
+			if !matchAuthEvent(e, filter) {
+				continue
+			}
+			if skipped < filter.Offset {
+				skipped++
+				continue
+			}
+			events = append(events, e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *MemoryAuditRepo) DeleteAuthEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		kept := t.authEvents[:0:0]
+		for _, e := range t.authEvents {
+			if e.CreatedAt.Before(before) {
+				n++
+				continue
+			}
+			kept = append(kept, e)
+		}
+		t.authEvents = kept
+		return nil
+	})
+
+	return n, err
+}
+
+func matchAuthEvent(e domain.AuthEvent, filter domain.AuthEventFilter) bool {
+	if filter.UserID != uuid.Nil && e.UserID != filter.UserID {
+		return false
+	}
+	if filter.EventType != "" && e.EventType != filter.EventType {
+		return false
+	}
+	if filter.Outcome != "" && e.Outcome != filter.Outcome {
+		return false
+	}
+	if filter.Since != nil && e.CreatedAt.Before(*filter.Since) {
+		return false
+	}
+	if filter.Until != nil && !e.CreatedAt.Before(*filter.Until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,282 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const defaultRole = "user"
+
+type MemoryAuthRepo struct {
+	s *Storage
+}
+
+func (r *MemoryAuthRepo) CreateUser(ctx context.Context, email string, passwordHash string) (*domain.User, error) {
+	var res *domain.User
+
+	err := r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.usersByEmail[email]; ok {
+			return repository.ErrEmailAlreadyExists
+		}
+
+		u := domain.UserWithPassword{
+			UserID:       uuid.New(),
+			Email:        email,
+			PasswordHash: passwordHash,
+			CreatedAt:    time.Now().UTC(),
+			IsActive:     true,
+			Role:         defaultRole,
+		}
+		t.users[u.UserID] = u
+		t.usersByEmail[email] = u.UserID
+
+		res = toDomainUser(u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryAuthRepo) GetUserInfo(ctx context.Context, user_id uuid.UUID) (*domain.User, error) {
+	var res *domain.User
+
+	err := r.s.do(ctx, func(t *tables) error {
+		u, ok := t.users[user_id]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		res = toDomainUser(u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryAuthRepo) FindUserByEmail(ctx context.Context, email string) (*domain.UserWithPassword, error) {
+	var res domain.UserWithPassword
+
+	err := r.s.do(ctx, func(t *tables) error {
+		id, ok := t.usersByEmail[email]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		res = t.users[id]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *MemoryAuthRepo) UpdateUserPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		u, ok := t.users[userID]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		u.PasswordHash = passwordHash
+		t.users[userID] = u
+		return nil
+	})
+}
+
+func (r *MemoryAuthRepo) DeactivateUser(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	var res *domain.User
+
+	err := r.s.do(ctx, func(t *tables) error {
+		u, ok := t.users[userID]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		u.IsActive = false
+		t.users[userID] = u
+
+		res = toDomainUser(u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryAuthRepo) UpdateUserEmail(ctx context.Context, userID uuid.UUID, email string) (*domain.User, error) {
+	var res *domain.User
+
+	err := r.s.do(ctx, func(t *tables) error {
+		u, ok := t.users[userID]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		if id, ok := t.usersByEmail[email]; ok && id != userID {
+			return repository.ErrEmailAlreadyExists
+		}
+
+		delete(t.usersByEmail, u.Email)
+		u.Email = email
+		t.users[userID] = u
+		t.usersByEmail[email] = userID
+
+		res = toDomainUser(u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryAuthRepo) ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, deleteAfter time.Time) (*domain.User, error) {
+	var res *domain.User
+
+	err := r.s.do(ctx, func(t *tables) error {
+		u, ok := t.users[userID]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		u.IsActive = false
+		t.users[userID] = u
+		t.deleteAfter[userID] = deleteAfter
+
+		res = toDomainUser(u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryAuthRepo) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	var res *domain.User
+
+	err := r.s.do(ctx, func(t *tables) error {
+		u, ok := t.users[userID]
+		if _, scheduled := t.deleteAfter[userID]; !ok || !scheduled {
+			return repository.ErrNotFound
+		}
+
+		u.IsActive = true
+		t.users[userID] = u
+		delete(t.deleteAfter, userID)
+
+		res = toDomainUser(u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryAuthRepo) ListUsersDueForDeletion(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	type due struct {
+		userID      uuid.UUID
+		deleteAfter time.Time
+	}
+	var users []due
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for id, at := range t.deleteAfter {
+			if !at.After(now) {
+				users = append(users, due{userID: id, deleteAfter: at})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(users, func(a, b due) int {
+		return a.deleteAfter.Compare(b.deleteAfter)
+	})
+
+	ids := make([]uuid.UUID, 0, min(len(users), limit))
+	for _, u := range users[:min(len(users), limit)] {
+		ids = append(ids, u.userID)
+	}
+
+	return ids, nil
+}
+
+// DeleteScheduledUser removes the user and, like the foreign keys of the SQL stores,
+// every row that references it.
+func (r *MemoryAuthRepo) DeleteScheduledUser(ctx context.Context, userID uuid.UUID) error {
+	return r.s.do(ctx, func(t *tables) error {
+		at, ok := t.deleteAfter[userID]
+		if !ok || at.After(time.Now()) {
+			return repository.ErrNotFound
+		}
+
+		delete(t.usersByEmail, t.users[userID].Email)
+		delete(t.users, userID)
+		delete(t.deleteAfter, userID)
+
+		for hash, tok := range t.tokens {
+			if tok.userID == userID {
+				delete(t.tokens, hash)
+			}
+		}
+		for jti, rev := range t.revokedJTIs {
+			if rev.userID == userID {
+				delete(t.revokedJTIs, jti)
+			}
+		}
+		delete(t.revokedUsers, userID)
+		delete(t.attributes, userID)
+		delete(t.emailChanges, userID)
+		delete(t.magicLinks, userID)
+		delete(t.otps, userID)
+		delete(t.otpRequests, userID)
+		delete(t.magicLinkRequests, userID)
+		for hash, d := range t.deviceCodes {
+			if d.UserID == userID {
+				delete(t.deviceCodes, hash)
+			}
+		}
+		for id, k := range t.apiKeys {
+			if k.UserID == userID {
+				delete(t.apiKeys, id)
+			}
+		}
+		t.authEvents = slices.DeleteFunc(t.authEvents, func(e domain.AuthEvent) bool {
+			return e.UserID == userID
+		})
+
+		return nil
+	})
+}
+
+func toDomainUser(u domain.UserWithPassword) *domain.User {
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}
+}
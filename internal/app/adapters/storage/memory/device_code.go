@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type MemoryDeviceCodeRepo struct {
+	s *Storage
+}
+
+func (r *MemoryDeviceCodeRepo) SaveDeviceCode(ctx context.Context, deviceCodeHash string, userCodeHash string, clientID string, interval time.Duration, expiresAt time.Time) error {
+	return r.s.do(ctx, func(t *tables) error {
+		for hash, d := range t.deviceCodes {
+			if hash == deviceCodeHash || d.UserCodeHash == userCodeHash {
+				return errors.New("save device code: duplicate code")
+			}
+		}
+
+		t.deviceCodes[deviceCodeHash] = domain.DeviceCode{
+			DeviceCodeHash: deviceCodeHash,
+			UserCodeHash:   userCodeHash,
+			ClientID:       clientID,
+			Status:         domain.DeviceCodePending,
+			Interval:       interval.Truncate(time.Second),
+			ExpiresAt:      expiresAt,
+			CreatedAt:      time.Now().UTC(),
+		}
+		return nil
+	})
+}
+
+func (r *MemoryDeviceCodeRepo) GetDeviceCode(ctx context.Context, deviceCodeHash string) (*domain.DeviceCode, error) {
+	var res *domain.DeviceCode
+
+	err := r.s.do(ctx, func(t *tables) error {
+		d, ok := t.deviceCodes[deviceCodeHash]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		res = &d
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryDeviceCodeRepo) GetDeviceCodeByUserCode(ctx context.Context, userCodeHash string) (*domain.DeviceCode, error) {
+	var res *domain.DeviceCode
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for _, d := range t.deviceCodes {
+			if d.UserCodeHash == userCodeHash {
+				res = &d
+				return nil
+			}
+		}
+		return repository.ErrNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryDeviceCodeRepo) DecideDeviceCode(ctx context.Context, userCodeHash string, userID uuid.UUID, status string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		for hash, d := range t.deviceCodes {
+			if d.UserCodeHash != userCodeHash || d.Status != domain.DeviceCodePending {
+				continue
+			}
+			d.Status = status
+			d.UserID = userID
+			t.deviceCodes[hash] = d
+			return nil
+		}
+		return repository.ErrNotFound
+	})
+}
+
+func (r *MemoryDeviceCodeRepo) PollDeviceCode(ctx context.Context, deviceCodeHash string, polledAt time.Time, interval time.Duration) (bool, error) {
+	var ok bool
+
+	err := r.s.do(ctx, func(t *tables) error {
+		d, found := t.deviceCodes[deviceCodeHash]
+		if !found {
+			return nil
+		}
+		if d.LastPolledAt != nil && d.LastPolledAt.After(polledAt.Add(-interval)) {
+			return nil
+		}
+		d.LastPolledAt = &polledAt
+		t.deviceCodes[deviceCodeHash] = d
+		ok = true
+		return nil
+	})
+
+	return ok, err
+}
+
+func (r *MemoryDeviceCodeRepo) SlowDownDeviceCode(ctx context.Context, deviceCodeHash string, step time.Duration) error {
+	return r.s.do(ctx, func(t *tables) error {
+		d, ok := t.deviceCodes[deviceCodeHash]
+		if !ok {
+			return nil
+		}
+		d.Interval += step.Truncate(time.Second)
+		t.deviceCodes[deviceCodeHash] = d
+		return nil
+	})
+}
+
+func (r *MemoryDeviceCodeRepo) DeleteDeviceCode(ctx context.Context, deviceCodeHash string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.deviceCodes[deviceCodeHash]; !ok {
+			return repository.ErrNoRowDeleted
+		}
+		delete(t.deviceCodes, deviceCodeHash)
+		return nil
+	})
+}
+
+func (r *MemoryDeviceCodeRepo) DeleteExpiredDeviceCodes(ctx context.Context, limit int) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for hash, d := range t.deviceCodes {
+			if n >= int64(limit) {
+				break
+			}
+			if !d.ExpiresAt.After(now) {
+				delete(t.deviceCodes, hash)
+				n++
+			}
+		}
+		return nil
+	})
+
+	return n, err
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+type MemoryDPoPProofRepo struct {
+	s *Storage
+}
+
+func (r *MemoryDPoPProofRepo) SaveDPoPProof(ctx context.Context, proofHash string, expiresAt time.Time) (bool, error) {
+	var saved bool
+
+	err := r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.dpopProofs[proofHash]; ok {
+			return nil
+		}
+		t.dpopProofs[proofHash] = expiresAt
+		saved = true
+		return nil
+	})
+
+	return saved, err
+}
+
+func (r *MemoryDPoPProofRepo) DeleteExpiredDPoPProofs(ctx context.Context, limit int) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for hash, expiresAt := range t.dpopProofs {
+			if n >= int64(limit) {
+				break
+			}
+			if !expiresAt.After(now) {
+				delete(t.dpopProofs, hash)
+				n++
+			}
+		}
+		return nil
+	})
+
+	return n, err
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type emailChange struct {
+	domain.EmailChange
+	confirmHash string
+	cancelHash  string
+}
+
+type MemoryEmailChangeRepo struct {
+	s *Storage
+}
+
+func (r *MemoryEmailChangeRepo) SaveEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, confirmTokenHash string, cancelTokenHash string, expiresAt time.Time) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("save email change: user %s does not exist", userID)
+		}
+
+		t.emailChanges[userID] = emailChange{
+			EmailChange: domain.EmailChange{
+				UserID:    userID,
+				NewEmail:  newEmail,
+				ExpiresAt: expiresAt,
+				CreatedAt: time.Now().UTC(),
+			},
+			confirmHash: confirmTokenHash,
+			cancelHash:  cancelTokenHash,
+		}
+		return nil
+	})
+}
+
+func (r *MemoryEmailChangeRepo) ConsumeEmailChange(ctx context.Context, confirmTokenHash string) (*domain.EmailChange, error) {
+	return r.delete(ctx, func(c emailChange) bool {
+		return c.confirmHash == confirmTokenHash
+	})
+}
+
+func (r *MemoryEmailChangeRepo) CancelEmailChange(ctx context.Context, cancelTokenHash string) (*domain.EmailChange, error) {
+	return r.delete(ctx, func(c emailChange) bool {
+		return c.cancelHash == cancelTokenHash
+	})
+}
+
+func (r *MemoryEmailChangeRepo) delete(ctx context.Context, match func(emailChange) bool) (*domain.EmailChange, error) {
+	var res *domain.EmailChange
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for userID, c := range t.emailChanges {
+			if match(c) {
+				delete(t.emailChanges, userID)
+				res = &c.EmailChange
+				return nil
+			}
+		}
+		return repository.ErrNoRowDeleted
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
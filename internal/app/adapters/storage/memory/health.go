@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/auth-service/migrations"
+)
+
+type MemoryHealthRepo struct{}
+
+func (r *MemoryHealthRepo) Ping(ctx context.Context) error {
+	return nil
+}
+
+// SchemaVersion reports the latest embedded migration: the in-memory tables always
+// have the current schema.
+func (r *MemoryHealthRepo) SchemaVersion(ctx context.Context) (int64, bool, error) {
+	version, err := migrations.Latest()
+	if err != nil {
+		return 0, false, err
+	}
+
+	return int64(version), false, nil
+}
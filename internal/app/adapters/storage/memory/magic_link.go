@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type magicLink struct {
+	domain.MagicLink
+	tokenHash string
+}
+
+type magicLinkRequests struct {
+	requests     int
+	windowEndsAt time.Time
+}
+
+type MemoryMagicLinkRepo struct {
+	s *Storage
+}
+
+func (r *MemoryMagicLinkRepo) SaveMagicLink(ctx context.Context, userID uuid.UUID, tokenHash string, bindingHash string, rememberMe bool, expiresAt time.Time) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("save magic link: user %s does not exist", userID)
+		}
+
+		t.magicLinks[userID] = magicLink{
+			MagicLink: domain.MagicLink{
+				UserID:      userID,
+				BindingHash: bindingHash,
+				RememberMe:  rememberMe,
+				ExpiresAt:   expiresAt,
+				CreatedAt:   time.Now().UTC(),
+			},
+			tokenHash: tokenHash,
+		}
+		return nil
+	})
+}
+
+func (r *MemoryMagicLinkRepo) ConsumeMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	var res *domain.MagicLink
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for userID, l := range t.magicLinks {
+			if l.tokenHash == tokenHash {
+				delete(t.magicLinks, userID)
+				res = &l.MagicLink
+				return nil
+			}
+		}
+		return repository.ErrNoRowDeleted
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryMagicLinkRepo) CountMagicLinkRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error) {
+	var res int
+
+	err := r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("count magic link request: user %s does not exist", userID)
+		}
+
+		now := time.Now().UTC()
+		req := t.magicLinkRequests[userID]
+		if !req.windowEndsAt.After(now) {
+			req = magicLinkRequests{windowEndsAt: now.Add(window)}
+		}
+		req.requests++
+		t.magicLinkRequests[userID] = req
+		res = req.requests
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
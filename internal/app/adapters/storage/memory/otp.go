@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type otpRequests struct {
+	requests     int
+	windowEndsAt time.Time
+}
+
+type MemoryOTPRepo struct {
+	s *Storage
+}
+
+func (r *MemoryOTPRepo) SaveOTP(ctx context.Context, userID uuid.UUID, channel string, codeHash string, rememberMe bool, expiresAt time.Time) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("save otp: user %s does not exist", userID)
+		}
+
+		t.otps[userID] = domain.OTP{
+			UserID:     userID,
+			Channel:    channel,
+			CodeHash:   codeHash,
+			RememberMe: rememberMe,
+			ExpiresAt:  expiresAt,
+			CreatedAt:  time.Now().UTC(),
+		}
+		return nil
+	})
+}
+
+func (r *MemoryOTPRepo) GetOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error) {
+	var res *domain.OTP
+
+	err := r.s.do(ctx, func(t *tables) error {
+		c, ok := t.otps[userID]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		res = &c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryOTPRepo) AttemptOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error) {
+	var res *domain.OTP
+
+	err := r.s.do(ctx, func(t *tables) error {
+		c, ok := t.otps[userID]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		c.Attempts++
+		t.otps[userID] = c
+		res = &c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryOTPRepo) DeleteOTP(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		c, ok := t.otps[userID]
+		if !ok || c.CodeHash != codeHash {
+			return repository.ErrNoRowDeleted
+		}
+		delete(t.otps, userID)
+		return nil
+	})
+}
+
+func (r *MemoryOTPRepo) CountOTPRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error) {
+	var res int
+
+	err := r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("count otp request: user %s does not exist", userID)
+		}
+
+		now := time.Now().UTC()
+		req := t.otpRequests[userID]
+		if !req.windowEndsAt.After(now) {
+			req = otpRequests{windowEndsAt: now.Add(window)}
+		}
+		req.requests++
+		t.otpRequests[userID] = req
+		res = req.requests
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+type outboxEvent struct {
+	domain.OutboxEvent
+	publishedAt   *time.Time
+	nextAttemptAt time.Time
+	lastError     string
+}
+
+type MemoryOutboxRepo struct {
+	s *Storage
+}
+
+func (r *MemoryOutboxRepo) CreateOutboxEvent(ctx context.Context, aggregateID uuid.UUID, eventType string, payload []byte) error {
+	return r.s.do(ctx, func(t *tables) error {
+		now := time.Now().UTC()
+
+		t.outbox = append(t.outbox, outboxEvent{
+			OutboxEvent: domain.OutboxEvent{
+				ID:          uuid.New(),
+				AggregateID: aggregateID,
+				EventType:   eventType,
+				Payload:     slices.Clone(payload),
+				CreatedAt:   now,
+			},
+			nextAttemptAt: now,
+		})
+		return nil
+	})
+}
+
+// ClaimOutboxEvents returns due events in creation order and leases them until
+// leaseUntil.
+func (r *MemoryOutboxRepo) ClaimOutboxEvents(ctx context.Context, batchSize int, maxAttempts int, leaseUntil time.Time) ([]domain.OutboxEvent, error) {
+	events := []domain.OutboxEvent{}
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for i, e := range t.outbox {
+			if len(events) >= batchSize {
+				break
+			}
+			if e.publishedAt == nil && !e.nextAttemptAt.After(now) && e.Attempts < maxAttempts {
+				t.outbox[i].nextAttemptAt = leaseUntil
+				events = append(events, e.OutboxEvent)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *MemoryOutboxRepo) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if i := outboxIndex(t, id); i >= 0 {
+			now := time.Now().UTC()
+			t.outbox[i].publishedAt = &now
+			t.outbox[i].Attempts++
+			t.outbox[i].lastError = ""
+		}
+		return nil
+	})
+}
+
+func (r *MemoryOutboxRepo) MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if i := outboxIndex(t, id); i >= 0 {
+			t.outbox[i].Attempts++
+			t.outbox[i].nextAttemptAt = nextAttemptAt
+			t.outbox[i].lastError = lastError
+		}
+		return nil
+	})
+}
+
+func (r *MemoryOutboxRepo) DeleteOutboxEventsByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		t.outbox = slices.DeleteFunc(t.outbox, func(e outboxEvent) bool {
+			if e.AggregateID == aggregateID {
+				n++
+				return true
+			}
+			return false
+		})
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (r *MemoryOutboxRepo) DeletePublishedOutboxEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		t.outbox = slices.DeleteFunc(t.outbox, func(e outboxEvent) bool {
+			if e.publishedAt != nil && e.publishedAt.Before(before) {
+				n++
+				return true
+			}
+			return false
+		})
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func outboxIndex(t *tables, id uuid.UUID) int {
+	return slices.IndexFunc(t.outbox, func(e outboxEvent) bool {
+		return e.ID == id
+	})
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type revokedAccessToken struct {
+	userID    uuid.UUID
+	revokedAt time.Time
+	expiresAt time.Time
+}
+
+type userRevocation struct {
+	issuedBefore time.Time
+	expiresAt    time.Time
+}
+
+type MemoryRevocationRepo struct {
+	s *Storage
+}
+
+func (r *MemoryRevocationRepo) RevokeAccessToken(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("revoke access token: user %s does not exist", userID)
+		}
+		if _, ok := t.revokedJTIs[jti]; ok {
+			return nil
+		}
+
+		t.revokedJTIs[jti] = revokedAccessToken{
+			userID:    userID,
+			revokedAt: time.Now().UTC(),
+			expiresAt: expiresAt,
+		}
+		return nil
+	})
+}
+
+func (r *MemoryRevocationRepo) RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID, issuedBefore time.Time, expiresAt time.Time) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("revoke user access tokens: user %s does not exist", userID)
+		}
+
+		rev := t.revokedUsers[userID]
+		if issuedBefore.After(rev.issuedBefore) {
+			rev.issuedBefore = issuedBefore
+		}
+		if expiresAt.After(rev.expiresAt) {
+			rev.expiresAt = expiresAt
+		}
+		t.revokedUsers[userID] = rev
+		return nil
+	})
+}
+
+func (r *MemoryRevocationRepo) IsAccessTokenRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	var revoked bool
+
+	err := r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.revokedJTIs[jti]; ok {
+			revoked = true
+			return nil
+		}
+
+		rev, ok := t.revokedUsers[userID]
+		revoked = ok && !rev.issuedBefore.Before(issuedAt)
+		return nil
+	})
+
+	return revoked, err
+}
+
+func (r *MemoryRevocationRepo) DeleteExpiredRevocations(ctx context.Context, limit int) (int64, error) {
+	var tokens, users int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for jti, rev := range t.revokedJTIs {
+			if tokens >= int64(limit) {
+				break
+			}
+			if !rev.expiresAt.After(now) {
+				delete(t.revokedJTIs, jti)
+				tokens++
+			}
+		}
+		for userID, rev := range t.revokedUsers {
+			if users >= int64(limit) {
+				break
+			}
+			if !rev.expiresAt.After(now) {
+				delete(t.revokedUsers, userID)
+				users++
+			}
+		}
+		return nil
+	})
+
+	return tokens + users, err
+}
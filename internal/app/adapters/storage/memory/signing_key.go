@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+type MemorySigningKeyRepo struct {
+	s *Storage
+}
+
+func (r *MemorySigningKeyRepo) CreateSigningKey(ctx context.Context, kid string, secret []byte) (*domain.SigningKey, error) {
+	var res domain.SigningKey
+
+	err := r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.signingKeys[kid]; ok {
+			return fmt.Errorf("create signing key: duplicate kid %q", kid)
+		}
+
+		res = domain.SigningKey{
+			KID:       kid,
+			Secret:    slices.Clone(secret),
+			CreatedAt: time.Now().UTC(),
+		}
+		t.signingKeys[kid] = res
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *MemorySigningKeyRepo) ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error) {
+	keys := []domain.SigningKey{}
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for _, k := range t.signingKeys {
+			if k.RetiredAt == nil || k.RetiredAt.After(now) {
+				keys = append(keys, k)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(keys, func(a, b domain.SigningKey) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func (r *MemorySigningKeyRepo) RetireSigningKeys(ctx context.Context, keepKID string, retireAt time.Time) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for kid, k := range t.signingKeys {
+			if kid == keepKID || k.RetiredAt != nil {
+				continue
+			}
+
+			at := retireAt
+			k.RetiredAt = &at
+			t.signingKeys[kid] = k
+			n++
+		}
+		return nil
+	})
+
+	return n, err
+}
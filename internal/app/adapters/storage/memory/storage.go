@@ -0,0 +1,298 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// Storage keeps every table in process memory. It is meant for development and tests:
+// nothing survives a restart and replicas do not share state.
+//
+// All repositories share one mutex. A transaction holds it until it ends, so
+// transactions are serializable and other callers wait for them, the way they would
+// wait on row locks in Postgres.
+type Storage struct {
+	mu   sync.Mutex
+	data *tables
+
+	locksMu sync.Mutex
+	locks   map[int64]struct{}
+
+	authRepo    *MemoryAuthRepo
+	tokenRepo   *MemoryTokenRepo
+	revokeRepo  *MemoryRevocationRepo
+	attrRepo    *MemoryUserAttributeRepo
+	emailRepo   *MemoryEmailChangeRepo
+	magicRepo   *MemoryMagicLinkRepo
+	otpRepo     *MemoryOTPRepo
+	deviceRepo  *MemoryDeviceCodeRepo
+	dpopRepo    *MemoryDPoPProofRepo
+	apiKeyRepo  *MemoryAPIKeyRepo
+	auditRepo   *MemoryAuditRepo
+	outboxRepo  *MemoryOutboxRepo
+	webhookRepo *MemoryWebhookRepo
+	keyRepo     *MemorySigningKeyRepo
+	healthRepo  *MemoryHealthRepo
+}
+
+type tables struct {
+	users             map[uuid.UUID]domain.UserWithPassword
+	usersByEmail      map[string]uuid.UUID
+	deleteAfter       map[uuid.UUID]time.Time
+	tokens            map[string]token
+	revokedJTIs       map[string]revokedAccessToken
+	revokedUsers      map[uuid.UUID]userRevocation
+	attributes        map[uuid.UUID]map[string]domain.UserAttribute
+	emailChanges      map[uuid.UUID]emailChange
+	magicLinks        map[uuid.UUID]magicLink
+	otps              map[uuid.UUID]domain.OTP
+	otpRequests       map[uuid.UUID]otpRequests
+	magicLinkRequests map[uuid.UUID]magicLinkRequests
+	deviceCodes       map[string]domain.DeviceCode
+	dpopProofs        map[string]time.Time
+	apiKeys           map[uuid.UUID]apiKey
+	authEvents        []domain.AuthEvent
+	outbox            []outboxEvent
+	webhookSubs       map[uuid.UUID]domain.WebhookSubscription
+	deliveries        map[uuid.UUID]domain.WebhookDelivery
+	signingKeys       map[string]domain.SigningKey
+}
+
+func New() *Storage {
+	s := &Storage{
+		data:  newTables(),
+		locks: make(map[int64]struct{}),
+	}
+
+	s.authRepo = &MemoryAuthRepo{s: s}
+	s.tokenRepo = &MemoryTokenRepo{s: s}
+	s.revokeRepo = &MemoryRevocationRepo{s: s}
+	s.attrRepo = &MemoryUserAttributeRepo{s: s}
+	s.emailRepo = &MemoryEmailChangeRepo{s: s}
+	s.magicRepo = &MemoryMagicLinkRepo{s: s}
+	s.otpRepo = &MemoryOTPRepo{s: s}
+	s.deviceRepo = &MemoryDeviceCodeRepo{s: s}
+	s.dpopRepo = &MemoryDPoPProofRepo{s: s}
+	s.apiKeyRepo = &MemoryAPIKeyRepo{s: s}
+	s.auditRepo = &MemoryAuditRepo{s: s}
+	s.outboxRepo = &MemoryOutboxRepo{s: s}
+	s.webhookRepo = &MemoryWebhookRepo{s: s}
+	s.keyRepo = &MemorySigningKeyRepo{s: s}
+	s.healthRepo = &MemoryHealthRepo{}
+
+	return s
+}
+
+func newTables() *tables {
+	return &tables{
+		users:             make(map[uuid.UUID]domain.UserWithPassword),
+		usersByEmail:      make(map[string]uuid.UUID),
+		deleteAfter:       make(map[uuid.UUID]time.Time),
+		tokens:            make(map[string]token),
+		revokedJTIs:       make(map[string]revokedAccessToken),
+		revokedUsers:      make(map[uuid.UUID]userRevocation),
+		attributes:        make(map[uuid.UUID]map[string]domain.UserAttribute),
+		emailChanges:      make(map[uuid.UUID]emailChange),
+		magicLinks:        make(map[uuid.UUID]magicLink),
+		otps:              make(map[uuid.UUID]domain.OTP),
+		otpRequests:       make(map[uuid.UUID]otpRequests),
+		magicLinkRequests: make(map[uuid.UUID]magicLinkRequests),
+		deviceCodes:       make(map[string]domain.DeviceCode),
+		dpopProofs:        make(map[string]time.Time),
+		apiKeys:           make(map[uuid.UUID]apiKey),
+		webhookSubs:       make(map[uuid.UUID]domain.WebhookSubscription),
+		deliveries:        make(map[uuid.UUID]domain.WebhookDelivery),
+		signingKeys:       make(map[string]domain.SigningKey),
+	}
+}
+
+// clone copies the tables for rolling back a transaction. Rows are values and their
+// slices are never modified in place, so a shallow copy of each row is enough.
+func (t *tables) clone() *tables {
+	c := newTables()
+	for k, v := range t.users {
+		c.users[k] = v
+	}
+	for k, v := range t.usersByEmail {
+		c.usersByEmail[k] = v
+	}
+	for k, v := range t.deleteAfter {
+		c.deleteAfter[k] = v
+	}
+	for k, v := range t.tokens {
+		c.tokens[k] = v
+	}
+	for k, v := range t.revokedJTIs {
+		c.revokedJTIs[k] = v
+	}
+	for k, v := range t.revokedUsers {
+		c.revokedUsers[k] = v
+	}
+	for k, v := range t.attributes {
+		c.attributes[k] = maps.Clone(v)
+	}
+	for k, v := range t.emailChanges {
+		c.emailChanges[k] = v
+	}
+	for k, v := range t.magicLinks {
+		c.magicLinks[k] = v
+	}
+	for k, v := range t.otps {
+		c.otps[k] = v
+	}
+	for k, v := range t.otpRequests {
+		c.otpRequests[k] = v
+	}
+	for k, v := range t.magicLinkRequests {
+		c.magicLinkRequests[k] = v
+	}
+	for k, v := range t.deviceCodes {
+		c.deviceCodes[k] = v
+	}
+	for k, v := range t.dpopProofs {
+		c.dpopProofs[k] = v
+	}
+	for k, v := range t.apiKeys {
+		c.apiKeys[k] = v
+	}
+	c.authEvents = append([]domain.AuthEvent(nil), t.authEvents...)
+	c.outbox = append([]outboxEvent(nil), t.outbox...)
+	for k, v := range t.webhookSubs {
+		c.webhookSubs[k] = v
+	}
+	for k, v := range t.deliveries {
+		c.deliveries[k] = v
+	}
+	for k, v := range t.signingKeys {
+		c.signingKeys[k] = v
+	}
+	return c
+}
+
+func (s *Storage) Auth() repository.AuthRepository {
+	return s.authRepo
+}
+
+func (s *Storage) Token() repository.TokenRepository {
+	return s.tokenRepo
+}
+
+func (s *Storage) Revocation() repository.RevocationRepository {
+	return s.revokeRepo
+}
+
+func (s *Storage) UserAttribute() repository.UserAttributeRepository {
+	return s.attrRepo
+}
+
+func (s *Storage) EmailChange() repository.EmailChangeRepository {
+	return s.emailRepo
+}
+
+func (s *Storage) MagicLink() repository.MagicLinkRepository {
+	return s.magicRepo
+}
+
+func (s *Storage) OTP() repository.OTPRepository {
+	return s.otpRepo
+}
+
+func (s *Storage) DeviceCode() repository.DeviceCodeRepository {
+	return s.deviceRepo
+}
+
+func (s *Storage) DPoPProof() repository.DPoPProofRepository {
+	return s.dpopRepo
+}
+
+func (s *Storage) APIKey() repository.APIKeyRepository {
+	return s.apiKeyRepo
+}
+
+func (s *Storage) Audit() repository.AuditRepository {
+	return s.auditRepo
+}
+
+func (s *Storage) Outbox() repository.OutboxRepository {
+	return s.outboxRepo
+}
+
+func (s *Storage) Webhook() repository.WebhookRepository {
+	return s.webhookRepo
+}
+
+func (s *Storage) SigningKey() repository.SigningKeyRepository {
+	return s.keyRepo
+}
+
+func (s *Storage) Health() repository.HealthRepository {
+	return s.healthRepo
+}
+
+type txKey struct{}
+
+func (s *Storage) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(txKey{}) == s {
+		return fn(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ctx.Err() != nil {
+		return repository.ErrGatewayTimeout
+	}
+
+	snapshot := s.data.clone()
+	if err := fn(context.WithValue(ctx, txKey{}, s)); err != nil {
+		s.data = snapshot
+		return err
+	}
+
+	return nil
+}
+
+func (s *Storage) TryWithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, repository.ErrGatewayTimeout
+	}
+
+	s.locksMu.Lock()
+	if _, held := s.locks[key]; held {
+		s.locksMu.Unlock()
+		return false, nil
+	}
+	s.locks[key] = struct{}{}
+	s.locksMu.Unlock()
+
+	defer func() {
+		s.locksMu.Lock()
+		delete(s.locks, key)
+		s.locksMu.Unlock()
+	}()
+
+	return true, fn(ctx)
+}
+
+// do runs fn on the tables, taking the storage mutex unless ctx belongs to a
+// transaction that already holds it. A done ctx fails like a timed out query.
+func (s *Storage) do(ctx context.Context, fn func(t *tables) error) error {
+	if ctx.Err() != nil {
+		return repository.ErrGatewayTimeout
+	}
+
+	if ctx.Value(txKey{}) == s {
+		return fn(s.data)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return fn(s.data)
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/memory"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/storagetest"
+)
+
+func TestStorage(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		return memory.New()
+	})
+}
@@ -0,0 +1,190 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type token struct {
+	id               uuid.UUID
+	userID           uuid.UUID
+	hash             string
+	createdAt        time.Time
+	expiresAt        time.Time
+	sessionStartedAt time.Time
+	rememberMe       bool
+	dpopJKT          string
+	clientID         string
+	x5t              string
+}
+
+type MemoryTokenRepo struct {
+	s *Storage
+}
+
+func (r *MemoryTokenRepo) FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var res *domain.RefreshToken
+
+	err := r.s.do(ctx, func(t *tables) error {
+		tok, ok := t.tokens[tokenHash]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		res = toDomainRefreshToken(tok)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryTokenRepo) SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time, sessionStartedAt time.Time, rememberMe bool, dpopJKT string, clientID string, x5t string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("save refresh token: user %s does not exist", userID)
+		}
+		if _, ok := t.tokens[tokenHash]; ok {
+			return errors.New("save refresh token: duplicate token hash")
+		}
+
+		t.tokens[tokenHash] = token{
+			id:               uuid.New(),
+			userID:           userID,
+			hash:             tokenHash,
+			createdAt:        time.Now().UTC(),
+			expiresAt:        expiresAt,
+			sessionStartedAt: sessionStartedAt,
+			rememberMe:       rememberMe,
+			dpopJKT:          dpopJKT,
+			clientID:         clientID,
+			x5t:              x5t,
+		}
+		return nil
+	})
+}
+
+func (r *MemoryTokenRepo) DeleteRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var res *domain.RefreshToken
+
+	err := r.s.do(ctx, func(t *tables) error {
+		tok, ok := t.tokens[tokenHash]
+		if !ok {
+			return repository.ErrNoRowDeleted
+		}
+
+		delete(t.tokens, tokenHash)
+		res = toDomainRefreshToken(tok)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MemoryTokenRepo) CountActiveRefreshTokens(ctx context.Context) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for _, tok := range t.tokens {
+			if tok.expiresAt.After(now) {
+				n++
+			}
+		}
+		return nil
+	})
+
+	return n, err
+}
+
+func (r *MemoryTokenRepo) ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	var sessions []domain.Session
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for _, tok := range t.tokens {
+			if tok.userID == userID && tok.expiresAt.After(now) {
+				sessions = append(sessions, domain.Session{
+					ID:               tok.id,
+					UserID:           tok.userID,
+					CreatedAt:        tok.createdAt,
+					ExpiresAt:        tok.expiresAt,
+					SessionStartedAt: tok.sessionStartedAt,
+					RememberMe:       tok.rememberMe,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(sessions, func(a, b domain.Session) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return sessions, nil
+}
+
+func (r *MemoryTokenRepo) DeleteRefreshTokensByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for hash, tok := range t.tokens {
+			if tok.userID == userID {
+				delete(t.tokens, hash)
+				n++
+			}
+		}
+		return nil
+	})
+
+	return n, err
+}
+
+func (r *MemoryTokenRepo) DeleteExpiredRefreshTokens(ctx context.Context, limit int) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for hash, tok := range t.tokens {
+			if n >= int64(limit) {
+				break
+			}
+			if !tok.expiresAt.After(now) {
+				delete(t.tokens, hash)
+				n++
+			}
+		}
+		return nil
+	})
+
+	return n, err
+}
+
+func toDomainRefreshToken(tok token) *domain.RefreshToken {
+	return &domain.RefreshToken{
+		UserID:           tok.userID,
+		RefreshToken:     tok.hash,
+		CreatedAt:        tok.createdAt,
+		ExpiresAt:        tok.expiresAt,
+		SessionStartedAt: tok.sessionStartedAt,
+		RememberMe:       tok.rememberMe,
+		DPoPJKT:          tok.dpopJKT,
+		ClientID:         tok.clientID,
+		X5T:              tok.x5t,
+	}
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type MemoryUserAttributeRepo struct {
+	s *Storage
+}
+
+func (r *MemoryUserAttributeRepo) ListUserAttributes(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error) {
+	var attrs []domain.UserAttribute
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for _, a := range t.attributes[userID] {
+			attrs = append(attrs, a)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(attrs, func(a, b domain.UserAttribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return attrs, nil
+}
+
+func (r *MemoryUserAttributeRepo) SetUserAttribute(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.users[userID]; !ok {
+			return fmt.Errorf("set user attribute: user %s does not exist", userID)
+		}
+
+		attrs := t.attributes[userID]
+		if attrs == nil {
+			attrs = make(map[string]domain.UserAttribute)
+			t.attributes[userID] = attrs
+		}
+		attrs[name] = domain.UserAttribute{
+			Name:      name,
+			Value:     slices.Clone(value),
+			UpdatedAt: time.Now().UTC(),
+		}
+		return nil
+	})
+}
+
+func (r *MemoryUserAttributeRepo) DeleteUserAttribute(ctx context.Context, userID uuid.UUID, name string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.attributes[userID][name]; !ok {
+			return repository.ErrNoRowDeleted
+		}
+
+		delete(t.attributes[userID], name)
+		return nil
+	})
+}
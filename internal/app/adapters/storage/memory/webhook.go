@@ -0,0 +1,263 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type MemoryWebhookRepo struct {
+	s *Storage
+}
+
+func (r *MemoryWebhookRepo) CreateWebhookSubscription(ctx context.Context, url string, secret string, eventTypes []string) (*domain.WebhookSubscription, error) {
+	var res domain.WebhookSubscription
+
+	err := r.s.do(ctx, func(t *tables) error {
+		res = domain.WebhookSubscription{
+			ID:         uuid.New(),
+			URL:        url,
+			Secret:     secret,
+			EventTypes: slices.Clone(eventTypes),
+			CreatedAt:  time.Now().UTC(),
+		}
+		t.webhookSubs[res.ID] = res
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *MemoryWebhookRepo) ListWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return r.listSubscriptions(ctx, func(domain.WebhookSubscription) bool { return true })
+}
+
+func (r *MemoryWebhookRepo) ListWebhookSubscriptionsByEvent(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error) {
+	return r.listSubscriptions(ctx, func(s domain.WebhookSubscription) bool {
+		return slices.Contains(s.EventTypes, eventType)
+	})
+}
+
+func (r *MemoryWebhookRepo) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	return r.s.do(ctx, func(t *tables) error {
+		if _, ok := t.webhookSubs[id]; !ok {
+			return repository.ErrNoRowDeleted
+		}
+
+		delete(t.webhookSubs, id)
+		for deliveryID, d := range t.deliveries {
+			if d.SubscriptionID == id {
+				delete(t.deliveries, deliveryID)
+			}
+		}
+		return nil
+	})
+}
+
+// CreateWebhookDelivery ignores a second delivery of the same event to the same
+// subscription, like the unique constraint does in Postgres.
+func (r *MemoryWebhookRepo) CreateWebhookDelivery(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID, eventType string, payload []byte) error {
+	return r.s.do(ctx, func(t *tables) error {
+		for _, d := range t.deliveries {
+			if d.SubscriptionID == subscriptionID && d.EventID == eventID {
+				return nil
+			}
+		}
+
+		now := time.Now().UTC()
+		d := domain.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscriptionID,
+			EventID:        eventID,
+			EventType:      eventType,
+			Payload:        slices.Clone(payload),
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		t.deliveries[d.ID] = d
+		return nil
+	})
+}
+
+func (r *MemoryWebhookRepo) ClaimWebhookDeliveries(ctx context.Context, batchSize int, leaseUntil time.Time) ([]domain.PendingWebhookDelivery, error) {
+	deliveries := []domain.PendingWebhookDelivery{}
+
+	err := r.s.do(ctx, func(t *tables) error {
+		now := time.Now()
+		for _, d := range t.deliveries {
+			sub, ok := t.webhookSubs[d.SubscriptionID]
+			if !ok || d.Status != domain.WebhookDeliveryPending || d.NextAttemptAt.After(now) {
+				continue
+			}
+
+			deliveries = append(deliveries, domain.PendingWebhookDelivery{
+				WebhookDelivery: d,
+				URL:             sub.URL,
+				Secret:          sub.Secret,
+			})
+		}
+
+		slices.SortFunc(deliveries, func(a, b domain.PendingWebhookDelivery) int {
+			return a.NextAttemptAt.Compare(b.NextAttemptAt)
+		})
+		if len(deliveries) > batchSize {
+			deliveries = deliveries[:batchSize]
+		}
+
+		for _, d := range deliveries {
+			leased := t.deliveries[d.ID]
+			leased.NextAttemptAt = leaseUntil
+			t.deliveries[d.ID] = leased
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *MemoryWebhookRepo) MarkWebhookDeliverySucceeded(ctx context.Context, id uuid.UUID, statusCode int) error {
+	return r.s.do(ctx, func(t *tables) error {
+		d, ok := t.deliveries[id]
+		if !ok {
+			return nil
+		}
+
+		d.Status = domain.WebhookDeliverySucceeded
+		d.Attempts++
+		d.LastStatusCode = statusCode
+		d.LastError = ""
+		d.UpdatedAt = time.Now().UTC()
+		t.deliveries[id] = d
+		return nil
+	})
+}
+
+func (r *MemoryWebhookRepo) MarkWebhookDeliveryFailed(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, statusCode int, lastError string) error {
+	return r.s.do(ctx, func(t *tables) error {
+		d, ok := t.deliveries[id]
+		if !ok {
+			return nil
+		}
+
+		d.Status = status
+		d.Attempts++
+		d.NextAttemptAt = nextAttemptAt
+		d.LastStatusCode = statusCode
+		d.LastError = lastError
+		d.UpdatedAt = time.Now().UTC()
+		t.deliveries[id] = d
+		return nil
+	})
+}
+
+func (r *MemoryWebhookRepo) ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error) {
+	deliveries := []domain.WebhookDelivery{}
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for _, d := range t.deliveries {
+			if d.SubscriptionID == subscriptionID && (status == "" || d.Status == status) {
+				deliveries = append(deliveries, d)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(deliveries, func(a, b domain.WebhookDelivery) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return page(deliveries, limit, offset), nil
+}
+
+func (r *MemoryWebhookRepo) DeleteWebhookDeliveriesByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		events := make(map[uuid.UUID]struct{})
+		for _, e := range t.outbox {
+			if e.AggregateID == aggregateID {
+				events[e.ID] = struct{}{}
+			}
+		}
+
+		for id, d := range t.deliveries {
+			if _, ok := events[d.EventID]; ok {
+				delete(t.deliveries, id)
+				n++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (r *MemoryWebhookRepo) DeleteFinishedWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for id, d := range t.deliveries {
+			if d.Status != domain.WebhookDeliveryPending && d.UpdatedAt.Before(before) {
+				delete(t.deliveries, id)
+				n++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (r *MemoryWebhookRepo) listSubscriptions(ctx context.Context, match func(domain.WebhookSubscription) bool) ([]domain.WebhookSubscription, error) {
+	subs := []domain.WebhookSubscription{}
+
+	err := r.s.do(ctx, func(t *tables) error {
+		for _, s := range t.webhookSubs {
+			if match(s) {
+				subs = append(subs, s)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(subs, func(a, b domain.WebhookSubscription) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return subs, nil
+}
+
+func page[T any](rows []T, limit int, offset int) []T {
+	if offset >= len(rows) {
+		return rows[:0]
+	}
+	rows = rows[offset:]
+	if len(rows) > limit {
+		rows = rows[:limit]
+	}
+	return rows
+}
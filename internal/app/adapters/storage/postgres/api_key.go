@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresAPIKeyRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresAPIKeyRepo(q *gen.Queries) *PostgresAPIKeyRepo {
+	return &PostgresAPIKeyRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresAPIKeyRepo) CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time) (*domain.APIKey, error) {
+	k, err := queries(ctx, r.queries).CreateAPIKey(ctx, gen.CreateAPIKeyParams{
+		UserID:    userID,
+		Name:      name,
+		KeyPrefix: prefix,
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		ExpiresAt: toNullTime(expiresAt),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.APIKey{
+		ID:         k.ID,
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.KeyPrefix,
+		Scopes:     k.Scopes,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  fromNullTime(k.ExpiresAt),
+		LastUsedAt: fromNullTime(k.LastUsedAt),
+	}, nil
+}
+
+func (r *PostgresAPIKeyRepo) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	rows, err := queries(ctx, r.queries).ListAPIKeysByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	keys := make([]domain.APIKey, 0, len(rows))
+	for _, k := range rows {
+		keys = append(keys, domain.APIKey{
+			ID:         k.ID,
+			UserID:     k.UserID,
+			Name:       k.Name,
+			Prefix:     k.KeyPrefix,
+			Scopes:     k.Scopes,
+			CreatedAt:  k.CreatedAt,
+			ExpiresAt:  fromNullTime(k.ExpiresAt),
+			LastUsedAt: fromNullTime(k.LastUsedAt),
+		})
+	}
+
+	return keys, nil
+}
+
+func (r *PostgresAPIKeyRepo) FindAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	k, err := queries(ctx, r.queries).FindAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.APIKey{
+		ID:         k.ID,
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.KeyPrefix,
+		Scopes:     k.Scopes,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  fromNullTime(k.ExpiresAt),
+		LastUsedAt: fromNullTime(k.LastUsedAt),
+	}, nil
+}
+
+func (r *PostgresAPIKeyRepo) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	if err := queries(ctx, r.queries).TouchAPIKey(ctx, id); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresAPIKeyRepo) DeleteAPIKey(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	_, err := queries(ctx, r.queries).DeleteAPIKey(ctx, gen.DeleteAPIKeyParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrNoRowDeleted
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func fromNullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresAuditRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresAuditRepo(q *gen.Queries) *PostgresAuditRepo {
+	return &PostgresAuditRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresAuditRepo) CreateAuthEvent(ctx context.Context, event *domain.AuthEvent) error {
+	err := queries(ctx, r.queries).CreateAuthEvent(ctx, gen.CreateAuthEventParams{
+		EventType: event.EventType,
+		UserID:    toNullUUID(event.UserID),
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		Outcome:   event.Outcome,
+		Reason:    event.Reason,
+		RequestID: event.RequestID,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresAuditRepo) ListAuthEventsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error) {
+	rows, err := queries(ctx, r.queries).ListAuthEventsByUser(ctx, gen.ListAuthEventsByUserParams{
+		UserID: toNullUUID(userID),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainAuthEvents(rows), nil
+}
+
+func (r *PostgresAuditRepo) ListAuthEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error) {
+	rows, err := queries(ctx, r.queries).ListAuthEvents(ctx, gen.ListAuthEventsParams{
+		UserID:    toNullUUID(filter.UserID),
+		EventType: toNullString(filter.EventType),
+		Outcome:   toNullString(filter.Outcome),
+		Since:     toNullTime(filter.Since),
+		Until:     toNullTime(filter.Until),
+		Limit:     int32(filter.Limit),
+		Offset:    int32(filter.Offset),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainAuthEvents(rows), nil
+}
+
+func (r *PostgresAuditRepo) DeleteAuthEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteAuthEventsBefore(ctx, before)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainAuthEvents(rows []gen.AuthEvent) []domain.AuthEvent {
+	events := make([]domain.AuthEvent, 0, len(rows))
+	for _, e := range rows {
+		events = append(events, domain.AuthEvent{
+			ID:        e.ID,
+			EventType: e.EventType,
+			UserID:    e.UserID.UUID,
+			IP:        e.Ip,
+			UserAgent: e.UserAgent,
+			Outcome:   e.Outcome,
+			Reason:    e.Reason,
+			RequestID: e.RequestID,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return events
+}
+
+func toNullUUID(id uuid.UUID) uuid.NullUUID {
+	if id == uuid.Nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}
+}
+
+func toNullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
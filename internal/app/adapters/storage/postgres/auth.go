@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -25,17 +26,15 @@ func NewPostgresAuthRepo(q *gen.Queries) *PostgresAuthRepo {
 func (r *PostgresAuthRepo) CreateUser(ctx context.Context, email string, passwordHash string) (*domain.User, error) {
 	var pqErr *pq.Error
 
-	u, err := r.queries.CreateUser(ctx, gen.CreateUserParams{
+	u, err := queries(ctx, r.queries).CreateUser(ctx, gen.CreateUserParams{
 		Email:        email,
 		PasswordHash: passwordHash,
 	})
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			return nil, repository.ErrGatewayTimeout
-		} else if errors.As(err, &pqErr) {
-			if pqErr.Code == "23505" {
-				return nil, repository.ErrEmailAlreadyExists
-			}
+		} else if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, repository.ErrEmailAlreadyExists
 		} else {
 			return nil, err
 		}
@@ -46,11 +45,12 @@ func (r *PostgresAuthRepo) CreateUser(ctx context.Context, email string, passwor
 		Email:     u.Email,
 		CreatedAt: u.CreatedAt,
 		IsActive:  u.IsActive,
+		Role:      u.Role,
 	}, nil
 }
 
 func (r *PostgresAuthRepo) GetUserInfo(ctx context.Context, user_id uuid.UUID) (*domain.User, error) {
-	u, err := r.queries.GetUserInfo(ctx, user_id)
+	u, err := queries(ctx, r.queries).GetUserInfo(ctx, user_id)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			return nil, repository.ErrGatewayTimeout
@@ -66,11 +66,12 @@ func (r *PostgresAuthRepo) GetUserInfo(ctx context.Context, user_id uuid.UUID) (
 		Email:     u.Email,
 		CreatedAt: u.CreatedAt,
 		IsActive:  u.IsActive,
+		Role:      u.Role,
 	}, nil
 }
 
 func (r *PostgresAuthRepo) FindUserByEmail(ctx context.Context, email string) (*domain.UserWithPassword, error) {
-	u, err := r.queries.FindUserByEmail(ctx, email)
+	u, err := queries(ctx, r.queries).FindUserByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			return nil, repository.ErrGatewayTimeout
@@ -87,5 +88,156 @@ func (r *PostgresAuthRepo) FindUserByEmail(ctx context.Context, email string) (*
 		PasswordHash: u.PasswordHash,
 		CreatedAt:    u.CreatedAt,
 		IsActive:     u.IsActive,
+		Role:         u.Role,
 	}, nil
 }
+
+func (r *PostgresAuthRepo) UpdateUserPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	n, err := queries(ctx, r.queries).UpdateUserPassword(ctx, gen.UpdateUserPasswordParams{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresAuthRepo) DeactivateUser(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	u, err := queries(ctx, r.queries).DeactivateUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *PostgresAuthRepo) UpdateUserEmail(ctx context.Context, userID uuid.UUID, email string) (*domain.User, error) {
+	var pqErr *pq.Error
+
+	u, err := queries(ctx, r.queries).UpdateUserEmail(ctx, gen.UpdateUserEmailParams{
+		UserID: userID,
+		Email:  email,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, repository.ErrEmailAlreadyExists
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *PostgresAuthRepo) ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, deleteAfter time.Time) (*domain.User, error) {
+	u, err := queries(ctx, r.queries).ScheduleUserDeletion(ctx, gen.ScheduleUserDeletionParams{
+		UserID:      userID,
+		DeleteAfter: sql.NullTime{Time: deleteAfter, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *PostgresAuthRepo) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	u, err := queries(ctx, r.queries).CancelUserDeletion(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *PostgresAuthRepo) ListUsersDueForDeletion(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	ids, err := queries(ctx, r.queries).ListUsersDueForDeletion(ctx, gen.ListUsersDueForDeletionParams{
+		Now:       sql.NullTime{Time: time.Now(), Valid: true},
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *PostgresAuthRepo) DeleteScheduledUser(ctx context.Context, userID uuid.UUID) error {
+	n, err := queries(ctx, r.queries).DeleteScheduledUser(ctx, gen.DeleteScheduledUserParams{
+		UserID: userID,
+		Now:    sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresDeviceCodeRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresDeviceCodeRepo(q *gen.Queries) *PostgresDeviceCodeRepo {
+	return &PostgresDeviceCodeRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresDeviceCodeRepo) SaveDeviceCode(ctx context.Context, deviceCodeHash string, userCodeHash string, clientID string, interval time.Duration, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).SaveDeviceCode(ctx, gen.SaveDeviceCodeParams{
+		DeviceCodeHash: deviceCodeHash,
+		UserCodeHash:   userCodeHash,
+		ClientID:       clientID,
+		PollInterval:   int32(interval / time.Second),
+		ExpiresAt:      expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresDeviceCodeRepo) GetDeviceCode(ctx context.Context, deviceCodeHash string) (*domain.DeviceCode, error) {
+	d, err := queries(ctx, r.queries).GetDeviceCode(ctx, deviceCodeHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainDeviceCode(d), nil
+}
+
+func (r *PostgresDeviceCodeRepo) GetDeviceCodeByUserCode(ctx context.Context, userCodeHash string) (*domain.DeviceCode, error) {
+	d, err := queries(ctx, r.queries).GetDeviceCodeByUserCode(ctx, userCodeHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainDeviceCode(d), nil
+}
+
+func (r *PostgresDeviceCodeRepo) DecideDeviceCode(ctx context.Context, userCodeHash string, userID uuid.UUID, status string) error {
+	n, err := queries(ctx, r.queries).DecideDeviceCode(ctx, gen.DecideDeviceCodeParams{
+		UserCodeHash: userCodeHash,
+		Status:       status,
+		UserID:       toNullUUID(userID),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresDeviceCodeRepo) PollDeviceCode(ctx context.Context, deviceCodeHash string, polledAt time.Time, interval time.Duration) (bool, error) {
+	polledBefore := polledAt.Add(-interval)
+
+	n, err := queries(ctx, r.queries).PollDeviceCode(ctx, gen.PollDeviceCodeParams{
+		PolledAt:       toNullTime(&polledAt),
+		DeviceCodeHash: deviceCodeHash,
+		PolledBefore:   toNullTime(&polledBefore),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		} else {
+			return false, err
+		}
+	}
+
+	return n > 0, nil
+}
+
+func (r *PostgresDeviceCodeRepo) SlowDownDeviceCode(ctx context.Context, deviceCodeHash string, step time.Duration) error {
+	err := queries(ctx, r.queries).SlowDownDeviceCode(ctx, gen.SlowDownDeviceCodeParams{
+		Step:           int32(step / time.Second),
+		DeviceCodeHash: deviceCodeHash,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresDeviceCodeRepo) DeleteDeviceCode(ctx context.Context, deviceCodeHash string) error {
+	n, err := queries(ctx, r.queries).DeleteDeviceCode(ctx, deviceCodeHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+	if n == 0 {
+		return repository.ErrNoRowDeleted
+	}
+
+	return nil
+}
+
+func (r *PostgresDeviceCodeRepo) DeleteExpiredDeviceCodes(ctx context.Context, limit int) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteExpiredDeviceCodes(ctx, int32(limit))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainDeviceCode(d gen.DeviceCode) *domain.DeviceCode {
+	return &domain.DeviceCode{
+		DeviceCodeHash: d.DeviceCodeHash,
+		UserCodeHash:   d.UserCodeHash,
+		ClientID:       d.ClientID,
+		Status:         d.Status,
+		UserID:         d.UserID.UUID,
+		Interval:       time.Duration(d.PollInterval) * time.Second,
+		LastPolledAt:   fromNullTime(d.LastPolledAt),
+		ExpiresAt:      d.ExpiresAt,
+		CreatedAt:      d.CreatedAt,
+	}
+}
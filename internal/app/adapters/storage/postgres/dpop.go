@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresDPoPProofRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresDPoPProofRepo(q *gen.Queries) *PostgresDPoPProofRepo {
+	return &PostgresDPoPProofRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresDPoPProofRepo) SaveDPoPProof(ctx context.Context, proofHash string, expiresAt time.Time) (bool, error) {
+	n, err := queries(ctx, r.queries).SaveDPoPProof(ctx, gen.SaveDPoPProofParams{
+		ProofHash: proofHash,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		} else {
+			return false, err
+		}
+	}
+
+	return n > 0, nil
+}
+
+func (r *PostgresDPoPProofRepo) DeleteExpiredDPoPProofs(ctx context.Context, limit int) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteExpiredDPoPProofs(ctx, int32(limit))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresEmailChangeRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresEmailChangeRepo(q *gen.Queries) *PostgresEmailChangeRepo {
+	return &PostgresEmailChangeRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresEmailChangeRepo) SaveEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, confirmTokenHash string, cancelTokenHash string, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).SaveEmailChange(ctx, gen.SaveEmailChangeParams{
+		UserID:           userID,
+		NewEmail:         newEmail,
+		ConfirmTokenHash: confirmTokenHash,
+		CancelTokenHash:  cancelTokenHash,
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresEmailChangeRepo) ConsumeEmailChange(ctx context.Context, confirmTokenHash string) (*domain.EmailChange, error) {
+	c, err := queries(ctx, r.queries).ConsumeEmailChange(ctx, confirmTokenHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNoRowDeleted
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.EmailChange{
+		UserID:    c.UserID,
+		NewEmail:  c.NewEmail,
+		ExpiresAt: c.ExpiresAt,
+		CreatedAt: c.CreatedAt,
+	}, nil
+}
+
+func (r *PostgresEmailChangeRepo) CancelEmailChange(ctx context.Context, cancelTokenHash string) (*domain.EmailChange, error) {
+	c, err := queries(ctx, r.queries).CancelEmailChange(ctx, cancelTokenHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNoRowDeleted
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.EmailChange{
+		UserID:    c.UserID,
+		NewEmail:  c.NewEmail,
+		ExpiresAt: c.ExpiresAt,
+		CreatedAt: c.CreatedAt,
+	}, nil
+}
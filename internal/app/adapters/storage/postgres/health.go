@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type PostgresHealthRepo struct {
+	db *sql.DB
+}
+
+func NewPostgresHealthRepo(db *sql.DB) *PostgresHealthRepo {
+	return &PostgresHealthRepo{
+		db: db,
+	}
+}
+
+func (r *PostgresHealthRepo) Ping(ctx context.Context) error {
+	if err := r.db.PingContext(ctx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SchemaVersion reads the version recorded by golang-migrate in schema_migrations.
+func (r *PostgresHealthRepo) SchemaVersion(ctx context.Context) (int64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+
+	err := r.db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, false, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, repository.ErrNotFound
+		} else {
+			return 0, false, err
+		}
+	}
+
+	return version, dirty, nil
+}
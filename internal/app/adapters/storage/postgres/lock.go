@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// TryWithLock takes a session-level advisory lock on a dedicated connection, so the
+// lock is held across the separate statements and transactions fn runs.
+func (s *Storage) TryWithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		}
+		return false, fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		}
+		return false, fmt.Errorf("try advisory lock: %w", err)
+	}
+	if !acquired {
+		return false, nil
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			// A connection returned to the pool would keep holding the lock.
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+	}()
+
+	return true, fn(ctx)
+}
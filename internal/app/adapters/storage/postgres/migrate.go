@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratepq "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/vo1dFl0w/auth-service/migrations"
+)
+
+// migrationLockKey identifies the advisory lock held while migrations run, so only
+// one replica migrates at a time.
+const migrationLockKey int64 = 0x61757468_6d696772
+
+// Migrator applies the embedded migrations over a single dedicated connection.
+type Migrator struct {
+	conn *sql.Conn
+	m    *migrate.Migrate
+}
+
+func NewMigrator(ctx context.Context, db *sql.DB) (*Migrator, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("open embedded migrations: %w", err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("acquire connection: %w", err)
+	}
+
+	// WithConnection closes only conn on Close, while WithInstance would close db.
+	driver, err := migratepq.WithConnection(ctx, conn, &migratepq.Config{})
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("create migrate driver: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("create migrator: %w", err)
+	}
+
+	return &Migrator{
+		conn: conn,
+		m:    m,
+	}, nil
+}
+
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+// WithLock runs fn while holding the migration advisory lock. Concurrent callers on
+// other replicas block until the lock is released.
+func (m *Migrator) WithLock(ctx context.Context, fn func() error) error {
+	if _, err := m.conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		_, _ = m.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey)
+	}()
+
+	return fn()
+}
+
+// Up applies all pending migrations. It is a no-op when the schema is up to date.
+func (m *Migrator) Up() error {
+	if err := m.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Down rolls back the given number of applied migrations.
+func (m *Migrator) Down(steps int) error {
+	if err := m.m.Steps(-steps); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Force sets the schema version without running migrations and clears the dirty flag.
+func (m *Migrator) Force(version int) error {
+	return m.m.Force(version)
+}
+
+// Version returns the applied schema version, or 0 when no migration has run yet.
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if err != nil {
+		if errors.Is(err, migrate.ErrNilVersion) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return version, dirty, nil
+}
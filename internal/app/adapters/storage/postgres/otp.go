@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresOTPRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresOTPRepo(q *gen.Queries) *PostgresOTPRepo {
+	return &PostgresOTPRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresOTPRepo) SaveOTP(ctx context.Context, userID uuid.UUID, channel string, codeHash string, rememberMe bool, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).SaveOTP(ctx, gen.SaveOTPParams{
+		UserID:     userID,
+		Channel:    channel,
+		CodeHash:   codeHash,
+		RememberMe: rememberMe,
+		ExpiresAt:  expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresOTPRepo) GetOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error) {
+	c, err := queries(ctx, r.queries).GetOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainOTP(c), nil
+}
+
+func (r *PostgresOTPRepo) AttemptOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error) {
+	c, err := queries(ctx, r.queries).AttemptOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainOTP(c), nil
+}
+
+func (r *PostgresOTPRepo) DeleteOTP(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	n, err := queries(ctx, r.queries).DeleteOTP(ctx, gen.DeleteOTPParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+	if n == 0 {
+		return repository.ErrNoRowDeleted
+	}
+
+	return nil
+}
+
+func (r *PostgresOTPRepo) CountOTPRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error) {
+	now := time.Now().UTC()
+	n, err := queries(ctx, r.queries).CountOTPRequest(ctx, gen.CountOTPRequestParams{
+		UserID:          userID,
+		WindowStartedAt: now,
+		WindowEndsAt:    now.Add(window),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return int(n), nil
+}
+
+func toDomainOTP(c gen.OtpCode) *domain.OTP {
+	return &domain.OTP{
+		UserID:     c.UserID,
+		Channel:    c.Channel,
+		CodeHash:   c.CodeHash,
+		Attempts:   int(c.Attempts),
+		RememberMe: c.RememberMe,
+		ExpiresAt:  c.ExpiresAt,
+		CreatedAt:  c.CreatedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresOutboxRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresOutboxRepo(q *gen.Queries) *PostgresOutboxRepo {
+	return &PostgresOutboxRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresOutboxRepo) CreateOutboxEvent(ctx context.Context, aggregateID uuid.UUID, eventType string, payload []byte) error {
+	err := queries(ctx, r.queries).CreateOutboxEvent(ctx, gen.CreateOutboxEventParams{
+		AggregateID: aggregateID,
+		EventType:   eventType,
+		Payload:     payload,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepo) ClaimOutboxEvents(ctx context.Context, batchSize int, maxAttempts int, leaseUntil time.Time) ([]domain.OutboxEvent, error) {
+	rows, err := queries(ctx, r.queries).ClaimOutboxEvents(ctx, gen.ClaimOutboxEventsParams{
+		LeaseUntil:  leaseUntil,
+		MaxAttempts: int32(maxAttempts),
+		BatchSize:   int32(batchSize),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	events := make([]domain.OutboxEvent, 0, len(rows))
+	for _, e := range rows {
+		events = append(events, domain.OutboxEvent{
+			ID:          e.ID,
+			AggregateID: e.AggregateID,
+			EventType:   e.EventType,
+			Payload:     e.Payload,
+			CreatedAt:   e.CreatedAt,
+			Attempts:    int(e.Attempts),
+		})
+	}
+	slices.SortFunc(events, func(a, b domain.OutboxEvent) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return events, nil
+}
+
+func (r *PostgresOutboxRepo) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID) error {
+	if err := queries(ctx, r.queries).MarkOutboxEventPublished(ctx, id); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepo) MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error {
+	err := queries(ctx, r.queries).MarkOutboxEventFailed(ctx, gen.MarkOutboxEventFailedParams{
+		ID:            id,
+		NextAttemptAt: nextAttemptAt,
+		LastError:     lastError,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepo) DeleteOutboxEventsByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteOutboxEventsByAggregate(ctx, aggregateID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *PostgresOutboxRepo) DeletePublishedOutboxEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	n, err := queries(ctx, r.queries).DeletePublishedOutboxEventsBefore(ctx, sql.NullTime{Time: before, Valid: true})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
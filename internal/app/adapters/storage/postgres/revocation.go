@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresRevocationRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresRevocationRepo(q *gen.Queries) *PostgresRevocationRepo {
+	return &PostgresRevocationRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresRevocationRepo) RevokeAccessToken(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).RevokeAccessToken(ctx, gen.RevokeAccessTokenParams{
+		Jti:       jti,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresRevocationRepo) RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID, issuedBefore time.Time, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).RevokeUserAccessTokens(ctx, gen.RevokeUserAccessTokensParams{
+		UserID:       userID,
+		IssuedBefore: issuedBefore,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresRevocationRepo) IsAccessTokenRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	revoked, err := queries(ctx, r.queries).IsAccessTokenRevoked(ctx, gen.IsAccessTokenRevokedParams{
+		Jti:      jti,
+		UserID:   userID,
+		IssuedAt: issuedAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		} else {
+			return false, err
+		}
+	}
+
+	return revoked.Bool, nil
+}
+
+// DeleteExpiredRevocations deletes up to limit expired rows from each revocation table
+// and returns the total.
+func (r *PostgresRevocationRepo) DeleteExpiredRevocations(ctx context.Context, limit int) (int64, error) {
+	q := queries(ctx, r.queries)
+
+	tokens, err := q.DeleteExpiredRevokedAccessTokens(ctx, int32(limit))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	users, err := q.DeleteExpiredUserAccessTokenRevocations(ctx, int32(limit))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return tokens, repository.ErrGatewayTimeout
+		} else {
+			return tokens, err
+		}
+	}
+
+	return tokens + users, nil
+}
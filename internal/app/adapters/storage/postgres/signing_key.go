@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresSigningKeyRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresSigningKeyRepo(q *gen.Queries) *PostgresSigningKeyRepo {
+	return &PostgresSigningKeyRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresSigningKeyRepo) CreateSigningKey(ctx context.Context, kid string, secret []byte) (*domain.SigningKey, error) {
+	k, err := queries(ctx, r.queries).CreateSigningKey(ctx, gen.CreateSigningKeyParams{
+		Kid:    kid,
+		Secret: secret,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainSigningKey(k), nil
+}
+
+func (r *PostgresSigningKeyRepo) ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error) {
+	rows, err := queries(ctx, r.queries).ListSigningKeys(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	keys := make([]domain.SigningKey, 0, len(rows))
+	for _, k := range rows {
+		keys = append(keys, *toDomainSigningKey(k))
+	}
+
+	return keys, nil
+}
+
+func (r *PostgresSigningKeyRepo) RetireSigningKeys(ctx context.Context, keepKID string, retireAt time.Time) (int64, error) {
+	n, err := queries(ctx, r.queries).RetireSigningKeys(ctx, gen.RetireSigningKeysParams{
+		Kid:       keepKID,
+		RetiredAt: toNullTime(&retireAt),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainSigningKey(k gen.SigningKey) *domain.SigningKey {
+	return &domain.SigningKey{
+		KID:       k.Kid,
+		Secret:    k.Secret,
+		CreatedAt: k.CreatedAt,
+		RetiredAt: fromNullTime(k.RetiredAt),
+	}
+}
@@ -9,26 +9,65 @@ import (
 )
 
 type Storage struct {
-	db        *sql.DB
-	authOnce  sync.Once
-	authRepo  repository.AuthRepository
-	tokenOnce sync.Once
-	tokenRepo repository.TokenRepository
+	db          *sql.DB
+	authOnce    sync.Once
+	authRepo    repository.AuthRepository
+	tokenOnce   sync.Once
+	tokenRepo   repository.TokenRepository
+	revokeOnce  sync.Once
+	revokeRepo  repository.RevocationRepository
+	attrOnce    sync.Once
+	attrRepo    repository.UserAttributeRepository
+	emailOnce   sync.Once
+	emailRepo   repository.EmailChangeRepository
+	magicOnce   sync.Once
+	magicRepo   repository.MagicLinkRepository
+	otpOnce     sync.Once
+	otpRepo     repository.OTPRepository
+	deviceOnce  sync.Once
+	deviceRepo  repository.DeviceCodeRepository
+	dpopOnce    sync.Once
+	dpopRepo    repository.DPoPProofRepository
+	apiKeyOnce  sync.Once
+	apiKeyRepo  repository.APIKeyRepository
+	auditOnce   sync.Once
+	auditRepo   repository.AuditRepository
+	outboxOnce  sync.Once
+	outboxRepo  repository.OutboxRepository
+	webhookOnce sync.Once
+	webhookRepo repository.WebhookRepository
+	healthOnce  sync.Once
+	healthRepo  repository.HealthRepository
+	keyOnce     sync.Once
+	keyRepo     repository.SigningKeyRepository
 }
 
 func New(db *sql.DB) *Storage {
-	q := gen.New(db)
+	q := gen.New(instrument(db))
 
 	return &Storage{
-		db:        db,
-		authRepo:  NewPostgresAuthRepo(q),
-		tokenRepo: NewPostgresTokenRepo(q),
+		db:          db,
+		authRepo:    NewPostgresAuthRepo(q),
+		tokenRepo:   NewPostgresTokenRepo(q),
+		revokeRepo:  NewPostgresRevocationRepo(q),
+		attrRepo:    NewPostgresUserAttributeRepo(q),
+		emailRepo:   NewPostgresEmailChangeRepo(q),
+		magicRepo:   NewPostgresMagicLinkRepo(q),
+		otpRepo:     NewPostgresOTPRepo(q),
+		deviceRepo:  NewPostgresDeviceCodeRepo(q),
+		dpopRepo:    NewPostgresDPoPProofRepo(q),
+		apiKeyRepo:  NewPostgresAPIKeyRepo(q),
+		auditRepo:   NewPostgresAuditRepo(q),
+		outboxRepo:  NewPostgresOutboxRepo(q),
+		webhookRepo: NewPostgresWebhookRepo(q),
+		healthRepo:  NewPostgresHealthRepo(db),
+		keyRepo:     NewPostgresSigningKeyRepo(q),
 	}
 }
 
 func (s *Storage) Auth() repository.AuthRepository {
 	s.authOnce.Do(func() {
-		q := gen.New(s.db)
+		q := gen.New(instrument(s.db))
 		s.authRepo = NewPostgresAuthRepo(q)
 	})
 	return s.authRepo
@@ -36,8 +75,111 @@ func (s *Storage) Auth() repository.AuthRepository {
 
 func (s *Storage) Token() repository.TokenRepository {
 	s.tokenOnce.Do(func() {
-		q := gen.New(s.db)
+		q := gen.New(instrument(s.db))
 		s.tokenRepo = NewPostgresTokenRepo(q)
 	})
 	return s.tokenRepo
 }
+
+func (s *Storage) Revocation() repository.RevocationRepository {
+	s.revokeOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.revokeRepo = NewPostgresRevocationRepo(q)
+	})
+	return s.revokeRepo
+}
+
+func (s *Storage) UserAttribute() repository.UserAttributeRepository {
+	s.attrOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.attrRepo = NewPostgresUserAttributeRepo(q)
+	})
+	return s.attrRepo
+}
+
+func (s *Storage) EmailChange() repository.EmailChangeRepository {
+	s.emailOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.emailRepo = NewPostgresEmailChangeRepo(q)
+	})
+	return s.emailRepo
+}
+
+func (s *Storage) MagicLink() repository.MagicLinkRepository {
+	s.magicOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.magicRepo = NewPostgresMagicLinkRepo(q)
+	})
+	return s.magicRepo
+}
+
+func (s *Storage) OTP() repository.OTPRepository {
+	s.otpOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.otpRepo = NewPostgresOTPRepo(q)
+	})
+	return s.otpRepo
+}
+
+func (s *Storage) DeviceCode() repository.DeviceCodeRepository {
+	s.deviceOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.deviceRepo = NewPostgresDeviceCodeRepo(q)
+	})
+	return s.deviceRepo
+}
+
+func (s *Storage) DPoPProof() repository.DPoPProofRepository {
+	s.dpopOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.dpopRepo = NewPostgresDPoPProofRepo(q)
+	})
+	return s.dpopRepo
+}
+
+func (s *Storage) APIKey() repository.APIKeyRepository {
+	s.apiKeyOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.apiKeyRepo = NewPostgresAPIKeyRepo(q)
+	})
+	return s.apiKeyRepo
+}
+
+func (s *Storage) Audit() repository.AuditRepository {
+	s.auditOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.auditRepo = NewPostgresAuditRepo(q)
+	})
+	return s.auditRepo
+}
+
+func (s *Storage) Outbox() repository.OutboxRepository {
+	s.outboxOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.outboxRepo = NewPostgresOutboxRepo(q)
+	})
+	return s.outboxRepo
+}
+
+func (s *Storage) Webhook() repository.WebhookRepository {
+	s.webhookOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.webhookRepo = NewPostgresWebhookRepo(q)
+	})
+	return s.webhookRepo
+}
+
+func (s *Storage) SigningKey() repository.SigningKeyRepository {
+	s.keyOnce.Do(func() {
+		q := gen.New(instrument(s.db))
+		s.keyRepo = NewPostgresSigningKeyRepo(q)
+	})
+	return s.keyRepo
+}
+
+func (s *Storage) Health() repository.HealthRepository {
+	s.healthOnce.Do(func() {
+		s.healthRepo = NewPostgresHealthRepo(s.db)
+	})
+	return s.healthRepo
+}
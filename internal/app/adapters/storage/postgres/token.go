@@ -23,7 +23,7 @@ func NewPostgresTokenRepo(q *gen.Queries) *PostgresTokenRepo {
 }
 
 func (r *PostgresTokenRepo) FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
-	ref, err := r.queries.FindRefreshToken(ctx, tokenHash)
+	ref, err := queries(ctx, r.queries).FindRefreshToken(ctx, tokenHash)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			return nil, repository.ErrGatewayTimeout
@@ -35,17 +35,28 @@ func (r *PostgresTokenRepo) FindRefreshToken(ctx context.Context, tokenHash stri
 	}
 
 	return &domain.RefreshToken{
-		UserID:       ref.UserID,
-		RefreshToken: ref.RefreshTokenHash,
-		ExpiresAt:    ref.ExpiresAt,
+		UserID:           ref.UserID,
+		RefreshToken:     ref.RefreshTokenHash,
+		CreatedAt:        ref.CreatedAt,
+		ExpiresAt:        ref.ExpiresAt,
+		SessionStartedAt: ref.SessionStartedAt,
+		RememberMe:       ref.RememberMe,
+		DPoPJKT:          ref.DpopJkt,
+		ClientID:         ref.ClientID,
+		X5T:              ref.X5t,
 	}, nil
 }
 
-func (r *PostgresTokenRepo) SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error {
-	_, err := r.queries.SaveHashedRefreshToken(ctx, gen.SaveHashedRefreshTokenParams{
+func (r *PostgresTokenRepo) SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time, sessionStartedAt time.Time, rememberMe bool, dpopJKT string, clientID string, x5t string) error {
+	_, err := queries(ctx, r.queries).SaveHashedRefreshToken(ctx, gen.SaveHashedRefreshTokenParams{
 		UserID:           userID,
 		RefreshTokenHash: tokenHash,
 		ExpiresAt:        expiresAt,
+		SessionStartedAt: sessionStartedAt,
+		RememberMe:       rememberMe,
+		DpopJkt:          dpopJKT,
+		ClientID:         clientID,
+		X5t:              x5t,
 	})
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
@@ -59,7 +70,7 @@ func (r *PostgresTokenRepo) SaveHashedRefreshToken(ctx context.Context, userID u
 }
 
 func (r *PostgresTokenRepo) DeleteRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
-	ref, err := r.queries.DeleteRefreshToken(ctx, tokenHash)
+	ref, err := queries(ctx, r.queries).DeleteRefreshToken(ctx, tokenHash)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 			return nil, repository.ErrGatewayTimeout
@@ -71,8 +82,78 @@ func (r *PostgresTokenRepo) DeleteRefreshToken(ctx context.Context, tokenHash st
 	}
 
 	return &domain.RefreshToken{
-		UserID:       ref.UserID,
-		RefreshToken: ref.RefreshTokenHash,
-		ExpiresAt:    ref.ExpiresAt,
+		UserID:           ref.UserID,
+		RefreshToken:     ref.RefreshTokenHash,
+		CreatedAt:        ref.CreatedAt,
+		ExpiresAt:        ref.ExpiresAt,
+		SessionStartedAt: ref.SessionStartedAt,
+		RememberMe:       ref.RememberMe,
+		DPoPJKT:          ref.DpopJkt,
+		ClientID:         ref.ClientID,
+		X5T:              ref.X5t,
 	}, nil
 }
+
+func (r *PostgresTokenRepo) CountActiveRefreshTokens(ctx context.Context) (int64, error) {
+	n, err := queries(ctx, r.queries).CountActiveRefreshTokens(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *PostgresTokenRepo) ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	rows, err := queries(ctx, r.queries).ListRefreshTokensByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	sessions := make([]domain.Session, 0, len(rows))
+	for _, t := range rows {
+		sessions = append(sessions, domain.Session{
+			ID:               t.ID,
+			UserID:           t.UserID,
+			CreatedAt:        t.CreatedAt,
+			ExpiresAt:        t.ExpiresAt,
+			SessionStartedAt: t.SessionStartedAt,
+			RememberMe:       t.RememberMe,
+		})
+	}
+
+	return sessions, nil
+}
+
+func (r *PostgresTokenRepo) DeleteRefreshTokensByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteRefreshTokensByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *PostgresTokenRepo) DeleteExpiredRefreshTokens(ctx context.Context, limit int) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteExpiredRefreshTokens(ctx, int32(limit))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type txKey struct{}
+
+func (s *Storage) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		}
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		}
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// queries returns q bound to the transaction started by WithinTx, if ctx carries one.
+func queries(ctx context.Context, q *gen.Queries) *gen.Queries {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return gen.New(instrument(tx))
+	}
+	return q
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresUserAttributeRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresUserAttributeRepo(q *gen.Queries) *PostgresUserAttributeRepo {
+	return &PostgresUserAttributeRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresUserAttributeRepo) ListUserAttributes(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error) {
+	rows, err := queries(ctx, r.queries).ListUserAttributes(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	attrs := make([]domain.UserAttribute, 0, len(rows))
+	for _, a := range rows {
+		attrs = append(attrs, domain.UserAttribute{
+			Name:      a.Name,
+			Value:     a.Value,
+			UpdatedAt: a.UpdatedAt,
+		})
+	}
+
+	return attrs, nil
+}
+
+func (r *PostgresUserAttributeRepo) SetUserAttribute(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error {
+	err := queries(ctx, r.queries).SetUserAttribute(ctx, gen.SetUserAttributeParams{
+		UserID: userID,
+		Name:   name,
+		Value:  value,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresUserAttributeRepo) DeleteUserAttribute(ctx context.Context, userID uuid.UUID, name string) error {
+	n, err := queries(ctx, r.queries).DeleteUserAttribute(ctx, gen.DeleteUserAttributeParams{
+		UserID: userID,
+		Name:   name,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+	if n == 0 {
+		return repository.ErrNoRowDeleted
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+type PostgresWebhookRepo struct {
+	queries *gen.Queries
+}
+
+func NewPostgresWebhookRepo(q *gen.Queries) *PostgresWebhookRepo {
+	return &PostgresWebhookRepo{
+		queries: q,
+	}
+}
+
+func (r *PostgresWebhookRepo) CreateWebhookSubscription(ctx context.Context, url string, secret string, eventTypes []string) (*domain.WebhookSubscription, error) {
+	s, err := queries(ctx, r.queries).CreateWebhookSubscription(ctx, gen.CreateWebhookSubscriptionParams{
+		Url:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	sub := toDomainWebhookSubscription(s)
+	return &sub, nil
+}
+
+func (r *PostgresWebhookRepo) ListWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	rows, err := queries(ctx, r.queries).ListWebhookSubscriptions(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	subs := make([]domain.WebhookSubscription, 0, len(rows))
+	for _, s := range rows {
+		subs = append(subs, toDomainWebhookSubscription(s))
+	}
+
+	return subs, nil
+}
+
+func (r *PostgresWebhookRepo) ListWebhookSubscriptionsByEvent(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error) {
+	rows, err := queries(ctx, r.queries).ListWebhookSubscriptionsByEvent(ctx, eventType)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	subs := make([]domain.WebhookSubscription, 0, len(rows))
+	for _, s := range rows {
+		subs = append(subs, toDomainWebhookSubscription(s))
+	}
+
+	return subs, nil
+}
+
+func (r *PostgresWebhookRepo) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	if _, err := queries(ctx, r.queries).DeleteWebhookSubscription(ctx, id); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrNoRowDeleted
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepo) CreateWebhookDelivery(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID, eventType string, payload []byte) error {
+	err := queries(ctx, r.queries).CreateWebhookDelivery(ctx, gen.CreateWebhookDeliveryParams{
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepo) ClaimWebhookDeliveries(ctx context.Context, batchSize int, leaseUntil time.Time) ([]domain.PendingWebhookDelivery, error) {
+	rows, err := queries(ctx, r.queries).ClaimWebhookDeliveries(ctx, gen.ClaimWebhookDeliveriesParams{
+		LeaseUntil: leaseUntil,
+		BatchSize:  int32(batchSize),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	deliveries := make([]domain.PendingWebhookDelivery, 0, len(rows))
+	for _, d := range rows {
+		deliveries = append(deliveries, domain.PendingWebhookDelivery{
+			WebhookDelivery: domain.WebhookDelivery{
+				ID:             d.ID,
+				SubscriptionID: d.SubscriptionID,
+				EventID:        d.EventID,
+				EventType:      d.EventType,
+				Payload:        d.Payload,
+				Status:         domain.WebhookDeliveryPending,
+				Attempts:       int(d.Attempts),
+				CreatedAt:      d.CreatedAt,
+			},
+			URL:    d.Url,
+			Secret: d.Secret,
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (r *PostgresWebhookRepo) MarkWebhookDeliverySucceeded(ctx context.Context, id uuid.UUID, statusCode int) error {
+	err := queries(ctx, r.queries).MarkWebhookDeliverySucceeded(ctx, gen.MarkWebhookDeliverySucceededParams{
+		ID:             id,
+		LastStatusCode: int32(statusCode),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepo) MarkWebhookDeliveryFailed(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, statusCode int, lastError string) error {
+	err := queries(ctx, r.queries).MarkWebhookDeliveryFailed(ctx, gen.MarkWebhookDeliveryFailedParams{
+		ID:             id,
+		Status:         status,
+		NextAttemptAt:  nextAttemptAt,
+		LastStatusCode: int32(statusCode),
+		LastError:      lastError,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepo) ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error) {
+	rows, err := queries(ctx, r.queries).ListWebhookDeliveries(ctx, gen.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Status:         toNullString(status),
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(rows))
+	for _, d := range rows {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:             d.ID,
+			SubscriptionID: d.SubscriptionID,
+			EventID:        d.EventID,
+			EventType:      d.EventType,
+			Payload:        d.Payload,
+			Status:         d.Status,
+			Attempts:       int(d.Attempts),
+			NextAttemptAt:  d.NextAttemptAt,
+			LastStatusCode: int(d.LastStatusCode),
+			LastError:      d.LastError,
+			CreatedAt:      d.CreatedAt,
+			UpdatedAt:      d.UpdatedAt,
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (r *PostgresWebhookRepo) DeleteWebhookDeliveriesByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteWebhookDeliveriesByAggregate(ctx, aggregateID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *PostgresWebhookRepo) DeleteFinishedWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteFinishedWebhookDeliveriesBefore(ctx, before)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainWebhookSubscription(s gen.WebhookSubscription) domain.WebhookSubscription {
+	return domain.WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.Url,
+		Secret:     s.Secret,
+		EventTypes: s.EventTypes,
+		CreatedAt:  s.CreatedAt,
+	}
+}
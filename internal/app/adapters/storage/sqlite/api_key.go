@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteAPIKeyRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteAPIKeyRepo(q *sqlitegen.Queries) *SQLiteAPIKeyRepo {
+	return &SQLiteAPIKeyRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteAPIKeyRepo) CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time) (*domain.APIKey, error) {
+	encodedScopes, err := encodeList(scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := queries(ctx, r.queries).CreateAPIKey(ctx, sqlitegen.CreateAPIKeyParams{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		KeyPrefix: prefix,
+		KeyHash:   keyHash,
+		Scopes:    encodedScopes,
+		CreatedAt: time.Now(),
+		ExpiresAt: toNullTime(expiresAt),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainAPIKey(k.ID, k.UserID, k.Name, k.KeyPrefix, k.Scopes, k.CreatedAt, k.ExpiresAt, k.LastUsedAt)
+}
+
+func (r *SQLiteAPIKeyRepo) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	rows, err := queries(ctx, r.queries).ListAPIKeysByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	keys := make([]domain.APIKey, 0, len(rows))
+	for _, k := range rows {
+		key, err := toDomainAPIKey(k.ID, k.UserID, k.Name, k.KeyPrefix, k.Scopes, k.CreatedAt, k.ExpiresAt, k.LastUsedAt)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+
+	return keys, nil
+}
+
+func (r *SQLiteAPIKeyRepo) FindAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	k, err := queries(ctx, r.queries).FindAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainAPIKey(k.ID, k.UserID, k.Name, k.KeyPrefix, k.Scopes, k.CreatedAt, k.ExpiresAt, k.LastUsedAt)
+}
+
+func (r *SQLiteAPIKeyRepo) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	err := queries(ctx, r.queries).TouchAPIKey(ctx, sqlitegen.TouchAPIKeyParams{
+		ID:  id,
+		Now: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteAPIKeyRepo) DeleteAPIKey(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	_, err := queries(ctx, r.queries).DeleteAPIKey(ctx, sqlitegen.DeleteAPIKeyParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrNoRowDeleted
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toDomainAPIKey(id uuid.UUID, userID uuid.UUID, name string, prefix string, scopes string, createdAt time.Time, expiresAt sql.NullTime, lastUsedAt sql.NullTime) (*domain.APIKey, error) {
+	decodedScopes, err := decodeList(scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.APIKey{
+		ID:         id,
+		UserID:     userID,
+		Name:       name,
+		Prefix:     prefix,
+		Scopes:     decodedScopes,
+		CreatedAt:  createdAt,
+		ExpiresAt:  fromNullTime(expiresAt),
+		LastUsedAt: fromNullTime(lastUsedAt),
+	}, nil
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func fromNullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// encodeList stores a Postgres TEXT[] column as a JSON array.
+func encodeList(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("encode list: %w", err)
+	}
+	return string(b), nil
+}
+
+func decodeList(s string) ([]string, error) {
+	var values []string
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil, fmt.Errorf("decode list: %w", err)
+	}
+	return values, nil
+}
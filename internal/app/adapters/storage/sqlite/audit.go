@@ -0,0 +1,130 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteAuditRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteAuditRepo(q *sqlitegen.Queries) *SQLiteAuditRepo {
+	return &SQLiteAuditRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteAuditRepo) CreateAuthEvent(ctx context.Context, event *domain.AuthEvent) error {
+	err := queries(ctx, r.queries).CreateAuthEvent(ctx, sqlitegen.CreateAuthEventParams{
+		ID:        uuid.New(),
+		EventType: event.EventType,
+		UserID:    toNullUUID(event.UserID),
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		Outcome:   event.Outcome,
+		Reason:    event.Reason,
+		RequestID: event.RequestID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteAuditRepo) ListAuthEventsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error) {
+	rows, err := queries(ctx, r.queries).ListAuthEventsByUser(ctx, sqlitegen.ListAuthEventsByUserParams{
+		UserID: toNullUUID(userID),
+		Limit:  int64(limit),
+		Offset: int64(offset),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainAuthEvents(rows), nil
+}
+
+func (r *SQLiteAuditRepo) ListAuthEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error) {
+	rows, err := queries(ctx, r.queries).ListAuthEvents(ctx, sqlitegen.ListAuthEventsParams{
+		UserID:    toNullUUID(filter.UserID),
+		EventType: toNullString(filter.EventType),
+		Outcome:   toNullString(filter.Outcome),
+		Since:     toNullTime(filter.Since),
+		Until:     toNullTime(filter.Until),
+		Limit:     int64(filter.Limit),
+		Offset:    int64(filter.Offset),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainAuthEvents(rows), nil
+}
+
+func (r *SQLiteAuditRepo) DeleteAuthEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteAuthEventsBefore(ctx, before)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainAuthEvents(rows []sqlitegen.AuthEvent) []domain.AuthEvent {
+	events := make([]domain.AuthEvent, 0, len(rows))
+	for _, e := range rows {
+		events = append(events, domain.AuthEvent{
+			ID:        e.ID,
+			EventType: e.EventType,
+			UserID:    e.UserID.UUID,
+			IP:        e.Ip,
+			UserAgent: e.UserAgent,
+			Outcome:   e.Outcome,
+			Reason:    e.Reason,
+			RequestID: e.RequestID,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return events
+}
+
+func toNullUUID(id uuid.UUID) uuid.NullUUID {
+	if id == uuid.Nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}
+}
+
+func toNullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
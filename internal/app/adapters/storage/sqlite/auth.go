@@ -0,0 +1,246 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+type SQLiteAuthRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteAuthRepo(q *sqlitegen.Queries) *SQLiteAuthRepo {
+	return &SQLiteAuthRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteAuthRepo) CreateUser(ctx context.Context, email string, passwordHash string) (*domain.User, error) {
+	var sqliteErr *sqlite.Error
+
+	u, err := queries(ctx, r.queries).CreateUser(ctx, sqlitegen.CreateUserParams{
+		UserID:       uuid.New(),
+		Email:        email,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			return nil, repository.ErrEmailAlreadyExists
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *SQLiteAuthRepo) GetUserInfo(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	u, err := queries(ctx, r.queries).GetUserInfo(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *SQLiteAuthRepo) FindUserByEmail(ctx context.Context, email string) (*domain.UserWithPassword, error) {
+	u, err := queries(ctx, r.queries).FindUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.UserWithPassword{
+		UserID:       u.UserID,
+		Email:        u.Email,
+		PasswordHash: u.PasswordHash,
+		CreatedAt:    u.CreatedAt,
+		IsActive:     u.IsActive,
+		Role:         u.Role,
+	}, nil
+}
+
+func (r *SQLiteAuthRepo) UpdateUserPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	n, err := queries(ctx, r.queries).UpdateUserPassword(ctx, sqlitegen.UpdateUserPasswordParams{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLiteAuthRepo) DeactivateUser(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	u, err := queries(ctx, r.queries).DeactivateUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *SQLiteAuthRepo) UpdateUserEmail(ctx context.Context, userID uuid.UUID, email string) (*domain.User, error) {
+	var sqliteErr *sqlite.Error
+
+	u, err := queries(ctx, r.queries).UpdateUserEmail(ctx, sqlitegen.UpdateUserEmailParams{
+		UserID: userID,
+		Email:  email,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			return nil, repository.ErrEmailAlreadyExists
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *SQLiteAuthRepo) ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, deleteAfter time.Time) (*domain.User, error) {
+	u, err := queries(ctx, r.queries).ScheduleUserDeletion(ctx, sqlitegen.ScheduleUserDeletionParams{
+		UserID:      userID,
+		DeleteAfter: sql.NullTime{Time: deleteAfter, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *SQLiteAuthRepo) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	u, err := queries(ctx, r.queries).CancelUserDeletion(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+func (r *SQLiteAuthRepo) ListUsersDueForDeletion(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	ids, err := queries(ctx, r.queries).ListUsersDueForDeletion(ctx, sqlitegen.ListUsersDueForDeletionParams{
+		Now:       sql.NullTime{Time: time.Now(), Valid: true},
+		BatchSize: int64(limit),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *SQLiteAuthRepo) DeleteScheduledUser(ctx context.Context, userID uuid.UUID) error {
+	n, err := queries(ctx, r.queries).DeleteScheduledUser(ctx, sqlitegen.DeleteScheduledUserParams{
+		UserID: userID,
+		Now:    sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
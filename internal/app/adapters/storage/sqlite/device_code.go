@@ -0,0 +1,174 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteDeviceCodeRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteDeviceCodeRepo(q *sqlitegen.Queries) *SQLiteDeviceCodeRepo {
+	return &SQLiteDeviceCodeRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteDeviceCodeRepo) SaveDeviceCode(ctx context.Context, deviceCodeHash string, userCodeHash string, clientID string, interval time.Duration, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).SaveDeviceCode(ctx, sqlitegen.SaveDeviceCodeParams{
+		DeviceCodeHash: deviceCodeHash,
+		UserCodeHash:   userCodeHash,
+		ClientID:       clientID,
+		PollInterval:   int64(interval / time.Second),
+		ExpiresAt:      expiresAt,
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteDeviceCodeRepo) GetDeviceCode(ctx context.Context, deviceCodeHash string) (*domain.DeviceCode, error) {
+	d, err := queries(ctx, r.queries).GetDeviceCode(ctx, deviceCodeHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainDeviceCode(d), nil
+}
+
+func (r *SQLiteDeviceCodeRepo) GetDeviceCodeByUserCode(ctx context.Context, userCodeHash string) (*domain.DeviceCode, error) {
+	d, err := queries(ctx, r.queries).GetDeviceCodeByUserCode(ctx, userCodeHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainDeviceCode(d), nil
+}
+
+func (r *SQLiteDeviceCodeRepo) DecideDeviceCode(ctx context.Context, userCodeHash string, userID uuid.UUID, status string) error {
+	n, err := queries(ctx, r.queries).DecideDeviceCode(ctx, sqlitegen.DecideDeviceCodeParams{
+		UserCodeHash: userCodeHash,
+		Status:       status,
+		UserID:       toNullUUID(userID),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLiteDeviceCodeRepo) PollDeviceCode(ctx context.Context, deviceCodeHash string, polledAt time.Time, interval time.Duration) (bool, error) {
+	polledBefore := polledAt.Add(-interval)
+
+	n, err := queries(ctx, r.queries).PollDeviceCode(ctx, sqlitegen.PollDeviceCodeParams{
+		PolledAt:       toNullTime(&polledAt),
+		DeviceCodeHash: deviceCodeHash,
+		PolledBefore:   toNullTime(&polledBefore),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		} else {
+			return false, err
+		}
+	}
+
+	return n > 0, nil
+}
+
+func (r *SQLiteDeviceCodeRepo) SlowDownDeviceCode(ctx context.Context, deviceCodeHash string, step time.Duration) error {
+	err := queries(ctx, r.queries).SlowDownDeviceCode(ctx, sqlitegen.SlowDownDeviceCodeParams{
+		Step:           int64(step / time.Second),
+		DeviceCodeHash: deviceCodeHash,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteDeviceCodeRepo) DeleteDeviceCode(ctx context.Context, deviceCodeHash string) error {
+	n, err := queries(ctx, r.queries).DeleteDeviceCode(ctx, deviceCodeHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+	if n == 0 {
+		return repository.ErrNoRowDeleted
+	}
+
+	return nil
+}
+
+func (r *SQLiteDeviceCodeRepo) DeleteExpiredDeviceCodes(ctx context.Context, limit int) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteExpiredDeviceCodes(ctx, sqlitegen.DeleteExpiredDeviceCodesParams{
+		Now:   time.Now(),
+		Limit: int64(limit),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainDeviceCode(d sqlitegen.DeviceCode) *domain.DeviceCode {
+	return &domain.DeviceCode{
+		DeviceCodeHash: d.DeviceCodeHash,
+		UserCodeHash:   d.UserCodeHash,
+		ClientID:       d.ClientID,
+		Status:         d.Status,
+		UserID:         d.UserID.UUID,
+		Interval:       time.Duration(d.PollInterval) * time.Second,
+		LastPolledAt:   fromNullTime(d.LastPolledAt),
+		ExpiresAt:      d.ExpiresAt,
+		CreatedAt:      d.CreatedAt,
+	}
+}
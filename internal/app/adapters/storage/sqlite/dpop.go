@@ -0,0 +1,52 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteDPoPProofRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteDPoPProofRepo(q *sqlitegen.Queries) *SQLiteDPoPProofRepo {
+	return &SQLiteDPoPProofRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteDPoPProofRepo) SaveDPoPProof(ctx context.Context, proofHash string, expiresAt time.Time) (bool, error) {
+	n, err := queries(ctx, r.queries).SaveDPoPProof(ctx, sqlitegen.SaveDPoPProofParams{
+		ProofHash: proofHash,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		} else {
+			return false, err
+		}
+	}
+
+	return n > 0, nil
+}
+
+func (r *SQLiteDPoPProofRepo) DeleteExpiredDPoPProofs(ctx context.Context, limit int) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteExpiredDPoPProofs(ctx, sqlitegen.DeleteExpiredDPoPProofsParams{
+		Now:   time.Now(),
+		Limit: int64(limit),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const queryNamePrefix = "-- name: "
+
+var tracer = otel.Tracer("github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/sqlite")
+
+// instrumentedDB traces every sqlc query and records its latency under the name
+// declared in its "-- name:" header. It also converts time arguments to UTC: SQLite
+// stores times as text and compares them as strings, which only orders correctly when
+// every value has the same offset.
+type instrumentedDB struct {
+	db sqlitegen.DBTX
+}
+
+func instrument(db sqlitegen.DBTX) sqlitegen.DBTX {
+	return &instrumentedDB{db: db}
+}
+
+func (d *instrumentedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span, start := startQuery(ctx, query)
+	res, err := d.db.ExecContext(ctx, query, utc(args)...)
+	endQuery(span, query, start, err)
+	return res, err
+}
+
+func (d *instrumentedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return d.db.PrepareContext(ctx, query)
+}
+
+func (d *instrumentedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span, start := startQuery(ctx, query)
+	rows, err := d.db.QueryContext(ctx, query, utc(args)...)
+	endQuery(span, query, start, err)
+	return rows, err
+}
+
+func (d *instrumentedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span, start := startQuery(ctx, query)
+	row := d.db.QueryRowContext(ctx, query, utc(args)...)
+	endQuery(span, query, start, row.Err())
+	return row
+}
+
+func startQuery(ctx context.Context, query string) (context.Context, trace.Span, time.Time) {
+	ctx, span := tracer.Start(ctx, queryName(query),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameSQLite,
+			semconv.DBOperationName(queryName(query)),
+		),
+	)
+	return ctx, span, time.Now()
+}
+
+func endQuery(span trace.Span, query string, start time.Time, err error) {
+	result := metrics.ResultSuccess
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		result = metrics.ResultFailure
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+
+	metrics.DBQueryDuration.WithLabelValues(queryName(query), result).Observe(time.Since(start).Seconds())
+}
+
+func queryName(query string) string {
+	if !strings.HasPrefix(query, queryNamePrefix) {
+		return "unknown"
+	}
+
+	name, _, _ := strings.Cut(strings.TrimPrefix(query, queryNamePrefix), " ")
+	return name
+}
+
+func utc(args []interface{}) []interface{} {
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case time.Time:
+			args[i] = v.UTC()
+		case sql.NullTime:
+			v.Time = v.Time.UTC()
+			args[i] = v
+		}
+	}
+	return args
+}
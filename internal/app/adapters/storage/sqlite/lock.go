@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// TryWithLock holds key in process memory. SQLite has no advisory locks, and a
+// database file is served by a single process, so there is no one else to exclude.
+func (s *Storage) TryWithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, repository.ErrGatewayTimeout
+	}
+
+	s.locksMu.Lock()
+	if _, held := s.locks[key]; held {
+		s.locksMu.Unlock()
+		return false, nil
+	}
+	s.locks[key] = struct{}{}
+	s.locksMu.Unlock()
+
+	defer func() {
+		s.locksMu.Lock()
+		delete(s.locks, key)
+		s.locksMu.Unlock()
+	}()
+
+	return true, fn(ctx)
+}
@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteMagicLinkRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteMagicLinkRepo(q *sqlitegen.Queries) *SQLiteMagicLinkRepo {
+	return &SQLiteMagicLinkRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteMagicLinkRepo) SaveMagicLink(ctx context.Context, userID uuid.UUID, tokenHash string, bindingHash string, rememberMe bool, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).SaveMagicLink(ctx, sqlitegen.SaveMagicLinkParams{
+		UserID:      userID,
+		TokenHash:   tokenHash,
+		BindingHash: bindingHash,
+		RememberMe:  rememberMe,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteMagicLinkRepo) ConsumeMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	l, err := queries(ctx, r.queries).ConsumeMagicLink(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNoRowDeleted
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.MagicLink{
+		UserID:      l.UserID,
+		BindingHash: l.BindingHash,
+		RememberMe:  l.RememberMe,
+		ExpiresAt:   l.ExpiresAt,
+		CreatedAt:   l.CreatedAt,
+	}, nil
+}
+
+func (r *SQLiteMagicLinkRepo) CountMagicLinkRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error) {
+	now := time.Now().UTC()
+	n, err := queries(ctx, r.queries).CountMagicLinkRequest(ctx, sqlitegen.CountMagicLinkRequestParams{
+		UserID:          userID,
+		WindowStartedAt: now,
+		WindowEndsAt:    now.Add(window),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return int(n), nil
+}
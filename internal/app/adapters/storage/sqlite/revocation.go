@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteRevocationRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteRevocationRepo(q *sqlitegen.Queries) *SQLiteRevocationRepo {
+	return &SQLiteRevocationRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteRevocationRepo) RevokeAccessToken(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).RevokeAccessToken(ctx, sqlitegen.RevokeAccessTokenParams{
+		Jti:       jti,
+		UserID:    userID,
+		RevokedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteRevocationRepo) RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID, issuedBefore time.Time, expiresAt time.Time) error {
+	err := queries(ctx, r.queries).RevokeUserAccessTokens(ctx, sqlitegen.RevokeUserAccessTokensParams{
+		UserID:       userID,
+		IssuedBefore: issuedBefore,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteRevocationRepo) IsAccessTokenRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	revoked, err := queries(ctx, r.queries).IsAccessTokenRevoked(ctx, sqlitegen.IsAccessTokenRevokedParams{
+		Jti:      jti,
+		UserID:   userID,
+		IssuedAt: issuedAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return false, repository.ErrGatewayTimeout
+		} else {
+			return false, err
+		}
+	}
+
+	return revoked.Bool, nil
+}
+
+// DeleteExpiredRevocations deletes up to limit expired rows from each revocation table
+// and returns the total.
+func (r *SQLiteRevocationRepo) DeleteExpiredRevocations(ctx context.Context, limit int) (int64, error) {
+	q := queries(ctx, r.queries)
+	now := time.Now()
+
+	tokens, err := q.DeleteExpiredRevokedAccessTokens(ctx, sqlitegen.DeleteExpiredRevokedAccessTokensParams{
+		Now:   now,
+		Limit: int64(limit),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	users, err := q.DeleteExpiredUserAccessTokenRevocations(ctx, sqlitegen.DeleteExpiredUserAccessTokenRevocationsParams{
+		Now:   now,
+		Limit: int64(limit),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return tokens, repository.ErrGatewayTimeout
+		} else {
+			return tokens, err
+		}
+	}
+
+	return tokens + users, nil
+}
@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteSigningKeyRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteSigningKeyRepo(q *sqlitegen.Queries) *SQLiteSigningKeyRepo {
+	return &SQLiteSigningKeyRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteSigningKeyRepo) CreateSigningKey(ctx context.Context, kid string, secret []byte) (*domain.SigningKey, error) {
+	k, err := queries(ctx, r.queries).CreateSigningKey(ctx, sqlitegen.CreateSigningKeyParams{
+		Kid:       kid,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainSigningKey(k), nil
+}
+
+func (r *SQLiteSigningKeyRepo) ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error) {
+	rows, err := queries(ctx, r.queries).ListSigningKeys(ctx, sql.NullTime{Time: time.Now(), Valid: true})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	keys := make([]domain.SigningKey, 0, len(rows))
+	for _, k := range rows {
+		keys = append(keys, *toDomainSigningKey(k))
+	}
+
+	return keys, nil
+}
+
+func (r *SQLiteSigningKeyRepo) RetireSigningKeys(ctx context.Context, keepKID string, retireAt time.Time) (int64, error) {
+	n, err := queries(ctx, r.queries).RetireSigningKeys(ctx, sqlitegen.RetireSigningKeysParams{
+		Kid:       keepKID,
+		RetiredAt: toNullTime(&retireAt),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainSigningKey(k sqlitegen.SigningKey) *domain.SigningKey {
+	return &domain.SigningKey{
+		KID:       k.Kid,
+		Secret:    k.Secret,
+		CreatedAt: k.CreatedAt,
+		RetiredAt: fromNullTime(k.RetiredAt),
+	}
+}
@@ -0,0 +1,149 @@
+// Package sqlite stores everything in a single SQLite database file, for small
+// deployments and local demos that do not want to run Postgres.
+//
+// The database is opened with a single connection: SQLite allows one writer at a time,
+// and serializing in database/sql avoids SQLITE_BUSY errors and lost updates in the
+// claim queries that Postgres guards with FOR UPDATE SKIP LOCKED. Only one process may
+// serve from a database file.
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+	_ "modernc.org/sqlite"
+)
+
+type Storage struct {
+	db          *sql.DB
+	authRepo    repository.AuthRepository
+	tokenRepo   repository.TokenRepository
+	revokeRepo  repository.RevocationRepository
+	attrRepo    repository.UserAttributeRepository
+	emailRepo   repository.EmailChangeRepository
+	magicRepo   repository.MagicLinkRepository
+	otpRepo     repository.OTPRepository
+	deviceRepo  repository.DeviceCodeRepository
+	dpopRepo    repository.DPoPProofRepository
+	apiKeyRepo  repository.APIKeyRepository
+	auditRepo   repository.AuditRepository
+	outboxRepo  repository.OutboxRepository
+	webhookRepo repository.WebhookRepository
+	healthRepo  repository.HealthRepository
+	keyRepo     repository.SigningKeyRepository
+
+	locksMu sync.Mutex
+	locks   map[int64]struct{}
+}
+
+// Open opens the database file at path, creating it if it does not exist.
+func Open(path string) (*sql.DB, error) {
+	dsn := "file:" + path + "?" + url.Values{
+		"_pragma":      {"foreign_keys(1)", "busy_timeout(5000)", "journal_mode(WAL)"},
+		"_time_format": {"sqlite"},
+		"_txlock":      {"immediate"},
+	}.Encode()
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open db: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping db: %w", err)
+	}
+
+	return db, nil
+}
+
+func New(db *sql.DB) *Storage {
+	q := sqlitegen.New(instrument(db))
+
+	return &Storage{
+		db:          db,
+		authRepo:    NewSQLiteAuthRepo(q),
+		tokenRepo:   NewSQLiteTokenRepo(q),
+		revokeRepo:  NewSQLiteRevocationRepo(q),
+		attrRepo:    NewSQLiteUserAttributeRepo(q),
+		emailRepo:   NewSQLiteEmailChangeRepo(q),
+		magicRepo:   NewSQLiteMagicLinkRepo(q),
+		otpRepo:     NewSQLiteOTPRepo(q),
+		deviceRepo:  NewSQLiteDeviceCodeRepo(q),
+		dpopRepo:    NewSQLiteDPoPProofRepo(q),
+		apiKeyRepo:  NewSQLiteAPIKeyRepo(q),
+		auditRepo:   NewSQLiteAuditRepo(q),
+		outboxRepo:  NewSQLiteOutboxRepo(q),
+		webhookRepo: NewSQLiteWebhookRepo(q),
+		healthRepo:  NewSQLiteHealthRepo(db),
+		keyRepo:     NewSQLiteSigningKeyRepo(q),
+		locks:       make(map[int64]struct{}),
+	}
+}
+
+func (s *Storage) Auth() repository.AuthRepository {
+	return s.authRepo
+}
+
+func (s *Storage) Token() repository.TokenRepository {
+	return s.tokenRepo
+}
+
+func (s *Storage) Revocation() repository.RevocationRepository {
+	return s.revokeRepo
+}
+
+func (s *Storage) UserAttribute() repository.UserAttributeRepository {
+	return s.attrRepo
+}
+
+func (s *Storage) EmailChange() repository.EmailChangeRepository {
+	return s.emailRepo
+}
+
+func (s *Storage) MagicLink() repository.MagicLinkRepository {
+	return s.magicRepo
+}
+
+func (s *Storage) OTP() repository.OTPRepository {
+	return s.otpRepo
+}
+
+func (s *Storage) DeviceCode() repository.DeviceCodeRepository {
+	return s.deviceRepo
+}
+
+func (s *Storage) DPoPProof() repository.DPoPProofRepository {
+	return s.dpopRepo
+}
+
+func (s *Storage) APIKey() repository.APIKeyRepository {
+	return s.apiKeyRepo
+}
+
+func (s *Storage) Audit() repository.AuditRepository {
+	return s.auditRepo
+}
+
+func (s *Storage) Outbox() repository.OutboxRepository {
+	return s.outboxRepo
+}
+
+func (s *Storage) Webhook() repository.WebhookRepository {
+	return s.webhookRepo
+}
+
+func (s *Storage) SigningKey() repository.SigningKeyRepository {
+	return s.keyRepo
+}
+
+func (s *Storage) Health() repository.HealthRepository {
+	return s.healthRepo
+}
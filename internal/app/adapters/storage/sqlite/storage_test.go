@@ -0,0 +1,56 @@
+package sqlite_test
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/sqlite"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/storagetest"
+	"github.com/vo1dFl0w/auth-service/migrations"
+)
+
+func TestStorage(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		db, err := sqlite.Open(filepath.Join(t.TempDir(), "auth.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		migrator, err := sqlite.NewMigrator(db)
+		require.NoError(t, err)
+		require.NoError(t, migrator.Up())
+
+		return sqlite.New(db)
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "auth.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator, err := sqlite.NewMigrator(db)
+	require.NoError(t, err)
+	defer migrator.Close()
+
+	require.NoError(t, migrator.Up())
+	latest, dirty, err := migrator.Version()
+	require.NoError(t, err)
+	require.False(t, dirty)
+
+	ups, err := fs.Glob(migrations.SQLiteFS, "sqlite/*.up.sql")
+	require.NoError(t, err)
+
+	// Every down migration must undo its up migration.
+	require.NoError(t, migrator.Down(len(ups)))
+	version, _, err := migrator.Version()
+	require.NoError(t, err)
+	require.Zero(t, version)
+
+	require.NoError(t, migrator.Up())
+	version, _, err = migrator.Version()
+	require.NoError(t, err)
+	require.Equal(t, latest, version)
+}
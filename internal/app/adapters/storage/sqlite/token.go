@@ -0,0 +1,167 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteTokenRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteTokenRepo(q *sqlitegen.Queries) *SQLiteTokenRepo {
+	return &SQLiteTokenRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteTokenRepo) FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ref, err := queries(ctx, r.queries).FindRefreshToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.RefreshToken{
+		UserID:           ref.UserID,
+		RefreshToken:     ref.RefreshTokenHash,
+		CreatedAt:        ref.CreatedAt,
+		ExpiresAt:        ref.ExpiresAt,
+		SessionStartedAt: ref.SessionStartedAt,
+		RememberMe:       ref.RememberMe,
+		DPoPJKT:          ref.DpopJkt,
+		ClientID:         ref.ClientID,
+		X5T:              ref.X5t,
+	}, nil
+}
+
+func (r *SQLiteTokenRepo) SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time, sessionStartedAt time.Time, rememberMe bool, dpopJKT string, clientID string, x5t string) error {
+	_, err := queries(ctx, r.queries).SaveHashedRefreshToken(ctx, sqlitegen.SaveHashedRefreshTokenParams{
+		ID:               uuid.New(),
+		UserID:           userID,
+		RefreshTokenHash: tokenHash,
+		CreatedAt:        time.Now(),
+		ExpiresAt:        expiresAt,
+		SessionStartedAt: sessionStartedAt,
+		RememberMe:       rememberMe,
+		DpopJkt:          dpopJKT,
+		ClientID:         clientID,
+		X5t:              x5t,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteTokenRepo) DeleteRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ref, err := queries(ctx, r.queries).DeleteRefreshToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNoRowDeleted
+		} else {
+			return nil, err
+		}
+	}
+
+	return &domain.RefreshToken{
+		UserID:           ref.UserID,
+		RefreshToken:     ref.RefreshTokenHash,
+		CreatedAt:        ref.CreatedAt,
+		ExpiresAt:        ref.ExpiresAt,
+		SessionStartedAt: ref.SessionStartedAt,
+		RememberMe:       ref.RememberMe,
+		DPoPJKT:          ref.DpopJkt,
+		ClientID:         ref.ClientID,
+		X5T:              ref.X5t,
+	}, nil
+}
+
+func (r *SQLiteTokenRepo) CountActiveRefreshTokens(ctx context.Context) (int64, error) {
+	n, err := queries(ctx, r.queries).CountActiveRefreshTokens(ctx, time.Now())
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *SQLiteTokenRepo) ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	rows, err := queries(ctx, r.queries).ListRefreshTokensByUser(ctx, sqlitegen.ListRefreshTokensByUserParams{
+		UserID: userID,
+		Now:    time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	sessions := make([]domain.Session, 0, len(rows))
+	for _, t := range rows {
+		sessions = append(sessions, domain.Session{
+			ID:               t.ID,
+			UserID:           t.UserID,
+			CreatedAt:        t.CreatedAt,
+			ExpiresAt:        t.ExpiresAt,
+			SessionStartedAt: t.SessionStartedAt,
+			RememberMe:       t.RememberMe,
+		})
+	}
+
+	return sessions, nil
+}
+
+func (r *SQLiteTokenRepo) DeleteRefreshTokensByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteRefreshTokensByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *SQLiteTokenRepo) DeleteExpiredRefreshTokens(ctx context.Context, limit int) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteExpiredRefreshTokens(ctx, sqlitegen.DeleteExpiredRefreshTokensParams{
+		Now:   time.Now(),
+		Limit: int64(limit),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteUserAttributeRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteUserAttributeRepo(q *sqlitegen.Queries) *SQLiteUserAttributeRepo {
+	return &SQLiteUserAttributeRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteUserAttributeRepo) ListUserAttributes(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error) {
+	rows, err := queries(ctx, r.queries).ListUserAttributes(ctx, userID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	attrs := make([]domain.UserAttribute, 0, len(rows))
+	for _, a := range rows {
+		attrs = append(attrs, domain.UserAttribute{
+			Name:      a.Name,
+			Value:     a.Value,
+			UpdatedAt: a.UpdatedAt,
+		})
+	}
+
+	return attrs, nil
+}
+
+func (r *SQLiteUserAttributeRepo) SetUserAttribute(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error {
+	err := queries(ctx, r.queries).SetUserAttribute(ctx, sqlitegen.SetUserAttributeParams{
+		UserID:    userID,
+		Name:      name,
+		Value:     value,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteUserAttributeRepo) DeleteUserAttribute(ctx context.Context, userID uuid.UUID, name string) error {
+	n, err := queries(ctx, r.queries).DeleteUserAttribute(ctx, sqlitegen.DeleteUserAttributeParams{
+		UserID: userID,
+		Name:   name,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+	if n == 0 {
+		return repository.ErrNoRowDeleted
+	}
+
+	return nil
+}
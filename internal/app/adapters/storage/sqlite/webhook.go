@@ -0,0 +1,269 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/gen/sqlitegen"
+)
+
+type SQLiteWebhookRepo struct {
+	queries *sqlitegen.Queries
+}
+
+func NewSQLiteWebhookRepo(q *sqlitegen.Queries) *SQLiteWebhookRepo {
+	return &SQLiteWebhookRepo{
+		queries: q,
+	}
+}
+
+func (r *SQLiteWebhookRepo) CreateWebhookSubscription(ctx context.Context, url string, secret string, eventTypes []string) (*domain.WebhookSubscription, error) {
+	encodedEventTypes, err := encodeList(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := queries(ctx, r.queries).CreateWebhookSubscription(ctx, sqlitegen.CreateWebhookSubscriptionParams{
+		ID:         uuid.New(),
+		Url:        url,
+		Secret:     secret,
+		EventTypes: encodedEventTypes,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainWebhookSubscription(s)
+}
+
+func (r *SQLiteWebhookRepo) ListWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	rows, err := queries(ctx, r.queries).ListWebhookSubscriptions(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainWebhookSubscriptions(rows)
+}
+
+func (r *SQLiteWebhookRepo) ListWebhookSubscriptionsByEvent(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error) {
+	rows, err := queries(ctx, r.queries).ListWebhookSubscriptionsByEvent(ctx, eventType)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	return toDomainWebhookSubscriptions(rows)
+}
+
+func (r *SQLiteWebhookRepo) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	if _, err := queries(ctx, r.queries).DeleteWebhookSubscription(ctx, id); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrNoRowDeleted
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteWebhookRepo) CreateWebhookDelivery(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID, eventType string, payload []byte) error {
+	err := queries(ctx, r.queries).CreateWebhookDelivery(ctx, sqlitegen.CreateWebhookDeliveryParams{
+		ID:             uuid.New(),
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Now:            time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteWebhookRepo) ClaimWebhookDeliveries(ctx context.Context, batchSize int, leaseUntil time.Time) ([]domain.PendingWebhookDelivery, error) {
+	rows, err := queries(ctx, r.queries).ClaimWebhookDeliveries(ctx, sqlitegen.ClaimWebhookDeliveriesParams{
+		LeaseUntil: leaseUntil,
+		Now:        time.Now(),
+		BatchSize:  int64(batchSize),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	deliveries := make([]domain.PendingWebhookDelivery, 0, len(rows))
+	for _, d := range rows {
+		deliveries = append(deliveries, domain.PendingWebhookDelivery{
+			WebhookDelivery: domain.WebhookDelivery{
+				ID:             d.ID,
+				SubscriptionID: d.SubscriptionID,
+				EventID:        d.EventID,
+				EventType:      d.EventType,
+				Payload:        d.Payload,
+				Status:         domain.WebhookDeliveryPending,
+				Attempts:       int(d.Attempts),
+				CreatedAt:      d.CreatedAt,
+			},
+			URL:    d.Url,
+			Secret: d.Secret,
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (r *SQLiteWebhookRepo) MarkWebhookDeliverySucceeded(ctx context.Context, id uuid.UUID, statusCode int) error {
+	err := queries(ctx, r.queries).MarkWebhookDeliverySucceeded(ctx, sqlitegen.MarkWebhookDeliverySucceededParams{
+		ID:             id,
+		LastStatusCode: int64(statusCode),
+		Now:            time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteWebhookRepo) MarkWebhookDeliveryFailed(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, statusCode int, lastError string) error {
+	err := queries(ctx, r.queries).MarkWebhookDeliveryFailed(ctx, sqlitegen.MarkWebhookDeliveryFailedParams{
+		ID:             id,
+		Status:         status,
+		NextAttemptAt:  nextAttemptAt,
+		LastStatusCode: int64(statusCode),
+		LastError:      lastError,
+		Now:            time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return repository.ErrGatewayTimeout
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteWebhookRepo) ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error) {
+	rows, err := queries(ctx, r.queries).ListWebhookDeliveries(ctx, sqlitegen.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Status:         toNullString(status),
+		Limit:          int64(limit),
+		Offset:         int64(offset),
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return nil, repository.ErrGatewayTimeout
+		} else {
+			return nil, err
+		}
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(rows))
+	for _, d := range rows {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:             d.ID,
+			SubscriptionID: d.SubscriptionID,
+			EventID:        d.EventID,
+			EventType:      d.EventType,
+			Payload:        d.Payload,
+			Status:         d.Status,
+			Attempts:       int(d.Attempts),
+			NextAttemptAt:  d.NextAttemptAt,
+			LastStatusCode: int(d.LastStatusCode),
+			LastError:      d.LastError,
+			CreatedAt:      d.CreatedAt,
+			UpdatedAt:      d.UpdatedAt,
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (r *SQLiteWebhookRepo) DeleteWebhookDeliveriesByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteWebhookDeliveriesByAggregate(ctx, aggregateID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *SQLiteWebhookRepo) DeleteFinishedWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	n, err := queries(ctx, r.queries).DeleteFinishedWebhookDeliveriesBefore(ctx, before)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return 0, repository.ErrGatewayTimeout
+		} else {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func toDomainWebhookSubscriptions(rows []sqlitegen.WebhookSubscription) ([]domain.WebhookSubscription, error) {
+	subs := make([]domain.WebhookSubscription, 0, len(rows))
+	for _, s := range rows {
+		sub, err := toDomainWebhookSubscription(s)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, *sub)
+	}
+
+	return subs, nil
+}
+
+func toDomainWebhookSubscription(s sqlitegen.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	eventTypes, err := decodeList(s.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.Url,
+		Secret:     s.Secret,
+		EventTypes: eventTypes,
+		CreatedAt:  s.CreatedAt,
+	}, nil
+}
@@ -3,6 +3,21 @@ package storage
 import "github.com/vo1dFl0w/auth-service/internal/app/repository"
 
 type Storage interface {
+	repository.Transactor
+	repository.Locker
 	Auth() repository.AuthRepository
 	Token() repository.TokenRepository
+	Revocation() repository.RevocationRepository
+	UserAttribute() repository.UserAttributeRepository
+	EmailChange() repository.EmailChangeRepository
+	MagicLink() repository.MagicLinkRepository
+	OTP() repository.OTPRepository
+	DeviceCode() repository.DeviceCodeRepository
+	DPoPProof() repository.DPoPProofRepository
+	APIKey() repository.APIKeyRepository
+	Audit() repository.AuditRepository
+	Outbox() repository.OutboxRepository
+	Webhook() repository.WebhookRepository
+	SigningKey() repository.SigningKeyRepository
+	Health() repository.HealthRepository
 }
\ No newline at end of file
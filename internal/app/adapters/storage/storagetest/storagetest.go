@@ -0,0 +1,1301 @@
+// Package storagetest is a conformance suite for storage.Storage implementations. Every
+// adapter runs it, so use cases can rely on the same results and errors from each.
+package storagetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// Run runs the suite against the storage returned by newStorage. The storage may hold
+// data of other tests: every case creates its own users and only asserts on those.
+func Run(t *testing.T, newStorage func(t *testing.T) storage.Storage) {
+	cases := []struct {
+		name string
+		fn   func(t *testing.T, s storage.Storage)
+	}{
+		{"CreateUser", testCreateUser},
+		{"FindUser", testFindUser},
+		{"UpdateUserPassword", testUpdateUserPassword},
+		{"DeactivateUser", testDeactivateUser},
+		{"UpdateUserEmail", testUpdateUserEmail},
+		{"ScheduleUserDeletion", testScheduleUserDeletion},
+		{"DeleteScheduledUser", testDeleteScheduledUser},
+		{"EmailChange", testEmailChange},
+		{"MagicLink", testMagicLink},
+		{"MagicLinkRequests", testMagicLinkRequests},
+		{"OTP", testOTP},
+		{"OTPRequests", testOTPRequests},
+		{"DeviceCode", testDeviceCode},
+		{"DPoPProof", testDPoPProof},
+		{"RefreshToken", testRefreshToken},
+		{"RefreshTokensByUser", testRefreshTokensByUser},
+		{"DeleteExpiredRefreshTokens", testDeleteExpiredRefreshTokens},
+		{"Revocation", testRevocation},
+		{"DeleteExpiredRevocations", testDeleteExpiredRevocations},
+		{"UserAttributes", testUserAttributes},
+		{"ConcurrentCreateUser", testConcurrentCreateUser},
+		{"ConcurrentDeleteRefreshToken", testConcurrentDeleteRefreshToken},
+		{"WithinTx", testWithinTx},
+		{"TryWithLock", testTryWithLock},
+		{"CanceledContext", testCanceledContext},
+		{"APIKey", testAPIKey},
+		{"AuditEvents", testAuditEvents},
+		{"Outbox", testOutbox},
+		{"Webhook", testWebhook},
+		{"SigningKeys", testSigningKeys},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.fn(t, newStorage(t))
+		})
+	}
+}
+
+func uniqueEmail() string {
+	return fmt.Sprintf("%s@example.org", uuid.NewString())
+}
+
+func uniqueHash() string {
+	return uuid.NewString()
+}
+
+var errRollback = errors.New("rollback")
+
+// rollback runs fn in a transaction that is always rolled back, for cases that touch
+// rows of other tests, like claiming from a queue or retiring every signing key.
+func rollback(t *testing.T, s storage.Storage, fn func(ctx context.Context)) {
+	err := s.WithinTx(context.Background(), func(ctx context.Context) error {
+		fn(ctx)
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+}
+
+func testCreateUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	email := uniqueEmail()
+
+	u, err := s.Auth().CreateUser(ctx, email, "password-hash")
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, u.UserID)
+	assert.Equal(t, email, u.Email)
+	assert.True(t, u.IsActive)
+	assert.Equal(t, "user", u.Role)
+	assert.WithinDuration(t, time.Now(), u.CreatedAt, time.Minute)
+	assert.Empty(t, u.Password)
+
+	_, err = s.Auth().CreateUser(ctx, email, "other-hash")
+	assert.ErrorIs(t, err, repository.ErrEmailAlreadyExists)
+}
+
+func testFindUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	info, err := s.Auth().GetUserInfo(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, info.UserID)
+	assert.Equal(t, u.Email, info.Email)
+
+	found, err := s.Auth().FindUserByEmail(ctx, u.Email)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, found.UserID)
+	assert.Equal(t, "password-hash", found.PasswordHash)
+	assert.True(t, found.IsActive)
+
+	_, err = s.Auth().GetUserInfo(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	_, err = s.Auth().FindUserByEmail(ctx, uniqueEmail())
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func testUpdateUserPassword(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	err = s.Auth().UpdateUserPassword(ctx, u.UserID, "new-password-hash")
+	require.NoError(t, err)
+
+	found, err := s.Auth().FindUserByEmail(ctx, u.Email)
+	require.NoError(t, err)
+	assert.Equal(t, "new-password-hash", found.PasswordHash)
+
+	err = s.Auth().UpdateUserPassword(ctx, uuid.New(), "new-password-hash")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func testUpdateUserEmail(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+	other, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	email := uniqueEmail()
+	updated, err := s.Auth().UpdateUserEmail(ctx, u.UserID, email)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, updated.UserID)
+	assert.Equal(t, email, updated.Email)
+
+	found, err := s.Auth().FindUserByEmail(ctx, email)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, found.UserID)
+	assert.Equal(t, "password-hash", found.PasswordHash)
+
+	_, err = s.Auth().FindUserByEmail(ctx, u.Email)
+	assert.ErrorIs(t, err, repository.ErrNotFound, "the old address is released")
+
+	_, err = s.Auth().UpdateUserEmail(ctx, u.UserID, other.Email)
+	assert.ErrorIs(t, err, repository.ErrEmailAlreadyExists)
+
+	_, err = s.Auth().UpdateUserEmail(ctx, uuid.New(), uniqueEmail())
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func testEmailChange(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	suffix := uuid.NewString()
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	require.NoError(t, s.EmailChange().SaveEmailChange(ctx, u.UserID, "first@example.org", "confirm-1-"+suffix, "cancel-1-"+suffix, expiresAt))
+	require.NoError(t, s.EmailChange().SaveEmailChange(ctx, u.UserID, "second@example.org", "confirm-2-"+suffix, "cancel-2-"+suffix, expiresAt))
+
+	_, err = s.EmailChange().ConsumeEmailChange(ctx, "confirm-1-"+suffix)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "a new request replaces the pending change")
+
+	c, err := s.EmailChange().ConsumeEmailChange(ctx, "confirm-2-"+suffix)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, c.UserID)
+	assert.Equal(t, "second@example.org", c.NewEmail)
+	assert.True(t, expiresAt.Equal(c.ExpiresAt))
+	assert.False(t, c.CreatedAt.IsZero())
+
+	_, err = s.EmailChange().ConsumeEmailChange(ctx, "confirm-2-"+suffix)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "confirm tokens are single use")
+	_, err = s.EmailChange().CancelEmailChange(ctx, "cancel-2-"+suffix)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "a confirmed change can no longer be cancelled")
+
+	require.NoError(t, s.EmailChange().SaveEmailChange(ctx, u.UserID, "third@example.org", "confirm-3-"+suffix, "cancel-3-"+suffix, expiresAt))
+	c, err = s.EmailChange().CancelEmailChange(ctx, "cancel-3-"+suffix)
+	require.NoError(t, err)
+	assert.Equal(t, "third@example.org", c.NewEmail)
+	_, err = s.EmailChange().ConsumeEmailChange(ctx, "confirm-3-"+suffix)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "a cancelled change can no longer be confirmed")
+
+	err = s.EmailChange().SaveEmailChange(ctx, uuid.New(), "fourth@example.org", "confirm-4-"+suffix, "cancel-4-"+suffix, expiresAt)
+	assert.Error(t, err, "changes must belong to an existing user")
+}
+
+func testMagicLink(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	suffix := uuid.NewString()
+	expiresAt := time.Now().Add(time.Minute * 15).UTC().Truncate(time.Second)
+
+	require.NoError(t, s.MagicLink().SaveMagicLink(ctx, u.UserID, "link-1-"+suffix, "", false, expiresAt))
+	require.NoError(t, s.MagicLink().SaveMagicLink(ctx, u.UserID, "link-2-"+suffix, "binding-"+suffix, true, expiresAt))
+
+	_, err = s.MagicLink().ConsumeMagicLink(ctx, "link-1-"+suffix)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "a new link replaces the pending one")
+
+	l, err := s.MagicLink().ConsumeMagicLink(ctx, "link-2-"+suffix)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, l.UserID)
+	assert.Equal(t, "binding-"+suffix, l.BindingHash)
+	assert.True(t, l.RememberMe)
+	assert.True(t, expiresAt.Equal(l.ExpiresAt))
+	assert.False(t, l.CreatedAt.IsZero())
+
+	_, err = s.MagicLink().ConsumeMagicLink(ctx, "link-2-"+suffix)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "links are single use")
+
+	err = s.MagicLink().SaveMagicLink(ctx, uuid.New(), "link-3-"+suffix, "", false, expiresAt)
+	assert.Error(t, err, "links must belong to an existing user")
+}
+
+func testMagicLinkRequests(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	for i := 1; i <= 3; i++ {
+		n, err := s.MagicLink().CountMagicLinkRequest(ctx, u.UserID, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, i, n)
+	}
+
+	// The count outlives the links of the window.
+	require.NoError(t, s.MagicLink().SaveMagicLink(ctx, u.UserID, "link-"+uuid.NewString(), "", false, time.Now().Add(time.Minute)))
+	n, err := s.MagicLink().CountMagicLinkRequest(ctx, u.UserID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	// OTP codes are counted apart from links.
+	n, err = s.OTP().CountOTPRequest(ctx, u.UserID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	other, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+	n, err = s.MagicLink().CountMagicLinkRequest(ctx, other.UserID, -time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = s.MagicLink().CountMagicLinkRequest(ctx, other.UserID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n, "a new window starts after the previous one ended")
+
+	_, err = s.MagicLink().CountMagicLinkRequest(ctx, uuid.New(), time.Hour)
+	assert.Error(t, err, "requests must belong to an existing user")
+}
+
+func testOTP(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Minute * 5).UTC().Truncate(time.Second)
+
+	_, err = s.OTP().GetOTP(ctx, u.UserID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	_, err = s.OTP().AttemptOTP(ctx, u.UserID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	require.NoError(t, s.OTP().SaveOTP(ctx, u.UserID, "email", "code-1", false, expiresAt))
+	c, err := s.OTP().AttemptOTP(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, c.Attempts)
+
+	require.NoError(t, s.OTP().SaveOTP(ctx, u.UserID, "sms", "code-2", true, expiresAt))
+	c, err = s.OTP().GetOTP(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, c.Attempts, "a new code resets the attempts")
+	assert.Equal(t, "sms", c.Channel)
+	assert.Equal(t, "code-2", c.CodeHash)
+	assert.True(t, c.RememberMe)
+	assert.True(t, expiresAt.Equal(c.ExpiresAt))
+	assert.False(t, c.CreatedAt.IsZero())
+
+	for i := 1; i <= 2; i++ {
+		c, err = s.OTP().AttemptOTP(ctx, u.UserID)
+		require.NoError(t, err)
+		assert.Equal(t, i, c.Attempts)
+	}
+
+	err = s.OTP().DeleteOTP(ctx, u.UserID, "code-1")
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "a replaced code cannot be deleted")
+	require.NoError(t, s.OTP().DeleteOTP(ctx, u.UserID, "code-2"))
+	err = s.OTP().DeleteOTP(ctx, u.UserID, "code-2")
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "codes are single use")
+
+	err = s.OTP().SaveOTP(ctx, uuid.New(), "email", "code-3", false, expiresAt)
+	assert.Error(t, err, "codes must belong to an existing user")
+}
+
+func testOTPRequests(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	for i := 1; i <= 3; i++ {
+		n, err := s.OTP().CountOTPRequest(ctx, u.UserID, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, i, n)
+	}
+
+	// The count outlives the codes of the window.
+	require.NoError(t, s.OTP().SaveOTP(ctx, u.UserID, "email", "code", false, time.Now().Add(time.Minute)))
+	require.NoError(t, s.OTP().DeleteOTP(ctx, u.UserID, "code"))
+	n, err := s.OTP().CountOTPRequest(ctx, u.UserID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	// Once a window ended, the next request starts a new one.
+	other, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+	n, err = s.OTP().CountOTPRequest(ctx, other.UserID, -time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = s.OTP().CountOTPRequest(ctx, other.UserID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n, "a new window starts after the previous one ended")
+	n, err = s.OTP().CountOTPRequest(ctx, other.UserID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	_, err = s.OTP().CountOTPRequest(ctx, uuid.New(), time.Hour)
+	assert.Error(t, err, "requests must belong to an existing user")
+}
+
+func testDeviceCode(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	deviceCode, userCode := uuid.NewString(), uuid.NewString()
+	expiresAt := time.Now().Add(time.Minute * 10).UTC().Truncate(time.Second)
+
+	_, err = s.DeviceCode().GetDeviceCode(ctx, deviceCode)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	require.NoError(t, s.DeviceCode().SaveDeviceCode(ctx, deviceCode, userCode, "cli", time.Second*5, expiresAt))
+	err = s.DeviceCode().SaveDeviceCode(ctx, uuid.NewString(), userCode, "cli", time.Second*5, expiresAt)
+	assert.Error(t, err, "user codes are unique")
+
+	d, err := s.DeviceCode().GetDeviceCodeByUserCode(ctx, userCode)
+	require.NoError(t, err)
+	assert.Equal(t, deviceCode, d.DeviceCodeHash)
+	assert.Equal(t, "cli", d.ClientID)
+	assert.Equal(t, domain.DeviceCodePending, d.Status)
+	assert.Equal(t, uuid.Nil, d.UserID)
+	assert.Equal(t, time.Second*5, d.Interval)
+	assert.Nil(t, d.LastPolledAt)
+	assert.True(t, expiresAt.Equal(d.ExpiresAt))
+
+	polledAt := time.Now()
+	ok, err := s.DeviceCode().PollDeviceCode(ctx, deviceCode, polledAt, d.Interval)
+	require.NoError(t, err)
+	assert.True(t, ok, "the first poll is never too early")
+	ok, err = s.DeviceCode().PollDeviceCode(ctx, deviceCode, polledAt.Add(time.Second), d.Interval)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = s.DeviceCode().PollDeviceCode(ctx, deviceCode, polledAt.Add(time.Second*6), d.Interval)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, s.DeviceCode().SlowDownDeviceCode(ctx, deviceCode, time.Second*5))
+	d, err = s.DeviceCode().GetDeviceCode(ctx, deviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second*10, d.Interval)
+	if assert.NotNil(t, d.LastPolledAt) {
+		assert.WithinDuration(t, polledAt.Add(time.Second*6), *d.LastPolledAt, time.Second)
+	}
+
+	require.NoError(t, s.DeviceCode().DecideDeviceCode(ctx, userCode, u.UserID, domain.DeviceCodeApproved))
+	err = s.DeviceCode().DecideDeviceCode(ctx, userCode, u.UserID, domain.DeviceCodeDenied)
+	assert.ErrorIs(t, err, repository.ErrNotFound, "only pending requests can be decided")
+	d, err = s.DeviceCode().GetDeviceCode(ctx, deviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeviceCodeApproved, d.Status)
+	assert.Equal(t, u.UserID, d.UserID)
+
+	require.NoError(t, s.DeviceCode().DeleteDeviceCode(ctx, deviceCode))
+	err = s.DeviceCode().DeleteDeviceCode(ctx, deviceCode)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted)
+
+	expired := uuid.NewString()
+	require.NoError(t, s.DeviceCode().SaveDeviceCode(ctx, expired, uuid.NewString(), "cli", time.Second*5, time.Now().Add(-time.Second)))
+	n, err := s.DeviceCode().DeleteExpiredDeviceCodes(ctx, 100)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, n, int64(1))
+	_, err = s.DeviceCode().GetDeviceCode(ctx, expired)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func testDPoPProof(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	hash := uniqueHash()
+	expired := uniqueHash()
+
+	saved, err := s.DPoPProof().SaveDPoPProof(ctx, hash, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, saved)
+
+	saved, err = s.DPoPProof().SaveDPoPProof(ctx, hash, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, saved, "a proof can only be used once")
+
+	saved, err = s.DPoPProof().SaveDPoPProof(ctx, expired, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.True(t, saved)
+
+	for {
+		n, err := s.DPoPProof().DeleteExpiredDPoPProofs(ctx, 100)
+		require.NoError(t, err)
+		if n < 100 {
+			break
+		}
+	}
+
+	saved, err = s.DPoPProof().SaveDPoPProof(ctx, expired, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, saved, "expired proofs are deleted")
+
+	saved, err = s.DPoPProof().SaveDPoPProof(ctx, hash, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, saved, "proofs that have not expired are kept")
+}
+
+func testDeactivateUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	deactivated, err := s.Auth().DeactivateUser(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, deactivated.UserID)
+	assert.False(t, deactivated.IsActive)
+
+	info, err := s.Auth().GetUserInfo(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.False(t, info.IsActive)
+
+	_, err = s.Auth().DeactivateUser(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func testScheduleUserDeletion(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	due, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+	later, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	scheduled, err := s.Auth().ScheduleUserDeletion(ctx, due.UserID, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.False(t, scheduled.IsActive, "users are deactivated until they are deleted")
+	_, err = s.Auth().ScheduleUserDeletion(ctx, later.UserID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	ids, err := s.Auth().ListUsersDueForDeletion(ctx, 1000)
+	require.NoError(t, err)
+	assert.Contains(t, ids, due.UserID)
+	assert.NotContains(t, ids, later.UserID, "the grace period has not passed")
+
+	err = s.Auth().DeleteScheduledUser(ctx, later.UserID)
+	assert.ErrorIs(t, err, repository.ErrNotFound, "users are not deleted before the grace period")
+
+	restored, err := s.Auth().CancelUserDeletion(ctx, due.UserID)
+	require.NoError(t, err)
+	assert.True(t, restored.IsActive)
+
+	ids, err = s.Auth().ListUsersDueForDeletion(ctx, 1000)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, due.UserID)
+
+	err = s.Auth().DeleteScheduledUser(ctx, due.UserID)
+	assert.ErrorIs(t, err, repository.ErrNotFound, "restored users are kept")
+	_, err = s.Auth().CancelUserDeletion(ctx, due.UserID)
+	assert.ErrorIs(t, err, repository.ErrNotFound, "the user is not scheduled for deletion")
+	_, err = s.Auth().ScheduleUserDeletion(ctx, uuid.New(), time.Now())
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func testDeleteScheduledUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+	require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, uniqueHash(), expiresAt, time.Now(), false, "", "", ""))
+	require.NoError(t, s.Revocation().RevokeAccessToken(ctx, uuid.NewString(), u.UserID, expiresAt))
+	require.NoError(t, s.Revocation().RevokeUserAccessTokens(ctx, u.UserID, time.Now(), expiresAt))
+	require.NoError(t, s.UserAttribute().SetUserAttribute(ctx, u.UserID, "tenant", json.RawMessage(`"acme"`)))
+	confirmHash, keyHash := uniqueHash(), uniqueHash()
+	require.NoError(t, s.EmailChange().SaveEmailChange(ctx, u.UserID, uniqueEmail(), confirmHash, uniqueHash(), expiresAt))
+	require.NoError(t, s.Audit().CreateAuthEvent(ctx, &domain.AuthEvent{EventType: "login", UserID: u.UserID, Outcome: "success"}))
+	_, err = s.APIKey().CreateAPIKey(ctx, u.UserID, "ci", "ak_del", keyHash, []string{"read"}, nil)
+	require.NoError(t, err)
+
+	rollback(t, s, func(ctx context.Context) {
+		eventType := "test." + uuid.NewString()
+		sub, err := s.Webhook().CreateWebhookSubscription(ctx, "https://example.org/hook", "secret", []string{eventType})
+		require.NoError(t, err)
+		require.NoError(t, s.Outbox().CreateOutboxEvent(ctx, u.UserID, eventType, []byte(`{"email":"a@example.org"}`)))
+		require.NoError(t, s.Outbox().CreateOutboxEvent(ctx, uuid.New(), eventType, []byte(`{}`)))
+
+		claimed := claimOutbox(t, ctx, s, u.UserID)
+		require.Len(t, claimed, 1)
+		require.NoError(t, s.Webhook().CreateWebhookDelivery(ctx, sub.ID, claimed[0].ID, eventType, claimed[0].Payload))
+
+		n, err := s.Webhook().DeleteWebhookDeliveriesByAggregate(ctx, u.UserID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		n, err = s.Outbox().DeleteOutboxEventsByAggregate(ctx, u.UserID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n, "events of other aggregates are kept")
+
+		deliveries, err := s.Webhook().ListWebhookDeliveries(ctx, sub.ID, "", 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, deliveries)
+		assert.Empty(t, claimOutbox(t, ctx, s, u.UserID))
+
+		_, err = s.Auth().ScheduleUserDeletion(ctx, u.UserID, time.Now().Add(-time.Second))
+		require.NoError(t, err)
+		require.NoError(t, s.Auth().DeleteScheduledUser(ctx, u.UserID))
+
+		_, err = s.Auth().GetUserInfo(ctx, u.UserID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		sessions, err := s.Token().ListRefreshTokensByUser(ctx, u.UserID)
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+		attrs, err := s.UserAttribute().ListUserAttributes(ctx, u.UserID)
+		require.NoError(t, err)
+		assert.Empty(t, attrs)
+		events, err := s.Audit().ListAuthEventsByUser(ctx, u.UserID, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+		keys, err := s.APIKey().ListAPIKeys(ctx, u.UserID)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+		_, err = s.APIKey().FindAPIKeyByHash(ctx, keyHash)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		_, err = s.EmailChange().ConsumeEmailChange(ctx, confirmHash)
+		assert.ErrorIs(t, err, repository.ErrNoRowDeleted)
+
+		err = s.Auth().DeleteScheduledUser(ctx, u.UserID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func testRefreshToken(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	activeBefore, err := s.Token().CountActiveRefreshTokens(ctx)
+	require.NoError(t, err)
+
+	hash := uniqueHash()
+	expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
+	startedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+
+	err = s.Token().SaveHashedRefreshToken(ctx, u.UserID, hash, expiresAt, startedAt, true, "jkt", "web", "x5t")
+	require.NoError(t, err)
+
+	activeAfter, err := s.Token().CountActiveRefreshTokens(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, activeBefore+1, activeAfter)
+
+	found, err := s.Token().FindRefreshToken(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, found.UserID)
+	assert.Equal(t, hash, found.RefreshToken)
+	assert.True(t, expiresAt.Equal(found.ExpiresAt))
+	assert.True(t, startedAt.Equal(found.SessionStartedAt))
+	assert.True(t, found.RememberMe)
+	assert.Equal(t, "jkt", found.DPoPJKT)
+	assert.Equal(t, "web", found.ClientID)
+	assert.Equal(t, "x5t", found.X5T)
+	assert.False(t, found.CreatedAt.IsZero())
+
+	deleted, err := s.Token().DeleteRefreshToken(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, deleted.UserID)
+	assert.True(t, startedAt.Equal(deleted.SessionStartedAt), "rotation carries the session start over")
+	assert.True(t, deleted.RememberMe)
+	assert.Equal(t, "jkt", deleted.DPoPJKT, "rotation keeps the key binding")
+	assert.Equal(t, "web", deleted.ClientID)
+	assert.Equal(t, "x5t", deleted.X5T, "rotation keeps the certificate binding")
+	assert.True(t, found.CreatedAt.Equal(deleted.CreatedAt))
+
+	_, err = s.Token().FindRefreshToken(ctx, hash)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	_, err = s.Token().DeleteRefreshToken(ctx, hash)
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted)
+
+	err = s.Token().SaveHashedRefreshToken(ctx, uuid.New(), uniqueHash(), expiresAt, startedAt, false, "", "", "")
+	assert.Error(t, err, "tokens must belong to an existing user")
+}
+
+func testRefreshTokensByUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, uniqueHash(), now.Add(time.Hour), now, false, "", "", ""))
+	require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, uniqueHash(), now.Add(time.Hour*2), now, true, "", "", ""))
+	require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, uniqueHash(), now.Add(-time.Hour), now, false, "", "", ""))
+
+	sessions, err := s.Token().ListRefreshTokensByUser(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 2, "expired tokens are not sessions")
+	for i, session := range sessions {
+		assert.Equal(t, u.UserID, session.UserID)
+		assert.NotEqual(t, uuid.Nil, session.ID)
+		if i > 0 {
+			assert.False(t, session.CreatedAt.After(sessions[i-1].CreatedAt), "newest first")
+		}
+	}
+
+	n, err := s.Token().DeleteRefreshTokensByUser(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	sessions, err = s.Token().ListRefreshTokensByUser(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	n, err = s.Token().DeleteRefreshTokensByUser(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func testDeleteExpiredRefreshTokens(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	active := uniqueHash()
+	expired := []string{uniqueHash(), uniqueHash(), uniqueHash()}
+
+	require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, active, time.Now().UTC().Add(time.Hour), time.Now().UTC(), false, "", "", ""))
+	for _, hash := range expired {
+		require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, hash, time.Now().UTC().Add(-time.Hour), time.Now().UTC(), false, "", "", ""))
+	}
+
+	n, err := s.Token().DeleteExpiredRefreshTokens(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n, "a batch is bounded by its limit")
+
+	for {
+		n, err := s.Token().DeleteExpiredRefreshTokens(ctx, 100)
+		require.NoError(t, err)
+		if n < 100 {
+			break
+		}
+	}
+
+	for _, hash := range expired {
+		_, err := s.Token().FindRefreshToken(ctx, hash)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	}
+
+	_, err = s.Token().FindRefreshToken(ctx, active)
+	assert.NoError(t, err)
+}
+
+func testRevocation(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	expiresAt := now.Add(time.Minute * 15)
+	jti := uuid.NewString()
+
+	revoked, err := s.Revocation().IsAccessTokenRevoked(ctx, jti, u.UserID, now)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.NoError(t, s.Revocation().RevokeAccessToken(ctx, jti, u.UserID, expiresAt))
+	require.NoError(t, s.Revocation().RevokeAccessToken(ctx, jti, u.UserID, expiresAt), "revoking twice is not an error")
+
+	revoked, err = s.Revocation().IsAccessTokenRevoked(ctx, jti, u.UserID, now)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	other := uuid.NewString()
+
+	revoked, err = s.Revocation().IsAccessTokenRevoked(ctx, other, u.UserID, now)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.NoError(t, s.Revocation().RevokeUserAccessTokens(ctx, u.UserID, now, expiresAt))
+	// An earlier cutoff does not undo a later one.
+	require.NoError(t, s.Revocation().RevokeUserAccessTokens(ctx, u.UserID, now.Add(-time.Hour), expiresAt))
+
+	revoked, err = s.Revocation().IsAccessTokenRevoked(ctx, other, u.UserID, now)
+	require.NoError(t, err)
+	assert.True(t, revoked, "issued at the cutoff")
+
+	revoked, err = s.Revocation().IsAccessTokenRevoked(ctx, other, u.UserID, now.Add(time.Second))
+	require.NoError(t, err)
+	assert.False(t, revoked, "issued after the cutoff")
+}
+
+func testDeleteExpiredRevocations(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	active := uuid.NewString()
+	expired := uuid.NewString()
+
+	require.NoError(t, s.Revocation().RevokeAccessToken(ctx, active, u.UserID, now.Add(time.Hour)))
+	require.NoError(t, s.Revocation().RevokeAccessToken(ctx, expired, u.UserID, now.Add(-time.Hour)))
+	require.NoError(t, s.Revocation().RevokeUserAccessTokens(ctx, u.UserID, now.Add(-time.Hour*2), now.Add(-time.Hour)))
+
+	for {
+		n, err := s.Revocation().DeleteExpiredRevocations(ctx, 100)
+		require.NoError(t, err)
+		if n < 100 {
+			break
+		}
+	}
+
+	revoked, err := s.Revocation().IsAccessTokenRevoked(ctx, expired, u.UserID, now.Add(-time.Hour*3))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	revoked, err = s.Revocation().IsAccessTokenRevoked(ctx, active, u.UserID, now)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func testUserAttributes(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	attrs, err := s.UserAttribute().ListUserAttributes(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Empty(t, attrs)
+
+	require.NoError(t, s.UserAttribute().SetUserAttribute(ctx, u.UserID, "plan", json.RawMessage(`"free"`)))
+	require.NoError(t, s.UserAttribute().SetUserAttribute(ctx, u.UserID, "features", json.RawMessage(`["beta"]`)))
+	require.NoError(t, s.UserAttribute().SetUserAttribute(ctx, u.UserID, "plan", json.RawMessage(`"pro"`)))
+
+	attrs, err = s.UserAttribute().ListUserAttributes(ctx, u.UserID)
+	require.NoError(t, err)
+	require.Len(t, attrs, 2, "setting an attribute again replaces it")
+	assert.Equal(t, "features", attrs[0].Name, "ordered by name")
+	assert.JSONEq(t, `["beta"]`, string(attrs[0].Value))
+	assert.Equal(t, "plan", attrs[1].Name)
+	assert.JSONEq(t, `"pro"`, string(attrs[1].Value))
+	assert.False(t, attrs[1].UpdatedAt.IsZero())
+
+	require.NoError(t, s.UserAttribute().DeleteUserAttribute(ctx, u.UserID, "plan"))
+	err = s.UserAttribute().DeleteUserAttribute(ctx, u.UserID, "plan")
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted)
+
+	attrs, err = s.UserAttribute().ListUserAttributes(ctx, u.UserID)
+	require.NoError(t, err)
+	assert.Len(t, attrs, 1)
+
+	err = s.UserAttribute().SetUserAttribute(ctx, uuid.New(), "plan", json.RawMessage(`"free"`))
+	assert.Error(t, err, "attributes must belong to an existing user")
+}
+
+func testConcurrentCreateUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	email := uniqueEmail()
+
+	const workers = 8
+	errs := make([]error, workers)
+
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = s.Auth().CreateUser(ctx, email, "password-hash")
+		}()
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(t, err, repository.ErrEmailAlreadyExists)
+	}
+	assert.Equal(t, 1, created)
+}
+
+func testConcurrentDeleteRefreshToken(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	hash := uniqueHash()
+	require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, hash, time.Now().UTC().Add(time.Hour), time.Now().UTC(), false, "", "", ""))
+
+	// Only one of several concurrent rotations of the same refresh token may win.
+	const workers = 8
+	errs := make([]error, workers)
+
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = s.Token().DeleteRefreshToken(ctx, hash)
+		}()
+	}
+	wg.Wait()
+
+	deleted := 0
+	for _, err := range errs {
+		if err == nil {
+			deleted++
+			continue
+		}
+		assert.ErrorIs(t, err, repository.ErrNoRowDeleted)
+	}
+	assert.Equal(t, 1, deleted)
+}
+
+func testWithinTx(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	rolledBack := uniqueEmail()
+	err := s.WithinTx(ctx, func(ctx context.Context) error {
+		u, err := s.Auth().CreateUser(ctx, rolledBack, "password-hash")
+		require.NoError(t, err)
+		require.NoError(t, s.Token().SaveHashedRefreshToken(ctx, u.UserID, uniqueHash(), time.Now().UTC().Add(time.Hour), time.Now().UTC(), false, "", "", ""))
+
+		// Writes are visible inside the transaction.
+		_, err = s.Auth().FindUserByEmail(ctx, rolledBack)
+		require.NoError(t, err)
+
+		return errRollback
+	})
+	assert.ErrorIs(t, err, errRollback)
+
+	_, err = s.Auth().FindUserByEmail(ctx, rolledBack)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	committed := uniqueEmail()
+	err = s.WithinTx(ctx, func(ctx context.Context) error {
+		// Nested calls join the outer transaction.
+		return s.WithinTx(ctx, func(ctx context.Context) error {
+			_, err := s.Auth().CreateUser(ctx, committed, "password-hash")
+			return err
+		})
+	})
+	require.NoError(t, err)
+
+	_, err = s.Auth().FindUserByEmail(ctx, committed)
+	assert.NoError(t, err)
+}
+
+func testTryWithLock(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	key := int64(0x73746f72)
+
+	ran := false
+	acquired, err := s.TryWithLock(ctx, key, func(ctx context.Context) error {
+		ran = true
+
+		acquired, err := s.TryWithLock(ctx, key, func(context.Context) error {
+			t.Error("lock acquired while held")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	assert.True(t, ran)
+
+	acquired, err = s.TryWithLock(ctx, key, func(context.Context) error { return nil })
+	require.NoError(t, err)
+	assert.True(t, acquired, "lock released after fn returned")
+}
+
+func testCanceledContext(t *testing.T, s storage.Storage) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	assert.ErrorIs(t, err, repository.ErrGatewayTimeout)
+
+	_, err = s.Auth().GetUserInfo(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrGatewayTimeout)
+
+	_, err = s.Token().DeleteRefreshToken(ctx, uniqueHash())
+	assert.ErrorIs(t, err, repository.ErrGatewayTimeout)
+}
+
+func testAPIKey(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
+	hash := uniqueHash()
+
+	created, err := s.APIKey().CreateAPIKey(ctx, u.UserID, "ci", "ak_123", hash, []string{"read", "write"}, &expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, u.UserID, created.UserID)
+	assert.Equal(t, "ci", created.Name)
+	assert.Equal(t, "ak_123", created.Prefix)
+	assert.Equal(t, []string{"read", "write"}, created.Scopes)
+	require.NotNil(t, created.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*created.ExpiresAt))
+	assert.Nil(t, created.LastUsedAt)
+
+	_, err = s.APIKey().CreateAPIKey(ctx, u.UserID, "no scopes", "ak_456", uniqueHash(), nil, nil)
+	require.NoError(t, err)
+
+	keys, err := s.APIKey().ListAPIKeys(ctx, u.UserID)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "no scopes", keys[0].Name, "newest first")
+	assert.Empty(t, keys[0].Scopes)
+	assert.Nil(t, keys[0].ExpiresAt)
+
+	found, err := s.APIKey().FindAPIKeyByHash(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+
+	require.NoError(t, s.APIKey().TouchAPIKey(ctx, created.ID))
+	found, err = s.APIKey().FindAPIKeyByHash(ctx, hash)
+	require.NoError(t, err)
+	require.NotNil(t, found.LastUsedAt)
+	assert.WithinDuration(t, time.Now(), *found.LastUsedAt, time.Minute)
+
+	err = s.APIKey().DeleteAPIKey(ctx, created.ID, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrNoRowDeleted, "keys are deleted only by their owner")
+
+	require.NoError(t, s.APIKey().DeleteAPIKey(ctx, created.ID, u.UserID))
+
+	_, err = s.APIKey().FindAPIKeyByHash(ctx, hash)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	// Keys stop working while their user is inactive, whether it was deactivated or
+	// scheduled for deletion.
+	for _, deactivate := range []func(userID uuid.UUID) error{
+		func(userID uuid.UUID) error {
+			_, err := s.Auth().DeactivateUser(ctx, userID)
+			return err
+		},
+		func(userID uuid.UUID) error {
+			_, err := s.Auth().ScheduleUserDeletion(ctx, userID, time.Now().UTC().Add(time.Hour))
+			return err
+		},
+	} {
+		u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+		require.NoError(t, err)
+		hash := uniqueHash()
+		_, err = s.APIKey().CreateAPIKey(ctx, u.UserID, "ci", "ak_789", hash, nil, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, deactivate(u.UserID))
+
+		_, err = s.APIKey().FindAPIKeyByHash(ctx, hash)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	}
+}
+
+func testAuditEvents(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	before := time.Now().UTC().Add(-time.Second)
+
+	u, err := s.Auth().CreateUser(ctx, uniqueEmail(), "password-hash")
+	require.NoError(t, err)
+
+	for _, e := range []domain.AuthEvent{
+		{EventType: "login", UserID: u.UserID, Outcome: "failure", Reason: "invalid_credentials", IP: "192.0.2.1"},
+		{EventType: "login", UserID: u.UserID, Outcome: "success", IP: "192.0.2.1", UserAgent: "curl", RequestID: "req-1"},
+		{EventType: "logout", UserID: u.UserID, Outcome: "success"},
+	} {
+		require.NoError(t, s.Audit().CreateAuthEvent(ctx, &e))
+		// Distinct timestamps keep the newest first order deterministic.
+		time.Sleep(time.Millisecond * 2)
+	}
+
+	events, err := s.Audit().ListAuthEventsByUser(ctx, u.UserID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "logout", events[0].EventType, "newest first")
+	assert.Equal(t, "req-1", events[1].RequestID)
+	assert.Equal(t, "curl", events[1].UserAgent)
+	assert.Equal(t, "invalid_credentials", events[2].Reason)
+
+	events, err = s.Audit().ListAuthEventsByUser(ctx, u.UserID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "success", events[0].Outcome)
+
+	events, err = s.Audit().ListAuthEvents(ctx, domain.AuthEventFilter{
+		UserID:    u.UserID,
+		EventType: "login",
+		Outcome:   "failure",
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "invalid_credentials", events[0].Reason)
+
+	future := time.Now().UTC().Add(time.Hour)
+	events, err = s.Audit().ListAuthEvents(ctx, domain.AuthEventFilter{UserID: u.UserID, Since: &future, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	events, err = s.Audit().ListAuthEvents(ctx, domain.AuthEventFilter{UserID: u.UserID, Since: &before, Until: &future, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	require.NoError(t, s.Audit().CreateAuthEvent(ctx, &domain.AuthEvent{EventType: "login", Outcome: "failure"}), "events without a user are kept too")
+
+	rollback(t, s, func(ctx context.Context) {
+		n, err := s.Audit().DeleteAuthEventsBefore(ctx, future)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, n, int64(4))
+
+		events, err := s.Audit().ListAuthEventsByUser(ctx, u.UserID, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
+func testOutbox(t *testing.T, s storage.Storage) {
+	rollback(t, s, func(ctx context.Context) {
+		aggregateID := uuid.New()
+		require.NoError(t, s.Outbox().CreateOutboxEvent(ctx, aggregateID, "user.registered", []byte(`{"email":"a@example.org"}`)))
+		require.NoError(t, s.Outbox().CreateOutboxEvent(ctx, aggregateID, "user.deactivated", []byte(`{}`)))
+
+		claimed := claimOutbox(t, ctx, s, aggregateID)
+		require.Len(t, claimed, 2)
+		registered, deactivated := claimed[0], claimed[1]
+		if registered.EventType != "user.registered" {
+			registered, deactivated = deactivated, registered
+		}
+		assert.Equal(t, "user.registered", registered.EventType)
+		assert.JSONEq(t, `{"email":"a@example.org"}`, string(registered.Payload))
+		assert.Zero(t, registered.Attempts)
+
+		require.NoError(t, s.Outbox().MarkOutboxEventPublished(ctx, registered.ID))
+		require.NoError(t, s.Outbox().MarkOutboxEventFailed(ctx, deactivated.ID, time.Now().UTC().Add(-time.Second), "connection refused"))
+
+		claimed = claimOutbox(t, ctx, s, aggregateID)
+		require.Len(t, claimed, 1, "published events are not claimed again")
+		assert.Equal(t, "user.deactivated", claimed[0].EventType)
+		assert.Equal(t, 1, claimed[0].Attempts)
+
+		require.NoError(t, s.Outbox().MarkOutboxEventFailed(ctx, claimed[0].ID, time.Now().UTC().Add(time.Hour), "connection refused"))
+		assert.Empty(t, claimOutbox(t, ctx, s, aggregateID), "events are not claimed before their next attempt")
+	})
+
+	rollback(t, s, func(ctx context.Context) {
+		aggregateID := uuid.New()
+		require.NoError(t, s.Outbox().CreateOutboxEvent(ctx, aggregateID, "user.registered", []byte(`{}`)))
+
+		events, err := s.Outbox().ClaimOutboxEvents(ctx, 1000, 10, time.Now().UTC().Add(time.Hour))
+		require.NoError(t, err)
+		assert.True(t, slices.ContainsFunc(events, func(e domain.OutboxEvent) bool { return e.AggregateID == aggregateID }))
+		assert.Empty(t, claimOutbox(t, ctx, s, aggregateID), "leased events are not claimed again")
+	})
+
+	for _, tc := range []struct {
+		name   string
+		before time.Time
+		kept   int64
+	}{
+		{"published since", time.Now().UTC().Add(-time.Hour), 2},
+		{"published before", time.Now().UTC().Add(time.Hour), 1},
+	} {
+		rollback(t, s, func(ctx context.Context) {
+			aggregateID := uuid.New()
+			require.NoError(t, s.Outbox().CreateOutboxEvent(ctx, aggregateID, "user.registered", []byte(`{}`)))
+			require.NoError(t, s.Outbox().CreateOutboxEvent(ctx, aggregateID, "user.login_failed", []byte(`{}`)))
+
+			claimed := claimOutbox(t, ctx, s, aggregateID)
+			require.Len(t, claimed, 2)
+			require.NoError(t, s.Outbox().MarkOutboxEventPublished(ctx, claimed[0].ID))
+
+			_, err := s.Outbox().DeletePublishedOutboxEventsBefore(ctx, tc.before)
+			require.NoError(t, err)
+
+			// pending events are kept however old they are
+			n, err := s.Outbox().DeleteOutboxEventsByAggregate(ctx, aggregateID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.kept, n, tc.name)
+		})
+	}
+}
+
+func claimOutbox(t *testing.T, ctx context.Context, s storage.Storage, aggregateID uuid.UUID) []domain.OutboxEvent {
+	events, err := s.Outbox().ClaimOutboxEvents(ctx, 1000, 10, time.Now().UTC().Add(-time.Hour))
+	require.NoError(t, err)
+
+	var claimed []domain.OutboxEvent
+	for _, e := range events {
+		if e.AggregateID == aggregateID {
+			claimed = append(claimed, e)
+		}
+	}
+	return claimed
+}
+
+func testWebhook(t *testing.T, s storage.Storage) {
+	rollback(t, s, func(ctx context.Context) {
+		eventType := "test." + uuid.NewString()
+
+		sub, err := s.Webhook().CreateWebhookSubscription(ctx, "https://example.org/hook", "secret", []string{eventType, "user.registered"})
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.org/hook", sub.URL)
+		assert.Equal(t, []string{eventType, "user.registered"}, sub.EventTypes)
+
+		other, err := s.Webhook().CreateWebhookSubscription(ctx, "https://example.org/other", "secret", []string{"user.registered"})
+		require.NoError(t, err)
+
+		subs, err := s.Webhook().ListWebhookSubscriptionsByEvent(ctx, eventType)
+		require.NoError(t, err)
+		require.Len(t, subs, 1)
+		assert.Equal(t, sub.ID, subs[0].ID)
+
+		subs, err = s.Webhook().ListWebhookSubscriptions(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(subs), 2)
+
+		eventID := uuid.New()
+		require.NoError(t, s.Webhook().CreateWebhookDelivery(ctx, sub.ID, eventID, eventType, []byte(`{"n":1}`)))
+		require.NoError(t, s.Webhook().CreateWebhookDelivery(ctx, sub.ID, eventID, eventType, []byte(`{"n":1}`)), "redelivered events are ignored")
+		require.NoError(t, s.Webhook().CreateWebhookDelivery(ctx, other.ID, eventID, eventType, []byte(`{"n":1}`)))
+
+		deliveries, err := s.Webhook().ListWebhookDeliveries(ctx, sub.ID, "", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, domain.WebhookDeliveryPending, deliveries[0].Status)
+
+		claimed, err := s.Webhook().ClaimWebhookDeliveries(ctx, 1000, time.Now().UTC().Add(time.Hour))
+		require.NoError(t, err)
+		var pending *domain.PendingWebhookDelivery
+		for _, d := range claimed {
+			if d.ID == deliveries[0].ID {
+				pending = &d
+			}
+		}
+		require.NotNil(t, pending)
+		assert.Equal(t, sub.URL, pending.URL)
+		assert.Equal(t, "secret", pending.Secret)
+		assert.JSONEq(t, `{"n":1}`, string(pending.Payload))
+
+		claimed, err = s.Webhook().ClaimWebhookDeliveries(ctx, 1000, time.Now().UTC().Add(time.Hour))
+		require.NoError(t, err)
+		assert.False(t, slices.ContainsFunc(claimed, func(d domain.PendingWebhookDelivery) bool { return d.ID == pending.ID }), "leased deliveries are not claimed again")
+
+		require.NoError(t, s.Webhook().MarkWebhookDeliveryFailed(ctx, pending.ID, domain.WebhookDeliveryPending, time.Now().UTC().Add(time.Hour), 503, "service unavailable"))
+
+		deliveries, err = s.Webhook().ListWebhookDeliveries(ctx, sub.ID, domain.WebhookDeliveryPending, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		assert.Equal(t, 503, deliveries[0].LastStatusCode)
+		assert.Equal(t, "service unavailable", deliveries[0].LastError)
+
+		require.NoError(t, s.Webhook().MarkWebhookDeliverySucceeded(ctx, pending.ID, 200))
+
+		deliveries, err = s.Webhook().ListWebhookDeliveries(ctx, sub.ID, domain.WebhookDeliverySucceeded, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+		assert.Empty(t, deliveries[0].LastError)
+
+		require.NoError(t, s.Webhook().DeleteWebhookSubscription(ctx, sub.ID))
+		err = s.Webhook().DeleteWebhookSubscription(ctx, sub.ID)
+		assert.ErrorIs(t, err, repository.ErrNoRowDeleted)
+
+		deliveries, err = s.Webhook().ListWebhookDeliveries(ctx, sub.ID, "", 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, deliveries, "deliveries are deleted with their subscription")
+	})
+
+	rollback(t, s, func(ctx context.Context) {
+		sub, err := s.Webhook().CreateWebhookSubscription(ctx, "https://example.org/hook", "secret", []string{"user.registered"})
+		require.NoError(t, err)
+
+		require.NoError(t, s.Webhook().CreateWebhookDelivery(ctx, sub.ID, uuid.New(), "user.registered", []byte(`{}`)))
+		require.NoError(t, s.Webhook().CreateWebhookDelivery(ctx, sub.ID, uuid.New(), "user.registered", []byte(`{}`)))
+
+		deliveries, err := s.Webhook().ListWebhookDeliveries(ctx, sub.ID, "", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		require.NoError(t, s.Webhook().MarkWebhookDeliverySucceeded(ctx, deliveries[0].ID, 200))
+
+		_, err = s.Webhook().DeleteFinishedWebhookDeliveriesBefore(ctx, time.Now().UTC().Add(-time.Hour))
+		require.NoError(t, err)
+
+		deliveries, err = s.Webhook().ListWebhookDeliveries(ctx, sub.ID, "", 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, deliveries, 2, "deliveries finished since are kept")
+
+		n, err := s.Webhook().DeleteFinishedWebhookDeliveriesBefore(ctx, time.Now().UTC().Add(time.Hour))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, n, int64(1))
+
+		deliveries, err = s.Webhook().ListWebhookDeliveries(ctx, sub.ID, "", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1, "pending deliveries are kept")
+		assert.Equal(t, domain.WebhookDeliveryPending, deliveries[0].Status)
+	})
+}
+
+func testSigningKeys(t *testing.T, s storage.Storage) {
+	rollback(t, s, func(ctx context.Context) {
+		old := uuid.NewString()
+		current := uuid.NewString()
+
+		_, err := s.SigningKey().CreateSigningKey(ctx, old, []byte("old-secret"))
+		require.NoError(t, err)
+		created, err := s.SigningKey().CreateSigningKey(ctx, current, []byte("current-secret"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("current-secret"), created.Secret)
+		assert.Nil(t, created.RetiredAt)
+
+		n, err := s.SigningKey().RetireSigningKeys(ctx, current, time.Now().UTC().Add(time.Hour))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, n, int64(1))
+
+		keys, err := s.SigningKey().ListSigningKeys(ctx)
+		require.NoError(t, err)
+		byKID := make(map[string]domain.SigningKey, len(keys))
+		for _, k := range keys {
+			byKID[k.KID] = k
+		}
+		require.Contains(t, byKID, current)
+		assert.Nil(t, byKID[current].RetiredAt)
+		require.Contains(t, byKID, old, "retiring keys stay valid until their retirement time")
+		assert.NotNil(t, byKID[old].RetiredAt)
+
+		n, err = s.SigningKey().RetireSigningKeys(ctx, current, time.Now().UTC().Add(-time.Second))
+		require.NoError(t, err)
+		assert.Zero(t, n, "already retiring keys keep their retirement time")
+
+		_, err = s.SigningKey().CreateSigningKey(ctx, current, []byte("again"))
+		assert.Error(t, err, "kids are unique")
+	})
+}
@@ -0,0 +1,77 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/webhook"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+func TestClient_Deliver(t *testing.T) {
+	secret := "whsec_test"
+	body := []byte(`{"id":"1","type":"user.registered"}`)
+
+	var verifyErr error
+	status := http.StatusOK
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		verifyErr = webhook.Verify(secret, r.Header, b, time.Minute)
+		assert.Equal(t, domain.EventUserRegistered, r.Header.Get(webhook.HeaderEvent))
+		w.WriteHeader(status)
+	}))
+	defer receiver.Close()
+
+	client := webhook.NewClient(time.Second)
+
+	delivery := domain.PendingWebhookDelivery{
+		WebhookDelivery: domain.WebhookDelivery{
+			ID:        uuid.New(),
+			EventID:   uuid.New(),
+			EventType: domain.EventUserRegistered,
+			Payload:   body,
+		},
+		URL:    receiver.URL,
+		Secret: secret,
+	}
+
+	code, err := client.Deliver(context.Background(), delivery)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.NoError(t, verifyErr)
+
+	status = http.StatusInternalServerError
+
+	code, err = client.Deliver(context.Background(), delivery)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, code)
+
+	delivery.Secret = "whsec_rotated"
+	status = http.StatusOK
+
+	_, err = client.Deliver(context.Background(), delivery)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, verifyErr, webhook.ErrInvalidSignature)
+}
+
+func TestVerify(t *testing.T) {
+	secret := "whsec_test"
+	body := []byte(`{}`)
+
+	header := http.Header{}
+	ts := time.Now().Add(-time.Hour).Unix()
+	header.Set(webhook.HeaderTimestamp, strconv.FormatInt(ts, 10))
+	header.Set(webhook.HeaderSignature, webhook.Sign(secret, ts, body))
+
+	assert.ErrorIs(t, webhook.Verify(secret, header, body, time.Minute), webhook.ErrStaleTimestamp)
+	assert.NoError(t, webhook.Verify(secret, header, body, time.Hour*2))
+	assert.ErrorIs(t, webhook.Verify(secret, http.Header{}, body, time.Minute), webhook.ErrMissingSignature)
+}
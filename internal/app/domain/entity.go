@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ type User struct {
 	Password  string
 	CreatedAt time.Time
 	IsActive  bool
+	Role      string
 }
 
 type UserWithPassword struct {
@@ -20,6 +22,7 @@ type UserWithPassword struct {
 	PasswordHash string
 	CreatedAt    time.Time
 	IsActive     bool
+	Role         string
 }
 
 type Tokens struct {
@@ -28,8 +31,185 @@ type Tokens struct {
 	RefreshTokenExpiresAt time.Time
 }
 
+// RefreshToken is the current link of a session's refresh chain. CreatedAt is
+// the time of the last rotation, SessionStartedAt the time of the login that
+// started the chain.
 type RefreshToken struct {
-	UserID       uuid.UUID
-	RefreshToken string
-	ExpiresAt    time.Time
+	UserID           uuid.UUID
+	RefreshToken     string
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+	// DPoPJKT is the thumbprint of the key the token is bound to, or empty if the
+	// token is not bound to a key.
+	DPoPJKT string
+	// ClientID is the authenticated client the session was started by, or empty for
+	// a public client. Refreshes keep it, whatever client they come from.
+	ClientID string
+	// X5T is the thumbprint of the TLS client certificate the token is bound to, or
+	// empty if the token is not bound to a certificate.
+	X5T string
+}
+
+// Session is an active refresh token as seen by operators. The token itself is
+// never exposed, only its metadata.
+type Session struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+}
+
+// SigningKey is an HMAC key for access tokens. Retired keys still verify tokens
+// until RetiredAt, so tokens signed before a rotation stay valid until they expire.
+type SigningKey struct {
+	KID       string
+	Secret    []byte
+	CreatedAt time.Time
+	RetiredAt *time.Time
+}
+
+// UserAttribute is a piece of data about a user that is put into their access
+// tokens as a custom claim named after the attribute.
+type UserAttribute struct {
+	Name      string
+	Value     json.RawMessage
+	UpdatedAt time.Time
+}
+
+// EmailChange is a pending change of a user's email. The address is only swapped
+// once the change is confirmed with the token sent to NewEmail.
+type EmailChange struct {
+	UserID    uuid.UUID
+	NewEmail  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// MagicLink is a pending passwordless login. BindingHash is the hash of the nonce
+// given to the browser that asked for the link, or empty if the link works in any
+// browser.
+type MagicLink struct {
+	UserID      uuid.UUID
+	BindingHash string
+	RememberMe  bool
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+const (
+	OTPChannelEmail = "email"
+	OTPChannelSMS   = "sms"
+)
+
+// OTP is a pending one-time login code, sent to the user over Channel. Attempts
+// counts the tries to enter it.
+type OTP struct {
+	UserID     uuid.UUID
+	Channel    string
+	CodeHash   string
+	Attempts   int
+	RememberMe bool
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+const (
+	DeviceCodePending  = "pending"
+	DeviceCodeApproved = "approved"
+	DeviceCodeDenied   = "denied"
+)
+
+// DeviceCode is a device authorization request (RFC 8628) of a client. Both codes are
+// stored hashed. UserID is set once a user approved or denied the request, and
+// Interval is the time the client has to wait between polls.
+type DeviceCode struct {
+	DeviceCodeHash string
+	UserCodeHash   string
+	ClientID       string
+	Status         string
+	UserID         uuid.UUID
+	Interval       time.Duration
+	LastPolledAt   *time.Time
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+}
+
+// DeviceAuthorization is handed to a client that started a device authorization. The
+// client shows UserCode and VerificationURI to the user and polls with DeviceCode.
+type DeviceAuthorization struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresAt               time.Time
+	Interval                time.Duration
+}
+
+// ExchangedToken is an access token issued by a token exchange. It acts for the user
+// of the exchanged token, limited to Audiences and Scopes.
+type ExchangedToken struct {
+	AccessToken string
+	Audiences   []string
+	Scopes      []string
+	ExpiresAt   time.Time
+}
+
+// AccountExport is everything stored about a user, as handed out on a data export
+// request. API keys are listed without their hashes.
+type AccountExport struct {
+	User       User
+	Sessions   []Session
+	APIKeys    []APIKey
+	Attributes []UserAttribute
+	AuthEvents []AuthEvent
+	ExportedAt time.Time
+}
+
+// Message is a notification addressed to a user, e.g. an email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type APIKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+type CreatedAPIKey struct {
+	APIKey
+	Key string
+}
+
+type AuthEvent struct {
+	ID        uuid.UUID
+	EventType string
+	UserID    uuid.UUID
+	IP        string
+	UserAgent string
+	Outcome   string
+	Reason    string
+	RequestID string
+	CreatedAt time.Time
+}
+
+type AuthEventFilter struct {
+	UserID    uuid.UUID
+	EventType string
+	Outcome   string
+	Since     *time.Time
+	Until     *time.Time
+	Limit     int
+	Offset    int
 }
@@ -3,16 +3,51 @@ package domain
 import "errors"
 
 var (
+	ErrAccessDenied                 = errors.New("access denied")
+	ErrAPIKeyNotFound               = errors.New("api key not found")
+	ErrAuthorizationPending         = errors.New("authorization pending")
+	ErrClaimsTooLarge               = errors.New("custom claims too large")
+	ErrDeletionNotScheduled         = errors.New("user is not scheduled for deletion")
 	ErrEmailAlreadyExists           = errors.New("email already exists")
 	ErrEmptyPassword                = errors.New("empty password")
 	ErrEmptyRefreshToken            = errors.New("empty refresh token")
 	ErrExpiredAccessToken           = errors.New("expired access token")
+	ErrExpiredDeviceCode            = errors.New("expired device code")
+	ErrForbidden                    = errors.New("forbidden")
 	ErrInvalidPassword              = errors.New("invalid password")
 	ErrInvalidEmail                 = errors.New("invalid email")
 	ErrInvalidAccessToken           = errors.New("invalid access token")
+	ErrInvalidClaim                 = errors.New("invalid custom claim")
+	ErrInvalidEmailChangeToken      = errors.New("invalid or expired email change token")
+	ErrInvalidClient                = errors.New("invalid client")
+	ErrInvalidDPoPProof             = errors.New("invalid dpop proof")
+	ErrInvalidGrant                 = errors.New("invalid grant")
+	ErrInvalidRequest               = errors.New("invalid request")
+	ErrInvalidScope                 = errors.New("invalid scope")
+	ErrInvalidTarget                = errors.New("invalid target")
+	ErrInvalidUserCode              = errors.New("invalid or expired user code")
+	ErrInvalidOTP                   = errors.New("invalid or expired code")
+	ErrInvalidOTPChannel            = errors.New("invalid code channel")
+	ErrInvalidMagicLink             = errors.New("invalid or expired magic link")
+	ErrInvalidAPIKey                = errors.New("invalid api key")
+	ErrInvalidAPIKeyExpiry          = errors.New("invalid api key expiry")
+	ErrInvalidAPIKeyName            = errors.New("invalid api key name")
+	ErrInvalidAPIKeyScope           = errors.New("invalid api key scope")
+	ErrInsufficientScope            = errors.New("insufficient scope")
+	ErrInvalidWebhookEventType      = errors.New("invalid webhook event type")
+	ErrInvalidWebhookURL            = errors.New("invalid webhook url")
 	ErrInvalidOrExpiredRefreshToken = errors.New("invalid or expired refresh token")
+	ErrSlowDown                     = errors.New("slow down")
+	ErrUnauthorizedClient           = errors.New("unauthorized client")
+	ErrUseDPoPNonce                 = errors.New("use dpop nonce")
+	ErrUnsupportedGrantType         = errors.New("unsupported grant type")
+	ErrUnsupportedTokenType         = errors.New("unsupported token type")
+	ErrRevokedAccessToken           = errors.New("revoked access token")
 	ErrGatewayTimeout               = errors.New("gateway timeout")
 	ErrNotFound                     = errors.New("not found")
 	ErrWrongEmailOrPassword         = errors.New("wrong email or password")
+	ErrWrongPassword                = errors.New("wrong password")
+	ErrWebhookNotFound              = errors.New("webhook not found")
 	ErrWrongUserID                  = errors.New("wrong user id")
+	ErrUserNotFound                 = errors.New("user not found")
 )
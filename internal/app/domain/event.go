@@ -0,0 +1,45 @@
+package domain
+
+const (
+	EventRegister      = "register"
+	EventLogin         = "login"
+	EventRefresh       = "refresh"
+	EventLogout        = "logout"
+	EventEmailChange   = "email_change"
+	EventDeleteAccount = "delete_account"
+	EventMagicLink     = "magic_link"
+	EventOTP           = "otp"
+	EventDeviceCode    = "device_code"
+	EventTokenExchange = "token_exchange"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+const (
+	ReasonEmailAlreadyExists = "email_already_exists"
+	ReasonInvalidEmail       = "invalid_email"
+	ReasonInvalidPassword    = "invalid_password"
+	ReasonUserNotFound       = "user_not_found"
+	ReasonUserInactive       = "user_inactive"
+	ReasonWrongPassword      = "wrong_password"
+	ReasonExpiredToken       = "expired_token"
+	ReasonUnknownToken       = "unknown_token"
+	ReasonSessionExpired     = "session_expired"
+	ReasonSessionIdle        = "session_idle"
+	ReasonCancelled          = "cancelled"
+	ReasonBindingMismatch    = "binding_mismatch"
+	ReasonWrongCode          = "wrong_code"
+	ReasonTooManyAttempts    = "too_many_attempts"
+	ReasonResendTooSoon      = "resend_too_soon"
+	ReasonTooManyCodes       = "too_many_codes"
+	ReasonTooManyLinks       = "too_many_links"
+	ReasonNoPhoneNumber      = "no_phone_number"
+	ReasonAccessDenied       = "access_denied"
+	ReasonClientMismatch     = "client_mismatch"
+	ReasonInvalidToken       = "invalid_token"
+	ReasonInvalidTarget      = "invalid_target"
+	ReasonInvalidScope       = "invalid_scope"
+)
@@ -0,0 +1,23 @@
+package domain
+
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+	HealthStatusDraining    = "draining"
+)
+
+const (
+	HealthCheckPostgres    = "postgres"
+	HealthCheckMigrations  = "migrations"
+	HealthCheckSigningKeys = "signing_keys"
+)
+
+type HealthReport struct {
+	Status string
+	// Checks maps the name of every dependency check to "ok" or the reason it failed.
+	Checks map[string]string
+}
+
+func (r HealthReport) Ready() bool {
+	return r.Status == HealthStatusOK
+}
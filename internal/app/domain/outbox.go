@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventUserRegistered   = "user.registered"
+	EventUserDeactivated  = "user.deactivated"
+	EventUserEmailChanged = "user.email_changed"
+	EventUserDeleted      = "user.deleted"
+	EventUserLoginFailed  = "user.login_failed"
+	EventSessionRevoked   = "session.revoked"
+)
+
+type OutboxEvent struct {
+	ID          uuid.UUID
+	AggregateID uuid.UUID
+	EventType   string
+	Payload     []byte
+	CreatedAt   time.Time
+	Attempts    int
+}
+
+// EventEnvelope is the wire format of an outbox event. ID is stable across
+// redeliveries and is meant to be used by consumers as an idempotency key.
+type EventEnvelope struct {
+	ID          uuid.UUID       `json:"id"`
+	Type        string          `json:"type"`
+	AggregateID uuid.UUID       `json:"aggregate_id"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+func (e OutboxEvent) Envelope() EventEnvelope {
+	return EventEnvelope{
+		ID:          e.ID,
+		Type:        e.EventType,
+		AggregateID: e.AggregateID,
+		CreatedAt:   e.CreatedAt,
+		Data:        e.Payload,
+	}
+}
+
+type UserLifecyclePayload struct {
+	UserID        uuid.UUID `json:"user_id"`
+	Email         string    `json:"email"`
+	PreviousEmail string    `json:"previous_email,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// UserDeletedPayload announces that a user and everything stored about it is gone.
+// It carries no email, as the address is personal data that was just erased.
+type UserDeletedPayload struct {
+	UserID     uuid.UUID `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type LoginFailedPayload struct {
+	UserID     *uuid.UUID `json:"user_id,omitempty"`
+	Email      string     `json:"email"`
+	Reason     string     `json:"reason"`
+	IP         string     `json:"ip"`
+	OccurredAt time.Time  `json:"occurred_at"`
+}
+
+const (
+	RevokeReasonLogout        = "logout"
+	RevokeReasonRevokeAll     = "revoke_all"
+	RevokeReasonPasswordReset = "password_reset"
+	RevokeReasonDeactivated   = "user_deactivated"
+	RevokeReasonDeleted       = "user_deleted"
+)
+
+type SessionRevokedPayload struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Reason     string    `json:"reason"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
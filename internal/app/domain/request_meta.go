@@ -0,0 +1,41 @@
+package domain
+
+import "context"
+
+type requestMetaKey struct{}
+
+// RequestMeta describes the client a request came from. It is attached to the
+// context by the transport layer so usecases can record it without depending on it.
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+	RequestID string
+	// ClientID names the application the request came from. Token lifetimes and
+	// audiences can be configured per client.
+	ClientID string
+	// DPoPJKT is the thumbprint of the key of a verified DPoP proof sent with the
+	// request. Tokens issued for the request are bound to that key.
+	DPoPJKT string
+	// ClientCert is the verified TLS client certificate of the request, or nil. Tokens
+	// issued for the request are bound to it.
+	ClientCert *ClientCertificate
+}
+
+// ClientCertificate describes a TLS client certificate (RFC 8705). SubjectDN is in the
+// form of "CN=orders,O=Example", Thumbprint is the unpadded base64url SHA-256 hash of
+// the DER encoding.
+type ClientCertificate struct {
+	SubjectDN  string
+	DNSNames   []string
+	URIs       []string
+	Thumbprint string
+}
+
+func WithRequestMeta(ctx context.Context, m RequestMeta) context.Context {
+	return context.WithValue(ctx, requestMetaKey{}, m)
+}
+
+func RequestMetaFromContext(ctx context.Context) RequestMeta {
+	m, _ := ctx.Value(requestMetaKey{}).(RequestMeta)
+	return m
+}
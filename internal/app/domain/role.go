@@ -0,0 +1,6 @@
+package domain
+
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
@@ -0,0 +1,17 @@
+package domain
+
+import "slices"
+
+const (
+	ScopeUserRead  = "user:read"
+	ScopeAuditRead = "audit:read"
+)
+
+var APIKeyScopes = []string{
+	ScopeUserRead,
+	ScopeAuditRead,
+}
+
+func IsKnownScope(scope string) bool {
+	return slices.Contains(APIKeyScopes, scope)
+}
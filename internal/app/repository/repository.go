@@ -2,21 +2,189 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/vo1dFl0w/auth-service/internal/app/domain"
 )
 
-
 type AuthRepository interface {
 	CreateUser(ctx context.Context, email string, passwordHash string) (*domain.User, error)
 	GetUserInfo(ctx context.Context, user_id uuid.UUID) (*domain.User, error)
 	FindUserByEmail(ctx context.Context, email string) (*domain.UserWithPassword, error)
+	UpdateUserPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error
+	DeactivateUser(ctx context.Context, userID uuid.UUID) (*domain.User, error)
+	UpdateUserEmail(ctx context.Context, userID uuid.UUID, email string) (*domain.User, error)
+	// ScheduleUserDeletion deactivates the user and marks it for deletion once
+	// deleteAfter has passed. CancelUserDeletion reactivates a user marked for deletion
+	// and returns ErrNotFound for any other user.
+	ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, deleteAfter time.Time) (*domain.User, error)
+	CancelUserDeletion(ctx context.Context, userID uuid.UUID) (*domain.User, error)
+	ListUsersDueForDeletion(ctx context.Context, limit int) ([]uuid.UUID, error)
+	// DeleteScheduledUser deletes a user whose deletion is due together with every
+	// row that references it. It returns ErrNotFound if the deletion is not due.
+	DeleteScheduledUser(ctx context.Context, userID uuid.UUID) error
 }
 
 type TokenRepository interface {
 	FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
-	SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error
+	SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time, sessionStartedAt time.Time, rememberMe bool, dpopJKT string, clientID string, x5t string) error
 	DeleteRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	CountActiveRefreshTokens(ctx context.Context) (int64, error)
+	ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]domain.Session, error)
+	DeleteRefreshTokensByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteExpiredRefreshTokens(ctx context.Context, limit int) (int64, error)
+}
+
+type UserAttributeRepository interface {
+	ListUserAttributes(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error)
+	SetUserAttribute(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error
+	DeleteUserAttribute(ctx context.Context, userID uuid.UUID, name string) error
+}
+
+// EmailChangeRepository stores pending email changes, at most one per user. Saving a
+// change replaces the pending one. A change is deleted when it is consumed by its
+// confirm token or cancelled by its cancel token, whichever comes first.
+type EmailChangeRepository interface {
+	SaveEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, confirmTokenHash string, cancelTokenHash string, expiresAt time.Time) error
+	ConsumeEmailChange(ctx context.Context, confirmTokenHash string) (*domain.EmailChange, error)
+	CancelEmailChange(ctx context.Context, cancelTokenHash string) (*domain.EmailChange, error)
+}
+
+// MagicLinkRepository stores pending magic links, at most one per user. Saving a link
+// replaces the pending one; consuming a link deletes it.
+type MagicLinkRepository interface {
+	SaveMagicLink(ctx context.Context, userID uuid.UUID, tokenHash string, bindingHash string, rememberMe bool, expiresAt time.Time) error
+	ConsumeMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error)
+	// CountMagicLinkRequest counts a link sent to the user and returns the number of
+	// links sent in the current window of the user, like CountOTPRequest.
+	CountMagicLinkRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error)
+}
+
+// OTPRepository stores pending one-time login codes, at most one per user. Saving a
+// code replaces the pending one and resets its attempts.
+type OTPRepository interface {
+	SaveOTP(ctx context.Context, userID uuid.UUID, channel string, codeHash string, rememberMe bool, expiresAt time.Time) error
+	GetOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error)
+	// AttemptOTP counts a try to enter the code of the user and returns the code with
+	// the new count.
+	AttemptOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error)
+	// DeleteOTP deletes the code of the user if it is still the one of codeHash. It
+	// returns ErrNoRowDeleted otherwise, e.g. if the code was used in the meantime.
+	DeleteOTP(ctx context.Context, userID uuid.UUID, codeHash string) error
+	// CountOTPRequest counts a code sent to the user and returns the number of codes
+	// sent in the current window of the user. A window starts with the first code
+	// after the previous window ended and lasts for window.
+	CountOTPRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error)
+}
+
+// DeviceCodeRepository stores device authorization requests by the hash of their
+// device code.
+type DeviceCodeRepository interface {
+	SaveDeviceCode(ctx context.Context, deviceCodeHash string, userCodeHash string, clientID string, interval time.Duration, expiresAt time.Time) error
+	GetDeviceCode(ctx context.Context, deviceCodeHash string) (*domain.DeviceCode, error)
+	GetDeviceCodeByUserCode(ctx context.Context, userCodeHash string) (*domain.DeviceCode, error)
+	// DecideDeviceCode sets the status of a pending request and the user who decided
+	// on it. It returns ErrNotFound if there is no pending request for userCodeHash.
+	DecideDeviceCode(ctx context.Context, userCodeHash string, userID uuid.UUID, status string) error
+	// PollDeviceCode records a poll at polledAt. It reports false and records nothing
+	// if the previous poll was less than interval before.
+	PollDeviceCode(ctx context.Context, deviceCodeHash string, polledAt time.Time, interval time.Duration) (bool, error)
+	// SlowDownDeviceCode lengthens the poll interval of the request by step.
+	SlowDownDeviceCode(ctx context.Context, deviceCodeHash string, step time.Duration) error
+	DeleteDeviceCode(ctx context.Context, deviceCodeHash string) error
+	DeleteExpiredDeviceCodes(ctx context.Context, limit int) (int64, error)
+}
+
+// DPoPProofRepository remembers DPoP proofs until they expire, so that a proof
+// cannot be replayed.
+type DPoPProofRepository interface {
+	// SaveDPoPProof stores the hash of a proof. It reports false if the proof was
+	// already stored.
+	SaveDPoPProof(ctx context.Context, proofHash string, expiresAt time.Time) (bool, error)
+	DeleteExpiredDPoPProofs(ctx context.Context, limit int) (int64, error)
+}
+
+// RevocationRepository stores access tokens revoked before they expire. A single token
+// is revoked by its jti; every token of a user issued up to a point in time is revoked
+// by a per-user cutoff.
+type RevocationRepository interface {
+	RevokeAccessToken(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time) error
+	RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID, issuedBefore time.Time, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error)
+	DeleteExpiredRevocations(ctx context.Context, limit int) (int64, error)
+}
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time) (*domain.APIKey, error)
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error)
+	// FindAPIKeyByHash returns the key with keyHash. Keys of inactive users are not
+	// found, so deactivating a user or scheduling its deletion disables them too.
+	FindAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+	DeleteAPIKey(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+}
+
+type AuditRepository interface {
+	CreateAuthEvent(ctx context.Context, event *domain.AuthEvent) error
+	ListAuthEventsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error)
+	ListAuthEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error)
+	DeleteAuthEventsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Transactor runs fn in a single database transaction. Repositories called with
+// the context passed to fn take part in that transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Locker runs fn while holding a lock identified by key that is shared by every
+// replica. If another holder has the lock, fn is not run and acquired is false.
+type Locker interface {
+	TryWithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (acquired bool, err error)
+}
+
+type OutboxRepository interface {
+	CreateOutboxEvent(ctx context.Context, aggregateID uuid.UUID, eventType string, payload []byte) error
+	// ClaimOutboxEvents leases up to batchSize due events in creation order until
+	// leaseUntil, so other relays skip them while they are published.
+	ClaimOutboxEvents(ctx context.Context, batchSize int, maxAttempts int, leaseUntil time.Time) ([]domain.OutboxEvent, error)
+	MarkOutboxEventPublished(ctx context.Context, id uuid.UUID) error
+	MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error
+	DeleteOutboxEventsByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error)
+	// DeletePublishedOutboxEventsBefore deletes the events published before the given
+	// time. Pending events are kept however old they are.
+	DeletePublishedOutboxEventsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type WebhookRepository interface {
+	CreateWebhookSubscription(ctx context.Context, url string, secret string, eventTypes []string) (*domain.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+	ListWebhookSubscriptionsByEvent(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error
+	CreateWebhookDelivery(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID, eventType string, payload []byte) error
+	// ClaimWebhookDeliveries leases up to batchSize due deliveries until leaseUntil, so
+	// other dispatchers skip them while they are sent.
+	ClaimWebhookDeliveries(ctx context.Context, batchSize int, leaseUntil time.Time) ([]domain.PendingWebhookDelivery, error)
+	MarkWebhookDeliverySucceeded(ctx context.Context, id uuid.UUID, statusCode int) error
+	MarkWebhookDeliveryFailed(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, statusCode int, lastError string) error
+	ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error)
+	// DeleteWebhookDeliveriesByAggregate deletes the deliveries of the outbox events
+	// of an aggregate, so it has to run before the events themselves are deleted.
+	DeleteWebhookDeliveriesByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error)
+	// DeleteFinishedWebhookDeliveriesBefore deletes the succeeded and dead deliveries
+	// last updated before the given time. Pending deliveries are kept.
+	DeleteFinishedWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type SigningKeyRepository interface {
+	CreateSigningKey(ctx context.Context, kid string, secret []byte) (*domain.SigningKey, error)
+	ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error)
+	RetireSigningKeys(ctx context.Context, keepKID string, retireAt time.Time) (int64, error)
+}
+
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	SchemaVersion(ctx context.Context) (version int64, dirty bool, err error)
 }
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrAccessDenied       = errors.New("access denied")
+	ErrForbidden          = errors.New("forbidden")
+	ErrGatewayTimeout     = errors.New("gateway timeout")
+	ErrInternalError      = errors.New("internal error")
+	ErrMissingBearerToken = errors.New("missing bearer token")
+)
+
+// MapError converts usecase errors to gRPC statuses following the same rules
+// the HTTP transport uses for status codes.
+func MapError(err error) *status.Status {
+	switch {
+	case errors.Is(err, domain.ErrEmailAlreadyExists):
+		return status.New(codes.AlreadyExists, domain.ErrEmailAlreadyExists.Error())
+	case errors.Is(err, domain.ErrInvalidEmail) || errors.Is(err, domain.ErrInvalidPassword):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrWrongEmailOrPassword):
+		return status.New(codes.Unauthenticated, domain.ErrWrongEmailOrPassword.Error())
+	case errors.Is(err, domain.ErrGatewayTimeout):
+		return status.New(codes.DeadlineExceeded, ErrGatewayTimeout.Error())
+	case errors.Is(err, domain.ErrWrongUserID) || errors.Is(err, ErrMissingBearerToken):
+		return status.New(codes.Unauthenticated, ErrAccessDenied.Error())
+	case errors.Is(err, domain.ErrInvalidAccessToken) || errors.Is(err, domain.ErrExpiredAccessToken) || errors.Is(err, domain.ErrRevokedAccessToken):
+		return status.New(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrEmptyRefreshToken):
+		return status.New(codes.Unauthenticated, domain.ErrEmptyRefreshToken.Error())
+	case errors.Is(err, domain.ErrInvalidOrExpiredRefreshToken):
+		return status.New(codes.Unauthenticated, domain.ErrInvalidOrExpiredRefreshToken.Error())
+	case errors.Is(err, domain.ErrInvalidClient):
+		return status.New(codes.Unauthenticated, domain.ErrInvalidClient.Error())
+	case errors.Is(err, domain.ErrInvalidDPoPProof):
+		return status.New(codes.Unauthenticated, domain.ErrInvalidDPoPProof.Error())
+	case errors.Is(err, domain.ErrUseDPoPNonce):
+		return status.New(codes.Unauthenticated, domain.ErrUseDPoPNonce.Error())
+	case errors.Is(err, domain.ErrInvalidOTP):
+		return status.New(codes.Unauthenticated, domain.ErrInvalidOTP.Error())
+	case errors.Is(err, domain.ErrInvalidMagicLink):
+		return status.New(codes.Unauthenticated, domain.ErrInvalidMagicLink.Error())
+	case errors.Is(err, domain.ErrInvalidGrant):
+		return status.New(codes.InvalidArgument, domain.ErrInvalidGrant.Error())
+	case errors.Is(err, domain.ErrInvalidRequest) || errors.Is(err, domain.ErrInvalidScope) || errors.Is(err, domain.ErrInvalidTarget) || errors.Is(err, domain.ErrUnsupportedTokenType):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidOTPChannel) || errors.Is(err, domain.ErrInvalidUserCode) || errors.Is(err, domain.ErrInvalidEmailChangeToken):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrUnauthorizedClient):
+		return status.New(codes.PermissionDenied, domain.ErrUnauthorizedClient.Error())
+	case errors.Is(err, domain.ErrForbidden) || errors.Is(err, domain.ErrInsufficientScope):
+		return status.New(codes.PermissionDenied, ErrForbidden.Error())
+	default:
+		return status.New(codes.Internal, ErrInternalError.Error())
+	}
+}
@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/gen/authv1"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Server struct {
+	authv1.UnimplementedAuthServiceServer
+	log               *slog.Logger
+	authService       usecase.AuthService
+	tokenService      usecase.TokenService
+	revocationService usecase.RevocationService
+}
+
+func NewServer(log *slog.Logger, authService usecase.AuthService, tokenService usecase.TokenService, revocationService usecase.RevocationService) *Server {
+	return &Server{
+		log:               log,
+		authService:       authService,
+		tokenService:      tokenService,
+		revocationService: revocationService,
+	}
+}
+
+func (s *Server) Register(ctx context.Context, req *authv1.RegisterRequest) (*authv1.RegisterResponse, error) {
+	u, err := s.authService.Register(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &authv1.RegisterResponse{
+		UserId:    u.UserID.String(),
+		Email:     u.Email,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+	}, nil
+}
+
+func (s *Server) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.TokenResponse, error) {
+	tokens, err := s.authService.Login(ctx, req.GetEmail(), req.GetPassword(), req.GetRememberMe())
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return toTokenResponse(tokens), nil
+}
+
+func (s *Server) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*authv1.TokenResponse, error) {
+	tokens, err := s.authService.RefreshTokens(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return toTokenResponse(tokens), nil
+}
+
+func (s *Server) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	// The access token is optional here: it is only revoked along with the session.
+	accessToken, _ := bearerToken(ctx)
+
+	if err := s.authService.Logout(ctx, req.GetRefreshToken(), accessToken); err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &authv1.LogoutResponse{}, nil
+}
+
+func (s *Server) UserInfo(ctx context.Context, req *authv1.UserInfoRequest) (*authv1.UserInfoResponse, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	claims, err := s.validateAccessToken(ctx, token)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, s.statusError(ctx, domain.ErrInvalidAccessToken)
+	}
+
+	u, err := s.authService.UserInfo(ctx, id)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &authv1.UserInfoResponse{
+		UserId:    u.UserID.String(),
+		Email:     u.Email,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+	}, nil
+}
+
+// ValidateToken lets other services verify an access token without sharing the signing secret.
+func (s *Server) ValidateToken(ctx context.Context, req *authv1.ValidateTokenRequest) (*authv1.ValidateTokenResponse, error) {
+	claims, err := s.validateAccessToken(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	resp := &authv1.ValidateTokenResponse{
+		UserId: claims.Subject,
+	}
+	if claims.IssuedAt != nil {
+		resp.IssuedAt = timestamppb.New(claims.IssuedAt.Time)
+	}
+	if claims.ExpiresAt != nil {
+		resp.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+	}
+
+	return resp, nil
+}
+
+// validateAccessToken verifies the token and rejects it if it has been revoked. Tokens
+// bound to a DPoP key or a client certificate are rejected too, as calls carry no proof
+// of the key and the certificate of the connection is not checked against the token.
+func (s *Server) validateAccessToken(ctx context.Context, token string) (*jwt.RegisteredClaims, error) {
+	claims, err := s.tokenService.ValidateAccessToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Cnf != nil {
+		return nil, domain.ErrInvalidAccessToken
+	}
+
+	if err := s.revocationService.CheckAccessToken(ctx, &claims.RegisteredClaims); err != nil {
+		return nil, err
+	}
+
+	return &claims.RegisteredClaims, nil
+}
+
+func (s *Server) statusError(ctx context.Context, err error) error {
+	st := MapError(err)
+	s.LogGRPCError(ctx, err, st)
+	return st.Err()
+}
+
+func (s *Server) LogGRPCError(ctx context.Context, err error, st *status.Status) {
+	attrs := []any{
+		"request_id", domain.RequestMetaFromContext(ctx).RequestID,
+		"error", err,
+		"code", st.Code().String(),
+		"message", st.Message(),
+	}
+
+	switch st.Code() {
+	case codes.Internal:
+		trace.SpanFromContext(ctx).RecordError(err)
+		s.log.ErrorContext(ctx, "grpc_request_failed", append(attrs, "reason", "internal_server_error")...)
+	case codes.DeadlineExceeded:
+		trace.SpanFromContext(ctx).RecordError(err)
+		s.log.ErrorContext(ctx, "grpc_request_failed", append(attrs, "reason", "dependency_timeout")...)
+	default:
+		s.log.WarnContext(ctx, "grpc_request_rejected", append(attrs, "reason", "client_error")...)
+	}
+}
+
+func toTokenResponse(t *domain.Tokens) *authv1.TokenResponse {
+	return &authv1.TokenResponse{
+		AccessToken:           t.AccessToken,
+		RefreshToken:          t.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(t.RefreshTokenExpiresAt),
+	}
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(v, "Bearer "); ok && token != "" {
+			return token, nil
+		}
+	}
+
+	return "", ErrMissingBearerToken
+}
@@ -0,0 +1,273 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	grpcadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/grpc"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/gen/authv1"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestClient(t *testing.T, authService *mocks.AuthServiceMock, tokenService *mocks.TokenServiceMock, revocationService *mocks.RevocationServiceMock) authv1.AuthServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcadapter.RequestMetaInterceptor(usecase.NewClientAuthService(usecase.ClientAuthOptions{
+			Clients: map[string]usecase.ClientCredentials{
+				"orders": {SecretHash: usecase.HashRefreshTokenFunc("orders-secret")},
+			},
+		})),
+		grpcadapter.LoggerInterceptor(slog.Default()),
+	))
+	authv1.RegisterAuthServiceServer(srv, grpcadapter.NewServer(slog.Default(), authService, tokenService, revocationService))
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %s", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return authv1.NewAuthServiceClient(conn)
+}
+
+func TestServer_Register(t *testing.T) {
+	authService := &mocks.AuthServiceMock{}
+	client := newTestClient(t, authService, &mocks.TokenServiceMock{}, &mocks.RevocationServiceMock{})
+
+	u := &domain.User{
+		UserID:    uuid.New(),
+		Email:     "user@example.org",
+		CreatedAt: time.Now().UTC(),
+	}
+
+	authService.On("Register", mock.Anything, u.Email, "password").Return(u, nil).Once()
+
+	resp, err := client.Register(context.Background(), &authv1.RegisterRequest{Email: u.Email, Password: "password"})
+	assert.NoError(t, err)
+	assert.Equal(t, u.UserID.String(), resp.GetUserId())
+	assert.True(t, u.CreatedAt.Equal(resp.GetCreatedAt().AsTime()))
+
+	authService.On("Register", mock.Anything, u.Email, "password").Return(nil, domain.ErrEmailAlreadyExists).Once()
+
+	_, err = client.Register(context.Background(), &authv1.RegisterRequest{Email: u.Email, Password: "password"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	authService.AssertExpectations(t)
+}
+
+func TestServer_Login(t *testing.T) {
+	authService := &mocks.AuthServiceMock{}
+	client := newTestClient(t, authService, &mocks.TokenServiceMock{}, &mocks.RevocationServiceMock{})
+
+	tokens := &domain.Tokens{
+		AccessToken:           "access-token",
+		RefreshToken:          "refresh-token",
+		RefreshTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+	}
+
+	authService.On("Login", mock.MatchedBy(func(ctx context.Context) bool {
+		return domain.RequestMetaFromContext(ctx).RequestID == "request-id"
+	}), "user@example.org", "password", false).Return(tokens, nil).Once()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "request-id")
+
+	resp, err := client.Login(ctx, &authv1.LoginRequest{Email: "user@example.org", Password: "password"})
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.AccessToken, resp.GetAccessToken())
+	assert.Equal(t, tokens.RefreshToken, resp.GetRefreshToken())
+
+	authService.On("Login", mock.Anything, "user@example.org", "password", true).Return(tokens, nil).Once()
+
+	_, err = client.Login(context.Background(), &authv1.LoginRequest{Email: "user@example.org", Password: "password", RememberMe: true})
+	assert.NoError(t, err)
+
+	authService.On("Login", mock.Anything, "user@example.org", "wrong", false).Return(nil, domain.ErrWrongEmailOrPassword).Once()
+
+	_, err = client.Login(context.Background(), &authv1.LoginRequest{Email: "user@example.org", Password: "wrong"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authService.On("Login", mock.Anything, "user@example.org", "password", false).Return(nil, domain.ErrGatewayTimeout).Once()
+
+	_, err = client.Login(context.Background(), &authv1.LoginRequest{Email: "user@example.org", Password: "password"})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// Tokens are issued for a client only once it authenticated.
+	authService.On("Login", mock.MatchedBy(func(ctx context.Context) bool {
+		return domain.RequestMetaFromContext(ctx).ClientID == "orders"
+	}), "user@example.org", "password", false).Return(tokens, nil).Once()
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "x-client-id", "orders", "x-client-secret", "orders-secret")
+	_, err = client.Login(ctx, &authv1.LoginRequest{Email: "user@example.org", Password: "password"})
+	assert.NoError(t, err)
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "x-client-id", "orders")
+	_, err = client.Login(ctx, &authv1.LoginRequest{Email: "user@example.org", Password: "password"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authService.AssertExpectations(t)
+}
+
+func TestServer_UserInfo(t *testing.T) {
+	authService := &mocks.AuthServiceMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	revocationService := &mocks.RevocationServiceMock{}
+	client := newTestClient(t, authService, tokenService, revocationService)
+
+	u := &domain.User{
+		UserID:    uuid.New(),
+		Email:     "user@example.org",
+		CreatedAt: time.Now().UTC(),
+	}
+	claims := &usecase.AccessClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: u.UserID.String()}}
+
+	tokenService.On("ValidateAccessToken", "access-token").Return(claims, nil).Twice()
+	revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(nil).Once()
+	authService.On("UserInfo", mock.Anything, u.UserID).Return(u, nil).Once()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer access-token")
+
+	resp, err := client.UserInfo(ctx, &authv1.UserInfoRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, u.Email, resp.GetEmail())
+
+	_, err = client.UserInfo(context.Background(), &authv1.UserInfoRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(domain.ErrRevokedAccessToken).Once()
+
+	_, err = client.UserInfo(ctx, &authv1.UserInfoRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authService.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	revocationService.AssertExpectations(t)
+}
+
+func TestServer_ValidateToken(t *testing.T) {
+	tokenService := &mocks.TokenServiceMock{}
+	revocationService := &mocks.RevocationServiceMock{}
+	client := newTestClient(t, &mocks.AuthServiceMock{}, tokenService, revocationService)
+
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Minute * 15).Truncate(time.Second)
+	claims := &usecase.AccessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	tokenService.On("ValidateAccessToken", "access-token").Return(claims, nil).Once()
+	revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(nil).Once()
+
+	resp, err := client.ValidateToken(context.Background(), &authv1.ValidateTokenRequest{AccessToken: "access-token"})
+	assert.NoError(t, err)
+	assert.Equal(t, userID.String(), resp.GetUserId())
+	assert.True(t, expiresAt.Equal(resp.GetExpiresAt().AsTime()))
+	assert.Nil(t, resp.GetIssuedAt())
+
+	tokenService.On("ValidateAccessToken", "expired").Return(nil, domain.ErrExpiredAccessToken).Once()
+
+	_, err = client.ValidateToken(context.Background(), &authv1.ValidateTokenRequest{AccessToken: "expired"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, domain.ErrExpiredAccessToken.Error(), status.Convert(err).Message())
+
+	// Calls carry no DPoP proof, so tokens bound to a key are not accepted.
+	bound := &usecase.AccessClaims{
+		RegisteredClaims: claims.RegisteredClaims,
+		Cnf:              &usecase.Confirmation{JKT: "jkt"},
+	}
+	tokenService.On("ValidateAccessToken", "bound").Return(bound, nil).Once()
+
+	_, err = client.ValidateToken(context.Background(), &authv1.ValidateTokenRequest{AccessToken: "bound"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Nor are tokens bound to a client certificate.
+	certBound := &usecase.AccessClaims{
+		RegisteredClaims: claims.RegisteredClaims,
+		Cnf:              &usecase.Confirmation{X5T: "x5t"},
+	}
+	tokenService.On("ValidateAccessToken", "cert-bound").Return(certBound, nil).Once()
+
+	_, err = client.ValidateToken(context.Background(), &authv1.ValidateTokenRequest{AccessToken: "cert-bound"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	tokenService.AssertExpectations(t)
+	revocationService.AssertExpectations(t)
+}
+
+func TestServer_Logout(t *testing.T) {
+	authService := &mocks.AuthServiceMock{}
+	client := newTestClient(t, authService, &mocks.TokenServiceMock{}, &mocks.RevocationServiceMock{})
+
+	// The access token is handed on for revocation when the caller sends it.
+	authService.On("Logout", mock.Anything, "refresh-token", "").Return(nil).Once()
+	authService.On("Logout", mock.Anything, "refresh-token", "access-token").Return(nil).Once()
+
+	_, err := client.Logout(context.Background(), &authv1.LogoutRequest{RefreshToken: "refresh-token"})
+	assert.NoError(t, err)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer access-token")
+
+	_, err = client.Logout(ctx, &authv1.LogoutRequest{RefreshToken: "refresh-token"})
+	assert.NoError(t, err)
+
+	authService.AssertExpectations(t)
+}
+
+func TestMapError(t *testing.T) {
+	tests := []struct {
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{fmt.Errorf("%w: more than one proof", domain.ErrInvalidDPoPProof), codes.Unauthenticated, domain.ErrInvalidDPoPProof.Error()},
+		{domain.ErrUseDPoPNonce, codes.Unauthenticated, domain.ErrUseDPoPNonce.Error()},
+		{domain.ErrInvalidOTP, codes.Unauthenticated, domain.ErrInvalidOTP.Error()},
+		{domain.ErrInvalidMagicLink, codes.Unauthenticated, domain.ErrInvalidMagicLink.Error()},
+		{domain.ErrInvalidClient, codes.Unauthenticated, domain.ErrInvalidClient.Error()},
+		{fmt.Errorf("%w: subject token revoked", domain.ErrInvalidGrant), codes.InvalidArgument, domain.ErrInvalidGrant.Error()},
+		{domain.ErrInvalidTarget, codes.InvalidArgument, domain.ErrInvalidTarget.Error()},
+		{domain.ErrInvalidUserCode, codes.InvalidArgument, domain.ErrInvalidUserCode.Error()},
+		{domain.ErrUnauthorizedClient, codes.PermissionDenied, domain.ErrUnauthorizedClient.Error()},
+		{errors.New("boom"), codes.Internal, grpcadapter.ErrInternalError.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			st := grpcadapter.MapError(tt.err)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+		})
+	}
+}
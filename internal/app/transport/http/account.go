@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+
+	"github.com/go-faster/jx"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func (h *Handler) APIV1AuthMeDelete(ctx context.Context, req *gen.DeleteAccountRequest) (gen.APIV1AuthMeDeleteRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToDeleteAccountErrResp(), nil
+	}
+
+	deleteAfter, err := h.accountService.DeleteAccount(ctx, id, req.Password)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToDeleteAccountErrResp(), nil
+	}
+
+	return &gen.AccountDeletionResponseHeaders{
+		SetCookie: gen.NewOptString(h.clearRefreshTokenCookie()),
+		Response: gen.AccountDeletionResponse{
+			DeleteAfter: deleteAfter.UTC(),
+		},
+	}, nil
+}
+
+func (h *Handler) APIV1AuthMeExportGet(ctx context.Context) (gen.APIV1AuthMeExportGetRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToExportAccountErrResp(), nil
+	}
+
+	export, err := h.accountService.ExportAccount(ctx, id)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToExportAccountErrResp(), nil
+	}
+
+	resp := toGenAccountExport(export)
+	return &resp, nil
+}
+
+func toGenAccountExport(e *domain.AccountExport) gen.AccountExport {
+	sessions := make([]gen.SessionResponse, 0, len(e.Sessions))
+	for _, s := range e.Sessions {
+		sessions = append(sessions, gen.SessionResponse{
+			ID:         s.ID,
+			StartedAt:  s.SessionStartedAt,
+			RememberMe: s.RememberMe,
+			CreatedAt:  s.CreatedAt,
+			ExpiresAt:  s.ExpiresAt,
+		})
+	}
+
+	apiKeys := make(gen.APIKeyList, 0, len(e.APIKeys))
+	for _, k := range e.APIKeys {
+		apiKeys = append(apiKeys, toGenAPIKey(k))
+	}
+
+	attributes := make([]gen.UserAttributeResponse, 0, len(e.Attributes))
+	for _, a := range e.Attributes {
+		attributes = append(attributes, gen.UserAttributeResponse{
+			Name:      a.Name,
+			Value:     jx.Raw(a.Value),
+			UpdatedAt: a.UpdatedAt,
+		})
+	}
+
+	return gen.AccountExport{
+		User: gen.UserInfoResponse{
+			UserID:    e.User.UserID.String(),
+			Email:     e.User.Email,
+			CreatedAt: e.User.CreatedAt,
+		},
+		Sessions:   sessions,
+		APIKeys:    apiKeys,
+		Attributes: attributes,
+		AuthEvents: toGenAuthEventList(e.AuthEvents),
+		ExportedAt: e.ExportedAt,
+	}
+}
@@ -0,0 +1,124 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AuthMeDelete(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	accountService := &mocks.AccountServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AccountService: accountService})
+
+	userID := uuid.New()
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+	deleteAfter := time.Now().Add(time.Hour * 24 * 30)
+
+	accountService.On("DeleteAccount", mock.Anything, userID, "password123").Return(deleteAfter, nil).Once()
+
+	res, err := handler.APIV1AuthMeDelete(ctx, &gen.DeleteAccountRequest{Password: "password123"})
+	assert.NoError(t, err)
+
+	resp, ok := res.(*gen.AccountDeletionResponseHeaders)
+	if assert.True(t, ok) {
+		assert.True(t, deleteAfter.Equal(resp.Response.DeleteAfter))
+		cookie, ok := resp.SetCookie.Get()
+		assert.True(t, ok)
+		assert.True(t, strings.HasPrefix(cookie, "refresh_token=;"), "the refresh token cookie is cleared")
+	}
+
+	accountService.On("DeleteAccount", mock.Anything, userID, "wrong").Return(time.Time{}, domain.ErrWrongPassword).Once()
+
+	res, err = handler.APIV1AuthMeDelete(ctx, &gen.DeleteAccountRequest{Password: "wrong"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthMeDeleteForbidden{}, res)
+
+	accountService.On("DeleteAccount", mock.Anything, userID, "password123").Return(time.Time{}, domain.ErrWrongUserID).Once()
+
+	res, err = handler.APIV1AuthMeDelete(ctx, &gen.DeleteAccountRequest{Password: "password123"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthMeDeleteUnauthorized{}, res, "the user is already gone")
+
+	accountService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AuthMeExportGet(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	accountService := &mocks.AccountServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AccountService: accountService})
+
+	userID := uuid.New()
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+	now := time.Now().UTC()
+
+	accountService.On("ExportAccount", mock.Anything, userID).Return(&domain.AccountExport{
+		User:       domain.User{UserID: userID, Email: "user@example.org", CreatedAt: now},
+		Sessions:   []domain.Session{{ID: uuid.New(), UserID: userID, SessionStartedAt: now, RememberMe: true}},
+		APIKeys:    []domain.APIKey{{ID: uuid.New(), UserID: userID, Name: "ci", Prefix: "ak_1", Scopes: []string{"user:read"}}},
+		Attributes: []domain.UserAttribute{{Name: "tenant", Value: json.RawMessage(`{"id":"acme"}`), UpdatedAt: now}},
+		AuthEvents: []domain.AuthEvent{{ID: uuid.New(), UserID: userID, EventType: domain.EventLogin, Outcome: domain.OutcomeSuccess}},
+		ExportedAt: now,
+	}, nil).Once()
+
+	res, err := handler.APIV1AuthMeExportGet(ctx)
+	assert.NoError(t, err)
+
+	resp, ok := res.(*gen.AccountExport)
+	if assert.True(t, ok) {
+		assert.Equal(t, userID.String(), resp.User.UserID)
+		assert.Len(t, resp.Sessions, 1)
+		assert.True(t, resp.Sessions[0].RememberMe)
+		assert.Len(t, resp.APIKeys, 1)
+		assert.Len(t, resp.AuthEvents, 1)
+		if assert.Len(t, resp.Attributes, 1) {
+			assert.JSONEq(t, `{"id":"acme"}`, string(resp.Attributes[0].Value))
+		}
+
+		b, err := resp.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Contains(t, string(b), `"value":{"id":"acme"}`)
+	}
+
+	accountService.On("ExportAccount", mock.Anything, userID).Return(nil, domain.ErrGatewayTimeout).Once()
+
+	res, err = handler.APIV1AuthMeExportGet(ctx)
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthMeExportGetGatewayTimeout{}, res)
+
+	accountService.AssertExpectations(t)
+}
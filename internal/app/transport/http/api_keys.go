@@ -0,0 +1,94 @@
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func (h *Handler) APIV1AuthAPIKeysGet(ctx context.Context) (gen.APIV1AuthAPIKeysGetRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToListAPIKeysErrResp(), nil
+	}
+
+	keys, err := h.apiKeyService.ListAPIKeys(ctx, id)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToListAPIKeysErrResp(), nil
+	}
+
+	resp := make(gen.APIKeyList, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, toGenAPIKey(k))
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1AuthAPIKeysPost(ctx context.Context, req *gen.CreateAPIKeyRequest) (gen.APIV1AuthAPIKeysPostRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToCreateAPIKeyErrResp(), nil
+	}
+
+	var expiresAt *time.Time
+	if v, ok := req.ExpiresAt.Get(); ok {
+		expiresAt = &v
+	}
+
+	k, err := h.apiKeyService.CreateAPIKey(ctx, id, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToCreateAPIKeyErrResp(), nil
+	}
+
+	return &gen.CreateAPIKeyResponse{
+		Key:    k.Key,
+		APIKey: toGenAPIKey(k.APIKey),
+	}, nil
+}
+
+func (h *Handler) APIV1AuthAPIKeysKeyIDDelete(ctx context.Context, params gen.APIV1AuthAPIKeysKeyIDDeleteParams) (gen.APIV1AuthAPIKeysKeyIDDeleteRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToRevokeAPIKeyErrResp(), nil
+	}
+
+	if err := h.apiKeyService.RevokeAPIKey(ctx, id, params.KeyID); err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToRevokeAPIKeyErrResp(), nil
+	}
+
+	return &gen.APIV1AuthAPIKeysKeyIDDeleteNoContent{}, nil
+}
+
+func toGenAPIKey(k domain.APIKey) gen.APIKey {
+	res := gen.APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt,
+	}
+
+	if k.ExpiresAt != nil {
+		res.ExpiresAt = gen.NewOptDateTime(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		res.LastUsedAt = gen.NewOptDateTime(*k.LastUsedAt)
+	}
+
+	return res
+}
@@ -0,0 +1,168 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AuthAPIKeysPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	apiKeyService := &mocks.APIKeyServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{APIKeyService: apiKeyService})
+
+	userID := uuid.New()
+	name := "ci-deploy"
+	scopes := []string{domain.ScopeUserRead}
+
+	k := &domain.CreatedAPIKey{
+		APIKey: domain.APIKey{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Name:      name,
+			Prefix:    "ak_12345678",
+			Scopes:    scopes,
+			CreatedAt: time.Now().UTC(),
+		},
+		Key: "ak_12345678abcdef",
+	}
+
+	apiKeyService.On("CreateAPIKey", mock.Anything, userID, name, scopes, (*time.Time)(nil)).Return(k, nil).Once()
+
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+
+	res, err := handler.APIV1AuthAPIKeysPost(ctx, &gen.CreateAPIKeyRequest{
+		Name:   name,
+		Scopes: scopes,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	resp, ok := res.(*gen.CreateAPIKeyResponse)
+	assert.True(t, ok)
+	assert.Equal(t, k.Key, resp.Key)
+	assert.Equal(t, k.ID, resp.APIKey.ID)
+	assert.False(t, resp.APIKey.ExpiresAt.IsSet())
+
+	apiKeyService.On("CreateAPIKey", mock.Anything, userID, name, []string{"admin"}, (*time.Time)(nil)).Return(nil, domain.ErrInvalidAPIKeyScope).Once()
+
+	res, err = handler.APIV1AuthAPIKeysPost(ctx, &gen.CreateAPIKeyRequest{
+		Name:   name,
+		Scopes: []string{"admin"},
+	})
+	assert.NoError(t, err)
+
+	_, ok = res.(*gen.APIV1AuthAPIKeysPostBadRequest)
+	assert.True(t, ok)
+
+	apiKeyService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AuthAPIKeysGet(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	apiKeyService := &mocks.APIKeyServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{APIKeyService: apiKeyService})
+
+	userID := uuid.New()
+	lastUsedAt := time.Now().UTC()
+
+	keys := []domain.APIKey{
+		{
+			ID:         uuid.New(),
+			UserID:     userID,
+			Name:       "ci-deploy",
+			Prefix:     "ak_12345678",
+			Scopes:     []string{domain.ScopeUserRead},
+			CreatedAt:  time.Now().UTC(),
+			LastUsedAt: &lastUsedAt,
+		},
+	}
+
+	apiKeyService.On("ListAPIKeys", mock.Anything, userID).Return(keys, nil).Once()
+
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+
+	res, err := handler.APIV1AuthAPIKeysGet(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+
+	resp, ok := res.(*gen.APIKeyList)
+	assert.True(t, ok)
+	assert.Len(t, *resp, 1)
+	assert.Equal(t, keys[0].ID, (*resp)[0].ID)
+	assert.Equal(t, lastUsedAt, (*resp)[0].LastUsedAt.Value)
+
+	apiKeyService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AuthAPIKeysKeyIDDelete(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	apiKeyService := &mocks.APIKeyServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{APIKeyService: apiKeyService})
+
+	userID := uuid.New()
+	keyID := uuid.New()
+
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+
+	apiKeyService.On("RevokeAPIKey", mock.Anything, userID, keyID).Return(nil).Once()
+
+	res, err := handler.APIV1AuthAPIKeysKeyIDDelete(ctx, gen.APIV1AuthAPIKeysKeyIDDeleteParams{KeyID: keyID})
+	assert.NoError(t, err)
+
+	_, ok := res.(*gen.APIV1AuthAPIKeysKeyIDDeleteNoContent)
+	assert.True(t, ok)
+
+	apiKeyService.On("RevokeAPIKey", mock.Anything, userID, keyID).Return(domain.ErrAPIKeyNotFound).Once()
+
+	res, err = handler.APIV1AuthAPIKeysKeyIDDelete(ctx, gen.APIV1AuthAPIKeysKeyIDDeleteParams{KeyID: keyID})
+	assert.NoError(t, err)
+
+	_, ok = res.(*gen.APIV1AuthAPIKeysKeyIDDeleteNotFound)
+	assert.True(t, ok)
+
+	apiKeyService.AssertExpectations(t)
+}
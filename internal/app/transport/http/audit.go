@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func (h *Handler) APIV1AuthMeEventsGet(ctx context.Context, params gen.APIV1AuthMeEventsGetParams) (gen.APIV1AuthMeEventsGetRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToMeEventsErrResp(), nil
+	}
+
+	events, err := h.auditService.ListUserEvents(ctx, id, params.Limit.Or(0), params.Offset.Or(0))
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToMeEventsErrResp(), nil
+	}
+
+	resp := toGenAuthEventList(events)
+	return &resp, nil
+}
+
+func (h *Handler) APIV1AdminAuthEventsGet(ctx context.Context, params gen.APIV1AdminAuthEventsGetParams) (gen.APIV1AdminAuthEventsGetRes, error) {
+	filter := domain.AuthEventFilter{
+		UserID:    params.UserID.Or(uuid.Nil),
+		EventType: string(params.EventType.Or("")),
+		Outcome:   string(params.Outcome.Or("")),
+		Limit:     params.Limit.Or(0),
+		Offset:    params.Offset.Or(0),
+	}
+	if v, ok := params.Since.Get(); ok {
+		filter.Since = &v
+	}
+	if v, ok := params.Until.Get(); ok {
+		filter.Until = &v
+	}
+
+	events, err := h.auditService.ListEvents(ctx, filter)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToAdminAuthEventsErrResp(), nil
+	}
+
+	resp := toGenAuthEventList(events)
+	return &resp, nil
+}
+
+func toGenAuthEventList(events []domain.AuthEvent) gen.AuthEventList {
+	resp := make(gen.AuthEventList, 0, len(events))
+	for _, e := range events {
+		ev := gen.AuthEventResponse{
+			ID:        e.ID,
+			EventType: gen.AuthEventType(e.EventType),
+			IP:        e.IP,
+			UserAgent: e.UserAgent,
+			Outcome:   gen.AuthEventOutcome(e.Outcome),
+			Reason:    e.Reason,
+			RequestID: e.RequestID,
+			CreatedAt: e.CreatedAt,
+		}
+		if e.UserID != uuid.Nil {
+			ev.UserID = gen.NewOptUUID(e.UserID)
+		}
+		resp = append(resp, ev)
+	}
+
+	return resp
+}
@@ -0,0 +1,114 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AuthMeEventsGet(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	auditService := &mocks.AuditServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AuditService: auditService})
+
+	userID := uuid.New()
+	events := []domain.AuthEvent{
+		{
+			ID:        uuid.New(),
+			EventType: domain.EventLogin,
+			UserID:    userID,
+			IP:        "203.0.113.7",
+			Outcome:   domain.OutcomeFailure,
+			Reason:    domain.ReasonWrongPassword,
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+
+	auditService.On("ListUserEvents", mock.Anything, userID, 10, 0).Return(events, nil).Once()
+
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+
+	res, err := handler.APIV1AuthMeEventsGet(ctx, gen.APIV1AuthMeEventsGetParams{
+		Limit: gen.NewOptInt(10),
+	})
+	assert.NoError(t, err)
+
+	resp, ok := res.(*gen.AuthEventList)
+	assert.True(t, ok)
+	assert.Len(t, *resp, 1)
+	assert.Equal(t, gen.AuthEventTypeLogin, (*resp)[0].EventType)
+	assert.Equal(t, gen.AuthEventOutcomeFailure, (*resp)[0].Outcome)
+	assert.Equal(t, userID, (*resp)[0].UserID.Value)
+
+	auditService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AdminAuthEventsGet(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	auditService := &mocks.AuditServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AuditService: auditService})
+
+	userID := uuid.New()
+	since := time.Now().UTC().Add(-time.Hour)
+
+	filter := domain.AuthEventFilter{
+		UserID:  userID,
+		Outcome: domain.OutcomeFailure,
+		Since:   &since,
+	}
+
+	auditService.On("ListEvents", mock.Anything, filter).Return([]domain.AuthEvent{}, nil).Once()
+
+	res, err := handler.APIV1AdminAuthEventsGet(context.Background(), gen.APIV1AdminAuthEventsGetParams{
+		UserID:  gen.NewOptUUID(userID),
+		Outcome: gen.NewOptAuthEventOutcome(gen.AuthEventOutcomeFailure),
+		Since:   gen.NewOptDateTime(since),
+	})
+	assert.NoError(t, err)
+
+	resp, ok := res.(*gen.AuthEventList)
+	assert.True(t, ok)
+	assert.Empty(t, *resp)
+
+	auditService.On("ListEvents", mock.Anything, domain.AuthEventFilter{}).Return(nil, domain.ErrGatewayTimeout).Once()
+
+	res, err = handler.APIV1AdminAuthEventsGet(context.Background(), gen.APIV1AdminAuthEventsGetParams{})
+	assert.NoError(t, err)
+
+	_, ok = res.(*gen.APIV1AdminAuthEventsGetGatewayTimeout)
+	assert.True(t, ok)
+
+	auditService.AssertExpectations(t)
+}
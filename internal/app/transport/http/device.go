@@ -0,0 +1,38 @@
+package http
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func (h *Handler) APIV1AuthDeviceGet(ctx context.Context, params gen.APIV1AuthDeviceGetParams) (gen.APIV1AuthDeviceGetRes, error) {
+	d, err := h.deviceService.GetDeviceAuthorization(ctx, params.UserCode)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToGetDeviceErrResp(), nil
+	}
+
+	return &gen.DeviceAuthorizationInfo{
+		ClientID:  d.ClientID,
+		ExpiresAt: d.ExpiresAt,
+	}, nil
+}
+
+func (h *Handler) APIV1AuthDevicePost(ctx context.Context, req *gen.DeviceVerifyRequest) (gen.APIV1AuthDevicePostRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToVerifyDeviceErrResp(), nil
+	}
+
+	if err := h.deviceService.VerifyDevice(ctx, id, req.UserCode, req.Approve.Or(true)); err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToVerifyDeviceErrResp(), nil
+	}
+
+	return &gen.APIV1AuthDevicePostNoContent{}, nil
+}
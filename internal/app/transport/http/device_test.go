@@ -0,0 +1,83 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AuthDeviceGet(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	deviceService := &mocks.DeviceServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{DeviceService: deviceService})
+
+	expiresAt := time.Now().Add(time.Minute)
+	deviceService.On("GetDeviceAuthorization", mock.Anything, "BCDF-GHJK").Return(&domain.DeviceCode{ClientID: "cli", ExpiresAt: expiresAt}, nil).Once()
+
+	res, err := handler.APIV1AuthDeviceGet(context.Background(), gen.APIV1AuthDeviceGetParams{UserCode: "BCDF-GHJK"})
+	assert.NoError(t, err)
+	assert.Equal(t, &gen.DeviceAuthorizationInfo{ClientID: "cli", ExpiresAt: expiresAt}, res)
+
+	deviceService.On("GetDeviceAuthorization", mock.Anything, "ZZZZ-ZZZZ").Return(nil, domain.ErrInvalidUserCode).Once()
+
+	res, err = handler.APIV1AuthDeviceGet(context.Background(), gen.APIV1AuthDeviceGetParams{UserCode: "ZZZZ-ZZZZ"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthDeviceGetBadRequest{}, res)
+
+	deviceService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AuthDevicePost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	deviceService := &mocks.DeviceServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{DeviceService: deviceService})
+
+	userID := uuid.New()
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+
+	deviceService.On("VerifyDevice", mock.Anything, userID, "BCDF-GHJK", true).Return(nil).Once()
+
+	res, err := handler.APIV1AuthDevicePost(ctx, &gen.DeviceVerifyRequest{UserCode: "BCDF-GHJK"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthDevicePostNoContent{}, res, "approve is the default")
+
+	deviceService.On("VerifyDevice", mock.Anything, userID, "BCDF-GHJK", false).Return(domain.ErrInvalidUserCode).Once()
+
+	res, err = handler.APIV1AuthDevicePost(ctx, &gen.DeviceVerifyRequest{UserCode: "BCDF-GHJK", Approve: gen.NewOptBool(false)})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthDevicePostBadRequest{}, res)
+
+	deviceService.AssertExpectations(t)
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func (h *Handler) APIV1AuthEmailPost(ctx context.Context, req *gen.ChangeEmailRequest) (gen.APIV1AuthEmailPostRes, error) {
+	id, err := getUserID(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToChangeEmailErrResp(), nil
+	}
+
+	if err := h.emailChangeService.RequestEmailChange(ctx, id, req.NewEmail, req.Password); err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToChangeEmailErrResp(), nil
+	}
+
+	return &gen.APIV1AuthEmailPostAccepted{}, nil
+}
+
+func (h *Handler) APIV1AuthEmailConfirmPost(ctx context.Context, req *gen.EmailChangeTokenRequest) (gen.APIV1AuthEmailConfirmPostRes, error) {
+	u, err := h.emailChangeService.ConfirmEmailChange(ctx, req.Token)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToConfirmEmailChangeErrResp(), nil
+	}
+
+	return &gen.UserInfoResponse{
+		UserID:    u.UserID.String(),
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+	}, nil
+}
+
+func (h *Handler) APIV1AuthEmailCancelPost(ctx context.Context, req *gen.EmailChangeTokenRequest) (gen.APIV1AuthEmailCancelPostRes, error) {
+	if err := h.emailChangeService.CancelEmailChange(ctx, req.Token); err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToCancelEmailChangeErrResp(), nil
+	}
+
+	return &gen.APIV1AuthEmailCancelPostNoContent{}, nil
+}
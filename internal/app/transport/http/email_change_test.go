@@ -0,0 +1,114 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AuthEmailPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	emailChangeService := &mocks.EmailChangeServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{EmailChangeService: emailChangeService})
+
+	userID := uuid.New()
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyUserID, userID.String())
+
+	emailChangeService.On("RequestEmailChange", mock.Anything, userID, "new@example.org", "password123").Return(nil).Once()
+
+	res, err := handler.APIV1AuthEmailPost(ctx, &gen.ChangeEmailRequest{
+		NewEmail: "new@example.org",
+		Password: "password123",
+	})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthEmailPostAccepted{}, res)
+
+	emailChangeService.On("RequestEmailChange", mock.Anything, userID, "new@example.org", "wrong").Return(domain.ErrWrongPassword).Once()
+
+	res, err = handler.APIV1AuthEmailPost(ctx, &gen.ChangeEmailRequest{
+		NewEmail: "new@example.org",
+		Password: "wrong",
+	})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthEmailPostForbidden{}, res)
+
+	emailChangeService.On("RequestEmailChange", mock.Anything, userID, "taken@example.org", "password123").Return(domain.ErrEmailAlreadyExists).Once()
+
+	res, err = handler.APIV1AuthEmailPost(ctx, &gen.ChangeEmailRequest{
+		NewEmail: "taken@example.org",
+		Password: "password123",
+	})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthEmailPostConflict{}, res)
+
+	emailChangeService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AuthEmailConfirmPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	emailChangeService := &mocks.EmailChangeServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{EmailChangeService: emailChangeService})
+
+	u := &domain.User{
+		UserID:    uuid.New(),
+		Email:     "new@example.org",
+		CreatedAt: time.Now().UTC(),
+	}
+
+	emailChangeService.On("ConfirmEmailChange", mock.Anything, "confirm").Return(u, nil).Once()
+
+	res, err := handler.APIV1AuthEmailConfirmPost(context.Background(), &gen.EmailChangeTokenRequest{Token: "confirm"})
+	assert.NoError(t, err)
+
+	resp, ok := res.(*gen.UserInfoResponse)
+	if assert.True(t, ok) {
+		assert.Equal(t, u.UserID.String(), resp.UserID)
+		assert.Equal(t, u.Email, resp.Email)
+	}
+
+	emailChangeService.On("ConfirmEmailChange", mock.Anything, "expired").Return(nil, domain.ErrInvalidEmailChangeToken).Once()
+
+	res, err = handler.APIV1AuthEmailConfirmPost(context.Background(), &gen.EmailChangeTokenRequest{Token: "expired"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthEmailConfirmPostBadRequest{}, res)
+
+	emailChangeService.On("CancelEmailChange", mock.Anything, "cancel").Return(nil).Once()
+
+	cancelRes, err := handler.APIV1AuthEmailCancelPost(context.Background(), &gen.EmailChangeTokenRequest{Token: "cancel"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthEmailCancelPostNoContent{}, cancelRes)
+
+	emailChangeService.AssertExpectations(t)
+}
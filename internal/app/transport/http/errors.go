@@ -1,9 +1,12 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/vo1dFl0w/auth-service/internal/app/domain"
 	"github.com/vo1dFl0w/auth-service/internal/gen"
 )
@@ -12,6 +15,7 @@ var (
 	ErrAccessDenied                 = errors.New("access denied")
 	ErrBadRequest                   = errors.New("bad request")
 	ErrEmptyRefreshToken            = errors.New("empty refresh token")
+	ErrForbidden                    = errors.New("forbidden")
 	ErrGatewayTimeout               = errors.New("gateway timeout")
 	ErrInternalError                = errors.New("internal error")
 	ErrInvalidAuthorizationHeader   = errors.New("invalid authorization header")
@@ -132,6 +136,521 @@ func (e *HTTPError) ToRefreshErrResp() gen.APIV1AuthRefreshPostRes {
 	}
 }
 
+func (e *HTTPError) ToListAPIKeysErrResp() gen.APIV1AuthAPIKeysGetRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthAPIKeysGetUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthAPIKeysGetGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthAPIKeysGetInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToCreateAPIKeyErrResp() gen.APIV1AuthAPIKeysPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthAPIKeysPostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthAPIKeysPostUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthAPIKeysPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthAPIKeysPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToRevokeAPIKeyErrResp() gen.APIV1AuthAPIKeysKeyIDDeleteRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthAPIKeysKeyIDDeleteUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusNotFound:
+		return &gen.APIV1AuthAPIKeysKeyIDDeleteNotFound{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthAPIKeysKeyIDDeleteGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthAPIKeysKeyIDDeleteInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToMeEventsErrResp() gen.APIV1AuthMeEventsGetRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthMeEventsGetUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthMeEventsGetGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthMeEventsGetInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToAdminAuthEventsErrResp() gen.APIV1AdminAuthEventsGetRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AdminAuthEventsGetUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusForbidden:
+		return &gen.APIV1AdminAuthEventsGetForbidden{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AdminAuthEventsGetGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AdminAuthEventsGetInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToListWebhooksErrResp() gen.APIV1AdminWebhooksGetRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AdminWebhooksGetUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusForbidden:
+		return &gen.APIV1AdminWebhooksGetForbidden{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AdminWebhooksGetGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AdminWebhooksGetInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToCreateWebhookErrResp() gen.APIV1AdminWebhooksPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AdminWebhooksPostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusUnauthorized:
+		return &gen.APIV1AdminWebhooksPostUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusForbidden:
+		return &gen.APIV1AdminWebhooksPostForbidden{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AdminWebhooksPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AdminWebhooksPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToDeleteWebhookErrResp() gen.APIV1AdminWebhooksWebhookIDDeleteRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AdminWebhooksWebhookIDDeleteUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusForbidden:
+		return &gen.APIV1AdminWebhooksWebhookIDDeleteForbidden{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusNotFound:
+		return &gen.APIV1AdminWebhooksWebhookIDDeleteNotFound{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AdminWebhooksWebhookIDDeleteGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AdminWebhooksWebhookIDDeleteInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToWebhookDeliveriesErrResp() gen.APIV1AdminWebhooksWebhookIDDeliveriesGetRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AdminWebhooksWebhookIDDeliveriesGetUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusForbidden:
+		return &gen.APIV1AdminWebhooksWebhookIDDeliveriesGetForbidden{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AdminWebhooksWebhookIDDeliveriesGetGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AdminWebhooksWebhookIDDeliveriesGetInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToChangeEmailErrResp() gen.APIV1AuthEmailPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthEmailPostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthEmailPostUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusForbidden:
+		return &gen.APIV1AuthEmailPostForbidden{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusConflict:
+		return &gen.APIV1AuthEmailPostConflict{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthEmailPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthEmailPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToConfirmEmailChangeErrResp() gen.APIV1AuthEmailConfirmPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthEmailConfirmPostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusConflict:
+		return &gen.APIV1AuthEmailConfirmPostConflict{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthEmailConfirmPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthEmailConfirmPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToCancelEmailChangeErrResp() gen.APIV1AuthEmailCancelPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthEmailCancelPostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthEmailCancelPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthEmailCancelPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToRequestMagicLinkErrResp() gen.APIV1AuthMagicLinkPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthMagicLinkPostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthMagicLinkPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthMagicLinkPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToConsumeMagicLinkErrResp() gen.APIV1AuthMagicLinkConsumePostRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthMagicLinkConsumePostUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthMagicLinkConsumePostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthMagicLinkConsumePostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToRequestOTPErrResp() gen.APIV1AuthOtpPostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthOtpPostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthOtpPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthOtpPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToVerifyOTPErrResp() gen.APIV1AuthOtpVerifyPostRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthOtpVerifyPostUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthOtpVerifyPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthOtpVerifyPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToGetDeviceErrResp() gen.APIV1AuthDeviceGetRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthDeviceGetBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthDeviceGetUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthDeviceGetGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthDeviceGetInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToVerifyDeviceErrResp() gen.APIV1AuthDevicePostRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthDevicePostBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthDevicePostUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthDevicePostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthDevicePostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToDeviceAuthorizationErrResp() gen.APIV1OAuthDeviceAuthorizationPostRes {
+	switch e.Status {
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1OAuthDeviceAuthorizationPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1OAuthDeviceAuthorizationPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToTokenErrResp() gen.APIV1OAuthTokenPostRes {
+	switch e.Status {
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1OAuthTokenPostGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1OAuthTokenPostInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToDeleteAccountErrResp() gen.APIV1AuthMeDeleteRes {
+	switch e.Status {
+	case http.StatusBadRequest:
+		return &gen.APIV1AuthMeDeleteBadRequest{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthMeDeleteUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusForbidden:
+		return &gen.APIV1AuthMeDeleteForbidden{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthMeDeleteGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthMeDeleteInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
+func (e *HTTPError) ToExportAccountErrResp() gen.APIV1AuthMeExportGetRes {
+	switch e.Status {
+	case http.StatusUnauthorized:
+		return &gen.APIV1AuthMeExportGetUnauthorized{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	case http.StatusGatewayTimeout:
+		return &gen.APIV1AuthMeExportGetGatewayTimeout{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	default:
+		return &gen.APIV1AuthMeExportGetInternalServerError{
+			Message: e.Message,
+			Status:  e.Status,
+		}
+	}
+}
+
 func MapError(err error) *HTTPError {
 	switch {
 	case errors.Is(err, domain.ErrEmailAlreadyExists):
@@ -149,6 +668,41 @@ func MapError(err error) *HTTPError {
 			Message: domain.ErrWrongEmailOrPassword.Error(),
 			Status:  http.StatusUnauthorized,
 		}
+	case errors.Is(err, domain.ErrWrongPassword):
+		return &HTTPError{
+			Message: domain.ErrWrongPassword.Error(),
+			Status:  http.StatusForbidden,
+		}
+	case errors.Is(err, domain.ErrInvalidClient):
+		return &HTTPError{
+			Message: domain.ErrInvalidClient.Error(),
+			Status:  http.StatusUnauthorized,
+		}
+	case errors.Is(err, domain.ErrInvalidEmailChangeToken):
+		return &HTTPError{
+			Message: domain.ErrInvalidEmailChangeToken.Error(),
+			Status:  http.StatusBadRequest,
+		}
+	case errors.Is(err, domain.ErrInvalidMagicLink):
+		return &HTTPError{
+			Message: domain.ErrInvalidMagicLink.Error(),
+			Status:  http.StatusUnauthorized,
+		}
+	case errors.Is(err, domain.ErrInvalidOTP):
+		return &HTTPError{
+			Message: domain.ErrInvalidOTP.Error(),
+			Status:  http.StatusUnauthorized,
+		}
+	case errors.Is(err, domain.ErrInvalidOTPChannel):
+		return &HTTPError{
+			Message: domain.ErrInvalidOTPChannel.Error(),
+			Status:  http.StatusBadRequest,
+		}
+	case errors.Is(err, domain.ErrInvalidUserCode):
+		return &HTTPError{
+			Message: domain.ErrInvalidUserCode.Error(),
+			Status:  http.StatusBadRequest,
+		}
 	case errors.Is(err, domain.ErrGatewayTimeout):
 		return &HTTPError{
 			Message: ErrGatewayTimeout.Error(),
@@ -169,10 +723,67 @@ func MapError(err error) *HTTPError {
 			Message: ErrInvalidOrExpiredRefreshToken.Error(),
 			Status:  http.StatusUnauthorized,
 		}
+	case errors.Is(err, domain.ErrInvalidAPIKeyName) || errors.Is(err, domain.ErrInvalidAPIKeyScope) || errors.Is(err, domain.ErrInvalidAPIKeyExpiry):
+		return &HTTPError{
+			Message: err.Error(),
+			Status:  http.StatusBadRequest,
+		}
+	case errors.Is(err, domain.ErrForbidden) || errors.Is(err, domain.ErrInsufficientScope):
+		return &HTTPError{
+			Message: ErrForbidden.Error(),
+			Status:  http.StatusForbidden,
+		}
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		return &HTTPError{
+			Message: domain.ErrAPIKeyNotFound.Error(),
+			Status:  http.StatusNotFound,
+		}
+	case errors.Is(err, domain.ErrInvalidWebhookURL) || errors.Is(err, domain.ErrInvalidWebhookEventType):
+		return &HTTPError{
+			Message: err.Error(),
+			Status:  http.StatusBadRequest,
+		}
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		return &HTTPError{
+			Message: domain.ErrWebhookNotFound.Error(),
+			Status:  http.StatusNotFound,
+		}
 	default:
 		return &HTTPError{
 			Message: ErrInternalError.Error(),
 			Status:  http.StatusInternalServerError,
 		}
 	}
-}
\ No newline at end of file
+}
+
+// ErrorHandler renders errors raised by the generated server itself (request decoding,
+// security handlers) in the same ErrorResponse shape the handlers use.
+func (h *Handler) ErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	errHttp := &HTTPError{
+		Message: err.Error(),
+		Status:  ogenerrors.ErrorCode(err),
+	}
+
+	var secErr *ogenerrors.SecurityError
+	switch {
+	case errors.Is(err, domain.ErrForbidden) || errors.Is(err, domain.ErrInsufficientScope) || errors.Is(err, domain.ErrGatewayTimeout):
+		errHttp = MapError(err)
+	case errors.As(err, &secErr):
+		errHttp.Message = ErrAccessDenied.Error()
+	}
+
+	h.LogHTTPError(ctx, err, errHttp)
+
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	resp := gen.ErrorResponse{
+		Message: errHttp.Message,
+		Status:  errHttp.Status,
+	}
+	resp.Encode(e)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(errHttp.Status)
+	_, _ = w.Write(e.Bytes())
+}
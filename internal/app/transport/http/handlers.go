@@ -12,17 +12,45 @@ import (
 	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
 	"github.com/vo1dFl0w/auth-service/internal/config"
 	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Handler struct {
-	cfg          *config.Config
-	log          *slog.Logger
-	cors         *cors.Cors
-	authService  usecase.AuthService
-	cookieSecure bool
+	cfg                *config.Config
+	log                *slog.Logger
+	cors               *cors.Cors
+	authService        usecase.AuthService
+	apiKeyService      usecase.APIKeyService
+	auditService       usecase.AuditService
+	webhookService     usecase.WebhookService
+	emailChangeService usecase.EmailChangeService
+	accountService     usecase.AccountService
+	magicLinkService   usecase.MagicLinkService
+	otpService         usecase.OTPService
+	deviceService      usecase.DeviceService
+	exchangeService    usecase.TokenExchangeService
+	clientAuthService  usecase.ClientAuthService
+	cookieSecure       bool
 }
 
-func NewHandler(cfg *config.Config, log *slog.Logger, authService usecase.AuthService) *Handler {
+// HandlerDeps are the services the handlers of the API call. A handler only needs the
+// services of the endpoints it serves, so tests set just the ones they exercise.
+type HandlerDeps struct {
+	AuthService        usecase.AuthService
+	APIKeyService      usecase.APIKeyService
+	AuditService       usecase.AuditService
+	WebhookService     usecase.WebhookService
+	EmailChangeService usecase.EmailChangeService
+	AccountService     usecase.AccountService
+	MagicLinkService   usecase.MagicLinkService
+	OTPService         usecase.OTPService
+	DeviceService      usecase.DeviceService
+	ExchangeService    usecase.TokenExchangeService
+	ClientAuthService  usecase.ClientAuthService
+}
+
+func NewHandler(cfg *config.Config, log *slog.Logger, deps HandlerDeps) *Handler {
 	opts := cors.Options{
 		AllowedOrigins:   cfg.Cors.AllowedOrigins,
 		AllowedMethods:   cfg.Cors.AllowedMethods,
@@ -35,11 +63,21 @@ func NewHandler(cfg *config.Config, log *slog.Logger, authService usecase.AuthSe
 	c := cors.New(opts)
 
 	return &Handler{
-		cfg:          cfg,
-		log:          log,
-		cors:         c,
-		authService:  authService,
-		cookieSecure: cfg.Cookie.CookieSecure,
+		cfg:                cfg,
+		log:                log,
+		cors:               c,
+		authService:        deps.AuthService,
+		apiKeyService:      deps.APIKeyService,
+		auditService:       deps.AuditService,
+		webhookService:     deps.WebhookService,
+		emailChangeService: deps.EmailChangeService,
+		accountService:     deps.AccountService,
+		magicLinkService:   deps.MagicLinkService,
+		otpService:         deps.OTPService,
+		deviceService:      deps.DeviceService,
+		exchangeService:    deps.ExchangeService,
+		clientAuthService:  deps.ClientAuthService,
+		cookieSecure:       cfg.Cookie.CookieSecure,
 	}
 }
 
@@ -59,7 +97,7 @@ func (h *Handler) APIV1AuthRegisterPost(ctx context.Context, req *gen.RegisterRe
 }
 
 func (h *Handler) APIV1AuthLoginPost(ctx context.Context, req *gen.LoginRequest) (gen.APIV1AuthLoginPostRes, error) {
-	tokens, err := h.authService.Login(ctx, string(req.Email), req.Password)
+	tokens, err := h.authService.Login(ctx, string(req.Email), req.Password, req.RememberMe.Or(false))
 	if err != nil {
 		errHttp := MapError(err)
 		h.LogHTTPError(ctx, err, errHttp)
@@ -107,8 +145,10 @@ func (h *Handler) APIV1AuthLogoutPost(ctx context.Context, params gen.APIV1AuthL
 		h.LogHTTPError(ctx, ErrEmptyRefreshToken, errHttp)
 		return errHttp.ToLogoutErrResp(), nil
 	}
-	
-	if err := h.authService.Logout(ctx, token); err != nil {
+
+	accessToken, _ := ctx.Value(CtxKeyAccessToken).(string)
+
+	if err := h.authService.Logout(ctx, token, accessToken); err != nil {
 		errHttp := MapError(err)
 		h.LogHTTPError(ctx, err, errHttp)
 		return errHttp.ToLogoutErrResp(), nil
@@ -201,13 +241,17 @@ func (h *Handler) LogHTTPError(ctx context.Context, err error, httpErr *HTTPErro
 
 	switch {
 	case httpErr.Status >= 500:
+		span := trace.SpanFromContext(ctx)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, httpErr.Message)
+
 		switch httpErr.Status {
 		case http.StatusGatewayTimeout:
-			h.log.Error("http_request_failed", append(attrs, "reason", "dependency_timeout")...)
+			h.log.ErrorContext(ctx, "http_request_failed", append(attrs, "reason", "dependency_timeout")...)
 		default:
-			h.log.Error("http_request_failed", append(attrs, "reason", "internal_server_error")...)
+			h.log.ErrorContext(ctx, "http_request_failed", append(attrs, "reason", "internal_server_error")...)
 		}
 	case httpErr.Status >= 400:
-		h.log.Warn("http_request_rejected", append(attrs, "reason", "client_error")...)
+		h.log.WarnContext(ctx, "http_request_rejected", append(attrs, "reason", "client_error")...)
 	}
 }
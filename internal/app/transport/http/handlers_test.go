@@ -37,10 +37,11 @@ func TestHandlers_APIV1AuthLoginPost(t *testing.T) {
 	log := logger.LoadLogger(cfg.Env)
 
 	testCases := []struct {
-		name     string
-		email    string
-		password string
-		expErr   bool
+		name       string
+		email      string
+		password   string
+		rememberMe bool
+		expErr     bool
 	}{
 		{
 			name:     "valid",
@@ -48,6 +49,13 @@ func TestHandlers_APIV1AuthLoginPost(t *testing.T) {
 			password: "password",
 			expErr:   false,
 		},
+		{
+			name:       "remember me",
+			email:      "user@example.org",
+			password:   "password",
+			rememberMe: true,
+			expErr:     false,
+		},
 		{
 			name:     "invalid email",
 			email:    "",
@@ -66,7 +74,7 @@ func TestHandlers_APIV1AuthLoginPost(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			authService := &mocks.AuthServiceMock{}
 
-			handler := httpadapter.NewHandler(cfg, log, authService)
+			handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AuthService: authService})
 
 			if !tc.expErr {
 				tokens := &domain.Tokens{
@@ -75,10 +83,11 @@ func TestHandlers_APIV1AuthLoginPost(t *testing.T) {
 					RefreshTokenExpiresAt: time.Now().UTC().Add(time.Hour * 24 * 7),
 				}
 
-				authService.On("Login", mock.Anything, tc.email, tc.password).Return(tokens, nil).Once()
+				authService.On("Login", mock.Anything, tc.email, tc.password, tc.rememberMe).Return(tokens, nil).Once()
 				res, err := handler.APIV1AuthLoginPost(context.Background(), &gen.LoginRequest{
-					Email:    tc.email,
-					Password: tc.password,
+					Email:      tc.email,
+					Password:   tc.password,
+					RememberMe: gen.NewOptBool(tc.rememberMe),
 				})
 				assert.NoError(t, err)
 				assert.NotNil(t, res)
@@ -98,7 +107,7 @@ func TestHandlers_APIV1AuthLoginPost(t *testing.T) {
 
 				authService.AssertExpectations(t)
 			} else {
-				authService.On("Login", mock.Anything, tc.email, tc.password).Return(nil, domain.ErrWrongEmailOrPassword).Once()
+				authService.On("Login", mock.Anything, tc.email, tc.password, false).Return(nil, domain.ErrWrongEmailOrPassword).Once()
 				res, err := handler.APIV1AuthLoginPost(context.Background(), &gen.LoginRequest{
 					Email:    tc.email,
 					Password: tc.password,
@@ -130,11 +139,11 @@ func TestHandlers_APIV1AuthLogoutPost(t *testing.T) {
 
 	authService := &mocks.AuthServiceMock{}
 
-	handler := httpadapter.NewHandler(cfg, log, authService)
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AuthService: authService})
 
 	refreshToken := "refresh-token"
 
-	authService.On("Logout", mock.Anything, refreshToken).Return(nil).Once()
+	authService.On("Logout", mock.Anything, refreshToken, "").Return(nil).Once()
 
 	res, err := handler.APIV1AuthLogoutPost(context.Background(), gen.APIV1AuthLogoutPostParams{
 		RefreshToken: refreshToken,
@@ -145,6 +154,18 @@ func TestHandlers_APIV1AuthLogoutPost(t *testing.T) {
 	_, ok := res.(*gen.APIV1AuthLogoutPostNoContent)
 	assert.True(t, ok)
 
+	// The access token put in the context by the security handler is revoked as well.
+	authService.On("Logout", mock.Anything, refreshToken, "access-token").Return(nil).Once()
+
+	ctx := context.WithValue(context.Background(), httpadapter.CtxKeyAccessToken, "access-token")
+	res, err = handler.APIV1AuthLogoutPost(ctx, gen.APIV1AuthLogoutPostParams{
+		RefreshToken: refreshToken,
+	})
+	assert.NoError(t, err)
+
+	_, ok = res.(*gen.APIV1AuthLogoutPostNoContent)
+	assert.True(t, ok)
+
 	authService.AssertExpectations(t)
 }
 
@@ -162,7 +183,7 @@ func TestHandlers_APIV1AuthMeGet(t *testing.T) {
 
 	authService := &mocks.AuthServiceMock{}
 
-	handler := httpadapter.NewHandler(cfg, log, authService)
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AuthService: authService})
 
 	userID := uuid.New()
 	u := &domain.User{
@@ -203,7 +224,7 @@ func TestHandlers_APIV1AuthRefreshPost(t *testing.T) {
 
 	authService := &mocks.AuthServiceMock{}
 
-	handler := httpadapter.NewHandler(cfg, log, authService)
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AuthService: authService})
 
 	refreshToken := "refresh-token"
 	accessToken := "access-token"
@@ -283,7 +304,7 @@ func TestHandlers_APIV1AuthRegisterPost(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			authService := &mocks.AuthServiceMock{}
 
-			handler := httpadapter.NewHandler(cfg, log, authService)
+			handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{AuthService: authService})
 
 			if !tc.expErr {
 				userID := uuid.New()
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+)
+
+// HealthHandler serves the orchestrator probes. They are mounted next to the ogen
+// server, so probes bypass logging, metrics and CORS.
+type HealthHandler struct {
+	healthService usecase.HealthService
+}
+
+func NewHealthHandler(healthService usecase.HealthService) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+	}
+}
+
+type healthResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Healthz reports that the process is alive and serving requests.
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, http.StatusOK, healthResponse{Status: domain.HealthStatusOK})
+}
+
+// Readyz reports whether the service can handle traffic: Postgres is reachable, the
+// schema is at the expected migration and signing keys are loaded.
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	report := h.healthService.Readiness(r.Context())
+
+	code := http.StatusOK
+	if !report.Ready() {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeHealth(w, code, healthResponse{
+		Status: report.Status,
+		Checks: report.Checks,
+	})
+}
+
+func writeHealth(w http.ResponseWriter, code int, resp healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,79 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHealthHandler_Healthz(t *testing.T) {
+	healthService := &mocks.HealthServiceMock{}
+	handler := httpadapter.NewHealthHandler(healthService)
+
+	rec := httptest.NewRecorder()
+	handler.Healthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	healthService.AssertNotCalled(t, "Readiness", mock.Anything)
+}
+
+func TestHealthHandler_Readyz(t *testing.T) {
+	testCases := []struct {
+		name    string
+		report  domain.HealthReport
+		expCode int
+	}{
+		{
+			name: "ready",
+			report: domain.HealthReport{
+				Status: domain.HealthStatusOK,
+				Checks: map[string]string{domain.HealthCheckPostgres: domain.HealthStatusOK},
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "postgres unreachable",
+			report: domain.HealthReport{
+				Status: domain.HealthStatusUnavailable,
+				Checks: map[string]string{domain.HealthCheckPostgres: "connection refused"},
+			},
+			expCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:    "draining",
+			report:  domain.HealthReport{Status: domain.HealthStatusDraining},
+			expCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			healthService := &mocks.HealthServiceMock{}
+			handler := httpadapter.NewHealthHandler(healthService)
+
+			healthService.On("Readiness", mock.Anything).Return(tc.report).Once()
+
+			rec := httptest.NewRecorder()
+			handler.Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tc.expCode, rec.Code)
+
+			var body struct {
+				Status string            `json:"status"`
+				Checks map[string]string `json:"checks"`
+			}
+			assert.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			assert.Equal(t, tc.report.Status, body.Status)
+			assert.Equal(t, tc.report.Checks, body.Checks)
+
+			healthService.AssertExpectations(t)
+		})
+	}
+}
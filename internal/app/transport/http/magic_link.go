@@ -0,0 +1,62 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+// magicLinkBindingCookie carries the nonce that binds a magic link to the browser
+// that asked for it.
+const magicLinkBindingCookie = "magic_link_binding"
+
+func (h *Handler) APIV1AuthMagicLinkPost(ctx context.Context, req *gen.MagicLinkRequest) (gen.APIV1AuthMagicLinkPostRes, error) {
+	binding, err := h.magicLinkService.RequestMagicLink(ctx, req.Email, req.RememberMe.Or(false))
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToRequestMagicLinkErrResp(), nil
+	}
+
+	resp := &gen.APIV1AuthMagicLinkPostAccepted{}
+	if binding != "" {
+		resp.SetCookie = gen.NewOptString(h.magicLinkBindingCookieString(binding))
+	}
+
+	return resp, nil
+}
+
+func (h *Handler) APIV1AuthMagicLinkConsumePost(ctx context.Context, req *gen.MagicLinkConsumeRequest, params gen.APIV1AuthMagicLinkConsumePostParams) (gen.APIV1AuthMagicLinkConsumePostRes, error) {
+	tokens, err := h.magicLinkService.LoginWithMagicLink(ctx, req.Token, params.MagicLinkBinding.Or(""))
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToConsumeMagicLinkErrResp(), nil
+	}
+
+	cookie := h.formCookieString(tokens.RefreshToken, tokens.RefreshTokenExpiresAt)
+
+	return &gen.AccessTokenHeaders{
+		SetCookie: gen.NewOptString(cookie),
+		Response: gen.AccessToken{
+			AccessToken: tokens.AccessToken,
+		},
+	}, nil
+}
+
+func (h *Handler) magicLinkBindingCookieString(binding string) string {
+	ttl := time.Second * time.Duration(h.cfg.MagicLink.TTL)
+
+	c := &http.Cookie{
+		Name:     magicLinkBindingCookie,
+		Value:    binding,
+		Path:     "/api/v1/auth/magic-link/",
+		MaxAge:   int(ttl.Seconds()),
+		Secure:   h.cookieSecure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	return c.String()
+}
@@ -0,0 +1,108 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AuthMagicLinkPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	magicLinkService := &mocks.MagicLinkServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{MagicLinkService: magicLinkService})
+
+	magicLinkService.On("RequestMagicLink", mock.Anything, "user@example.org", true).Return("", nil).Once()
+
+	res, err := handler.APIV1AuthMagicLinkPost(context.Background(), &gen.MagicLinkRequest{
+		Email:      "user@example.org",
+		RememberMe: gen.NewOptBool(true),
+	})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.APIV1AuthMagicLinkPostAccepted{}, res) {
+		assert.False(t, res.(*gen.APIV1AuthMagicLinkPostAccepted).SetCookie.IsSet(), "unbound links set no cookie")
+	}
+
+	magicLinkService.On("RequestMagicLink", mock.Anything, "user@example.org", false).Return("binding", nil).Once()
+
+	res, err = handler.APIV1AuthMagicLinkPost(context.Background(), &gen.MagicLinkRequest{Email: "user@example.org"})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.APIV1AuthMagicLinkPostAccepted{}, res) {
+		header := http.Header{"Set-Cookie": {res.(*gen.APIV1AuthMagicLinkPostAccepted).SetCookie.Value}}
+		cookies := (&http.Response{Header: header}).Cookies()
+		if assert.Len(t, cookies, 1) {
+			assert.Equal(t, "magic_link_binding", cookies[0].Name)
+			assert.Equal(t, "binding", cookies[0].Value)
+			assert.True(t, cookies[0].HttpOnly)
+		}
+	}
+
+	magicLinkService.On("RequestMagicLink", mock.Anything, "not-an-email", false).Return("", domain.ErrInvalidEmail).Once()
+
+	res, err = handler.APIV1AuthMagicLinkPost(context.Background(), &gen.MagicLinkRequest{Email: "not-an-email"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthMagicLinkPostBadRequest{}, res)
+
+	magicLinkService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AuthMagicLinkConsumePost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	magicLinkService := &mocks.MagicLinkServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{MagicLinkService: magicLinkService})
+
+	magicLinkService.On("LoginWithMagicLink", mock.Anything, "token", "binding").Return(&domain.Tokens{
+		AccessToken:           "access-token",
+		RefreshToken:          "refresh-token",
+		RefreshTokenExpiresAt: time.Now().Add(time.Hour),
+	}, nil).Once()
+
+	res, err := handler.APIV1AuthMagicLinkConsumePost(context.Background(), &gen.MagicLinkConsumeRequest{Token: "token"}, gen.APIV1AuthMagicLinkConsumePostParams{
+		MagicLinkBinding: gen.NewOptString("binding"),
+	})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.AccessTokenHeaders{}, res) {
+		headers := res.(*gen.AccessTokenHeaders)
+		assert.Equal(t, "access-token", headers.Response.AccessToken)
+		assert.Contains(t, headers.SetCookie.Value, "refresh_token=refresh-token")
+	}
+
+	magicLinkService.On("LoginWithMagicLink", mock.Anything, "used", "").Return(nil, domain.ErrInvalidMagicLink).Once()
+
+	res, err = handler.APIV1AuthMagicLinkConsumePost(context.Background(), &gen.MagicLinkConsumeRequest{Token: "used"}, gen.APIV1AuthMagicLinkConsumePostParams{})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthMagicLinkConsumePostUnauthorized{}, res)
+
+	magicLinkService.AssertExpectations(t)
+}
@@ -2,11 +2,23 @@ package http
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type ctxKey string
@@ -15,6 +27,11 @@ const (
 	CtxKeyUserID       ctxKey = "user_id"
 	CtxKeyRefreshToken ctxKey = "refresh_token"
 	CtxKeyRequestID    ctxKey = "request_id"
+	CtxKeyAPIKeyID     ctxKey = "api_key_id"
+	CtxKeyAccessToken  ctxKey = "access_token"
+	// CtxKeyDPoP is set when the access token was sent with the DPoP scheme and a
+	// proof made for it.
+	CtxKeyDPoP ctxKey = "dpop"
 )
 
 func (h *Handler) CorsMiddleware(next http.Handler) http.Handler {
@@ -32,7 +49,7 @@ func (h *Handler) LoggerMiddleware(next http.Handler) http.Handler {
 			"path", r.URL.Path,
 		)
 
-		log.Info("started")
+		log.InfoContext(r.Context(), "started")
 
 		rw := &responseWriter{w, http.StatusOK}
 
@@ -49,15 +66,49 @@ func (h *Handler) LoggerMiddleware(next http.Handler) http.Handler {
 
 		switch {
 		case rw.code >= 500:
-			log.Error("failed", attrs...)
+			log.ErrorContext(r.Context(), "failed", attrs...)
 		case rw.code >= 400:
-			log.Warn("failed", attrs...)
+			log.WarnContext(r.Context(), "failed", attrs...)
 		default:
-			log.Info("completed", attrs...)
+			log.InfoContext(r.Context(), "completed", attrs...)
 		}
 	})
 }
 
+// RouteFinder resolves a request to its ogen route. It is implemented by *gen.Server.
+type RouteFinder interface {
+	FindRoute(method, path string) (gen.Route, bool)
+}
+
+// MetricsMiddleware counts requests and observes their latency per ogen operation.
+func (h *Handler) MetricsMiddleware(routes RouteFinder, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		var operation gen.OperationName = metrics.UnknownOperation
+		if route, ok := routes.FindRoute(r.Method, r.URL.Path); ok {
+			operation = route.Name()
+		}
+
+		rw := &responseWriter{w, http.StatusOK}
+
+		next.ServeHTTP(rw, r)
+
+		metrics.HTTPRequestsTotal.WithLabelValues(operation, strconv.Itoa(rw.code)).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	})
+}
+
+// TracingMiddleware continues the trace of the caller from the W3C traceparent and
+// baggage headers, so ogen operation spans become children of the upstream span.
+func (h *Handler) TracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func (h *Handler) RequestIDMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		reqID := r.Header.Get("X-Request-ID")
@@ -71,6 +122,173 @@ func (h *Handler) RequestIDMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+func (h *Handler) RequestMetaMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+
+		reqID, _ := r.Context().Value(CtxKeyRequestID).(string)
+
+		ctx := domain.WithRequestMeta(r.Context(), domain.RequestMeta{
+			IP:         ip,
+			UserAgent:  r.UserAgent(),
+			RequestID:  reqID,
+			ClientCert: clientCertificate(r),
+		})
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ClientAuthMiddleware authenticates the client named in the X-Client-ID header, with
+// the secret in X-Client-Secret or the TLS client certificate of the request, and
+// attaches it to the request metadata. Requests with wrong client credentials fail.
+// It has to run after RequestMetaMiddleware.
+func (h *Handler) ClientAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if _, err := h.clientAuthService.AuthenticateClient(r.Context(), clientID, r.Header.Get("X-Client-Secret")); err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+
+		meta := domain.RequestMetaFromContext(r.Context())
+		meta.ClientID = clientID
+		next.ServeHTTP(w, r.WithContext(domain.WithRequestMeta(r.Context(), meta)))
+	})
+}
+
+// clientCertificate returns the TLS client certificate of r, or nil if there is none
+// or it was not verified against the client CA of the server.
+func clientCertificate(r *http.Request) *domain.ClientCertificate {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		return nil
+	}
+
+	cert := r.TLS.VerifiedChains[0][0]
+	uris := make([]string, 0, len(cert.URIs))
+	for _, u := range cert.URIs {
+		uris = append(uris, u.String())
+	}
+	h := sha256.Sum256(cert.Raw)
+
+	return &domain.ClientCertificate{
+		SubjectDN:  cert.Subject.String(),
+		DNSNames:   cert.DNSNames,
+		URIs:       uris,
+		Thumbprint: base64.RawURLEncoding.EncodeToString(h[:]),
+	}
+}
+
+// DPoPMiddleware verifies the DPoP proof sent with a request and puts the thumbprint
+// of its key into the request meta, so that tokens issued for the request are bound
+// to the key. An access token sent with the DPoP scheme is handed on as a bearer token
+// once the proof is checked against it. It must run after RequestMetaMiddleware.
+func (h *Handler) DPoPMiddleware(dpopService usecase.DPoPService, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if nonce := dpopService.Nonce(); nonce != "" {
+			w.Header().Set("DPoP-Nonce", nonce)
+		}
+
+		proofs := r.Header.Values("DPoP")
+		if len(proofs) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var accessToken string
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		bound := strings.EqualFold(scheme, "DPoP")
+		if bound {
+			accessToken = token
+		}
+
+		if len(proofs) > 1 {
+			h.dpopError(w, r, bound, fmt.Errorf("%w: more than one proof", domain.ErrInvalidDPoPProof))
+			return
+		}
+
+		jkt, err := dpopService.VerifyProof(r.Context(), proofs[0], r.Method, h.dpopURL(r), accessToken)
+		if err != nil {
+			h.dpopError(w, r, bound, err)
+			return
+		}
+
+		meta := domain.RequestMetaFromContext(r.Context())
+		meta.DPoPJKT = jkt
+		ctx := domain.WithRequestMeta(r.Context(), meta)
+		if bound {
+			ctx = context.WithValue(ctx, CtxKeyDPoP, true)
+			r.Header.Set("Authorization", "Bearer "+accessToken)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// dpopURL returns the URL a proof for r has to be made for.
+func (h *Handler) dpopURL(r *http.Request) string {
+	if h.cfg.DPoP.PublicURL != "" {
+		return strings.TrimRight(h.cfg.DPoP.PublicURL, "/") + r.URL.EscapedPath()
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + r.URL.EscapedPath()
+}
+
+// dpopError answers a request with an invalid proof. A request for a protected
+// resource gets a 401 challenge, any other one an OAuth error.
+func (h *Handler) dpopError(w http.ResponseWriter, r *http.Request, resource bool, err error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	oauthErr := toOAuthError(err)
+	if oauthErr == nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	errHttp := &HTTPError{Message: oauthErr.Error, Status: http.StatusBadRequest}
+	if resource {
+		errHttp.Status = http.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf("DPoP error=%q", oauthErr.Error))
+	}
+	oauthErr.Encode(e)
+	h.LogHTTPError(r.Context(), err, errHttp)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(errHttp.Status)
+	_, _ = w.Write(e.Bytes())
+}
+
+// writeError answers a request a middleware fails with the ErrorResponse of err.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	errHttp := MapError(err)
+	resp := gen.ErrorResponse{
+		Message: errHttp.Message,
+		Status:  errHttp.Status,
+	}
+	resp.Encode(e)
+	h.LogHTTPError(r.Context(), err, errHttp)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(errHttp.Status)
+	_, _ = w.Write(e.Bytes())
+}
+
 func (h *Handler) TimeoutMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx, cancel := context.WithTimeout(r.Context(), time.Second*time.Duration(h.cfg.Server.RequestDuration))
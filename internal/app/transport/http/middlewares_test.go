@@ -0,0 +1,303 @@
+package http_test
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler_MetricsMiddleware(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{})
+	secHandler := httpadapter.NewSecuredHandler(&mocks.TokenServiceMock{}, &mocks.RevocationServiceMock{}, &mocks.APIKeyServiceMock{}, &mocks.AuthServiceMock{})
+
+	server, err := gen.NewServer(handler, secHandler, gen.WithErrorHandler(handler.ErrorHandler))
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+
+	testCases := []struct {
+		name      string
+		method    string
+		path      string
+		operation string
+		code      string
+	}{
+		{
+			name:      "known operation",
+			method:    http.MethodPost,
+			path:      "/api/v1/auth/logout",
+			operation: gen.APIV1AuthLogoutPostOperation,
+			code:      "400",
+		},
+		{
+			name:      "unknown route",
+			method:    http.MethodGet,
+			path:      "/does-not-exist",
+			operation: metrics.UnknownOperation,
+			code:      "404",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counter := metrics.HTTPRequestsTotal.WithLabelValues(tc.operation, tc.code)
+			before := testutil.ToFloat64(counter)
+
+			rec := httptest.NewRecorder()
+			handler.MetricsMiddleware(server, server).ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+
+			assert.Equal(t, tc.code, fmt.Sprint(rec.Code))
+			assert.Equal(t, before+1, testutil.ToFloat64(counter))
+		})
+	}
+}
+
+func TestHandler_TracingMiddleware(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	handler := httpadapter.NewHandler(cfg, logger.LoadLogger(cfg.Env), httpadapter.HandlerDeps{})
+
+	var sc trace.SpanContext
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sc = trace.SpanContextFromContext(r.Context())
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/me", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	handler.TracingMiddleware(next).ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.True(t, sc.IsRemote())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID().String())
+}
+
+func TestHandler_RequestMetaMiddlewareClientCert(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	handler := httpadapter.NewHandler(cfg, logger.LoadLogger(cfg.Env), httpadapter.HandlerDeps{})
+
+	var meta domain.RequestMeta
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		meta = domain.RequestMetaFromContext(r.Context())
+	})
+
+	cert := &x509.Certificate{
+		Raw:      []byte("certificate"),
+		Subject:  pkix.Name{CommonName: "orders", Organization: []string{"Example"}},
+		DNSNames: []string{"orders.example.org"},
+		URIs:     []*url.URL{{Scheme: "spiffe", Host: "example.org", Path: "/orders"}},
+	}
+	h := sha256.Sum256(cert.Raw)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/me", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}}
+	handler.RequestMetaMiddleware(next).ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, &domain.ClientCertificate{
+		SubjectDN:  "CN=orders,O=Example",
+		DNSNames:   []string{"orders.example.org"},
+		URIs:       []string{"spiffe://example.org/orders"},
+		Thumbprint: base64.RawURLEncoding.EncodeToString(h[:]),
+	}, meta.ClientCert)
+
+	// A certificate the server did not verify is ignored.
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	handler.RequestMetaMiddleware(next).ServeHTTP(httptest.NewRecorder(), req)
+	assert.Nil(t, meta.ClientCert)
+
+	req.TLS = nil
+	handler.RequestMetaMiddleware(next).ServeHTTP(httptest.NewRecorder(), req)
+	assert.Nil(t, meta.ClientCert)
+}
+
+func TestHandler_ClientAuthMiddleware(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	clientAuthService := &mocks.ClientAuthServiceMock{}
+	handler := httpadapter.NewHandler(cfg, logger.LoadLogger(cfg.Env), httpadapter.HandlerDeps{ClientAuthService: clientAuthService})
+
+	var (
+		meta   domain.RequestMeta
+		called bool
+	)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		meta, called = domain.RequestMetaFromContext(r.Context()), true
+	})
+	serve := func(clientID, secret string) *httptest.ResponseRecorder {
+		meta, called = domain.RequestMeta{}, false
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+		if clientID != "" {
+			req.Header.Set("X-Client-ID", clientID)
+		}
+		if secret != "" {
+			req.Header.Set("X-Client-Secret", secret)
+		}
+		rec := httptest.NewRecorder()
+		handler.RequestMetaMiddleware(handler.ClientAuthMiddleware(next)).ServeHTTP(rec, req)
+		return rec
+	}
+
+	serve("", "")
+	assert.True(t, called)
+	assert.Empty(t, meta.ClientID)
+
+	clientAuthService.On("AuthenticateClient", mock.Anything, "orders", "orders-secret").Return(true, nil).Once()
+	serve("orders", "orders-secret")
+	assert.True(t, called)
+	assert.Equal(t, "orders", meta.ClientID)
+
+	// A client without credentials cannot be named without them.
+	clientAuthService.On("AuthenticateClient", mock.Anything, "orders", "").Return(false, domain.ErrInvalidClient).Once()
+	rec := serve("orders", "")
+	assert.False(t, called)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	clientAuthService.AssertExpectations(t)
+}
+
+func TestHandler_DPoPMiddleware(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	handler := httpadapter.NewHandler(cfg, logger.LoadLogger(cfg.Env), httpadapter.HandlerDeps{})
+
+	dpopService := &mocks.DPoPServiceMock{}
+	dpopService.On("Nonce").Return("nonce")
+
+	var (
+		meta          domain.RequestMeta
+		dpop          bool
+		authorization string
+	)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		meta = domain.RequestMetaFromContext(r.Context())
+		dpop, _ = r.Context().Value(httpadapter.CtxKeyDPoP).(bool)
+		authorization = r.Header.Get("Authorization")
+	})
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		meta, dpop, authorization = domain.RequestMeta{}, false, ""
+		rec := httptest.NewRecorder()
+		handler.RequestMetaMiddleware(handler.DPoPMiddleware(dpopService, next)).ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Requests without a proof pass unchanged, but learn the nonce to use.
+	rec := serve(httptest.NewRequest(http.MethodGet, "/api/v1/auth/me", nil))
+	assert.Equal(t, "nonce", rec.Header().Get("DPoP-Nonce"))
+	assert.Empty(t, meta.DPoPJKT)
+
+	// A proof sent to get tokens binds them to its key.
+	dpopService.On("VerifyProof", mock.Anything, "proof", http.MethodPost, "http://example.com/api/v1/oauth/token", "").Return("jkt", nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/oauth/token?x=1", nil)
+	req.Header.Set("DPoP", "proof")
+	serve(req)
+	assert.Equal(t, "jkt", meta.DPoPJKT)
+	assert.False(t, dpop)
+
+	// A token sent with the DPoP scheme is checked against the proof and handed on as
+	// a bearer token.
+	dpopService.On("VerifyProof", mock.Anything, "proof", http.MethodGet, "http://example.com/api/v1/auth/me", "access-token").Return("jkt", nil).Once()
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/auth/me", nil)
+	req.Header.Set("DPoP", "proof")
+	req.Header.Set("Authorization", "DPoP access-token")
+	serve(req)
+	assert.Equal(t, "jkt", meta.DPoPJKT)
+	assert.True(t, dpop)
+	assert.Equal(t, "Bearer access-token", authorization)
+
+	dpopService.On("VerifyProof", mock.Anything, "bad-proof", mock.Anything, mock.Anything, mock.Anything).Return("", domain.ErrInvalidDPoPProof).Twice()
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/oauth/token", nil)
+	req.Header.Set("DPoP", "bad-proof")
+	rec = serve(req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error":"invalid_dpop_proof"`)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/auth/me", nil)
+	req.Header.Set("DPoP", "bad-proof")
+	req.Header.Set("Authorization", "DPoP access-token")
+	rec = serve(req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, `DPoP error="invalid_dpop_proof"`, rec.Header().Get("WWW-Authenticate"))
+
+	dpopService.On("VerifyProof", mock.Anything, "stale-proof", mock.Anything, mock.Anything, mock.Anything).Return("", domain.ErrUseDPoPNonce).Once()
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/oauth/token", nil)
+	req.Header.Set("DPoP", "stale-proof")
+	rec = serve(req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error":"use_dpop_nonce"`)
+	assert.Equal(t, "nonce", rec.Header().Get("DPoP-Nonce"))
+
+	// Only one proof may be sent.
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/oauth/token", nil)
+	req.Header.Add("DPoP", "proof")
+	req.Header.Add("DPoP", "proof")
+	rec = serve(req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	dpopService.AssertExpectations(t)
+}
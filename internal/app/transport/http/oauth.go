@@ -0,0 +1,191 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+const (
+	grantTypeDeviceCode    = "urn:ietf:params:oauth:grant-type:device_code"
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+// oauthErrors maps domain errors to the error codes of RFC 6749, RFC 8628 and RFC 9449. The
+// OAuth endpoints answer them with an OAuthError instead of an ErrorResponse.
+var oauthErrors = []struct {
+	err  error
+	code string
+}{
+	{domain.ErrAuthorizationPending, "authorization_pending"},
+	{domain.ErrSlowDown, "slow_down"},
+	{domain.ErrExpiredDeviceCode, "expired_token"},
+	{domain.ErrAccessDenied, "access_denied"},
+	{domain.ErrInvalidGrant, "invalid_grant"},
+	{domain.ErrInvalidClient, "invalid_client"},
+	{domain.ErrInvalidDPoPProof, "invalid_dpop_proof"},
+	{domain.ErrUseDPoPNonce, "use_dpop_nonce"},
+	{domain.ErrInvalidRequest, "invalid_request"},
+	{domain.ErrInvalidScope, "invalid_scope"},
+	{domain.ErrInvalidTarget, "invalid_target"},
+	{domain.ErrUnauthorizedClient, "unauthorized_client"},
+	{domain.ErrUnsupportedGrantType, "unsupported_grant_type"},
+	{domain.ErrUnsupportedTokenType, "invalid_request"},
+}
+
+// toOAuthError returns the OAuthError of err, or nil if err has no OAuth error code.
+func toOAuthError(err error) *gen.OAuthError {
+	for _, e := range oauthErrors {
+		if errors.Is(err, e.err) {
+			return &gen.OAuthError{
+				Error:            e.code,
+				ErrorDescription: gen.NewOptString(e.err.Error()),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) APIV1OAuthDeviceAuthorizationPost(ctx context.Context, req *gen.DeviceAuthorizationRequest) (gen.APIV1OAuthDeviceAuthorizationPostRes, error) {
+	auth, err := h.authorizeDevice(ctx, req.ClientID, req.ClientSecret.Or(""))
+	if err != nil {
+		if oauthErr := toOAuthError(err); oauthErr != nil {
+			h.LogHTTPError(ctx, err, &HTTPError{Message: oauthErr.Error, Status: http.StatusBadRequest})
+			return oauthErr, nil
+		}
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToDeviceAuthorizationErrResp(), nil
+	}
+
+	return &gen.DeviceAuthorizationResponse{
+		DeviceCode:              auth.DeviceCode,
+		UserCode:                auth.UserCode,
+		VerificationURI:         auth.VerificationURI,
+		VerificationURIComplete: auth.VerificationURIComplete,
+		ExpiresIn:               int(time.Until(auth.ExpiresAt).Round(time.Second).Seconds()),
+		Interval:                int(auth.Interval.Seconds()),
+	}, nil
+}
+
+// authorizeDevice starts a device authorization for a client, once the client is
+// authenticated.
+func (h *Handler) authorizeDevice(ctx context.Context, clientID string, clientSecret string) (*domain.DeviceAuthorization, error) {
+	if _, err := h.clientAuthService.AuthenticateClient(ctx, clientID, clientSecret); err != nil {
+		return nil, err
+	}
+
+	return h.deviceService.AuthorizeDevice(ctx, clientID)
+}
+
+func (h *Handler) APIV1OAuthTokenPost(ctx context.Context, req *gen.TokenRequest) (gen.APIV1OAuthTokenPostRes, error) {
+	res, err := h.grant(ctx, req)
+	if err != nil {
+		if oauthErr := toOAuthError(err); oauthErr != nil {
+			// Devices poll until the user decided, which is not worth a log line.
+			if !errors.Is(err, domain.ErrAuthorizationPending) {
+				h.LogHTTPError(ctx, err, &HTTPError{Message: oauthErr.Error, Status: http.StatusBadRequest})
+			}
+			return oauthErr, nil
+		}
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToTokenErrResp(), nil
+	}
+
+	return res, nil
+}
+
+// grant issues tokens for the grant type of req, once the client is authenticated.
+// Only confidential clients may exchange tokens, since an exchange hands out tokens to
+// the backends of the client.
+func (h *Handler) grant(ctx context.Context, req *gen.TokenRequest) (gen.APIV1OAuthTokenPostRes, error) {
+	confidential, err := h.clientAuthService.AuthenticateClient(ctx, req.ClientID.Or(""), req.ClientSecret.Or(""))
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GrantType {
+	case grantTypeDeviceCode:
+		return h.deviceCodeGrant(ctx, req)
+	case grantTypeTokenExchange:
+		if !confidential {
+			return nil, domain.ErrInvalidClient
+		}
+		return h.tokenExchangeGrant(ctx, req)
+	default:
+		return nil, domain.ErrUnsupportedGrantType
+	}
+}
+
+func (h *Handler) deviceCodeGrant(ctx context.Context, req *gen.TokenRequest) (gen.APIV1OAuthTokenPostRes, error) {
+	tokens, err := h.deviceService.PollDevice(ctx, req.ClientID.Or(""), req.DeviceCode.Or(""))
+	if err != nil {
+		return nil, err
+	}
+
+	tokenType, expiresAt := issuedAccessToken(tokens.AccessToken)
+	res := &gen.TokenResponse{
+		AccessToken:  tokens.AccessToken,
+		TokenType:    tokenType,
+		RefreshToken: gen.NewOptString(tokens.RefreshToken),
+	}
+	if !expiresAt.IsZero() {
+		res.ExpiresIn = gen.NewOptInt(int(time.Until(expiresAt).Round(time.Second).Seconds()))
+	}
+
+	return res, nil
+}
+
+func (h *Handler) tokenExchangeGrant(ctx context.Context, req *gen.TokenRequest) (gen.APIV1OAuthTokenPostRes, error) {
+	if t, ok := req.RequestedTokenType.Get(); ok && t != usecase.TokenTypeAccessToken {
+		return nil, domain.ErrUnsupportedTokenType
+	}
+
+	t, err := h.exchangeService.ExchangeToken(ctx, req.ClientID.Or(""), req.SubjectToken.Or(""), req.SubjectTokenType.Or(""), req.Audience, strings.Fields(req.Scope.Or("")))
+	if err != nil {
+		return nil, err
+	}
+
+	tokenType, _ := issuedAccessToken(t.AccessToken)
+	res := &gen.TokenResponse{
+		AccessToken:     t.AccessToken,
+		TokenType:       tokenType,
+		IssuedTokenType: gen.NewOptString(usecase.TokenTypeAccessToken),
+		ExpiresIn:       gen.NewOptInt(int(time.Until(t.ExpiresAt).Round(time.Second).Seconds())),
+	}
+	if len(t.Scopes) > 0 {
+		res.Scope = gen.NewOptString(strings.Join(t.Scopes, " "))
+	}
+
+	return res, nil
+}
+
+// issuedAccessToken returns the token type and expiry of an access token the service
+// just issued. A token bound to a DPoP key is of the DPoP type (RFC 9449), any other
+// one is a bearer token. The token is not verified again, since it was signed a moment
+// ago; a zero expiry means it could not be read.
+func issuedAccessToken(accessToken string) (string, time.Time) {
+	var claims usecase.AccessClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, &claims); err != nil {
+		return "Bearer", time.Time{}
+	}
+
+	tokenType := "Bearer"
+	if claims.Cnf != nil && claims.Cnf.JKT != "" {
+		tokenType = "DPoP"
+	}
+	if claims.ExpiresAt == nil {
+		return tokenType, time.Time{}
+	}
+
+	return tokenType, claims.ExpiresAt.Time
+}
@@ -0,0 +1,328 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+const (
+	grantTypeDeviceCode    = "urn:ietf:params:oauth:grant-type:device_code"
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+func TestHandlers_APIV1OAuthDeviceAuthorizationPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	deviceService := &mocks.DeviceServiceMock{}
+	clientAuthService := &mocks.ClientAuthServiceMock{}
+	clientAuthService.On("AuthenticateClient", mock.Anything, mock.Anything, "").Return(false, nil)
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{DeviceService: deviceService, ClientAuthService: clientAuthService})
+
+	deviceService.On("AuthorizeDevice", mock.Anything, "cli").Return(&domain.DeviceAuthorization{
+		DeviceCode:              "device-code",
+		UserCode:                "BCDF-GHJK",
+		VerificationURI:         "http://localhost:3000/device",
+		VerificationURIComplete: "http://localhost:3000/device?user_code=BCDF-GHJK",
+		ExpiresAt:               time.Now().Add(time.Minute * 10),
+		Interval:                time.Second * 5,
+	}, nil).Once()
+
+	res, err := handler.APIV1OAuthDeviceAuthorizationPost(context.Background(), &gen.DeviceAuthorizationRequest{ClientID: "cli"})
+	assert.NoError(t, err)
+	assert.Equal(t, &gen.DeviceAuthorizationResponse{
+		DeviceCode:              "device-code",
+		UserCode:                "BCDF-GHJK",
+		VerificationURI:         "http://localhost:3000/device",
+		VerificationURIComplete: "http://localhost:3000/device?user_code=BCDF-GHJK",
+		ExpiresIn:               600,
+		Interval:                5,
+	}, res)
+
+	deviceService.On("AuthorizeDevice", mock.Anything, "").Return(nil, domain.ErrInvalidClient).Once()
+
+	res, err = handler.APIV1OAuthDeviceAuthorizationPost(context.Background(), &gen.DeviceAuthorizationRequest{})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, res) {
+		assert.Equal(t, "invalid_client", res.(*gen.OAuthError).Error)
+	}
+
+	deviceService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1OAuthTokenPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	deviceService := &mocks.DeviceServiceMock{}
+	clientAuthService := &mocks.ClientAuthServiceMock{}
+	clientAuthService.On("AuthenticateClient", mock.Anything, mock.Anything, "").Return(false, nil)
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{DeviceService: deviceService, ClientAuthService: clientAuthService})
+
+	req := &gen.TokenRequest{
+		GrantType:  grantTypeDeviceCode,
+		DeviceCode: gen.NewOptString("device-code"),
+		ClientID:   gen.NewOptString("cli"),
+	}
+
+	deviceService.On("PollDevice", mock.Anything, "cli", "device-code").Return(&domain.Tokens{
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}, nil).Once()
+
+	res, err := handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, &gen.TokenResponse{
+		AccessToken:  "access-token",
+		TokenType:    "Bearer",
+		RefreshToken: gen.NewOptString("refresh-token"),
+	}, res)
+
+	// A token bound to a DPoP key is a DPoP token, and its lifetime is reported.
+	bound := signedAccessToken(t, &usecase.Confirmation{JKT: "jkt"}, time.Minute*15)
+	deviceService.On("PollDevice", mock.Anything, "cli", "device-code").Return(&domain.Tokens{
+		AccessToken:  bound,
+		RefreshToken: "refresh-token",
+	}, nil).Once()
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.TokenResponse{}, res) {
+		assert.Equal(t, "DPoP", res.(*gen.TokenResponse).TokenType)
+		assert.InDelta(t, 900, res.(*gen.TokenResponse).ExpiresIn.Or(0), 1)
+	}
+
+	unbound := signedAccessToken(t, nil, time.Minute*15)
+	deviceService.On("PollDevice", mock.Anything, "cli", "device-code").Return(&domain.Tokens{
+		AccessToken:  unbound,
+		RefreshToken: "refresh-token",
+	}, nil).Once()
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.TokenResponse{}, res) {
+		assert.Equal(t, "Bearer", res.(*gen.TokenResponse).TokenType)
+		assert.InDelta(t, 900, res.(*gen.TokenResponse).ExpiresIn.Or(0), 1)
+	}
+
+	for err, code := range map[error]string{
+		domain.ErrAuthorizationPending: "authorization_pending",
+		domain.ErrSlowDown:             "slow_down",
+		domain.ErrExpiredDeviceCode:    "expired_token",
+		domain.ErrAccessDenied:         "access_denied",
+		domain.ErrInvalidGrant:         "invalid_grant",
+	} {
+		deviceService.On("PollDevice", mock.Anything, "cli", "device-code").Return(nil, err).Once()
+
+		res, err := handler.APIV1OAuthTokenPost(context.Background(), req)
+		assert.NoError(t, err)
+		if assert.IsType(t, &gen.OAuthError{}, res) {
+			assert.Equal(t, code, res.(*gen.OAuthError).Error)
+		}
+	}
+
+	deviceService.On("PollDevice", mock.Anything, "cli", "device-code").Return(nil, domain.ErrGatewayTimeout).Once()
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1OAuthTokenPostGatewayTimeout{}, res)
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), &gen.TokenRequest{GrantType: "password"})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, res) {
+		assert.Equal(t, "unsupported_grant_type", res.(*gen.OAuthError).Error)
+	}
+
+	deviceService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1OAuthTokenPostTokenExchange(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	exchangeService := &mocks.TokenExchangeServiceMock{}
+	clientAuthService := &mocks.ClientAuthServiceMock{}
+	clientAuthService.On("AuthenticateClient", mock.Anything, "orders", "orders-secret").Return(true, nil)
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{ExchangeService: exchangeService, ClientAuthService: clientAuthService})
+
+	req := &gen.TokenRequest{
+		GrantType:        grantTypeTokenExchange,
+		ClientID:         gen.NewOptString("orders"),
+		ClientSecret:     gen.NewOptString("orders-secret"),
+		SubjectToken:     gen.NewOptString("subject-token"),
+		SubjectTokenType: gen.NewOptString(usecase.TokenTypeAccessToken),
+		Audience:         []string{"billing"},
+		Scope:            gen.NewOptString("invoices:read  invoices:write"),
+	}
+
+	exchangeService.On("ExchangeToken", mock.Anything, "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, []string{"invoices:read", "invoices:write"}).Return(&domain.ExchangedToken{
+		AccessToken: "exchanged-token",
+		Audiences:   []string{"billing"},
+		Scopes:      []string{"invoices:read", "invoices:write"},
+		ExpiresAt:   time.Now().Add(time.Minute * 5),
+	}, nil).Once()
+
+	res, err := handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, &gen.TokenResponse{
+		AccessToken:     "exchanged-token",
+		TokenType:       "Bearer",
+		IssuedTokenType: gen.NewOptString(usecase.TokenTypeAccessToken),
+		ExpiresIn:       gen.NewOptInt(300),
+		Scope:           gen.NewOptString("invoices:read invoices:write"),
+	}, res)
+
+	bound := signedAccessToken(t, &usecase.Confirmation{JKT: "jkt"}, time.Minute*5)
+	exchangeService.On("ExchangeToken", mock.Anything, "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, []string{"invoices:read", "invoices:write"}).Return(&domain.ExchangedToken{
+		AccessToken: bound,
+		Audiences:   []string{"billing"},
+		ExpiresAt:   time.Now().Add(time.Minute * 5),
+	}, nil).Once()
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.TokenResponse{}, res) {
+		assert.Equal(t, "DPoP", res.(*gen.TokenResponse).TokenType, "a token bound to a DPoP key is a DPoP token")
+	}
+
+	exchangeService.On("ExchangeToken", mock.Anything, "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, []string{"invoices:read", "invoices:write"}).Return(nil, domain.ErrInvalidTarget).Once()
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, res) {
+		assert.Equal(t, "invalid_target", res.(*gen.OAuthError).Error)
+	}
+
+	req.RequestedTokenType = gen.NewOptString("urn:ietf:params:oauth:token-type:refresh_token")
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, res) {
+		assert.Equal(t, "invalid_request", res.(*gen.OAuthError).Error, "only access tokens are issued")
+	}
+
+	exchangeService.AssertExpectations(t)
+}
+
+func TestHandlers_OAuthClientAuthentication(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	deviceService := &mocks.DeviceServiceMock{}
+	exchangeService := &mocks.TokenExchangeServiceMock{}
+	clientAuthService := &mocks.ClientAuthServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{DeviceService: deviceService, ExchangeService: exchangeService, ClientAuthService: clientAuthService})
+
+	// A client registered with credentials that did not present them gets nothing.
+	clientAuthService.On("AuthenticateClient", mock.Anything, "orders", "").Return(false, domain.ErrInvalidClient)
+
+	res, err := handler.APIV1OAuthTokenPost(context.Background(), &gen.TokenRequest{
+		GrantType:        grantTypeTokenExchange,
+		ClientID:         gen.NewOptString("orders"),
+		SubjectToken:     gen.NewOptString("subject-token"),
+		SubjectTokenType: gen.NewOptString(usecase.TokenTypeAccessToken),
+		Audience:         []string{"billing"},
+	})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, res) {
+		assert.Equal(t, "invalid_client", res.(*gen.OAuthError).Error)
+	}
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), &gen.TokenRequest{
+		GrantType:  grantTypeDeviceCode,
+		ClientID:   gen.NewOptString("orders"),
+		DeviceCode: gen.NewOptString("device-code"),
+	})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, res) {
+		assert.Equal(t, "invalid_client", res.(*gen.OAuthError).Error)
+	}
+
+	deviceRes, err := handler.APIV1OAuthDeviceAuthorizationPost(context.Background(), &gen.DeviceAuthorizationRequest{ClientID: "orders"})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, deviceRes) {
+		assert.Equal(t, "invalid_client", deviceRes.(*gen.OAuthError).Error)
+	}
+
+	// Public clients cannot exchange tokens.
+	clientAuthService.On("AuthenticateClient", mock.Anything, "cli", "").Return(false, nil)
+
+	res, err = handler.APIV1OAuthTokenPost(context.Background(), &gen.TokenRequest{
+		GrantType:        grantTypeTokenExchange,
+		ClientID:         gen.NewOptString("cli"),
+		SubjectToken:     gen.NewOptString("subject-token"),
+		SubjectTokenType: gen.NewOptString(usecase.TokenTypeAccessToken),
+		Audience:         []string{"billing"},
+	})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.OAuthError{}, res) {
+		assert.Equal(t, "invalid_client", res.(*gen.OAuthError).Error)
+	}
+
+	deviceService.AssertNotCalled(t, "AuthorizeDevice", mock.Anything, mock.Anything)
+	deviceService.AssertNotCalled(t, "PollDevice", mock.Anything, mock.Anything, mock.Anything)
+	exchangeService.AssertNotCalled(t, "ExchangeToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// signedAccessToken returns an access token bound to cnf that expires in ttl.
+func signedAccessToken(t *testing.T, cnf *usecase.Confirmation, ttl time.Duration) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, usecase.AccessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl))},
+		Cnf:              cnf,
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("failed to sign token: %s", err)
+	}
+
+	return token
+}
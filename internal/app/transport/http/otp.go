@@ -0,0 +1,37 @@
+package http
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func (h *Handler) APIV1AuthOtpPost(ctx context.Context, req *gen.OTPRequest) (gen.APIV1AuthOtpPostRes, error) {
+	channel := req.Channel.Or(gen.OTPRequestChannelEmail)
+
+	if err := h.otpService.RequestOTP(ctx, req.Email, string(channel), req.RememberMe.Or(false)); err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToRequestOTPErrResp(), nil
+	}
+
+	return &gen.APIV1AuthOtpPostAccepted{}, nil
+}
+
+func (h *Handler) APIV1AuthOtpVerifyPost(ctx context.Context, req *gen.OTPVerifyRequest) (gen.APIV1AuthOtpVerifyPostRes, error) {
+	tokens, err := h.otpService.LoginWithOTP(ctx, req.Email, req.Code)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToVerifyOTPErrResp(), nil
+	}
+
+	cookie := h.formCookieString(tokens.RefreshToken, tokens.RefreshTokenExpiresAt)
+
+	return &gen.AccessTokenHeaders{
+		SetCookie: gen.NewOptString(cookie),
+		Response: gen.AccessToken{
+			AccessToken: tokens.AccessToken,
+		},
+	}, nil
+}
@@ -0,0 +1,90 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AuthOtpPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	otpService := &mocks.OTPServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{OTPService: otpService})
+
+	otpService.On("RequestOTP", mock.Anything, "user@example.org", "email", false).Return(nil).Once()
+
+	res, err := handler.APIV1AuthOtpPost(context.Background(), &gen.OTPRequest{Email: "user@example.org"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthOtpPostAccepted{}, res, "email is the default channel")
+
+	otpService.On("RequestOTP", mock.Anything, "user@example.org", "sms", true).Return(domain.ErrInvalidOTPChannel).Once()
+
+	res, err = handler.APIV1AuthOtpPost(context.Background(), &gen.OTPRequest{
+		Email:      "user@example.org",
+		Channel:    gen.NewOptOTPRequestChannel(gen.OTPRequestChannelSMS),
+		RememberMe: gen.NewOptBool(true),
+	})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthOtpPostBadRequest{}, res)
+
+	otpService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AuthOtpVerifyPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	otpService := &mocks.OTPServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{OTPService: otpService})
+
+	otpService.On("LoginWithOTP", mock.Anything, "user@example.org", "123456").Return(&domain.Tokens{
+		AccessToken:           "access-token",
+		RefreshToken:          "refresh-token",
+		RefreshTokenExpiresAt: time.Now().Add(time.Hour),
+	}, nil).Once()
+
+	res, err := handler.APIV1AuthOtpVerifyPost(context.Background(), &gen.OTPVerifyRequest{Email: "user@example.org", Code: "123456"})
+	assert.NoError(t, err)
+	if assert.IsType(t, &gen.AccessTokenHeaders{}, res) {
+		headers := res.(*gen.AccessTokenHeaders)
+		assert.Equal(t, "access-token", headers.Response.AccessToken)
+		assert.Contains(t, headers.SetCookie.Value, "refresh_token=refresh-token")
+	}
+
+	otpService.On("LoginWithOTP", mock.Anything, "user@example.org", "000000").Return(nil, domain.ErrInvalidOTP).Once()
+
+	res, err = handler.APIV1AuthOtpVerifyPost(context.Background(), &gen.OTPVerifyRequest{Email: "user@example.org", Code: "000000"})
+	assert.NoError(t, err)
+	assert.IsType(t, &gen.APIV1AuthOtpVerifyPostUnauthorized{}, res)
+
+	otpService.AssertExpectations(t)
+}
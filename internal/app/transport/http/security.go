@@ -1,31 +1,125 @@
 package http
 
 import (
-    "context"
-    "fmt"
+	"context"
+	"fmt"
+	"slices"
 
-    "github.com/vo1dFl0w/auth-service/internal/gen"
-    "github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
 )
 
 type SecuredHandler struct {
-    tokenService usecase.TokenService
+	tokenService      usecase.TokenService
+	revocationService usecase.RevocationService
+	apiKeyService     usecase.APIKeyService
+	authService       usecase.AuthService
 }
 
-func NewSecuredHandler(tokenService usecase.TokenService) *SecuredHandler {
-    return &SecuredHandler{tokenService: tokenService}
+func NewSecuredHandler(tokenService usecase.TokenService, revocationService usecase.RevocationService, apiKeyService usecase.APIKeyService, authService usecase.AuthService) *SecuredHandler {
+	return &SecuredHandler{
+		tokenService:      tokenService,
+		revocationService: revocationService,
+		apiKeyService:     apiKeyService,
+		authService:       authService,
+	}
 }
 
 func (h *SecuredHandler) HandleBearerAuth(ctx context.Context, operationName gen.OperationName, t gen.BearerAuth) (context.Context, error) {
-    if t.Token == "" {
-        return ctx, fmt.Errorf("missing bearer token")
-    }
-
-    claims, err := h.tokenService.ValidateAccessToken(t.Token)
-    if err != nil {
-        return ctx, err
-    }
-
-    ctx = context.WithValue(ctx, CtxKeyUserID, claims.Subject)
-    return ctx, nil
-}
\ No newline at end of file
+	if t.Token == "" {
+		return ctx, fmt.Errorf("missing bearer token")
+	}
+
+	// Logout ends the session by its refresh token and must not fail because the access
+	// token sent along has expired, so the token is only passed on to be revoked.
+	if operationName == gen.APIV1AuthLogoutPostOperation {
+		return context.WithValue(ctx, CtxKeyAccessToken, t.Token), nil
+	}
+
+	claims, err := h.tokenService.ValidateAccessToken(t.Token)
+	if err != nil {
+		return ctx, err
+	}
+
+	// An exchanged token is limited to the audiences and scopes of the exchange and
+	// must not pass as a token of the user on the endpoints of this service.
+	if claims.Exchanged() {
+		return ctx, domain.ErrInvalidAccessToken
+	}
+
+	// A token bound to a DPoP key is only accepted with the DPoP scheme and a proof of
+	// that key, and a bearer token is not accepted with the DPoP scheme.
+	dpop, _ := ctx.Value(CtxKeyDPoP).(bool)
+	if claims.Cnf != nil && claims.Cnf.JKT != "" {
+		if !dpop || claims.Cnf.JKT != domain.RequestMetaFromContext(ctx).DPoPJKT {
+			return ctx, domain.ErrInvalidAccessToken
+		}
+	} else if dpop {
+		return ctx, domain.ErrInvalidAccessToken
+	}
+
+	// A token bound to a client certificate is only accepted over a connection made with
+	// that certificate (RFC 8705).
+	if claims.Cnf != nil && claims.Cnf.X5T != "" {
+		cert := domain.RequestMetaFromContext(ctx).ClientCert
+		if cert == nil || cert.Thumbprint != claims.Cnf.X5T {
+			return ctx, domain.ErrInvalidAccessToken
+		}
+	}
+
+	if err := h.revocationService.CheckAccessToken(ctx, &claims.RegisteredClaims); err != nil {
+		return ctx, err
+	}
+
+	if len(t.Roles) > 0 {
+		if err := h.checkRoles(ctx, claims.Subject, t.Roles); err != nil {
+			return ctx, err
+		}
+	}
+
+	ctx = context.WithValue(ctx, CtxKeyUserID, claims.Subject)
+	return ctx, nil
+}
+
+// checkRoles looks the user up on every call so that a revoked role takes effect
+// immediately rather than when the access token expires.
+func (h *SecuredHandler) checkRoles(ctx context.Context, subject string, roles []string) error {
+	id, err := uuid.Parse(subject)
+	if err != nil {
+		return domain.ErrInvalidAccessToken
+	}
+
+	u, err := h.authService.UserInfo(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(roles, u.Role) {
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
+
+func (h *SecuredHandler) HandleApiKeyAuth(ctx context.Context, operationName gen.OperationName, t gen.ApiKeyAuth) (context.Context, error) {
+	if t.APIKey == "" {
+		return ctx, fmt.Errorf("missing api key")
+	}
+
+	k, err := h.apiKeyService.ValidateAPIKey(ctx, t.APIKey)
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, scope := range t.Roles {
+		if !slices.Contains(k.Scopes, scope) {
+			return ctx, domain.ErrInsufficientScope
+		}
+	}
+
+	ctx = context.WithValue(ctx, CtxKeyUserID, k.UserID.String())
+	ctx = context.WithValue(ctx, CtxKeyAPIKeyID, k.ID.String())
+	return ctx, nil
+}
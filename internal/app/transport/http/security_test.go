@@ -0,0 +1,179 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestSecuredHandler_HandleBearerAuth(t *testing.T) {
+	tokenService := usecase.NewTokenService([]byte(jwtSecret), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{})
+	revocationService := &mocks.RevocationServiceMock{}
+	authService := &mocks.AuthServiceMock{}
+
+	secHandler := httpadapter.NewSecuredHandler(tokenService, revocationService, &mocks.APIKeyServiceMock{}, authService)
+
+	userID := uuid.New()
+	accessToken, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	revocationService.On("CheckAccessToken", mock.Anything, mock.Anything).Return(nil).Times(3)
+
+	ctx, err := secHandler.HandleBearerAuth(context.Background(), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: accessToken})
+	assert.NoError(t, err)
+	assert.Equal(t, userID.String(), ctx.Value(httpadapter.CtxKeyUserID))
+
+	authService.On("UserInfo", mock.Anything, userID).Return(&domain.User{UserID: userID, Role: domain.RoleUser}, nil).Once()
+
+	_, err = secHandler.HandleBearerAuth(context.Background(), gen.APIV1AdminAuthEventsGetOperation, gen.BearerAuth{
+		Token: accessToken,
+		Roles: []string{domain.RoleAdmin},
+	})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	authService.On("UserInfo", mock.Anything, userID).Return(&domain.User{UserID: userID, Role: domain.RoleAdmin}, nil).Once()
+
+	_, err = secHandler.HandleBearerAuth(context.Background(), gen.APIV1AdminAuthEventsGetOperation, gen.BearerAuth{
+		Token: accessToken,
+		Roles: []string{domain.RoleAdmin},
+	})
+	assert.NoError(t, err)
+
+	revocationService.On("CheckAccessToken", mock.Anything, mock.Anything).Return(domain.ErrRevokedAccessToken).Once()
+
+	_, err = secHandler.HandleBearerAuth(context.Background(), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: accessToken})
+	assert.ErrorIs(t, err, domain.ErrRevokedAccessToken)
+
+	// Logout accepts any access token and only passes it on to be revoked.
+	ctx, err = secHandler.HandleBearerAuth(context.Background(), gen.APIV1AuthLogoutPostOperation, gen.BearerAuth{Token: "expired"})
+	assert.NoError(t, err)
+	assert.Equal(t, "expired", ctx.Value(httpadapter.CtxKeyAccessToken))
+
+	authService.AssertExpectations(t)
+	revocationService.AssertExpectations(t)
+}
+
+func TestSecuredHandler_HandleBearerAuthExchanged(t *testing.T) {
+	tokenService := usecase.NewTokenService([]byte(jwtSecret), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{})
+	revocationService := &mocks.RevocationServiceMock{}
+
+	secHandler := httpadapter.NewSecuredHandler(tokenService, revocationService, &mocks.APIKeyServiceMock{}, &mocks.AuthServiceMock{})
+
+	userID := uuid.New()
+	for _, scopes := range [][]string{{"invoices:read"}, nil} {
+		token, _, err := tokenService.GenerateExchangedToken(context.Background(), userID, "orders", []string{"billing"}, scopes, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		_, err = secHandler.HandleBearerAuth(context.Background(), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: token})
+		assert.ErrorIs(t, err, domain.ErrInvalidAccessToken, "exchanged tokens are not first-party tokens")
+	}
+
+	revocationService.AssertExpectations(t)
+}
+
+func TestSecuredHandler_HandleApiKeyAuth(t *testing.T) {
+	apiKeyService := &mocks.APIKeyServiceMock{}
+
+	secHandler := httpadapter.NewSecuredHandler(&mocks.TokenServiceMock{}, &mocks.RevocationServiceMock{}, apiKeyService, &mocks.AuthServiceMock{})
+
+	k := &domain.APIKey{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Scopes: []string{domain.ScopeUserRead},
+	}
+
+	apiKeyService.On("ValidateAPIKey", mock.Anything, "api-key").Return(k, nil).Twice()
+
+	ctx, err := secHandler.HandleApiKeyAuth(context.Background(), gen.APIV1AuthMeGetOperation, gen.ApiKeyAuth{
+		APIKey: "api-key",
+		Roles:  []string{domain.ScopeUserRead},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, k.UserID.String(), ctx.Value(httpadapter.CtxKeyUserID))
+
+	_, err = secHandler.HandleApiKeyAuth(context.Background(), gen.APIV1AuthMeEventsGetOperation, gen.ApiKeyAuth{
+		APIKey: "api-key",
+		Roles:  []string{domain.ScopeAuditRead},
+	})
+	assert.ErrorIs(t, err, domain.ErrInsufficientScope)
+
+	apiKeyService.AssertExpectations(t)
+}
+
+func TestSecuredHandler_HandleBearerAuthDPoP(t *testing.T) {
+	tokenService := usecase.NewTokenService([]byte(jwtSecret), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{})
+	revocationService := &mocks.RevocationServiceMock{}
+
+	secHandler := httpadapter.NewSecuredHandler(tokenService, revocationService, &mocks.APIKeyServiceMock{}, &mocks.AuthServiceMock{})
+
+	userID := uuid.New()
+	bound, err := tokenService.GenerateAccessToken(domain.WithRequestMeta(context.Background(), domain.RequestMeta{DPoPJKT: "jkt"}), userID, "")
+	assert.NoError(t, err)
+	bearer, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	proven := func(jkt string) context.Context {
+		ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{DPoPJKT: jkt})
+		return context.WithValue(ctx, httpadapter.CtxKeyDPoP, true)
+	}
+
+	revocationService.On("CheckAccessToken", mock.Anything, mock.Anything).Return(nil).Once()
+
+	ctx, err := secHandler.HandleBearerAuth(proven("jkt"), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bound})
+	assert.NoError(t, err)
+	assert.Equal(t, userID.String(), ctx.Value(httpadapter.CtxKeyUserID))
+
+	_, err = secHandler.HandleBearerAuth(proven("other-jkt"), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bound})
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken, "the proof must be made with the key of the token")
+
+	_, err = secHandler.HandleBearerAuth(domain.WithRequestMeta(context.Background(), domain.RequestMeta{DPoPJKT: "jkt"}), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bound})
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken, "a bound token is not accepted as a bearer token")
+
+	_, err = secHandler.HandleBearerAuth(proven("jkt"), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bearer})
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken, "a bearer token is not accepted with the DPoP scheme")
+
+	revocationService.AssertExpectations(t)
+}
+
+func TestSecuredHandler_HandleBearerAuthCertificateBound(t *testing.T) {
+	tokenService := usecase.NewTokenService([]byte(jwtSecret), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{})
+	revocationService := &mocks.RevocationServiceMock{}
+
+	secHandler := httpadapter.NewSecuredHandler(tokenService, revocationService, &mocks.APIKeyServiceMock{}, &mocks.AuthServiceMock{})
+
+	withCert := func(thumbprint string) context.Context {
+		return domain.WithRequestMeta(context.Background(), domain.RequestMeta{ClientCert: &domain.ClientCertificate{Thumbprint: thumbprint}})
+	}
+
+	userID := uuid.New()
+	bound, err := tokenService.GenerateAccessToken(withCert("x5t"), userID, "")
+	assert.NoError(t, err)
+	bearer, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	revocationService.On("CheckAccessToken", mock.Anything, mock.Anything).Return(nil).Twice()
+
+	ctx, err := secHandler.HandleBearerAuth(withCert("x5t"), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bound})
+	assert.NoError(t, err)
+	assert.Equal(t, userID.String(), ctx.Value(httpadapter.CtxKeyUserID))
+
+	_, err = secHandler.HandleBearerAuth(withCert("other-x5t"), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bound})
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken, "the request must be made with the certificate of the token")
+
+	_, err = secHandler.HandleBearerAuth(context.Background(), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bound})
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken, "a bound token is not accepted without a certificate")
+
+	_, err = secHandler.HandleBearerAuth(withCert("x5t"), gen.APIV1AuthMeGetOperation, gen.BearerAuth{Token: bearer})
+	assert.NoError(t, err, "a bearer token is accepted over any connection")
+
+	revocationService.AssertExpectations(t)
+}
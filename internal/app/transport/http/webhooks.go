@@ -0,0 +1,95 @@
+package http
+
+import (
+	"context"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func (h *Handler) APIV1AdminWebhooksGet(ctx context.Context) (gen.APIV1AdminWebhooksGetRes, error) {
+	subs, err := h.webhookService.ListWebhooks(ctx)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToListWebhooksErrResp(), nil
+	}
+
+	resp := make(gen.WebhookList, 0, len(subs))
+	for _, s := range subs {
+		resp = append(resp, toGenWebhook(s))
+	}
+
+	return &resp, nil
+}
+
+func (h *Handler) APIV1AdminWebhooksPost(ctx context.Context, req *gen.CreateWebhookRequest) (gen.APIV1AdminWebhooksPostRes, error) {
+	eventTypes := make([]string, 0, len(req.EventTypes))
+	for _, t := range req.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+
+	sub, err := h.webhookService.CreateWebhook(ctx, req.URL, eventTypes)
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToCreateWebhookErrResp(), nil
+	}
+
+	return &gen.CreateWebhookResponse{
+		Secret:  sub.Secret,
+		Webhook: toGenWebhook(*sub),
+	}, nil
+}
+
+func (h *Handler) APIV1AdminWebhooksWebhookIDDelete(ctx context.Context, params gen.APIV1AdminWebhooksWebhookIDDeleteParams) (gen.APIV1AdminWebhooksWebhookIDDeleteRes, error) {
+	if err := h.webhookService.DeleteWebhook(ctx, params.WebhookID); err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToDeleteWebhookErrResp(), nil
+	}
+
+	return &gen.APIV1AdminWebhooksWebhookIDDeleteNoContent{}, nil
+}
+
+func (h *Handler) APIV1AdminWebhooksWebhookIDDeliveriesGet(ctx context.Context, params gen.APIV1AdminWebhooksWebhookIDDeliveriesGetParams) (gen.APIV1AdminWebhooksWebhookIDDeliveriesGetRes, error) {
+	deliveries, err := h.webhookService.ListDeliveries(ctx, params.WebhookID, string(params.Status.Or("")), params.Limit.Or(0), params.Offset.Or(0))
+	if err != nil {
+		errHttp := MapError(err)
+		h.LogHTTPError(ctx, err, errHttp)
+		return errHttp.ToWebhookDeliveriesErrResp(), nil
+	}
+
+	resp := make(gen.WebhookDeliveryList, 0, len(deliveries))
+	for _, d := range deliveries {
+		resp = append(resp, gen.WebhookDeliveryResponse{
+			ID:             d.ID,
+			WebhookID:      d.SubscriptionID,
+			EventID:        d.EventID,
+			EventType:      gen.WebhookEventType(d.EventType),
+			Status:         gen.WebhookDeliveryStatus(d.Status),
+			Attempts:       d.Attempts,
+			LastStatusCode: d.LastStatusCode,
+			LastError:      d.LastError,
+			NextAttemptAt:  d.NextAttemptAt,
+			CreatedAt:      d.CreatedAt,
+			UpdatedAt:      d.UpdatedAt,
+		})
+	}
+
+	return &resp, nil
+}
+
+func toGenWebhook(s domain.WebhookSubscription) gen.Webhook {
+	eventTypes := make([]gen.WebhookEventType, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		eventTypes = append(eventTypes, gen.WebhookEventType(t))
+	}
+
+	return gen.Webhook{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  s.CreatedAt,
+	}
+}
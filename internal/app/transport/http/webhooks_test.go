@@ -0,0 +1,154 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	httpadapter "github.com/vo1dFl0w/auth-service/internal/app/transport/http"
+	"github.com/vo1dFl0w/auth-service/internal/config"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/logger"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHandlers_APIV1AdminWebhooksPost(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	webhookService := &mocks.WebhookServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{WebhookService: webhookService})
+
+	url := "https://example.org/hooks/auth"
+	sub := &domain.WebhookSubscription{
+		ID:         uuid.New(),
+		URL:        url,
+		Secret:     "whsec_secret",
+		EventTypes: []string{domain.EventUserLoginFailed},
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	webhookService.On("CreateWebhook", mock.Anything, url, []string{domain.EventUserLoginFailed}).Return(sub, nil).Once()
+
+	res, err := handler.APIV1AdminWebhooksPost(context.Background(), &gen.CreateWebhookRequest{
+		URL:        url,
+		EventTypes: []gen.WebhookEventType{gen.WebhookEventTypeUserLoginFailed},
+	})
+	assert.NoError(t, err)
+
+	resp, ok := res.(*gen.CreateWebhookResponse)
+	assert.True(t, ok)
+	assert.Equal(t, sub.Secret, resp.Secret)
+	assert.Equal(t, sub.ID, resp.Webhook.ID)
+	assert.Equal(t, []gen.WebhookEventType{gen.WebhookEventTypeUserLoginFailed}, resp.Webhook.EventTypes)
+
+	webhookService.On("CreateWebhook", mock.Anything, "ftp://example.org", []string{domain.EventUserLoginFailed}).Return(nil, domain.ErrInvalidWebhookURL).Once()
+
+	res, err = handler.APIV1AdminWebhooksPost(context.Background(), &gen.CreateWebhookRequest{
+		URL:        "ftp://example.org",
+		EventTypes: []gen.WebhookEventType{gen.WebhookEventTypeUserLoginFailed},
+	})
+	assert.NoError(t, err)
+
+	_, ok = res.(*gen.APIV1AdminWebhooksPostBadRequest)
+	assert.True(t, ok)
+
+	webhookService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AdminWebhooksWebhookIDDelete(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	webhookService := &mocks.WebhookServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{WebhookService: webhookService})
+
+	id := uuid.New()
+
+	webhookService.On("DeleteWebhook", mock.Anything, id).Return(nil).Once()
+
+	res, err := handler.APIV1AdminWebhooksWebhookIDDelete(context.Background(), gen.APIV1AdminWebhooksWebhookIDDeleteParams{WebhookID: id})
+	assert.NoError(t, err)
+
+	_, ok := res.(*gen.APIV1AdminWebhooksWebhookIDDeleteNoContent)
+	assert.True(t, ok)
+
+	webhookService.On("DeleteWebhook", mock.Anything, id).Return(domain.ErrWebhookNotFound).Once()
+
+	res, err = handler.APIV1AdminWebhooksWebhookIDDelete(context.Background(), gen.APIV1AdminWebhooksWebhookIDDeleteParams{WebhookID: id})
+	assert.NoError(t, err)
+
+	_, ok = res.(*gen.APIV1AdminWebhooksWebhookIDDeleteNotFound)
+	assert.True(t, ok)
+
+	webhookService.AssertExpectations(t)
+}
+
+func TestHandlers_APIV1AdminWebhooksWebhookIDDeliveriesGet(t *testing.T) {
+	if err := setEnv(t); err != nil {
+		t.Fatalf("failed to set environments: %s", err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("failed to load config: %s", err)
+	}
+
+	log := logger.LoadLogger(cfg.Env)
+
+	webhookService := &mocks.WebhookServiceMock{}
+
+	handler := httpadapter.NewHandler(cfg, log, httpadapter.HandlerDeps{WebhookService: webhookService})
+
+	id := uuid.New()
+	deliveries := []domain.WebhookDelivery{
+		{
+			ID:             uuid.New(),
+			SubscriptionID: id,
+			EventID:        uuid.New(),
+			EventType:      domain.EventSessionRevoked,
+			Status:         domain.WebhookDeliveryDead,
+			Attempts:       8,
+			LastStatusCode: 502,
+			LastError:      "unexpected status code: 502",
+		},
+	}
+
+	webhookService.On("ListDeliveries", mock.Anything, id, domain.WebhookDeliveryDead, 0, 0).Return(deliveries, nil).Once()
+
+	res, err := handler.APIV1AdminWebhooksWebhookIDDeliveriesGet(context.Background(), gen.APIV1AdminWebhooksWebhookIDDeliveriesGetParams{
+		WebhookID: id,
+		Status:    gen.NewOptWebhookDeliveryStatus(gen.WebhookDeliveryStatusDead),
+	})
+	assert.NoError(t, err)
+
+	resp, ok := res.(*gen.WebhookDeliveryList)
+	assert.True(t, ok)
+	assert.Len(t, *resp, 1)
+	assert.Equal(t, gen.WebhookDeliveryStatusDead, (*resp)[0].Status)
+	assert.Equal(t, 502, (*resp)[0].LastStatusCode)
+
+	webhookService.AssertExpectations(t)
+}
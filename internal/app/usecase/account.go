@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	defaultAccountPurgeBatchSize = 100
+	exportEventsPageSize         = 500
+)
+
+// AccountService lets users delete their account and export the data stored about
+// them. A deleted account is deactivated right away and deleted for good, together
+// with every row referencing it, once the grace period has passed.
+type AccountService interface {
+	DeleteAccount(ctx context.Context, userID uuid.UUID, password string) (time.Time, error)
+	ExportAccount(ctx context.Context, userID uuid.UUID) (*domain.AccountExport, error)
+	PurgeDeletedAccounts(ctx context.Context) (int64, error)
+}
+
+// AccountOptions sets how long deleted accounts are kept before they are purged. A
+// zero grace period deletes accounts right away.
+type AccountOptions struct {
+	DeletionGracePeriod time.Duration
+	PurgeBatchSize      int
+}
+
+type accountService struct {
+	authRepo          repository.AuthRepository
+	tokenRepo         repository.TokenRepository
+	apiKeyRepo        repository.APIKeyRepository
+	attributeRepo     repository.UserAttributeRepository
+	auditRepo         repository.AuditRepository
+	outboxRepo        repository.OutboxRepository
+	webhookRepo       repository.WebhookRepository
+	revocationService RevocationService
+	auditService      AuditService
+	transactor        repository.Transactor
+	opts              AccountOptions
+}
+
+func NewAccountService(authRepo repository.AuthRepository, tokenRepo repository.TokenRepository, apiKeyRepo repository.APIKeyRepository, attributeRepo repository.UserAttributeRepository, auditRepo repository.AuditRepository, outboxRepo repository.OutboxRepository, webhookRepo repository.WebhookRepository, revocationService RevocationService, auditService AuditService, transactor repository.Transactor, opts AccountOptions) AccountService {
+	opts.PurgeBatchSize = cmp.Or(opts.PurgeBatchSize, defaultAccountPurgeBatchSize)
+
+	return &accountService{
+		authRepo:          authRepo,
+		tokenRepo:         tokenRepo,
+		apiKeyRepo:        apiKeyRepo,
+		attributeRepo:     attributeRepo,
+		auditRepo:         auditRepo,
+		outboxRepo:        outboxRepo,
+		webhookRepo:       webhookRepo,
+		revocationService: revocationService,
+		auditService:      auditService,
+		transactor:        transactor,
+		opts:              opts,
+	}
+}
+
+// DeleteAccount checks the password, signs the user out everywhere and schedules the
+// deletion. It returns the time the account is deleted at.
+func (s *accountService) DeleteAccount(ctx context.Context, userID uuid.UUID, password string) (time.Time, error) {
+	ctx, span := tracer.Start(ctx, "AccountService.DeleteAccount")
+	defer span.End()
+
+	u, err := s.authRepo.GetUserInfo(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return time.Time{}, domain.ErrWrongUserID
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return time.Time{}, domain.ErrGatewayTimeout
+		} else {
+			return time.Time{}, fmt.Errorf("get user info: %w", err)
+		}
+	}
+
+	current, err := s.authRepo.FindUserByEmail(ctx, u.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return time.Time{}, domain.ErrGatewayTimeout
+		} else {
+			return time.Time{}, fmt.Errorf("find user by email: %w", err)
+		}
+	}
+	if err := comparePasswords(ctx, password, current.PasswordHash); err != nil {
+		s.auditService.Record(ctx, domain.AuthEvent{
+			EventType: domain.EventDeleteAccount,
+			UserID:    userID,
+			Outcome:   domain.OutcomeFailure,
+			Reason:    domain.ReasonWrongPassword,
+		})
+		return time.Time{}, domain.ErrWrongPassword
+	}
+
+	deleteAfter := time.Now().Add(s.opts.DeletionGracePeriod)
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		u, err := s.authRepo.ScheduleUserDeletion(ctx, userID, deleteAfter)
+		if err != nil {
+			return err
+		}
+
+		if err := s.revocationService.RevokeUserAccessTokens(ctx, userID); err != nil {
+			return err
+		}
+
+		n, err := s.tokenRepo.DeleteRefreshTokensByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			err := enqueueEvent(ctx, s.outboxRepo, userID, domain.EventSessionRevoked, domain.SessionRevokedPayload{
+				UserID:     userID,
+				Reason:     domain.RevokeReasonDeleted,
+				OccurredAt: time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return enqueueEvent(ctx, s.outboxRepo, u.UserID, domain.EventUserDeactivated, domain.UserLifecyclePayload{
+			UserID:     u.UserID,
+			Email:      u.Email,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return time.Time{}, domain.ErrWrongUserID
+		} else if errors.Is(err, repository.ErrGatewayTimeout) || errors.Is(err, domain.ErrGatewayTimeout) {
+			return time.Time{}, domain.ErrGatewayTimeout
+		} else {
+			return time.Time{}, fmt.Errorf("schedule user deletion: %w", err)
+		}
+	}
+
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventDeleteAccount,
+		UserID:    userID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+
+	if s.opts.DeletionGracePeriod <= 0 {
+		if err := s.purge(ctx, userID); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return deleteAfter, nil
+}
+
+func (s *accountService) ExportAccount(ctx context.Context, userID uuid.UUID) (*domain.AccountExport, error) {
+	ctx, span := tracer.Start(ctx, "AccountService.ExportAccount")
+	defer span.End()
+
+	export, err := s.export(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrWrongUserID
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("export account: %w", err)
+		}
+	}
+
+	return export, nil
+}
+
+func (s *accountService) export(ctx context.Context, userID uuid.UUID) (*domain.AccountExport, error) {
+	u, err := s.authRepo.GetUserInfo(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.tokenRepo.ListRefreshTokensByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.apiKeyRepo.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := s.attributeRepo.ListUserAttributes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []domain.AuthEvent
+	for offset := 0; ; offset += exportEventsPageSize {
+		page, err := s.auditRepo.ListAuthEventsByUser(ctx, userID, exportEventsPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, page...)
+
+		if len(page) < exportEventsPageSize {
+			break
+		}
+	}
+
+	return &domain.AccountExport{
+		User:       *u,
+		Sessions:   sessions,
+		APIKeys:    apiKeys,
+		Attributes: attributes,
+		AuthEvents: events,
+		ExportedAt: time.Now().UTC(),
+	}, nil
+}
+
+// PurgeDeletedAccounts deletes the accounts whose grace period has passed and
+// returns how many were deleted. Accounts restored in the meantime are skipped.
+func (s *accountService) PurgeDeletedAccounts(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "AccountService.PurgeDeletedAccounts")
+	defer span.End()
+
+	var purged int64
+
+	for {
+		ids, err := s.authRepo.ListUsersDueForDeletion(ctx, s.opts.PurgeBatchSize)
+		if err != nil {
+			if errors.Is(err, repository.ErrGatewayTimeout) {
+				return purged, domain.ErrGatewayTimeout
+			} else {
+				return purged, fmt.Errorf("list users due for deletion: %w", err)
+			}
+		}
+
+		for _, id := range ids {
+			err := s.purge(ctx, id)
+			if errors.Is(err, domain.ErrWrongUserID) {
+				continue
+			} else if err != nil {
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(ids) < s.opts.PurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// purge deletes the user. The foreign keys take every row referencing it along; the
+// outbox events of the user and their webhook deliveries have none and are deleted
+// here, as their payloads carry the email.
+func (s *accountService) purge(ctx context.Context, userID uuid.UUID) error {
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.webhookRepo.DeleteWebhookDeliveriesByAggregate(ctx, userID); err != nil {
+			return err
+		}
+
+		if _, err := s.outboxRepo.DeleteOutboxEventsByAggregate(ctx, userID); err != nil {
+			return err
+		}
+
+		if err := s.authRepo.DeleteScheduledUser(ctx, userID); err != nil {
+			return err
+		}
+
+		return enqueueEvent(ctx, s.outboxRepo, userID, domain.EventUserDeleted, domain.UserDeletedPayload{
+			UserID:     userID,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.ErrWrongUserID
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("delete user %s: %w", userID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+type accountMocks struct {
+	authRepo          *mocks.AuthRepositoryMock
+	tokenRepo         *mocks.TokenRepositoryMock
+	apiKeyRepo        *mocks.APIKeyRepositoryMock
+	attributeRepo     *mocks.UserAttributeRepositoryMock
+	auditRepo         *mocks.AuditRepositoryMock
+	outboxRepo        *mocks.OutboxRepositoryMock
+	webhookRepo       *mocks.WebhookRepositoryMock
+	revocationService *mocks.RevocationServiceMock
+	auditService      *mocks.AuditServiceMock
+}
+
+func newAccountService(opts usecase.AccountOptions) (usecase.AccountService, *accountMocks) {
+	m := &accountMocks{
+		authRepo:          &mocks.AuthRepositoryMock{},
+		tokenRepo:         &mocks.TokenRepositoryMock{},
+		apiKeyRepo:        &mocks.APIKeyRepositoryMock{},
+		attributeRepo:     &mocks.UserAttributeRepositoryMock{},
+		auditRepo:         &mocks.AuditRepositoryMock{},
+		outboxRepo:        &mocks.OutboxRepositoryMock{},
+		webhookRepo:       &mocks.WebhookRepositoryMock{},
+		revocationService: &mocks.RevocationServiceMock{},
+		auditService:      &mocks.AuditServiceMock{},
+	}
+
+	service := usecase.NewAccountService(m.authRepo, m.tokenRepo, m.apiKeyRepo, m.attributeRepo, m.auditRepo, m.outboxRepo, m.webhookRepo, m.revocationService, m.auditService, newTransactorMock(), opts)
+	return service, m
+}
+
+func (m *accountMocks) assertExpectations(t *testing.T) {
+	m.authRepo.AssertExpectations(t)
+	m.tokenRepo.AssertExpectations(t)
+	m.apiKeyRepo.AssertExpectations(t)
+	m.attributeRepo.AssertExpectations(t)
+	m.auditRepo.AssertExpectations(t)
+	m.outboxRepo.AssertExpectations(t)
+	m.webhookRepo.AssertExpectations(t)
+	m.revocationService.AssertExpectations(t)
+	m.auditService.AssertExpectations(t)
+}
+
+// expectUser makes u with password "password123" known to the auth repository.
+func (m *accountMocks) expectUser(t *testing.T, u *domain.User) {
+	hash, err := usecase.HashPassword(context.Background(), "password123")
+	assert.NoError(t, err)
+
+	m.authRepo.On("GetUserInfo", mock.Anything, u.UserID).Return(u, nil)
+	m.authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(&domain.UserWithPassword{
+		UserID:       u.UserID,
+		Email:        u.Email,
+		PasswordHash: hash,
+		IsActive:     true,
+	}, nil)
+}
+
+// expectSchedule expects the deactivation and sign out of u on deletion.
+func (m *accountMocks) expectSchedule(u *domain.User, deleteAfter func(time.Time) bool) {
+	m.authRepo.On("ScheduleUserDeletion", mock.Anything, u.UserID, mock.MatchedBy(deleteAfter)).Return(u, nil).Once()
+	m.revocationService.On("RevokeUserAccessTokens", mock.Anything, u.UserID).Return(nil).Once()
+	m.tokenRepo.On("DeleteRefreshTokensByUser", mock.Anything, u.UserID).Return(int64(2), nil).Once()
+	m.outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventSessionRevoked, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.SessionRevokedPayload
+		return json.Unmarshal(payload, &p) == nil && p.Reason == domain.RevokeReasonDeleted
+	})).Return(nil).Once()
+	m.outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventUserDeactivated, mock.Anything).Return(nil).Once()
+	m.auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+		return e.EventType == domain.EventDeleteAccount && e.Outcome == domain.OutcomeSuccess
+	})).Once()
+}
+
+// expectPurge expects the deletion of the user and of its outbox events.
+func (m *accountMocks) expectPurge(userID uuid.UUID) {
+	m.webhookRepo.On("DeleteWebhookDeliveriesByAggregate", mock.Anything, userID).Return(int64(1), nil).Once()
+	m.outboxRepo.On("DeleteOutboxEventsByAggregate", mock.Anything, userID).Return(int64(3), nil).Once()
+	m.authRepo.On("DeleteScheduledUser", mock.Anything, userID).Return(nil).Once()
+	m.outboxRepo.On("CreateOutboxEvent", mock.Anything, userID, domain.EventUserDeleted, mock.MatchedBy(func(payload []byte) bool {
+		var p map[string]any
+		if json.Unmarshal(payload, &p) != nil {
+			return false
+		}
+		_, hasEmail := p["email"]
+		return p["user_id"] == userID.String() && !hasEmail
+	})).Return(nil).Once()
+}
+
+func TestAccountService_DeleteAccount(t *testing.T) {
+	t.Run("schedules the deletion", func(t *testing.T) {
+		service, m := newAccountService(usecase.AccountOptions{DeletionGracePeriod: time.Hour * 24})
+
+		u := &domain.User{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+		m.expectUser(t, u)
+		m.expectSchedule(u, func(at time.Time) bool {
+			return time.Until(at) > time.Hour*23
+		})
+
+		deleteAfter, err := service.DeleteAccount(context.Background(), u.UserID, "password123")
+		assert.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour*24), deleteAfter, time.Minute)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("deletes right away without grace period", func(t *testing.T) {
+		service, m := newAccountService(usecase.AccountOptions{})
+
+		u := &domain.User{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+		m.expectUser(t, u)
+		m.expectSchedule(u, func(at time.Time) bool {
+			return !at.After(time.Now())
+		})
+		m.expectPurge(u.UserID)
+
+		_, err := service.DeleteAccount(context.Background(), u.UserID, "password123")
+		assert.NoError(t, err)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		service, m := newAccountService(usecase.AccountOptions{DeletionGracePeriod: time.Hour})
+
+		u := &domain.User{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+		m.expectUser(t, u)
+		m.auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventDeleteAccount && e.Reason == domain.ReasonWrongPassword
+		})).Once()
+
+		_, err := service.DeleteAccount(context.Background(), u.UserID, "wrong-password")
+		assert.ErrorIs(t, err, domain.ErrWrongPassword)
+
+		m.assertExpectations(t)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		service, m := newAccountService(usecase.AccountOptions{})
+
+		userID := uuid.New()
+		m.authRepo.On("GetUserInfo", mock.Anything, userID).Return(nil, repository.ErrNotFound).Once()
+
+		_, err := service.DeleteAccount(context.Background(), userID, "password123")
+		assert.ErrorIs(t, err, domain.ErrWrongUserID)
+
+		m.assertExpectations(t)
+	})
+}
+
+func TestAccountService_ExportAccount(t *testing.T) {
+	service, m := newAccountService(usecase.AccountOptions{})
+
+	u := &domain.User{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	sessions := []domain.Session{{ID: uuid.New(), UserID: u.UserID}}
+	keys := []domain.APIKey{{ID: uuid.New(), UserID: u.UserID, Name: "ci"}}
+	attrs := []domain.UserAttribute{{Name: "tenant", Value: json.RawMessage(`"acme"`)}}
+
+	// A full page is followed by the next one until a page comes back short.
+	firstPage := make([]domain.AuthEvent, 500)
+	for i := range firstPage {
+		firstPage[i] = domain.AuthEvent{ID: uuid.New(), UserID: u.UserID, EventType: domain.EventLogin}
+	}
+	lastPage := []domain.AuthEvent{{ID: uuid.New(), UserID: u.UserID, EventType: domain.EventRegister}}
+
+	m.authRepo.On("GetUserInfo", mock.Anything, u.UserID).Return(u, nil).Once()
+	m.tokenRepo.On("ListRefreshTokensByUser", mock.Anything, u.UserID).Return(sessions, nil).Once()
+	m.apiKeyRepo.On("ListAPIKeys", mock.Anything, u.UserID).Return(keys, nil).Once()
+	m.attributeRepo.On("ListUserAttributes", mock.Anything, u.UserID).Return(attrs, nil).Once()
+	m.auditRepo.On("ListAuthEventsByUser", mock.Anything, u.UserID, 500, 0).Return(firstPage, nil).Once()
+	m.auditRepo.On("ListAuthEventsByUser", mock.Anything, u.UserID, 500, 500).Return(lastPage, nil).Once()
+
+	export, err := service.ExportAccount(context.Background(), u.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, *u, export.User)
+	assert.Equal(t, sessions, export.Sessions)
+	assert.Equal(t, keys, export.APIKeys)
+	assert.Equal(t, attrs, export.Attributes)
+	assert.Len(t, export.AuthEvents, 501)
+	assert.False(t, export.ExportedAt.IsZero())
+
+	unknown := uuid.New()
+	m.authRepo.On("GetUserInfo", mock.Anything, unknown).Return(nil, repository.ErrNotFound).Once()
+
+	_, err = service.ExportAccount(context.Background(), unknown)
+	assert.ErrorIs(t, err, domain.ErrWrongUserID)
+
+	m.assertExpectations(t)
+}
+
+func TestAccountService_PurgeDeletedAccounts(t *testing.T) {
+	service, m := newAccountService(usecase.AccountOptions{DeletionGracePeriod: time.Hour, PurgeBatchSize: 2})
+
+	first, second, restored := uuid.New(), uuid.New(), uuid.New()
+
+	m.authRepo.On("ListUsersDueForDeletion", mock.Anything, 2).Return([]uuid.UUID{first, restored}, nil).Once()
+	m.authRepo.On("ListUsersDueForDeletion", mock.Anything, 2).Return([]uuid.UUID{second}, nil).Once()
+	m.expectPurge(first)
+	m.expectPurge(second)
+
+	// Restored between listing and deleting: skipped, the transaction is rolled back.
+	m.webhookRepo.On("DeleteWebhookDeliveriesByAggregate", mock.Anything, restored).Return(int64(0), nil).Once()
+	m.outboxRepo.On("DeleteOutboxEventsByAggregate", mock.Anything, restored).Return(int64(0), nil).Once()
+	m.authRepo.On("DeleteScheduledUser", mock.Anything, restored).Return(repository.ErrNotFound).Once()
+
+	n, err := service.PurgeDeletedAccounts(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	m.authRepo.On("ListUsersDueForDeletion", mock.Anything, 2).Return(nil, repository.ErrGatewayTimeout).Once()
+
+	_, err = service.PurgeDeletedAccounts(context.Background())
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	m.assertExpectations(t)
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// AdminService implements the operator tasks exposed by the CLI. Users are addressed
+// by id; FindUser resolves an id or an email to a user.
+type AdminService interface {
+	FindUser(ctx context.Context, ref string) (*domain.User, error)
+	ResetPassword(ctx context.Context, userID uuid.UUID, password string) error
+	DeactivateUser(ctx context.Context, userID uuid.UUID) error
+	RestoreUser(ctx context.Context, userID uuid.UUID) (*domain.User, error)
+	RevokeAllSessions(ctx context.Context, userID uuid.UUID) (int64, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]domain.Session, error)
+}
+
+type adminService struct {
+	authRepo          repository.AuthRepository
+	tokenRepo         repository.TokenRepository
+	revocationService RevocationService
+	outboxRepo        repository.OutboxRepository
+	transactor        repository.Transactor
+}
+
+func NewAdminService(authRepo repository.AuthRepository, tokenRepo repository.TokenRepository, revocationService RevocationService, outboxRepo repository.OutboxRepository, transactor repository.Transactor) AdminService {
+	return &adminService{
+		authRepo:          authRepo,
+		tokenRepo:         tokenRepo,
+		revocationService: revocationService,
+		outboxRepo:        outboxRepo,
+		transactor:        transactor,
+	}
+}
+
+func (s *adminService) FindUser(ctx context.Context, ref string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "AdminService.FindUser")
+	defer span.End()
+
+	if id, err := uuid.Parse(ref); err == nil {
+		u, err := s.authRepo.GetUserInfo(ctx, id)
+		if err != nil {
+			return nil, mapUserLookupError(err)
+		}
+		return u, nil
+	}
+
+	u, err := s.authRepo.FindUserByEmail(ctx, ref)
+	if err != nil {
+		return nil, mapUserLookupError(err)
+	}
+
+	return &domain.User{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+		IsActive:  u.IsActive,
+		Role:      u.Role,
+	}, nil
+}
+
+// ResetPassword sets a new password and signs the user out everywhere.
+func (s *adminService) ResetPassword(ctx context.Context, userID uuid.UUID, password string) error {
+	ctx, span := tracer.Start(ctx, "AdminService.ResetPassword")
+	defer span.End()
+
+	if err := validatePassword(&domain.User{Password: password}); err != nil {
+		return domain.ErrInvalidPassword
+	}
+
+	hash, err := HashPassword(ctx, password)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.authRepo.UpdateUserPassword(ctx, userID, hash); err != nil {
+			return err
+		}
+		return s.revokeSessions(ctx, userID, domain.RevokeReasonPasswordReset)
+	})
+	if err != nil {
+		return mapUserLookupError(err)
+	}
+
+	return nil
+}
+
+// DeactivateUser blocks further logins and revokes every session of the user.
+func (s *adminService) DeactivateUser(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "AdminService.DeactivateUser")
+	defer span.End()
+
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		u, err := s.authRepo.DeactivateUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.revokeSessions(ctx, userID, domain.RevokeReasonDeactivated); err != nil {
+			return err
+		}
+
+		return enqueueEvent(ctx, s.outboxRepo, u.UserID, domain.EventUserDeactivated, domain.UserLifecyclePayload{
+			UserID:     u.UserID,
+			Email:      u.Email,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+	if err != nil {
+		return mapUserLookupError(err)
+	}
+
+	return nil
+}
+
+// RestoreUser cancels the deletion of an account within its grace period and
+// reactivates it. The sessions revoked on deletion stay revoked.
+func (s *adminService) RestoreUser(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "AdminService.RestoreUser")
+	defer span.End()
+
+	u, err := s.authRepo.CancelUserDeletion(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrDeletionNotScheduled
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("cancel user deletion: %w", err)
+		}
+	}
+
+	return u, nil
+}
+
+func (s *adminService) RevokeAllSessions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, span := tracer.Start(ctx, "AdminService.RevokeAllSessions")
+	defer span.End()
+
+	var revoked int64
+
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.revocationService.RevokeUserAccessTokens(ctx, userID); err != nil {
+			return err
+		}
+
+		n, err := s.tokenRepo.DeleteRefreshTokensByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		revoked = n
+
+		if n == 0 {
+			return nil
+		}
+
+		return enqueueEvent(ctx, s.outboxRepo, userID, domain.EventSessionRevoked, domain.SessionRevokedPayload{
+			UserID:     userID,
+			Reason:     domain.RevokeReasonRevokeAll,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) || errors.Is(err, domain.ErrGatewayTimeout) {
+			return 0, domain.ErrGatewayTimeout
+		} else {
+			return 0, fmt.Errorf("revoke sessions: %w", err)
+		}
+	}
+
+	return revoked, nil
+}
+
+func (s *adminService) ListSessions(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	ctx, span := tracer.Start(ctx, "AdminService.ListSessions")
+	defer span.End()
+
+	sessions, err := s.tokenRepo.ListRefreshTokensByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list sessions: %w", err)
+		}
+	}
+
+	return sessions, nil
+}
+
+// revokeSessions deletes the refresh tokens of the user and revokes the access tokens
+// issued so far.
+func (s *adminService) revokeSessions(ctx context.Context, userID uuid.UUID, reason string) error {
+	if err := s.revocationService.RevokeUserAccessTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	n, err := s.tokenRepo.DeleteRefreshTokensByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+
+	return enqueueEvent(ctx, s.outboxRepo, userID, domain.EventSessionRevoked, domain.SessionRevokedPayload{
+		UserID:     userID,
+		Reason:     reason,
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+func mapUserLookupError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return domain.ErrUserNotFound
+	} else if errors.Is(err, repository.ErrGatewayTimeout) {
+		return domain.ErrGatewayTimeout
+	} else {
+		return err
+	}
+}
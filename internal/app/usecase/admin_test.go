@@ -0,0 +1,203 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestAdminService_FindUser(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	adminService := usecase.NewAdminService(authRepo, &mocks.TokenRepositoryMock{}, &mocks.RevocationServiceMock{}, &mocks.OutboxRepositoryMock{}, newTransactorMock())
+
+	u := &domain.User{
+		UserID:   uuid.New(),
+		Email:    "user@example.org",
+		IsActive: true,
+	}
+
+	authRepo.On("GetUserInfo", mock.Anything, u.UserID).Return(u, nil).Once()
+
+	res, err := adminService.FindUser(context.Background(), u.UserID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, u, res)
+
+	authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(&domain.UserWithPassword{
+		UserID:       u.UserID,
+		Email:        u.Email,
+		PasswordHash: "hash",
+		IsActive:     true,
+	}, nil).Once()
+
+	res, err = adminService.FindUser(context.Background(), u.Email)
+	assert.NoError(t, err)
+	assert.Equal(t, u, res)
+
+	authRepo.On("FindUserByEmail", mock.Anything, "unknown@example.org").Return(nil, repository.ErrNotFound).Once()
+
+	_, err = adminService.FindUser(context.Background(), "unknown@example.org")
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+
+	authRepo.AssertExpectations(t)
+}
+
+func TestAdminService_ResetPassword(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	revocationService := &mocks.RevocationServiceMock{}
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	adminService := usecase.NewAdminService(authRepo, tokenRepo, revocationService, outboxRepo, newTransactorMock())
+
+	userID := uuid.New()
+
+	authRepo.On("UpdateUserPassword", mock.Anything, userID, mock.AnythingOfType("string")).Return(nil).Once()
+	revocationService.On("RevokeUserAccessTokens", mock.Anything, userID).Return(nil).Once()
+	tokenRepo.On("DeleteRefreshTokensByUser", mock.Anything, userID).Return(int64(2), nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, userID, domain.EventSessionRevoked, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.SessionRevokedPayload
+		return json.Unmarshal(payload, &p) == nil && p.Reason == domain.RevokeReasonPasswordReset
+	})).Return(nil).Once()
+
+	err := adminService.ResetPassword(context.Background(), userID, "new-password")
+	assert.NoError(t, err)
+
+	err = adminService.ResetPassword(context.Background(), userID, "short")
+	assert.ErrorIs(t, err, domain.ErrInvalidPassword)
+
+	unknown := uuid.New()
+	authRepo.On("UpdateUserPassword", mock.Anything, unknown, mock.AnythingOfType("string")).Return(repository.ErrNotFound).Once()
+
+	err = adminService.ResetPassword(context.Background(), unknown, "new-password")
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+
+	authRepo.AssertExpectations(t)
+	tokenRepo.AssertExpectations(t)
+	revocationService.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestAdminService_DeactivateUser(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	revocationService := &mocks.RevocationServiceMock{}
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	adminService := usecase.NewAdminService(authRepo, tokenRepo, revocationService, outboxRepo, newTransactorMock())
+
+	u := &domain.User{
+		UserID: uuid.New(),
+		Email:  "user@example.org",
+	}
+
+	authRepo.On("DeactivateUser", mock.Anything, u.UserID).Return(u, nil).Once()
+	revocationService.On("RevokeUserAccessTokens", mock.Anything, u.UserID).Return(nil).Once()
+	tokenRepo.On("DeleteRefreshTokensByUser", mock.Anything, u.UserID).Return(int64(1), nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventSessionRevoked, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.SessionRevokedPayload
+		return json.Unmarshal(payload, &p) == nil && p.Reason == domain.RevokeReasonDeactivated
+	})).Return(nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventUserDeactivated, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.UserLifecyclePayload
+		return json.Unmarshal(payload, &p) == nil && p.Email == u.Email
+	})).Return(nil).Once()
+
+	err := adminService.DeactivateUser(context.Background(), u.UserID)
+	assert.NoError(t, err)
+
+	unknown := uuid.New()
+	authRepo.On("DeactivateUser", mock.Anything, unknown).Return(nil, repository.ErrNotFound).Once()
+
+	err = adminService.DeactivateUser(context.Background(), unknown)
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+
+	authRepo.AssertExpectations(t)
+	tokenRepo.AssertExpectations(t)
+	revocationService.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestAdminService_RestoreUser(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	adminService := usecase.NewAdminService(authRepo, &mocks.TokenRepositoryMock{}, &mocks.RevocationServiceMock{}, &mocks.OutboxRepositoryMock{}, newTransactorMock())
+
+	u := &domain.User{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+
+	authRepo.On("CancelUserDeletion", mock.Anything, u.UserID).Return(u, nil).Once()
+
+	res, err := adminService.RestoreUser(context.Background(), u.UserID)
+	assert.NoError(t, err)
+	assert.True(t, res.IsActive)
+
+	authRepo.On("CancelUserDeletion", mock.Anything, u.UserID).Return(nil, repository.ErrNotFound).Once()
+
+	_, err = adminService.RestoreUser(context.Background(), u.UserID)
+	assert.ErrorIs(t, err, domain.ErrDeletionNotScheduled)
+
+	authRepo.AssertExpectations(t)
+}
+
+func TestAdminService_RevokeAllSessions(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	revocationService := &mocks.RevocationServiceMock{}
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	adminService := usecase.NewAdminService(&mocks.AuthRepositoryMock{}, tokenRepo, revocationService, outboxRepo, newTransactorMock())
+
+	userID := uuid.New()
+
+	// Access tokens are revoked even when there is no session left to delete.
+	revocationService.On("RevokeUserAccessTokens", mock.Anything, userID).Return(nil).Twice()
+	tokenRepo.On("DeleteRefreshTokensByUser", mock.Anything, userID).Return(int64(3), nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, userID, domain.EventSessionRevoked, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.SessionRevokedPayload
+		return json.Unmarshal(payload, &p) == nil && p.Reason == domain.RevokeReasonRevokeAll
+	})).Return(nil).Once()
+
+	n, err := adminService.RevokeAllSessions(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	// Nothing revoked, nothing to announce.
+	tokenRepo.On("DeleteRefreshTokensByUser", mock.Anything, userID).Return(int64(0), nil).Once()
+
+	n, err = adminService.RevokeAllSessions(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	tokenRepo.AssertExpectations(t)
+	revocationService.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestAdminService_ListSessions(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	adminService := usecase.NewAdminService(&mocks.AuthRepositoryMock{}, tokenRepo, &mocks.RevocationServiceMock{}, &mocks.OutboxRepositoryMock{}, newTransactorMock())
+
+	userID := uuid.New()
+	sessions := []domain.Session{{
+		ID:        uuid.New(),
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: time.Now().UTC().Add(time.Hour),
+	}}
+
+	tokenRepo.On("ListRefreshTokensByUser", mock.Anything, userID).Return(sessions, nil).Once()
+
+	res, err := adminService.ListSessions(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, sessions, res)
+
+	tokenRepo.On("ListRefreshTokensByUser", mock.Anything, userID).Return(nil, repository.ErrGatewayTimeout).Once()
+
+	_, err = adminService.ListSessions(context.Background(), userID)
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	tokenRepo.AssertExpectations(t)
+}
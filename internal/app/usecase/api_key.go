@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	apiKeyPrefix     = "ak_"
+	apiKeyVisibleLen = len(apiKeyPrefix) + 8
+)
+
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresAt *time.Time) (*domain.CreatedAPIKey, error)
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID uuid.UUID, keyID uuid.UUID) error
+	ValidateAPIKey(ctx context.Context, key string) (*domain.APIKey, error)
+}
+
+type apiKeyService struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+func NewAPIKeyService(apiKeyRepo repository.APIKeyRepository) APIKeyService {
+	return &apiKeyService{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresAt *time.Time) (*domain.CreatedAPIKey, error) {
+	ctx, span := tracer.Start(ctx, "APIKeyService.CreateAPIKey")
+	defer span.End()
+
+	if err := validateAPIKeyName(name); err != nil {
+		return nil, domain.ErrInvalidAPIKeyName
+	}
+
+	for _, scope := range scopes {
+		if !domain.IsKnownScope(scope) {
+			return nil, domain.ErrInvalidAPIKeyScope
+		}
+	}
+
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, domain.ErrInvalidAPIKeyExpiry
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate api key: %w", err)
+	}
+
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+
+	k, err := s.apiKeyRepo.CreateAPIKey(ctx, userID, name, key[:apiKeyVisibleLen], HashRefreshTokenFunc(key), scopes, expiresAt)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("create api key: %w", err)
+		}
+	}
+
+	return &domain.CreatedAPIKey{
+		APIKey: *k,
+		Key:    key,
+	}, nil
+}
+
+func (s *apiKeyService) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	ctx, span := tracer.Start(ctx, "APIKeyService.ListAPIKeys")
+	defer span.End()
+
+	keys, err := s.apiKeyRepo.ListAPIKeys(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list api keys: %w", err)
+		}
+	}
+
+	return keys, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, userID uuid.UUID, keyID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "APIKeyService.RevokeAPIKey")
+	defer span.End()
+
+	if err := s.apiKeyRepo.DeleteAPIKey(ctx, keyID, userID); err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) {
+			return domain.ErrAPIKeyNotFound
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("delete api key: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *apiKeyService) ValidateAPIKey(ctx context.Context, key string) (*domain.APIKey, error) {
+	ctx, span := tracer.Start(ctx, "APIKeyService.ValidateAPIKey")
+	defer span.End()
+
+	if len(key) <= apiKeyVisibleLen {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	k, err := s.apiKeyRepo.FindAPIKeyByHash(ctx, HashRefreshTokenFunc(key))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrInvalidAPIKey
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("find api key: %w", err)
+		}
+	}
+
+	if k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	if err := s.apiKeyRepo.TouchAPIKey(ctx, k.ID); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("touch api key: %w", err)
+		}
+	}
+
+	return k, nil
+}
+
+func generateAPIKey() (string, error) {
+	b := make([]byte, 32)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%x", apiKeyPrefix, b), nil
+}
@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestAPIKeyService_CreateAPIKey(t *testing.T) {
+	apiKeyRepo := &mocks.APIKeyRepositoryMock{}
+	apiKeyService := usecase.NewAPIKeyService(apiKeyRepo)
+
+	userID := uuid.New()
+	name := "ci-deploy"
+	scopes := []string{domain.ScopeUserRead}
+	expiresAt := time.Now().UTC().Add(time.Hour * 24 * 30)
+
+	k := &domain.APIKey{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Prefix:    "ak_12345678",
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: &expiresAt,
+	}
+
+	apiKeyRepo.On("CreateAPIKey", mock.Anything, userID, name, mock.Anything, mock.Anything, scopes, &expiresAt).Return(k, nil).Once()
+
+	res, err := apiKeyService.CreateAPIKey(context.Background(), userID, name, scopes, &expiresAt)
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, *k, res.APIKey)
+	assert.True(t, strings.HasPrefix(res.Key, "ak_"))
+
+	_, err = apiKeyService.CreateAPIKey(context.Background(), userID, "", scopes, nil)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidAPIKeyName)
+
+	_, err = apiKeyService.CreateAPIKey(context.Background(), userID, name, []string{"admin"}, nil)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidAPIKeyScope)
+
+	past := time.Now().UTC().Add(-time.Hour)
+	_, err = apiKeyService.CreateAPIKey(context.Background(), userID, name, scopes, &past)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidAPIKeyExpiry)
+
+	apiKeyRepo.AssertExpectations(t)
+}
+
+func TestAPIKeyService_ValidateAPIKey(t *testing.T) {
+	apiKeyRepo := &mocks.APIKeyRepositoryMock{}
+	apiKeyService := usecase.NewAPIKeyService(apiKeyRepo)
+
+	key := "ak_0123456789abcdef"
+	hash := usecase.HashRefreshTokenFunc(key)
+
+	k := &domain.APIKey{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Scopes: []string{domain.ScopeUserRead},
+	}
+
+	apiKeyRepo.On("FindAPIKeyByHash", mock.Anything, hash).Return(k, nil).Once()
+	apiKeyRepo.On("TouchAPIKey", mock.Anything, k.ID).Return(nil).Once()
+
+	res, err := apiKeyService.ValidateAPIKey(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Equal(t, k, res)
+
+	expiredKey := "ak_expired0123456789"
+	expiredAt := time.Now().UTC().Add(-time.Minute)
+	expired := &domain.APIKey{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		ExpiresAt: &expiredAt,
+	}
+
+	apiKeyRepo.On("FindAPIKeyByHash", mock.Anything, usecase.HashRefreshTokenFunc(expiredKey)).Return(expired, nil).Once()
+
+	_, err = apiKeyService.ValidateAPIKey(context.Background(), expiredKey)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidAPIKey)
+
+	unknownKey := "ak_unknown0123456789"
+	apiKeyRepo.On("FindAPIKeyByHash", mock.Anything, usecase.HashRefreshTokenFunc(unknownKey)).Return(nil, repository.ErrNotFound).Once()
+
+	_, err = apiKeyService.ValidateAPIKey(context.Background(), unknownKey)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidAPIKey)
+
+	_, err = apiKeyService.ValidateAPIKey(context.Background(), "short")
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidAPIKey)
+
+	apiKeyRepo.AssertExpectations(t)
+}
+
+func TestAPIKeyService_RevokeAPIKey(t *testing.T) {
+	apiKeyRepo := &mocks.APIKeyRepositoryMock{}
+	apiKeyService := usecase.NewAPIKeyService(apiKeyRepo)
+
+	userID := uuid.New()
+	keyID := uuid.New()
+
+	apiKeyRepo.On("DeleteAPIKey", mock.Anything, keyID, userID).Return(nil).Once()
+
+	err := apiKeyService.RevokeAPIKey(context.Background(), userID, keyID)
+	assert.NoError(t, err)
+
+	apiKeyRepo.On("DeleteAPIKey", mock.Anything, keyID, userID).Return(repository.ErrNoRowDeleted).Once()
+
+	err = apiKeyService.RevokeAPIKey(context.Background(), userID, keyID)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+
+	apiKeyRepo.AssertExpectations(t)
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	defaultEventsLimit = 50
+	maxEventsLimit     = 200
+	maxUserAgentLen    = 512
+)
+
+type AuditService interface {
+	Record(ctx context.Context, event domain.AuthEvent)
+	ListUserEvents(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error)
+	ListEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error)
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+type auditService struct {
+	auditRepo repository.AuditRepository
+	log       *slog.Logger
+	retention time.Duration
+}
+
+func NewAuditService(auditRepo repository.AuditRepository, log *slog.Logger, retention time.Duration) AuditService {
+	return &auditService{
+		auditRepo: auditRepo,
+		log:       log,
+		retention: retention,
+	}
+}
+
+// Record stores an authentication event enriched with the request metadata from ctx.
+// A failure to write the audit log is logged and never fails the audited operation.
+func (s *auditService) Record(ctx context.Context, event domain.AuthEvent) {
+	ctx, span := tracer.Start(ctx, "AuditService.Record")
+	defer span.End()
+
+	meta := domain.RequestMetaFromContext(ctx)
+
+	event.IP = meta.IP
+	event.UserAgent = meta.UserAgent
+	event.RequestID = meta.RequestID
+
+	if len(event.UserAgent) > maxUserAgentLen {
+		event.UserAgent = event.UserAgent[:maxUserAgentLen]
+	}
+
+	if err := s.auditRepo.CreateAuthEvent(ctx, &event); err != nil {
+		s.log.ErrorContext(ctx, "audit_record_failed",
+			"request_id", event.RequestID,
+			"event_type", event.EventType,
+			"outcome", event.Outcome,
+			"error", err,
+		)
+	}
+}
+
+func (s *auditService) ListUserEvents(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error) {
+	ctx, span := tracer.Start(ctx, "AuditService.ListUserEvents")
+	defer span.End()
+
+	limit, offset = normalizePage(limit, offset)
+
+	events, err := s.auditRepo.ListAuthEventsByUser(ctx, userID, limit, offset)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list auth events by user: %w", err)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *auditService) ListEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error) {
+	ctx, span := tracer.Start(ctx, "AuditService.ListEvents")
+	defer span.End()
+
+	filter.Limit, filter.Offset = normalizePage(filter.Limit, filter.Offset)
+
+	events, err := s.auditRepo.ListAuthEvents(ctx, filter)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list auth events: %w", err)
+		}
+	}
+
+	return events, nil
+}
+
+// PurgeExpired deletes events older than the retention period. A zero retention keeps events forever.
+func (s *auditService) PurgeExpired(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "AuditService.PurgeExpired")
+	defer span.End()
+
+	if s.retention <= 0 {
+		return 0, nil
+	}
+
+	n, err := s.auditRepo.DeleteAuthEventsBefore(ctx, time.Now().Add(-s.retention))
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return 0, domain.ErrGatewayTimeout
+		} else {
+			return 0, fmt.Errorf("delete auth events: %w", err)
+		}
+	}
+
+	return n, nil
+}
+
+func normalizePage(limit int, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultEventsLimit
+	}
+	if limit > maxEventsLimit {
+		limit = maxEventsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
@@ -0,0 +1,104 @@
+package usecase_test
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestAuditService_Record(t *testing.T) {
+	auditRepo := &mocks.AuditRepositoryMock{}
+	auditService := usecase.NewAuditService(auditRepo, slog.Default(), time.Hour)
+
+	userID := uuid.New()
+	ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{
+		IP:        "203.0.113.7",
+		UserAgent: strings.Repeat("a", 1000),
+		RequestID: "request-id",
+	})
+
+	auditRepo.On("CreateAuthEvent", mock.Anything, mock.MatchedBy(func(e *domain.AuthEvent) bool {
+		return e.UserID == userID &&
+			e.EventType == domain.EventLogin &&
+			e.IP == "203.0.113.7" &&
+			e.RequestID == "request-id" &&
+			len(e.UserAgent) == 512
+	})).Return(nil).Once()
+
+	auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventLogin,
+		UserID:    userID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+
+	auditRepo.On("CreateAuthEvent", mock.Anything, mock.Anything).Return(repository.ErrGatewayTimeout).Once()
+
+	assert.NotPanics(t, func() {
+		auditService.Record(ctx, domain.AuthEvent{EventType: domain.EventLogout})
+	})
+
+	auditRepo.AssertExpectations(t)
+}
+
+func TestAuditService_ListUserEvents(t *testing.T) {
+	auditRepo := &mocks.AuditRepositoryMock{}
+	auditService := usecase.NewAuditService(auditRepo, slog.Default(), time.Hour)
+
+	userID := uuid.New()
+	events := []domain.AuthEvent{
+		{
+			ID:        uuid.New(),
+			EventType: domain.EventLogin,
+			UserID:    userID,
+			Outcome:   domain.OutcomeSuccess,
+		},
+	}
+
+	auditRepo.On("ListAuthEventsByUser", mock.Anything, userID, 50, 0).Return(events, nil).Once()
+	auditRepo.On("ListAuthEventsByUser", mock.Anything, userID, 200, 10).Return(events, nil).Once()
+
+	res, err := auditService.ListUserEvents(context.Background(), userID, 0, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, events, res)
+
+	_, err = auditService.ListUserEvents(context.Background(), userID, 1000, 10)
+	assert.NoError(t, err)
+
+	auditRepo.On("ListAuthEventsByUser", mock.Anything, userID, 50, 0).Return(nil, repository.ErrGatewayTimeout).Once()
+
+	_, err = auditService.ListUserEvents(context.Background(), userID, 0, 0)
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	auditRepo.AssertExpectations(t)
+}
+
+func TestAuditService_PurgeExpired(t *testing.T) {
+	auditRepo := &mocks.AuditRepositoryMock{}
+	auditService := usecase.NewAuditService(auditRepo, slog.Default(), time.Hour*24)
+
+	auditRepo.On("DeleteAuthEventsBefore", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= time.Hour*24
+	})).Return(int64(3), nil).Once()
+
+	n, err := auditService.PurgeExpired(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	disabled := usecase.NewAuditService(auditRepo, slog.Default(), 0)
+
+	n, err = disabled.PurgeExpired(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	auditRepo.AssertExpectations(t)
+}
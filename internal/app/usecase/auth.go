@@ -4,50 +4,123 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/vo1dFl0w/auth-service/internal/app/domain"
 	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
 )
 
 type AuthService interface {
 	Register(ctx context.Context, email string, password string) (*domain.User, error)
-	Login(ctx context.Context, email string, password string) (*domain.Tokens, error)
-	Logout(ctx context.Context, token string) error
+	// Login starts a session. rememberMe selects the longer session policy.
+	Login(ctx context.Context, email string, password string, rememberMe bool) (*domain.Tokens, error)
+	// Logout ends the session of the refresh token. A still valid access token sent
+	// along is revoked as well.
+	Logout(ctx context.Context, token string, accessToken string) error
 	UserInfo(ctx context.Context, user_id uuid.UUID) (*domain.User, error)
+	// RefreshTokens rotates the refresh token of a session. It fails once the session
+	// exceeds the absolute or idle lifetime of its policy.
 	RefreshTokens(ctx context.Context, token string) (*domain.Tokens, error)
 }
 
+// SessionPolicy bounds a refresh chain. AbsoluteTTL caps the time since login and
+// IdleTTL the time since the last refresh. IdleTTL also replaces the lifetime of
+// each refresh token; a zero value disables the limit.
+type SessionPolicy struct {
+	AbsoluteTTL time.Duration
+	IdleTTL     time.Duration
+}
+
+// SessionOptions holds the policy of regular logins and the longer one of logins
+// with remember me.
+type SessionOptions struct {
+	Default    SessionPolicy
+	RememberMe SessionPolicy
+}
+
+func (o SessionOptions) policy(rememberMe bool) SessionPolicy {
+	if rememberMe {
+		return o.RememberMe
+	}
+	return o.Default
+}
+
+// expiresAt returns when a refresh token issued at now has to expire, given the
+// expiry the token service picked for it.
+func (p SessionPolicy) expiresAt(now time.Time, startedAt time.Time, expiresAt time.Time) time.Time {
+	if p.IdleTTL > 0 {
+		expiresAt = now.Add(p.IdleTTL)
+	}
+	if p.AbsoluteTTL > 0 {
+		if end := startedAt.Add(p.AbsoluteTTL); end.Before(expiresAt) {
+			expiresAt = end
+		}
+	}
+	return expiresAt
+}
+
+// exceeded returns the reason the session of token must not be refreshed at now,
+// or an empty string if it may. The policy can have been tightened since the token
+// was issued, so its expiry alone is not enough.
+func (p SessionPolicy) exceeded(now time.Time, token *domain.RefreshToken) string {
+	if p.AbsoluteTTL > 0 && now.After(token.SessionStartedAt.Add(p.AbsoluteTTL)) {
+		return domain.ReasonSessionExpired
+	}
+	if p.IdleTTL > 0 && now.After(token.CreatedAt.Add(p.IdleTTL)) {
+		return domain.ReasonSessionIdle
+	}
+	return ""
+}
+
 type authService struct {
-	authRepo     repository.AuthRepository
-	tokenRepo    repository.TokenRepository
-	tokenService TokenService
+	authRepo          repository.AuthRepository
+	tokenRepo         repository.TokenRepository
+	tokenService      TokenService
+	revocationService RevocationService
+	auditService      AuditService
+	outboxRepo        repository.OutboxRepository
+	transactor        repository.Transactor
+	log               *slog.Logger
+	sessionOptions    SessionOptions
 }
 
-func NewAuthService(authRepo repository.AuthRepository, tokenRepo repository.TokenRepository, tokenService TokenService) *authService {
+func NewAuthService(authRepo repository.AuthRepository, tokenRepo repository.TokenRepository, tokenService TokenService, revocationService RevocationService, auditService AuditService, outboxRepo repository.OutboxRepository, transactor repository.Transactor, log *slog.Logger, sessionOptions SessionOptions) *authService {
 	return &authService{
-		authRepo:     authRepo,
-		tokenRepo:    tokenRepo,
-		tokenService: tokenService,
+		authRepo:          authRepo,
+		tokenRepo:         tokenRepo,
+		tokenService:      tokenService,
+		revocationService: revocationService,
+		auditService:      auditService,
+		outboxRepo:        outboxRepo,
+		transactor:        transactor,
+		log:               log,
+		sessionOptions:    sessionOptions,
 	}
 }
 
 func (s *authService) Register(ctx context.Context, email string, password string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.Register")
+	defer span.End()
+
 	u := &domain.User{
 		Email:    email,
 		Password: password,
 	}
 
 	if err := validateEmail(u); err != nil {
+		s.recordFailure(ctx, domain.EventRegister, uuid.Nil, domain.ReasonInvalidEmail)
 		return nil, domain.ErrInvalidEmail
 	}
 
 	if err := validatePassword(u); err != nil {
+		s.recordFailure(ctx, domain.EventRegister, uuid.Nil, domain.ReasonInvalidPassword)
 		return nil, domain.ErrInvalidPassword
 	}
 
-	hashedPassword, err := HashPassword(password)
+	hashedPassword, err := HashPassword(ctx, password)
 	if err != nil {
 		if errors.Is(err, domain.ErrEmptyPassword) {
 			return nil, domain.ErrEmptyPassword
@@ -56,10 +129,29 @@ func (s *authService) Register(ctx context.Context, email string, password strin
 	}
 
 	u.Password = ""
-	
-	res, err := s.authRepo.CreateUser(ctx, email, hashedPassword)
+
+	var res *domain.User
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		created, err := s.authRepo.CreateUser(ctx, email, hashedPassword)
+		if err != nil {
+			return err
+		}
+
+		err = enqueueEvent(ctx, s.outboxRepo, created.UserID, domain.EventUserRegistered, domain.UserLifecyclePayload{
+			UserID:     created.UserID,
+			Email:      created.Email,
+			OccurredAt: created.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		res = created
+		return nil
+	})
 	if err != nil {
 		if errors.Is(err, repository.ErrEmailAlreadyExists) {
+			s.recordFailure(ctx, domain.EventRegister, uuid.Nil, domain.ReasonEmailAlreadyExists)
 			return nil, domain.ErrEmailAlreadyExists
 		} else if errors.Is(err, repository.ErrGatewayTimeout) {
 			return nil, domain.ErrGatewayTimeout
@@ -68,27 +160,34 @@ func (s *authService) Register(ctx context.Context, email string, password strin
 		}
 	}
 
+	s.recordSuccess(ctx, domain.EventRegister, res.UserID)
+
 	return res, nil
 }
 
-func (s *authService) Login(ctx context.Context, email string, password string) (*domain.Tokens, error) {
+func (s *authService) Login(ctx context.Context, email string, password string, rememberMe bool) (*domain.Tokens, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.Login")
+	defer span.End()
+
 	u := &domain.User{
 		Email:    email,
 		Password: password,
 	}
 
 	if err := validateEmail(u); err != nil {
+		s.recordFailure(ctx, domain.EventLogin, uuid.Nil, domain.ReasonInvalidEmail)
 		return nil, domain.ErrWrongEmailOrPassword
 	}
 
 	if err := validatePassword(u); err != nil {
+		s.recordFailure(ctx, domain.EventLogin, uuid.Nil, domain.ReasonInvalidPassword)
 		return nil, domain.ErrWrongEmailOrPassword
 	}
 
 	res, err := s.authRepo.FindUserByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, domain.ErrWrongEmailOrPassword
+			return nil, s.loginFailed(ctx, uuid.Nil, email, domain.ReasonUserNotFound)
 		} else if errors.Is(err, repository.ErrGatewayTimeout) {
 			return nil, domain.ErrGatewayTimeout
 		} else {
@@ -96,46 +195,65 @@ func (s *authService) Login(ctx context.Context, email string, password string)
 		}
 	}
 
-	if err := comparePasswords(password, res.PasswordHash); err != nil {
-		return nil, domain.ErrWrongEmailOrPassword
+	if err := comparePasswords(ctx, password, res.PasswordHash); err != nil {
+		return nil, s.loginFailed(ctx, res.UserID, email, domain.ReasonWrongPassword)
 	}
 
-	accessToken, err := s.tokenService.GenerateAccessToken(res.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("generate access token: %w", err)
+	if !res.IsActive {
+		return nil, s.loginFailed(ctx, res.UserID, email, domain.ReasonUserInactive)
 	}
 
-	refreshToken, err := s.tokenService.GenerateRefreshToken()
+	tokens, err := startSession(ctx, s.tokenRepo, s.tokenService, s.sessionOptions, res.UserID, rememberMe)
 	if err != nil {
-		return nil, fmt.Errorf("generate refresh token: %w", err)
+		return nil, err
 	}
 
-	h, expiresAt := s.tokenService.HashRefreshToken(refreshToken)
-	if err := s.tokenRepo.SaveHashedRefreshToken(ctx, res.UserID, h, expiresAt); err != nil {
-		if errors.Is(err, repository.ErrGatewayTimeout) {
-			return nil, domain.ErrGatewayTimeout
-		} else {
-			return nil, fmt.Errorf("save hashed refresh token: %w", err)
-		}
-	}
+	s.recordSuccess(ctx, domain.EventLogin, res.UserID)
 
-	return &domain.Tokens{
-		AccessToken:           accessToken,
-		RefreshToken:          refreshToken,
-		RefreshTokenExpiresAt: expiresAt,
-	}, nil
+	return tokens, nil
 }
 
-func (s *authService) Logout(ctx context.Context, token string) error {
+func (s *authService) Logout(ctx context.Context, token string, accessToken string) error {
+	ctx, span := tracer.Start(ctx, "AuthService.Logout")
+	defer span.End()
+
 	if token == "" {
 		return domain.ErrEmptyRefreshToken
 	}
 
+	// An expired or otherwise invalid access token is no longer accepted anyway.
+	if accessToken != "" {
+		if claims, err := s.tokenService.ValidateAccessToken(accessToken); err == nil {
+			if err := s.revocationService.RevokeAccessToken(ctx, &claims.RegisteredClaims); err != nil {
+				return err
+			}
+		}
+	}
+
 	hash := HashRefreshTokenFunc(token)
 
-	_, err := s.tokenRepo.DeleteRefreshToken(ctx, hash)
+	var res *domain.RefreshToken
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		deleted, err := s.tokenRepo.DeleteRefreshToken(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		err = enqueueEvent(ctx, s.outboxRepo, deleted.UserID, domain.EventSessionRevoked, domain.SessionRevokedPayload{
+			UserID:     deleted.UserID,
+			Reason:     domain.RevokeReasonLogout,
+			OccurredAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		res = deleted
+		return nil
+	})
 	if err != nil {
 		if errors.Is(err, repository.ErrNoRowDeleted) {
+			s.recordFailure(ctx, domain.EventLogout, uuid.Nil, domain.ReasonUnknownToken)
 			return nil
 		} else if errors.Is(err, repository.ErrGatewayTimeout) {
 			return domain.ErrGatewayTimeout
@@ -144,10 +262,15 @@ func (s *authService) Logout(ctx context.Context, token string) error {
 		}
 	}
 
+	s.recordSuccess(ctx, domain.EventLogout, res.UserID)
+
 	return nil
 }
 
 func (s *authService) UserInfo(ctx context.Context, user_id uuid.UUID) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.UserInfo")
+	defer span.End()
+
 	u, err := s.authRepo.GetUserInfo(ctx, user_id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -163,14 +286,51 @@ func (s *authService) UserInfo(ctx context.Context, user_id uuid.UUID) (*domain.
 }
 
 func (s *authService) RefreshTokens(ctx context.Context, token string) (*domain.Tokens, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.RefreshTokens")
+	defer span.End()
+
 	if token == "" {
 		return nil, domain.ErrEmptyRefreshToken
 	}
 
+	// The token is checked against the key, certificate and client it is bound to
+	// before it is consumed, so that a stolen token presented without them does not end
+	// the session of its holder.
 	hashToken := HashRefreshTokenFunc(token)
+	found, err := s.tokenRepo.FindRefreshToken(ctx, hashToken)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			s.recordFailure(ctx, domain.EventRefresh, uuid.Nil, domain.ReasonUnknownToken)
+			return nil, domain.ErrInvalidOrExpiredRefreshToken
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("find refresh token: %w", err)
+		}
+	}
+
+	// A refresh token bound to a DPoP key or a client certificate can only be used
+	// with a proof of that key and over a connection with that certificate. The
+	// binding of a refresh token never changes, while the access token is bound to
+	// whatever key and certificate the request was made with.
+	meta := domain.RequestMetaFromContext(ctx)
+	if !(&Confirmation{JKT: found.DPoPJKT, X5T: found.X5T}).matches(meta) {
+		s.recordFailure(ctx, domain.EventRefresh, found.UserID, domain.ReasonBindingMismatch)
+		return nil, domain.ErrInvalidOrExpiredRefreshToken
+	}
+	// The session keeps the client it was started by. A confidential client has to
+	// authenticate again to refresh it, and no other client can take it over.
+	if found.ClientID != "" && found.ClientID != meta.ClientID {
+		s.recordFailure(ctx, domain.EventRefresh, found.UserID, domain.ReasonClientMismatch)
+		return nil, domain.ErrInvalidOrExpiredRefreshToken
+	}
+
+	// Deleting the token is what consumes it: of concurrent refreshes with the same
+	// token, only one gets it.
 	res, err := s.tokenRepo.DeleteRefreshToken(ctx, hashToken)
 	if err != nil {
 		if errors.Is(err, repository.ErrNoRowDeleted) {
+			s.recordFailure(ctx, domain.EventRefresh, found.UserID, domain.ReasonUnknownToken)
 			return nil, domain.ErrInvalidOrExpiredRefreshToken
 		} else if errors.Is(err, repository.ErrGatewayTimeout) {
 			return nil, domain.ErrGatewayTimeout
@@ -179,11 +339,21 @@ func (s *authService) RefreshTokens(ctx context.Context, token string) (*domain.
 		}
 	}
 
-	if time.Now().After(res.ExpiresAt) {
+	now := time.Now()
+	if now.After(res.ExpiresAt) {
+		s.recordFailure(ctx, domain.EventRefresh, res.UserID, domain.ReasonExpiredToken)
 		return nil, domain.ErrInvalidOrExpiredRefreshToken
 	}
 
-	accessToken, err := s.tokenService.GenerateAccessToken(res.UserID)
+	policy := s.sessionOptions.policy(res.RememberMe)
+	if reason := policy.exceeded(now, res); reason != "" {
+		s.recordFailure(ctx, domain.EventRefresh, res.UserID, reason)
+		return nil, domain.ErrInvalidOrExpiredRefreshToken
+	}
+
+	clientID := res.ClientID
+
+	accessToken, err := s.tokenService.GenerateAccessToken(ctx, res.UserID, clientID)
 	if err != nil {
 		return nil, fmt.Errorf("generate access token: %w", err)
 	}
@@ -192,9 +362,53 @@ func (s *authService) RefreshTokens(ctx context.Context, token string) (*domain.
 		return nil, fmt.Errorf("generate refresh token: %w", err)
 	}
 
-	h, expiresAt := s.tokenService.HashRefreshToken(refreshToken)
+	h, expiresAt := s.tokenService.HashRefreshToken(refreshToken, clientID)
+	expiresAt = policy.expiresAt(now, res.SessionStartedAt, expiresAt)
+
+	if err := s.tokenRepo.SaveHashedRefreshToken(ctx, res.UserID, h, expiresAt, res.SessionStartedAt, res.RememberMe, res.DPoPJKT, res.ClientID, res.X5T); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("save hashed refresh token: %w", err)
+		}
+	}
+
+	s.recordSuccess(ctx, domain.EventRefresh, res.UserID)
+
+	return &domain.Tokens{
+		AccessToken:           accessToken,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: expiresAt,
+	}, nil
+}
+
+// startSession issues the access token and the first refresh token of a new session
+// of the user. Every way of logging in ends here once the user is authenticated. Both
+// tokens are bound to the DPoP key the request was proven with and the client
+// certificate it was made with, if any.
+func startSession(ctx context.Context, tokenRepo repository.TokenRepository, tokenService TokenService, sessionOptions SessionOptions, userID uuid.UUID, rememberMe bool) (*domain.Tokens, error) {
+	meta := domain.RequestMetaFromContext(ctx)
+	clientID := meta.ClientID
+
+	accessToken, err := tokenService.GenerateAccessToken(ctx, userID, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	refreshToken, err := tokenService.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	var x5t string
+	if meta.ClientCert != nil {
+		x5t = meta.ClientCert.Thumbprint
+	}
 
-	if err := s.tokenRepo.SaveHashedRefreshToken(ctx, res.UserID, h, expiresAt); err != nil {
+	now := time.Now()
+	h, expiresAt := tokenService.HashRefreshToken(refreshToken, clientID)
+	expiresAt = sessionOptions.policy(rememberMe).expiresAt(now, now, expiresAt)
+	if err := tokenRepo.SaveHashedRefreshToken(ctx, userID, h, expiresAt, now, rememberMe, meta.DPoPJKT, clientID, x5t); err != nil {
 		if errors.Is(err, repository.ErrGatewayTimeout) {
 			return nil, domain.ErrGatewayTimeout
 		} else {
@@ -208,3 +422,59 @@ func (s *authService) RefreshTokens(ctx context.Context, token string) (*domain.
 		RefreshTokenExpiresAt: expiresAt,
 	}, nil
 }
+
+// loginFailed records a failed login attempt and publishes it for webhook subscribers.
+// It returns the error Login has to report to the caller, which is always the
+// credential error: the event is best effort, like the audit record, and must not
+// tell callers apart by whether it could be stored.
+func (s *authService) loginFailed(ctx context.Context, userID uuid.UUID, email string, reason string) error {
+	s.recordFailure(ctx, domain.EventLogin, userID, reason)
+
+	payload := domain.LoginFailedPayload{
+		Email:      email,
+		Reason:     reason,
+		IP:         domain.RequestMetaFromContext(ctx).IP,
+		OccurredAt: time.Now().UTC(),
+	}
+	if userID != uuid.Nil {
+		payload.UserID = &userID
+	}
+
+	if err := enqueueEvent(ctx, s.outboxRepo, userID, domain.EventUserLoginFailed, payload); err != nil {
+		s.log.ErrorContext(ctx, "enqueue_login_failed_event_failed",
+			"request_id", domain.RequestMetaFromContext(ctx).RequestID,
+			"reason", reason,
+			"error", err,
+		)
+	}
+
+	return domain.ErrWrongEmailOrPassword
+}
+
+func (s *authService) recordSuccess(ctx context.Context, eventType string, userID uuid.UUID) {
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: eventType,
+		UserID:    userID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+	observeAuthEvent(eventType, metrics.ResultSuccess, "")
+}
+
+func (s *authService) recordFailure(ctx context.Context, eventType string, userID uuid.UUID, reason string) {
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: eventType,
+		UserID:    userID,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    reason,
+	})
+	observeAuthEvent(eventType, metrics.ResultFailure, reason)
+}
+
+func observeAuthEvent(eventType string, result string, reason string) {
+	switch eventType {
+	case domain.EventLogin:
+		metrics.LoginsTotal.WithLabelValues(result, reason).Inc()
+	case domain.EventRefresh:
+		metrics.RefreshRotationsTotal.WithLabelValues(result, reason).Inc()
+	}
+}
@@ -2,23 +2,29 @@ package usecase_test
 
 import (
 	"context"
+	"encoding/json"
+	"log/slog"
 	"testing"
 	"time"
 
+	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/vo1dFl0w/auth-service/internal/app/domain"
 	"github.com/vo1dFl0w/auth-service/internal/app/repository"
 	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
 	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
 )
 
 func TestAuthRepository_Register(t *testing.T) {
 	authRepo := &mocks.AuthRepositoryMock{}
 	tokenRepo := &mocks.TokenRepositoryMock{}
-	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo)
-	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService)
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo, usecase.TokenOptions{})
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, newAuditServiceMock(), outboxRepo, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
 
 	email := "user@example.org"
 	password := "password"
@@ -31,12 +37,22 @@ func TestAuthRepository_Register(t *testing.T) {
 	}
 
 	authRepo.On("CreateUser", mock.Anything, email, mock.Anything).Return(u, nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventUserRegistered, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.UserLifecyclePayload
+		return json.Unmarshal(payload, &p) == nil && p.UserID == u.UserID && p.Email == email
+	})).Return(nil).Once()
 
 	res, err := authService.Register(context.Background(), email, password)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, u, res)
 
+	authRepo.On("CreateUser", mock.Anything, email, mock.Anything).Return(u, nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventUserRegistered, mock.Anything).Return(repository.ErrGatewayTimeout).Once()
+
+	_, err = authService.Register(context.Background(), email, password)
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
 	_, err = authService.Register(context.Background(), "invalid-email", password)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrInvalidEmail)
@@ -46,13 +62,14 @@ func TestAuthRepository_Register(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrInvalidPassword)
 
 	authRepo.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
 }
 
 func TestAuthRepository_Login(t *testing.T) {
 	authRepo := &mocks.AuthRepositoryMock{}
 	tokenRepo := &mocks.TokenRepositoryMock{}
 	tokenService := &mocks.TokenServiceMock{}
-	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService)
+	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, newAuditServiceMock(), &mocks.OutboxRepositoryMock{}, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
 
 	email := "user@example.org"
 	password := "password"
@@ -61,7 +78,7 @@ func TestAuthRepository_Login(t *testing.T) {
 	refreshTokenHash := "hashed-refresh-token"
 	expiresAt := time.Now().UTC().Add(time.Hour * 24 * 7)
 
-	hash, _ := usecase.HashPassword(password)
+	hash, _ := usecase.HashPassword(context.Background(), password)
 
 	u := &domain.UserWithPassword{
 		UserID:       uuid.New(),
@@ -72,23 +89,35 @@ func TestAuthRepository_Login(t *testing.T) {
 	}
 
 	authRepo.On("FindUserByEmail", mock.Anything, email).Return(u, nil).Once()
-	tokenService.On("GenerateAccessToken", u.UserID).Return(accessToken, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "").Return(accessToken, nil).Once()
 	tokenService.On("GenerateRefreshToken").Return(refreshToken, nil).Once()
-	tokenService.On("HashRefreshToken", refreshToken).Return(refreshTokenHash, expiresAt).Once()
-	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, refreshTokenHash, expiresAt).Return(nil).Once()
+	tokenService.On("HashRefreshToken", refreshToken, "").Return(refreshTokenHash, expiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, refreshTokenHash, expiresAt, mock.Anything, false, "", "", "").Return(nil).Once()
 
-	res, err := authService.Login(context.Background(), email, password)
+	res, err := authService.Login(context.Background(), email, password, false)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, refreshToken, res.RefreshToken)
 	assert.Equal(t, accessToken, res.AccessToken)
 	assert.Equal(t, expiresAt, res.RefreshTokenExpiresAt)
 
-	_, err = authService.Login(context.Background(), "invalid email", password)
+	// Tokens are issued for the client named in the request metadata and bound to its
+	// certificate, which the session keeps.
+	ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{ClientID: "mobile", ClientCert: &domain.ClientCertificate{Thumbprint: "x5t"}})
+	authRepo.On("FindUserByEmail", mock.Anything, email).Return(u, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "mobile").Return(accessToken, nil).Once()
+	tokenService.On("GenerateRefreshToken").Return(refreshToken, nil).Once()
+	tokenService.On("HashRefreshToken", refreshToken, "mobile").Return(refreshTokenHash, expiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, refreshTokenHash, expiresAt, mock.Anything, false, "", "mobile", "x5t").Return(nil).Once()
+
+	_, err = authService.Login(ctx, email, password, false)
+	assert.NoError(t, err)
+
+	_, err = authService.Login(context.Background(), "invalid email", password, false)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrWrongEmailOrPassword)
 
-	_, err = authService.Login(context.Background(), email, "")
+	_, err = authService.Login(context.Background(), email, "", false)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrWrongEmailOrPassword)
 
@@ -101,24 +130,59 @@ func TestAuthRepository_Logout(t *testing.T) {
 	authRepo := &mocks.AuthRepositoryMock{}
 	tokenRepo := &mocks.TokenRepositoryMock{}
 	tokenService := &mocks.TokenServiceMock{}
-	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService)
+	revocationService := &mocks.RevocationServiceMock{}
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService, revocationService, newAuditServiceMock(), outboxRepo, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
 
 	refreshToken := "refresh-token"
 	hash := usecase.HashRefreshTokenFunc(refreshToken)
 
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(nil, nil).Once()
+	ref := &domain.RefreshToken{
+		UserID:       uuid.New(),
+		RefreshToken: hash,
+		ExpiresAt:    time.Now().UTC().Add(time.Hour * 24),
+	}
+
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, ref.UserID, domain.EventSessionRevoked, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.SessionRevokedPayload
+		return json.Unmarshal(payload, &p) == nil && p.UserID == ref.UserID && p.Reason == domain.RevokeReasonLogout
+	})).Return(nil).Once()
+
+	err := authService.Logout(context.Background(), refreshToken, "")
+	assert.NoError(t, err)
+
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(nil, repository.ErrNoRowDeleted).Once()
+
+	err = authService.Logout(context.Background(), refreshToken, "")
+	assert.NoError(t, err)
+
+	// A valid access token sent along is revoked, an expired one is ignored.
+	claims := &usecase.AccessClaims{RegisteredClaims: jwt.RegisteredClaims{ID: uuid.NewString(), Subject: ref.UserID.String()}}
+	tokenService.On("ValidateAccessToken", "access-token").Return(claims, nil).Once()
+	revocationService.On("RevokeAccessToken", mock.Anything, &claims.RegisteredClaims).Return(nil).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(nil, repository.ErrNoRowDeleted).Once()
+
+	err = authService.Logout(context.Background(), refreshToken, "access-token")
+	assert.NoError(t, err)
+
+	tokenService.On("ValidateAccessToken", "expired-token").Return(nil, domain.ErrExpiredAccessToken).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(nil, repository.ErrNoRowDeleted).Once()
 
-	err := authService.Logout(context.Background(), refreshToken)
+	err = authService.Logout(context.Background(), refreshToken, "expired-token")
 	assert.NoError(t, err)
 
 	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	revocationService.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
 }
 
 func TestAuthRepository_UserInfo(t *testing.T) {
 	authRepo := &mocks.AuthRepositoryMock{}
 	tokenRepo := &mocks.TokenRepositoryMock{}
 	tokenService := &mocks.TokenServiceMock{}
-	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService)
+	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, newAuditServiceMock(), &mocks.OutboxRepositoryMock{}, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
 
 	userID := uuid.New()
 	u := &domain.User{
@@ -148,7 +212,7 @@ func TestAuthRepository_RefreshTokens(t *testing.T) {
 	authRepo := &mocks.AuthRepositoryMock{}
 	tokenRepo := &mocks.TokenRepositoryMock{}
 	tokenService := &mocks.TokenServiceMock{}
-	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService)
+	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, newAuditServiceMock(), &mocks.OutboxRepositoryMock{}, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
 
 	refreshToken := "refresh-token"
 	hash := usecase.HashRefreshTokenFunc(refreshToken)
@@ -160,9 +224,11 @@ func TestAuthRepository_RefreshTokens(t *testing.T) {
 	newExpiresAt := time.Now().UTC().Add(time.Hour * 24 * 7)
 
 	ref := &domain.RefreshToken{
-		UserID:       uuid.New(),
-		RefreshToken: hash,
-		ExpiresAt:    expiresAt,
+		UserID:           uuid.New(),
+		RefreshToken:     hash,
+		CreatedAt:        time.Now().UTC().Add(-time.Hour),
+		ExpiresAt:        expiresAt,
+		SessionStartedAt: time.Now().UTC().Add(-time.Hour * 24),
 	}
 
 	tokens := &domain.Tokens{
@@ -171,11 +237,12 @@ func TestAuthRepository_RefreshTokens(t *testing.T) {
 		RefreshTokenExpiresAt: newExpiresAt,
 	}
 
+	tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
 	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
-	tokenService.On("GenerateAccessToken", ref.UserID).Return(accessToken, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, ref.UserID, "").Return(accessToken, nil).Once()
 	tokenService.On("GenerateRefreshToken").Return(newRefreshToken, nil).Once()
-	tokenService.On("HashRefreshToken", newRefreshToken).Return(newHash, newExpiresAt).Once()
-	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, ref.UserID, newHash, newExpiresAt).Return(nil).Once()
+	tokenService.On("HashRefreshToken", newRefreshToken, "").Return(newHash, newExpiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, ref.UserID, newHash, newExpiresAt, ref.SessionStartedAt, false, "", "", "").Return(nil).Once()
 
 	res, err := authService.RefreshTokens(context.Background(), refreshToken)
 	assert.NoError(t, err)
@@ -185,16 +252,401 @@ func TestAuthRepository_RefreshTokens(t *testing.T) {
 	_, err = authService.RefreshTokens(context.Background(), "")
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrEmptyRefreshToken)
-	
+
 	fakeToken := "fake-token"
 	fakeHash := usecase.HashRefreshTokenFunc(fakeToken)
-	tokenRepo.On("DeleteRefreshToken", mock.Anything, fakeHash).Return(nil, repository.ErrNoRowDeleted).Once()
+	tokenRepo.On("FindRefreshToken", mock.Anything, fakeHash).Return(nil, repository.ErrNotFound).Once()
 
 	_, err = authService.RefreshTokens(context.Background(), fakeToken)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrInvalidOrExpiredRefreshToken)
 
+	// A token consumed by a concurrent refresh after it was found is not used twice.
+	tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(nil, repository.ErrNoRowDeleted).Once()
+
+	_, err = authService.RefreshTokens(context.Background(), refreshToken)
+	assert.ErrorIs(t, err, domain.ErrInvalidOrExpiredRefreshToken)
+
 	tokenRepo.AssertExpectations(t)
 	tokenService.AssertExpectations(t)
 
 }
+
+func TestAuthRepository_RefreshTokensDPoP(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	authService := usecase.NewAuthService(&mocks.AuthRepositoryMock{}, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, auditService, &mocks.OutboxRepositoryMock{}, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
+
+	refreshToken := "refresh-token"
+	hash := usecase.HashRefreshTokenFunc(refreshToken)
+	ref := &domain.RefreshToken{
+		UserID:           uuid.New(),
+		RefreshToken:     hash,
+		ExpiresAt:        time.Now().UTC().Add(time.Hour),
+		SessionStartedAt: time.Now().UTC().Add(-time.Hour),
+		DPoPJKT:          "jkt",
+	}
+	newExpiresAt := time.Now().UTC().Add(time.Hour * 24)
+
+	// A bound refresh token is only accepted with a proof of its key, and the new one
+	// is bound to the same key.
+	ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{DPoPJKT: "jkt"})
+	tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, ref.UserID, "").Return("access-token", nil).Once()
+	tokenService.On("GenerateRefreshToken").Return("new-refresh-token", nil).Once()
+	tokenService.On("HashRefreshToken", "new-refresh-token", "").Return("new-hash", newExpiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, ref.UserID, "new-hash", newExpiresAt, ref.SessionStartedAt, false, "jkt", "", "").Return(nil).Once()
+	auditService.On("Record", mock.Anything, mock.Anything).Return().Once()
+
+	_, err := authService.RefreshTokens(ctx, refreshToken)
+	assert.NoError(t, err)
+
+	// A mismatch leaves the token to its holder instead of consuming it.
+	for _, jkt := range []string{"", "other-jkt"} {
+		ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{DPoPJKT: jkt})
+		tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventRefresh && e.Outcome == domain.OutcomeFailure && e.Reason == domain.ReasonBindingMismatch
+		})).Return().Once()
+
+		_, err = authService.RefreshTokens(ctx, refreshToken)
+		assert.ErrorIs(t, err, domain.ErrInvalidOrExpiredRefreshToken)
+	}
+	tokenRepo.AssertNumberOfCalls(t, "DeleteRefreshToken", 1)
+
+	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
+
+func TestAuthRepository_RefreshTokensCertificate(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	authService := usecase.NewAuthService(&mocks.AuthRepositoryMock{}, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, auditService, &mocks.OutboxRepositoryMock{}, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
+
+	refreshToken := "refresh-token"
+	hash := usecase.HashRefreshTokenFunc(refreshToken)
+	ref := &domain.RefreshToken{
+		UserID:           uuid.New(),
+		RefreshToken:     hash,
+		ExpiresAt:        time.Now().UTC().Add(time.Hour),
+		SessionStartedAt: time.Now().UTC().Add(-time.Hour),
+		X5T:              "x5t",
+	}
+	newExpiresAt := time.Now().UTC().Add(time.Hour * 24)
+
+	// A bound refresh token is only accepted over a connection with its certificate,
+	// and the new one is bound to the same certificate.
+	ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{ClientCert: &domain.ClientCertificate{Thumbprint: "x5t"}})
+	tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, ref.UserID, "").Return("access-token", nil).Once()
+	tokenService.On("GenerateRefreshToken").Return("new-refresh-token", nil).Once()
+	tokenService.On("HashRefreshToken", "new-refresh-token", "").Return("new-hash", newExpiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, ref.UserID, "new-hash", newExpiresAt, ref.SessionStartedAt, false, "", "", "x5t").Return(nil).Once()
+	auditService.On("Record", mock.Anything, mock.Anything).Return().Once()
+
+	_, err := authService.RefreshTokens(ctx, refreshToken)
+	assert.NoError(t, err)
+
+	for _, cert := range []*domain.ClientCertificate{nil, {Thumbprint: "other-x5t"}} {
+		ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{ClientCert: cert})
+		tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventRefresh && e.Outcome == domain.OutcomeFailure && e.Reason == domain.ReasonBindingMismatch
+		})).Return().Once()
+
+		_, err = authService.RefreshTokens(ctx, refreshToken)
+		assert.ErrorIs(t, err, domain.ErrInvalidOrExpiredRefreshToken)
+	}
+	tokenRepo.AssertNumberOfCalls(t, "DeleteRefreshToken", 1)
+
+	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
+
+func TestAuthRepository_RefreshTokensClient(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	authService := usecase.NewAuthService(&mocks.AuthRepositoryMock{}, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, auditService, &mocks.OutboxRepositoryMock{}, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
+
+	refreshToken := "refresh-token"
+	hash := usecase.HashRefreshTokenFunc(refreshToken)
+	ref := &domain.RefreshToken{
+		UserID:           uuid.New(),
+		RefreshToken:     hash,
+		ExpiresAt:        time.Now().UTC().Add(time.Hour),
+		SessionStartedAt: time.Now().UTC().Add(-time.Hour),
+		ClientID:         "orders",
+	}
+	newExpiresAt := time.Now().UTC().Add(time.Hour * 24)
+
+	// The options of the client the session was started by apply once it
+	// authenticates again, and the new refresh token keeps the client.
+	ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{ClientID: "orders"})
+	tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, ref.UserID, "orders").Return("access-token", nil).Once()
+	tokenService.On("GenerateRefreshToken").Return("new-refresh-token", nil).Once()
+	tokenService.On("HashRefreshToken", "new-refresh-token", "orders").Return("new-hash", newExpiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, ref.UserID, "new-hash", newExpiresAt, ref.SessionStartedAt, false, "", "orders", "").Return(nil).Once()
+	auditService.On("Record", mock.Anything, mock.Anything).Return().Once()
+
+	_, err := authService.RefreshTokens(ctx, refreshToken)
+	assert.NoError(t, err)
+
+	for _, clientID := range []string{"", "billing"} {
+		ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{ClientID: clientID})
+		tokenRepo.On("FindRefreshToken", mock.Anything, hash).Return(ref, nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventRefresh && e.Outcome == domain.OutcomeFailure && e.Reason == domain.ReasonClientMismatch
+		})).Return().Once()
+
+		_, err = authService.RefreshTokens(ctx, refreshToken)
+		assert.ErrorIs(t, err, domain.ErrInvalidOrExpiredRefreshToken)
+	}
+	tokenRepo.AssertNumberOfCalls(t, "DeleteRefreshToken", 1)
+
+	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
+
+func TestAuthRepository_SessionLifetimes(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, auditService, &mocks.OutboxRepositoryMock{}, newTransactorMock(), slog.Default(), usecase.SessionOptions{
+		Default:    usecase.SessionPolicy{AbsoluteTTL: time.Hour * 24, IdleTTL: time.Hour},
+		RememberMe: usecase.SessionPolicy{AbsoluteTTL: time.Hour * 24 * 30, IdleTTL: time.Hour * 24 * 7},
+	})
+	auditService.On("Record", mock.Anything, mock.Anything).Return()
+
+	email := "user@example.org"
+	hash, _ := usecase.HashPassword(context.Background(), "password")
+	u := &domain.UserWithPassword{
+		UserID:       uuid.New(),
+		Email:        email,
+		PasswordHash: hash,
+		IsActive:     true,
+	}
+	tokenExpiresAt := time.Now().Add(time.Hour * 24 * 3)
+
+	within := func(want time.Time) any {
+		return mock.MatchedBy(func(got time.Time) bool {
+			return got.Sub(want).Abs() < time.Minute
+		})
+	}
+
+	// Remember me selects the longer idle lifetime for the refresh token.
+	authRepo.On("FindUserByEmail", mock.Anything, email).Return(u, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "").Return("access-token", nil).Once()
+	tokenService.On("GenerateRefreshToken").Return("refresh-token", nil).Once()
+	tokenService.On("HashRefreshToken", "refresh-token", "").Return("refresh-hash", tokenExpiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, "refresh-hash", within(time.Now().Add(time.Hour*24*7)), within(time.Now()), true, "", "", "").Return(nil).Once()
+
+	res, err := authService.Login(context.Background(), email, "password", true)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour*24*7), res.RefreshTokenExpiresAt, time.Minute)
+
+	// A refresh never extends the session beyond its absolute lifetime.
+	startedAt := time.Now().Add(-time.Hour * 23)
+	ref := &domain.RefreshToken{
+		UserID:           u.UserID,
+		CreatedAt:        time.Now().Add(-time.Minute * 10),
+		ExpiresAt:        time.Now().Add(time.Minute * 50),
+		SessionStartedAt: startedAt,
+	}
+	tokenRepo.On("FindRefreshToken", mock.Anything, usecase.HashRefreshTokenFunc("active")).Return(ref, nil).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, usecase.HashRefreshTokenFunc("active")).Return(ref, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "").Return("access-token", nil).Once()
+	tokenService.On("GenerateRefreshToken").Return("refresh-token", nil).Once()
+	tokenService.On("HashRefreshToken", "refresh-token", "").Return("refresh-hash", tokenExpiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, "refresh-hash", startedAt.Add(time.Hour*24), startedAt, false, "", "", "").Return(nil).Once()
+
+	res, err = authService.RefreshTokens(context.Background(), "active")
+	assert.NoError(t, err)
+	assert.Equal(t, startedAt.Add(time.Hour*24), res.RefreshTokenExpiresAt)
+
+	// Limits are checked on refresh as well, so tightened policies apply to
+	// tokens issued before the change.
+	tests := []struct {
+		name  string
+		token *domain.RefreshToken
+	}{
+		{
+			name: "absolute lifetime exceeded",
+			token: &domain.RefreshToken{
+				UserID:           u.UserID,
+				CreatedAt:        time.Now().Add(-time.Minute),
+				ExpiresAt:        time.Now().Add(time.Hour),
+				SessionStartedAt: time.Now().Add(-time.Hour * 25),
+			},
+		},
+		{
+			name: "idle lifetime exceeded",
+			token: &domain.RefreshToken{
+				UserID:           u.UserID,
+				CreatedAt:        time.Now().Add(-time.Hour * 2),
+				ExpiresAt:        time.Now().Add(time.Hour),
+				SessionStartedAt: time.Now().Add(-time.Hour * 2),
+			},
+		},
+		{
+			name: "remember me idle lifetime exceeded",
+			token: &domain.RefreshToken{
+				UserID:           u.UserID,
+				CreatedAt:        time.Now().Add(-time.Hour * 24 * 8),
+				ExpiresAt:        time.Now().Add(time.Hour),
+				SessionStartedAt: time.Now().Add(-time.Hour * 24 * 8),
+				RememberMe:       true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenRepo.On("FindRefreshToken", mock.Anything, usecase.HashRefreshTokenFunc(tc.name)).Return(tc.token, nil).Once()
+			tokenRepo.On("DeleteRefreshToken", mock.Anything, usecase.HashRefreshTokenFunc(tc.name)).Return(tc.token, nil).Once()
+
+			_, err := authService.RefreshTokens(context.Background(), tc.name)
+			assert.ErrorIs(t, err, domain.ErrInvalidOrExpiredRefreshToken)
+		})
+	}
+
+	// The same idle time is fine for a remembered session.
+	ref = &domain.RefreshToken{
+		UserID:           u.UserID,
+		CreatedAt:        time.Now().Add(-time.Hour * 2),
+		ExpiresAt:        time.Now().Add(time.Hour),
+		SessionStartedAt: time.Now().Add(-time.Hour * 2),
+		RememberMe:       true,
+	}
+	tokenRepo.On("FindRefreshToken", mock.Anything, usecase.HashRefreshTokenFunc("remembered")).Return(ref, nil).Once()
+	tokenRepo.On("DeleteRefreshToken", mock.Anything, usecase.HashRefreshTokenFunc("remembered")).Return(ref, nil).Once()
+	tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "").Return("access-token", nil).Once()
+	tokenService.On("GenerateRefreshToken").Return("refresh-token", nil).Once()
+	tokenService.On("HashRefreshToken", "refresh-token", "").Return("refresh-hash", tokenExpiresAt).Once()
+	tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, "refresh-hash", mock.Anything, ref.SessionStartedAt, true, "", "", "").Return(nil).Once()
+
+	_, err = authService.RefreshTokens(context.Background(), "remembered")
+	assert.NoError(t, err)
+
+	authRepo.AssertExpectations(t)
+	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+}
+
+func TestAuthRepository_LoginRecordsAuthEvents(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	authService := usecase.NewAuthService(authRepo, tokenRepo, tokenService, &mocks.RevocationServiceMock{}, auditService, outboxRepo, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
+
+	email := "user@example.org"
+	hash, _ := usecase.HashPassword(context.Background(), "password")
+
+	u := &domain.UserWithPassword{
+		UserID:       uuid.New(),
+		Email:        email,
+		PasswordHash: hash,
+	}
+
+	authRepo.On("FindUserByEmail", mock.Anything, email).Return(u, nil).Once()
+	auditService.On("Record", mock.Anything, domain.AuthEvent{
+		EventType: domain.EventLogin,
+		UserID:    u.UserID,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    domain.ReasonWrongPassword,
+	}).Return().Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventUserLoginFailed, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.LoginFailedPayload
+		return json.Unmarshal(payload, &p) == nil && p.UserID != nil && *p.UserID == u.UserID && p.Reason == domain.ReasonWrongPassword
+	})).Return(nil).Once()
+
+	wrongPassword := metrics.LoginsTotal.WithLabelValues(metrics.ResultFailure, domain.ReasonWrongPassword)
+	before := testutil.ToFloat64(wrongPassword)
+
+	_, err := authService.Login(context.Background(), email, "wrong-password", false)
+	assert.ErrorIs(t, err, domain.ErrWrongEmailOrPassword)
+	assert.Equal(t, before+1, testutil.ToFloat64(wrongPassword))
+
+	authRepo.On("FindUserByEmail", mock.Anything, "unknown@example.org").Return(nil, repository.ErrNotFound).Once()
+	auditService.On("Record", mock.Anything, domain.AuthEvent{
+		EventType: domain.EventLogin,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    domain.ReasonUserNotFound,
+	}).Return().Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, uuid.Nil, domain.EventUserLoginFailed, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.LoginFailedPayload
+		return json.Unmarshal(payload, &p) == nil && p.UserID == nil && p.Email == "unknown@example.org"
+	})).Return(nil).Once()
+
+	_, err = authService.Login(context.Background(), "unknown@example.org", "password", false)
+	assert.ErrorIs(t, err, domain.ErrWrongEmailOrPassword)
+
+	// The event is best effort: the caller learns about the credentials only.
+	authRepo.On("FindUserByEmail", mock.Anything, "unknown@example.org").Return(nil, repository.ErrNotFound).Once()
+	auditService.On("Record", mock.Anything, mock.Anything).Return().Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, uuid.Nil, domain.EventUserLoginFailed, mock.Anything).Return(repository.ErrGatewayTimeout).Once()
+
+	_, err = authService.Login(context.Background(), "unknown@example.org", "password", false)
+	assert.ErrorIs(t, err, domain.ErrWrongEmailOrPassword)
+
+	authRepo.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
+}
+
+func newAuditServiceMock() *mocks.AuditServiceMock {
+	auditService := &mocks.AuditServiceMock{}
+	auditService.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+	return auditService
+}
+
+func newTransactorMock() *mocks.TransactorMock {
+	transactor := &mocks.TransactorMock{}
+	transactor.EXPECT().WithinTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	return transactor
+}
+
+func TestAuthRepository_LoginInactiveUser(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	authService := usecase.NewAuthService(authRepo, &mocks.TokenRepositoryMock{}, tokenService, &mocks.RevocationServiceMock{}, newAuditServiceMock(), outboxRepo, newTransactorMock(), slog.Default(), usecase.SessionOptions{})
+
+	email := "user@example.org"
+	hash, _ := usecase.HashPassword(context.Background(), "password")
+
+	u := &domain.UserWithPassword{
+		UserID:       uuid.New(),
+		Email:        email,
+		PasswordHash: hash,
+		IsActive:     false,
+	}
+
+	authRepo.On("FindUserByEmail", mock.Anything, email).Return(u, nil).Once()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, u.UserID, domain.EventUserLoginFailed, mock.MatchedBy(func(payload []byte) bool {
+		var p domain.LoginFailedPayload
+		return json.Unmarshal(payload, &p) == nil && p.Reason == domain.ReasonUserInactive
+	})).Return(nil).Once()
+
+	_, err := authService.Login(context.Background(), email, "password", false)
+	assert.ErrorIs(t, err, domain.ErrWrongEmailOrPassword)
+
+	authRepo.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
+	tokenService.AssertNotCalled(t, "GenerateAccessToken", mock.Anything, mock.Anything, mock.Anything)
+}
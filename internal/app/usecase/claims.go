@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	defaultMaxClaimsBytes = 1024
+	maxClaimNameLen       = 64
+)
+
+// reservedClaims are set by the token service itself. A provider returning one of
+// them fails the token instead of overriding it.
+var reservedClaims = []string{"iss", "sub", "aud", "exp", "nbf", "iat", "jti", "act", "scope", "cnf"}
+
+// ClaimsProvider adds custom claims to access tokens, so downstream services can read
+// data like a plan tier or feature flags without calling back. Providers are asked
+// every time an access token is issued; an error fails the login or refresh.
+type ClaimsProvider interface {
+	Name() string
+	Claims(ctx context.Context, userID uuid.UUID, clientID string) (map[string]any, error)
+}
+
+// validateClaimName rejects names a custom claim can't have.
+func validateClaimName(name string) error {
+	if name == "" || len(name) > maxClaimNameLen {
+		return fmt.Errorf("%w: name must be 1 to %d bytes long", domain.ErrInvalidClaim, maxClaimNameLen)
+	}
+	if slices.Contains(reservedClaims, name) {
+		return fmt.Errorf("%w: %q is reserved", domain.ErrInvalidClaim, name)
+	}
+	return nil
+}
+
+// validateCustomClaims checks the merged claims of all providers. maxBytes limits
+// their JSON encoding, which ends up in every request carrying the token.
+func validateCustomClaims(claims map[string]any, maxBytes int) error {
+	for name := range claims {
+		if err := validateClaimName(name); err != nil {
+			return err
+		}
+	}
+
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidClaim, err)
+	}
+	if len(b) > maxBytes {
+		return fmt.Errorf("%w: %d bytes exceed the limit of %d", domain.ErrClaimsTooLarge, len(b), maxBytes)
+	}
+
+	return nil
+}
+
+type attributeClaimsProvider struct {
+	attrRepo repository.UserAttributeRepository
+}
+
+// NewAttributeClaimsProvider returns a provider that puts every attribute of the user
+// into the token, named after the attribute.
+func NewAttributeClaimsProvider(attrRepo repository.UserAttributeRepository) ClaimsProvider {
+	return &attributeClaimsProvider{
+		attrRepo: attrRepo,
+	}
+}
+
+func (p *attributeClaimsProvider) Name() string {
+	return "attributes"
+}
+
+func (p *attributeClaimsProvider) Claims(ctx context.Context, userID uuid.UUID, clientID string) (map[string]any, error) {
+	attrs, err := p.attrRepo.ListUserAttributes(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list user attributes: %w", err)
+		}
+	}
+
+	claims := make(map[string]any, len(attrs))
+	for _, a := range attrs {
+		claims[a.Name] = a.Value
+	}
+
+	return claims, nil
+}
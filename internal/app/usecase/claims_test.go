@@ -0,0 +1,44 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestAttributeClaimsProvider(t *testing.T) {
+	attrRepo := &mocks.UserAttributeRepositoryMock{}
+	provider := usecase.NewAttributeClaimsProvider(attrRepo)
+
+	userID := uuid.New()
+	attrs := []domain.UserAttribute{
+		{Name: "plan", Value: json.RawMessage(`"pro"`), UpdatedAt: time.Now()},
+		{Name: "org_ids", Value: json.RawMessage(`["org-1","org-2"]`), UpdatedAt: time.Now()},
+	}
+
+	attrRepo.On("ListUserAttributes", mock.Anything, userID).Return(attrs, nil).Once()
+
+	claims, err := provider.Claims(context.Background(), userID, "")
+	assert.NoError(t, err)
+	assert.Len(t, claims, 2)
+
+	b, err := json.Marshal(claims)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"plan":"pro","org_ids":["org-1","org-2"]}`, string(b))
+
+	attrRepo.On("ListUserAttributes", mock.Anything, userID).Return(nil, repository.ErrGatewayTimeout).Once()
+
+	_, err = provider.Claims(context.Background(), userID, "")
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	attrRepo.AssertExpectations(t)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// ClientAuthService authenticates OAuth clients by a secret or by the TLS client
+// certificate of the request (RFC 8705). Clients without registered credentials are
+// public clients and cannot be authenticated.
+type ClientAuthService interface {
+	// AuthenticateClient checks the credentials of clientID and reports whether the
+	// client was authenticated, which is false for a public client. It fails with
+	// domain.ErrInvalidClient if clientID has credentials and the request did not carry
+	// them, or if a secret is sent for a client without one.
+	AuthenticateClient(ctx context.Context, clientID string, clientSecret string) (bool, error)
+}
+
+// ClientAuthOptions sets the clients that authenticate, by client id.
+type ClientAuthOptions struct {
+	Clients map[string]ClientCredentials
+}
+
+// ClientCredentials sets how a client authenticates: by a secret, given as the hex
+// encoded SHA-256 hash of it, or by a certificate named by exactly one of its subject
+// DN, a DNS name or a URI of its subject alternative names. The certificate itself is
+// verified against the CA of the server before.
+type ClientCredentials struct {
+	SecretHash string
+	SubjectDN  string
+	DNSName    string
+	URI        string
+}
+
+type clientAuthService struct {
+	opts ClientAuthOptions
+}
+
+func NewClientAuthService(opts ClientAuthOptions) ClientAuthService {
+	return &clientAuthService{
+		opts: opts,
+	}
+}
+
+func (s *clientAuthService) AuthenticateClient(ctx context.Context, clientID string, clientSecret string) (bool, error) {
+	_, span := tracer.Start(ctx, "ClientAuthService.AuthenticateClient")
+	defer span.End()
+
+	creds, ok := s.opts.Clients[clientID]
+	if !ok {
+		if clientSecret != "" {
+			return false, domain.ErrInvalidClient
+		}
+		return false, nil
+	}
+
+	if creds.SecretHash != "" {
+		if subtle.ConstantTimeCompare([]byte(HashRefreshTokenFunc(clientSecret)), []byte(creds.SecretHash)) != 1 {
+			return false, domain.ErrInvalidClient
+		}
+		return true, nil
+	}
+
+	cert := domain.RequestMetaFromContext(ctx).ClientCert
+	if cert == nil || !creds.matches(cert) {
+		return false, domain.ErrInvalidClient
+	}
+
+	return true, nil
+}
+
+func (c ClientCredentials) matches(cert *domain.ClientCertificate) bool {
+	switch {
+	case c.SubjectDN != "":
+		return cert.SubjectDN == c.SubjectDN
+	case c.DNSName != "":
+		return slices.Contains(cert.DNSNames, c.DNSName)
+	case c.URI != "":
+		return slices.Contains(cert.URIs, c.URI)
+	default:
+		return false
+	}
+}
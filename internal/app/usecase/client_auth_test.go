@@ -0,0 +1,60 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+)
+
+func TestClientAuthService_AuthenticateClient(t *testing.T) {
+	service := usecase.NewClientAuthService(usecase.ClientAuthOptions{
+		Clients: map[string]usecase.ClientCredentials{
+			"orders":   {SubjectDN: "CN=orders,O=Example"},
+			"billing":  {DNSName: "billing.example.org"},
+			"shipping": {URI: "spiffe://example.org/shipping"},
+			"reports":  {SecretHash: usecase.HashRefreshTokenFunc("reports-secret")},
+		},
+	})
+
+	withCert := func(cert *domain.ClientCertificate) context.Context {
+		return domain.WithRequestMeta(context.Background(), domain.RequestMeta{ClientCert: cert})
+	}
+
+	testCases := []struct {
+		name          string
+		clientID      string
+		secret        string
+		cert          *domain.ClientCertificate
+		authenticated bool
+		wantErr       error
+	}{
+		{"public client", "cli", "", nil, false, nil},
+		{"secret of a public client", "cli", "secret", nil, false, domain.ErrInvalidClient},
+		{"subject dn", "orders", "", &domain.ClientCertificate{SubjectDN: "CN=orders,O=Example"}, true, nil},
+		{"dns name", "billing", "", &domain.ClientCertificate{SubjectDN: "CN=billing", DNSNames: []string{"billing.internal", "billing.example.org"}}, true, nil},
+		{"uri", "shipping", "", &domain.ClientCertificate{URIs: []string{"spiffe://example.org/shipping"}}, true, nil},
+		{"secret", "reports", "reports-secret", nil, true, nil},
+		{"no certificate", "orders", "", nil, false, domain.ErrInvalidClient},
+		{"other subject dn", "orders", "", &domain.ClientCertificate{SubjectDN: "CN=billing,O=Example"}, false, domain.ErrInvalidClient},
+		{"other dns name", "billing", "", &domain.ClientCertificate{SubjectDN: "CN=billing.example.org"}, false, domain.ErrInvalidClient},
+		{"certificate of another client", "shipping", "", &domain.ClientCertificate{SubjectDN: "CN=orders,O=Example"}, false, domain.ErrInvalidClient},
+		{"wrong secret", "reports", "other-secret", nil, false, domain.ErrInvalidClient},
+		{"no secret", "reports", "", nil, false, domain.ErrInvalidClient},
+		{"secret instead of certificate", "orders", "reports-secret", nil, false, domain.ErrInvalidClient},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authenticated, err := service.AuthenticateClient(withCert(tc.cert), tc.clientID, tc.secret)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.authenticated, authenticated)
+		})
+	}
+}
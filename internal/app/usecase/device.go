@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	defaultDeviceCodeTTL      = time.Minute * 10
+	defaultDevicePollInterval = time.Second * 5
+	// deviceSlowDownStep is added to the poll interval of a client that polls too
+	// often, as RFC 8628 asks.
+	deviceSlowDownStep = time.Second * 5
+)
+
+// userCodeAlphabet leaves out vowels, so that user codes do not spell words, and
+// characters that are easily confused.
+const (
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// DeviceService implements the device authorization grant (RFC 8628) for clients
+// without a browser, like CLIs and TVs. The client shows a short user code, which a
+// logged-in user enters on another device to approve the client, while the client
+// polls for its tokens with the device code.
+type DeviceService interface {
+	AuthorizeDevice(ctx context.Context, clientID string) (*domain.DeviceAuthorization, error)
+	// GetDeviceAuthorization returns the pending request of userCode, so that the user
+	// can check the client before deciding on it.
+	GetDeviceAuthorization(ctx context.Context, userCode string) (*domain.DeviceCode, error)
+	// VerifyDevice approves or denies the pending request of userCode for userID.
+	VerifyDevice(ctx context.Context, userID uuid.UUID, userCode string, approve bool) error
+	// PollDevice exchanges the device code of an approved request for tokens. Until a
+	// user decided on the request it returns ErrAuthorizationPending, or ErrSlowDown if
+	// the client polls more often than its interval allows.
+	PollDevice(ctx context.Context, clientID string, deviceCode string) (*domain.Tokens, error)
+}
+
+// DeviceOptions sets how long a request can be approved, how often a client may poll
+// and the page users enter user codes on.
+type DeviceOptions struct {
+	TTL             time.Duration
+	Interval        time.Duration
+	VerificationURL string
+}
+
+type deviceService struct {
+	authRepo       repository.AuthRepository
+	deviceCodeRepo repository.DeviceCodeRepository
+	tokenRepo      repository.TokenRepository
+	tokenService   TokenService
+	auditService   AuditService
+	sessionOptions SessionOptions
+	opts           DeviceOptions
+}
+
+func NewDeviceService(authRepo repository.AuthRepository, deviceCodeRepo repository.DeviceCodeRepository, tokenRepo repository.TokenRepository, tokenService TokenService, auditService AuditService, sessionOptions SessionOptions, opts DeviceOptions) DeviceService {
+	opts.TTL = cmp.Or(opts.TTL, defaultDeviceCodeTTL)
+	opts.Interval = cmp.Or(opts.Interval, defaultDevicePollInterval)
+
+	return &deviceService{
+		authRepo:       authRepo,
+		deviceCodeRepo: deviceCodeRepo,
+		tokenRepo:      tokenRepo,
+		tokenService:   tokenService,
+		auditService:   auditService,
+		sessionOptions: sessionOptions,
+		opts:           opts,
+	}
+}
+
+func (s *deviceService) AuthorizeDevice(ctx context.Context, clientID string) (*domain.DeviceAuthorization, error) {
+	ctx, span := tracer.Start(ctx, "DeviceService.AuthorizeDevice")
+	defer span.End()
+
+	if clientID == "" {
+		return nil, domain.ErrInvalidClient
+	}
+
+	deviceCode, err := generateLinkToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate device code: %w", err)
+	}
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, fmt.Errorf("generate user code: %w", err)
+	}
+
+	verificationURL, err := url.Parse(s.opts.VerificationURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse verification url %q: %w", s.opts.VerificationURL, err)
+	}
+	complete := *verificationURL
+	q := complete.Query()
+	q.Set("user_code", userCode)
+	complete.RawQuery = q.Encode()
+
+	expiresAt := time.Now().Add(s.opts.TTL)
+	err = s.deviceCodeRepo.SaveDeviceCode(ctx, HashRefreshTokenFunc(deviceCode), HashRefreshTokenFunc(normalizeUserCode(userCode)), clientID, s.opts.Interval, expiresAt)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("save device code: %w", err)
+		}
+	}
+
+	return &domain.DeviceAuthorization{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         verificationURL.String(),
+		VerificationURIComplete: complete.String(),
+		ExpiresAt:               expiresAt,
+		Interval:                s.opts.Interval,
+	}, nil
+}
+
+func (s *deviceService) GetDeviceAuthorization(ctx context.Context, userCode string) (*domain.DeviceCode, error) {
+	ctx, span := tracer.Start(ctx, "DeviceService.GetDeviceAuthorization")
+	defer span.End()
+
+	return s.pending(ctx, userCode)
+}
+
+func (s *deviceService) VerifyDevice(ctx context.Context, userID uuid.UUID, userCode string, approve bool) error {
+	ctx, span := tracer.Start(ctx, "DeviceService.VerifyDevice")
+	defer span.End()
+
+	d, err := s.pending(ctx, userCode)
+	if err != nil {
+		return err
+	}
+
+	status := domain.DeviceCodeApproved
+	if !approve {
+		status = domain.DeviceCodeDenied
+	}
+
+	if err := s.deviceCodeRepo.DecideDeviceCode(ctx, d.UserCodeHash, userID, status); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.ErrInvalidUserCode
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("decide device code: %w", err)
+		}
+	}
+
+	if !approve {
+		s.recordFailure(ctx, userID, domain.ReasonAccessDenied)
+	}
+
+	return nil
+}
+
+func (s *deviceService) PollDevice(ctx context.Context, clientID string, deviceCode string) (*domain.Tokens, error) {
+	ctx, span := tracer.Start(ctx, "DeviceService.PollDevice")
+	defer span.End()
+
+	if deviceCode == "" {
+		return nil, domain.ErrInvalidGrant
+	}
+	hash := HashRefreshTokenFunc(deviceCode)
+
+	d, err := s.deviceCodeRepo.GetDeviceCode(ctx, hash)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrInvalidGrant
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("get device code: %w", err)
+		}
+	}
+
+	// A device code only works for the client it was issued to.
+	if d.ClientID != clientID {
+		s.recordFailure(ctx, d.UserID, domain.ReasonClientMismatch)
+		return nil, domain.ErrInvalidGrant
+	}
+	if time.Now().After(d.ExpiresAt) {
+		if err := s.delete(ctx, hash); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrExpiredDeviceCode
+	}
+
+	switch d.Status {
+	case domain.DeviceCodePending:
+		return nil, s.poll(ctx, d)
+	case domain.DeviceCodeDenied:
+		if err := s.delete(ctx, hash); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrAccessDenied
+	}
+
+	// The code is deleted before the tokens are issued, so that concurrent polls
+	// cannot both get them.
+	if err := s.deviceCodeRepo.DeleteDeviceCode(ctx, hash); err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) {
+			return nil, domain.ErrInvalidGrant
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("delete device code: %w", err)
+		}
+	}
+
+	u, err := s.authRepo.GetUserInfo(ctx, d.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrInvalidGrant
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("get user info: %w", err)
+		}
+	}
+	if !u.IsActive {
+		s.recordFailure(ctx, u.UserID, domain.ReasonUserInactive)
+		return nil, domain.ErrInvalidGrant
+	}
+
+	// Tokens are issued to the client that asked for them, which may differ from the
+	// one named in the request metadata.
+	meta := domain.RequestMetaFromContext(ctx)
+	meta.ClientID = d.ClientID
+	tokens, err := startSession(domain.WithRequestMeta(ctx, meta), s.tokenRepo, s.tokenService, s.sessionOptions, u.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventDeviceCode,
+		UserID:    u.UserID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+
+	return tokens, nil
+}
+
+// pending returns the request of userCode if it can still be decided on.
+func (s *deviceService) pending(ctx context.Context, userCode string) (*domain.DeviceCode, error) {
+	userCode = normalizeUserCode(userCode)
+	if len(userCode) != userCodeLength {
+		return nil, domain.ErrInvalidUserCode
+	}
+
+	d, err := s.deviceCodeRepo.GetDeviceCodeByUserCode(ctx, HashRefreshTokenFunc(userCode))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrInvalidUserCode
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("get device code by user code: %w", err)
+		}
+	}
+	if d.Status != domain.DeviceCodePending || time.Now().After(d.ExpiresAt) {
+		return nil, domain.ErrInvalidUserCode
+	}
+
+	return d, nil
+}
+
+// poll records a poll of a pending request and returns the error to report to the
+// client.
+func (s *deviceService) poll(ctx context.Context, d *domain.DeviceCode) error {
+	ok, err := s.deviceCodeRepo.PollDeviceCode(ctx, d.DeviceCodeHash, time.Now(), d.Interval)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("poll device code: %w", err)
+		}
+	}
+	if ok {
+		return domain.ErrAuthorizationPending
+	}
+
+	if err := s.deviceCodeRepo.SlowDownDeviceCode(ctx, d.DeviceCodeHash, deviceSlowDownStep); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("slow down device code: %w", err)
+		}
+	}
+
+	return domain.ErrSlowDown
+}
+
+// delete deletes a request that can no longer be completed.
+func (s *deviceService) delete(ctx context.Context, deviceCodeHash string) error {
+	err := s.deviceCodeRepo.DeleteDeviceCode(ctx, deviceCodeHash)
+	if err != nil && !errors.Is(err, repository.ErrNoRowDeleted) {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("delete device code: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *deviceService) recordFailure(ctx context.Context, userID uuid.UUID, reason string) {
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventDeviceCode,
+		UserID:    userID,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    reason,
+	})
+}
+
+// generateUserCode returns a random user code, formatted as two groups of four
+// characters for display.
+func generateUserCode() (string, error) {
+	var b strings.Builder
+
+	max := big.NewInt(int64(len(userCodeAlphabet)))
+	for i := range userCodeLength {
+		if i == userCodeLength/2 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(userCodeAlphabet[n.Int64()])
+	}
+
+	return b.String(), nil
+}
+
+// normalizeUserCode drops everything but the characters of user codes, like the
+// dash or spaces users type along, and upper-cases the rest.
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToUpper(r)
+		if !strings.ContainsRune(userCodeAlphabet, r) {
+			return -1
+		}
+		return r
+	}, userCode)
+}
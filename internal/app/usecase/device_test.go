@@ -0,0 +1,239 @@
+package usecase_test
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+var userCodeRe = regexp.MustCompile(`^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$`)
+
+var deviceOptions = usecase.DeviceOptions{
+	TTL:             time.Minute * 10,
+	Interval:        time.Second * 5,
+	VerificationURL: "http://localhost:3000/device",
+}
+
+func TestDeviceService_AuthorizeDevice(t *testing.T) {
+	deviceCodeRepo := &mocks.DeviceCodeRepositoryMock{}
+	service := usecase.NewDeviceService(&mocks.AuthRepositoryMock{}, deviceCodeRepo, &mocks.TokenRepositoryMock{}, &mocks.TokenServiceMock{}, newAuditServiceMock(), usecase.SessionOptions{}, deviceOptions)
+
+	var deviceCodeHash, userCodeHash string
+	deviceCodeRepo.On("SaveDeviceCode", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), "cli", time.Second*5, mock.MatchedBy(func(expiresAt time.Time) bool {
+		return time.Until(expiresAt) > time.Minute*9 && time.Until(expiresAt) <= time.Minute*10
+	})).Run(func(args mock.Arguments) {
+		deviceCodeHash = args.String(1)
+		userCodeHash = args.String(2)
+	}).Return(nil).Once()
+
+	auth, err := service.AuthorizeDevice(context.Background(), "cli")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Regexp(t, userCodeRe, auth.UserCode)
+	assert.Equal(t, usecase.HashRefreshTokenFunc(auth.DeviceCode), deviceCodeHash, "only the hash is stored")
+	assert.Equal(t, usecase.HashRefreshTokenFunc(auth.UserCode[:4]+auth.UserCode[5:]), userCodeHash, "the user code is stored without the dash")
+	assert.Equal(t, "http://localhost:3000/device", auth.VerificationURI)
+	complete, err := url.Parse(auth.VerificationURIComplete)
+	if assert.NoError(t, err) {
+		assert.Equal(t, auth.UserCode, complete.Query().Get("user_code"))
+	}
+	assert.Equal(t, time.Second*5, auth.Interval)
+
+	_, err = service.AuthorizeDevice(context.Background(), "")
+	assert.ErrorIs(t, err, domain.ErrInvalidClient)
+
+	deviceCodeRepo.AssertExpectations(t)
+}
+
+func TestDeviceService_VerifyDevice(t *testing.T) {
+	deviceCodeRepo := &mocks.DeviceCodeRepositoryMock{}
+	auditService := &mocks.AuditServiceMock{}
+	service := usecase.NewDeviceService(&mocks.AuthRepositoryMock{}, deviceCodeRepo, &mocks.TokenRepositoryMock{}, &mocks.TokenServiceMock{}, auditService, usecase.SessionOptions{}, deviceOptions)
+
+	userID := uuid.New()
+	userCodeHash := usecase.HashRefreshTokenFunc("BCDFGHJK")
+	pending := &domain.DeviceCode{
+		UserCodeHash: userCodeHash,
+		ClientID:     "cli",
+		Status:       domain.DeviceCodePending,
+		ExpiresAt:    time.Now().Add(time.Minute),
+	}
+
+	t.Run("approves", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCodeByUserCode", mock.Anything, userCodeHash).Return(pending, nil).Once()
+		deviceCodeRepo.On("DecideDeviceCode", mock.Anything, userCodeHash, userID, domain.DeviceCodeApproved).Return(nil).Once()
+
+		err := service.VerifyDevice(context.Background(), userID, "bcdf-ghjk", true)
+		assert.NoError(t, err, "user codes are case-insensitive")
+	})
+
+	t.Run("denies", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCodeByUserCode", mock.Anything, userCodeHash).Return(pending, nil).Once()
+		deviceCodeRepo.On("DecideDeviceCode", mock.Anything, userCodeHash, userID, domain.DeviceCodeDenied).Return(nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventDeviceCode && e.Outcome == domain.OutcomeFailure && e.Reason == domain.ReasonAccessDenied
+		})).Once()
+
+		err := service.VerifyDevice(context.Background(), userID, "BCDF GHJK", false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("decided concurrently", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCodeByUserCode", mock.Anything, userCodeHash).Return(pending, nil).Once()
+		deviceCodeRepo.On("DecideDeviceCode", mock.Anything, userCodeHash, userID, domain.DeviceCodeApproved).Return(repository.ErrNotFound).Once()
+
+		err := service.VerifyDevice(context.Background(), userID, "BCDF-GHJK", true)
+		assert.ErrorIs(t, err, domain.ErrInvalidUserCode)
+	})
+
+	t.Run("invalid user code", func(t *testing.T) {
+		expired := *pending
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+		deviceCodeRepo.On("GetDeviceCodeByUserCode", mock.Anything, userCodeHash).Return(&expired, nil).Once()
+
+		err := service.VerifyDevice(context.Background(), userID, "BCDF-GHJK", true)
+		assert.ErrorIs(t, err, domain.ErrInvalidUserCode)
+
+		decided := *pending
+		decided.Status = domain.DeviceCodeApproved
+		deviceCodeRepo.On("GetDeviceCodeByUserCode", mock.Anything, userCodeHash).Return(&decided, nil).Once()
+
+		err = service.VerifyDevice(context.Background(), userID, "BCDF-GHJK", true)
+		assert.ErrorIs(t, err, domain.ErrInvalidUserCode)
+
+		deviceCodeRepo.On("GetDeviceCodeByUserCode", mock.Anything, mock.Anything).Return(nil, repository.ErrNotFound).Once()
+
+		err = service.VerifyDevice(context.Background(), userID, "ZZZZ-ZZZZ", true)
+		assert.ErrorIs(t, err, domain.ErrInvalidUserCode)
+
+		err = service.VerifyDevice(context.Background(), userID, "BCDF", true)
+		assert.ErrorIs(t, err, domain.ErrInvalidUserCode)
+	})
+
+	deviceCodeRepo.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
+
+func TestDeviceService_PollDevice(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	deviceCodeRepo := &mocks.DeviceCodeRepositoryMock{}
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	service := usecase.NewDeviceService(authRepo, deviceCodeRepo, tokenRepo, tokenService, auditService, usecase.SessionOptions{}, deviceOptions)
+
+	u := &domain.User{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	hash := usecase.HashRefreshTokenFunc("device-code")
+	device := func(status string, expiresIn time.Duration) *domain.DeviceCode {
+		d := &domain.DeviceCode{
+			DeviceCodeHash: hash,
+			ClientID:       "cli",
+			Status:         status,
+			Interval:       time.Second * 5,
+			ExpiresAt:      time.Now().Add(expiresIn),
+		}
+		if status == domain.DeviceCodeApproved {
+			d.UserID = u.UserID
+		}
+		return d
+	}
+
+	t.Run("authorization pending", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, hash).Return(device(domain.DeviceCodePending, time.Minute), nil).Once()
+		deviceCodeRepo.On("PollDeviceCode", mock.Anything, hash, mock.Anything, time.Second*5).Return(true, nil).Once()
+
+		_, err := service.PollDevice(context.Background(), "cli", "device-code")
+		assert.ErrorIs(t, err, domain.ErrAuthorizationPending)
+	})
+
+	t.Run("slow down", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, hash).Return(device(domain.DeviceCodePending, time.Minute), nil).Once()
+		deviceCodeRepo.On("PollDeviceCode", mock.Anything, hash, mock.Anything, time.Second*5).Return(false, nil).Once()
+		deviceCodeRepo.On("SlowDownDeviceCode", mock.Anything, hash, time.Second*5).Return(nil).Once()
+
+		_, err := service.PollDevice(context.Background(), "cli", "device-code")
+		assert.ErrorIs(t, err, domain.ErrSlowDown)
+	})
+
+	t.Run("issues tokens to the client", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour * 24)
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, hash).Return(device(domain.DeviceCodeApproved, time.Minute), nil).Once()
+		deviceCodeRepo.On("DeleteDeviceCode", mock.Anything, hash).Return(nil).Once()
+		authRepo.On("GetUserInfo", mock.Anything, u.UserID).Return(u, nil).Once()
+		tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "cli").Return("access-token", nil).Once()
+		tokenService.On("GenerateRefreshToken").Return("refresh-token", nil).Once()
+		tokenService.On("HashRefreshToken", "refresh-token", "cli").Return("refresh-token-hash", expiresAt).Once()
+		tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, "refresh-token-hash", expiresAt, mock.Anything, false, "", "cli", "").Return(nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventDeviceCode && e.Outcome == domain.OutcomeSuccess && e.UserID == u.UserID
+		})).Once()
+
+		tokens, err := service.PollDevice(context.Background(), "cli", "device-code")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "access-token", tokens.AccessToken)
+			assert.Equal(t, "refresh-token", tokens.RefreshToken)
+		}
+	})
+
+	t.Run("approved code used concurrently", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, hash).Return(device(domain.DeviceCodeApproved, time.Minute), nil).Once()
+		deviceCodeRepo.On("DeleteDeviceCode", mock.Anything, hash).Return(repository.ErrNoRowDeleted).Once()
+
+		_, err := service.PollDevice(context.Background(), "cli", "device-code")
+		assert.ErrorIs(t, err, domain.ErrInvalidGrant)
+	})
+
+	t.Run("access denied", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, hash).Return(device(domain.DeviceCodeDenied, time.Minute), nil).Once()
+		deviceCodeRepo.On("DeleteDeviceCode", mock.Anything, hash).Return(nil).Once()
+
+		_, err := service.PollDevice(context.Background(), "cli", "device-code")
+		assert.ErrorIs(t, err, domain.ErrAccessDenied)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, hash).Return(device(domain.DeviceCodePending, -time.Second), nil).Once()
+		deviceCodeRepo.On("DeleteDeviceCode", mock.Anything, hash).Return(nil).Once()
+
+		_, err := service.PollDevice(context.Background(), "cli", "device-code")
+		assert.ErrorIs(t, err, domain.ErrExpiredDeviceCode)
+	})
+
+	t.Run("other client", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, hash).Return(device(domain.DeviceCodeApproved, time.Minute), nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventDeviceCode && e.Outcome == domain.OutcomeFailure && e.Reason == domain.ReasonClientMismatch
+		})).Once()
+
+		_, err := service.PollDevice(context.Background(), "tv", "device-code")
+		assert.ErrorIs(t, err, domain.ErrInvalidGrant)
+	})
+
+	t.Run("unknown device code", func(t *testing.T) {
+		deviceCodeRepo.On("GetDeviceCode", mock.Anything, usecase.HashRefreshTokenFunc("unknown")).Return(nil, repository.ErrNotFound).Once()
+
+		_, err := service.PollDevice(context.Background(), "cli", "unknown")
+		assert.ErrorIs(t, err, domain.ErrInvalidGrant)
+
+		_, err = service.PollDevice(context.Background(), "cli", "")
+		assert.ErrorIs(t, err, domain.ErrInvalidGrant)
+	})
+
+	authRepo.AssertExpectations(t)
+	deviceCodeRepo.AssertExpectations(t)
+	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
@@ -0,0 +1,330 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	defaultDPoPProofLifetime = time.Minute
+	defaultDPoPLeeway        = time.Second * 5
+	defaultDPoPNonceTTL      = time.Minute * 5
+
+	dpopProofType  = "dpop+jwt"
+	dpopMinRSABits = 2048
+)
+
+// dpopAlgorithms are the signature algorithms accepted for proofs. Symmetric ones are
+// useless for proving possession of a key and never accepted.
+var dpopAlgorithms = []string{"ES256", "ES384", "ES512", "RS256", "PS256", "EdDSA"}
+
+// DPoPService verifies DPoP proofs (RFC 9449), which bind tokens to a key of the
+// client so that a leaked token is useless without the key.
+type DPoPService interface {
+	// VerifyProof checks proof for a request with method to url. accessToken is the
+	// token sent along with the proof, if any, and has to be the one the proof was made
+	// for. It returns the JWK thumbprint of the key the proof was signed with.
+	VerifyProof(ctx context.Context, proof string, method string, url string, accessToken string) (string, error)
+	// Nonce returns the nonce proofs have to carry, or an empty string if nonces are
+	// not required.
+	Nonce() string
+}
+
+// DPoPOptions sets how old a proof can be and whether proofs have to carry a nonce
+// handed out by the service. Nonces are derived from Secret and rotate every NonceTTL;
+// the previous nonce is still accepted after a rotation.
+type DPoPOptions struct {
+	ProofLifetime time.Duration
+	// Leeway is the clock skew allowed when checking the iat of a proof.
+	Leeway       time.Duration
+	RequireNonce bool
+	NonceTTL     time.Duration
+	Secret       []byte
+}
+
+type dpopService struct {
+	proofRepo repository.DPoPProofRepository
+	parser    *jwt.Parser
+	nonceKey  []byte
+	opts      DPoPOptions
+}
+
+func NewDPoPService(proofRepo repository.DPoPProofRepository, opts DPoPOptions) DPoPService {
+	opts.ProofLifetime = cmp.Or(opts.ProofLifetime, defaultDPoPProofLifetime)
+	opts.Leeway = cmp.Or(opts.Leeway, defaultDPoPLeeway)
+	opts.NonceTTL = cmp.Or(opts.NonceTTL, defaultDPoPNonceTTL)
+
+	mac := hmac.New(sha256.New, opts.Secret)
+	mac.Write([]byte("dpop-nonce"))
+
+	return &dpopService{
+		proofRepo: proofRepo,
+		parser:    jwt.NewParser(jwt.WithValidMethods(dpopAlgorithms), jwt.WithoutClaimsValidation()),
+		nonceKey:  mac.Sum(nil),
+		opts:      opts,
+	}
+}
+
+// dpopClaims are the claims of a proof. The jti is kept in ID and the iat in IssuedAt.
+type dpopClaims struct {
+	jwt.RegisteredClaims
+	HTM   string `json:"htm"`
+	HTU   string `json:"htu"`
+	ATH   string `json:"ath,omitempty"`
+	Nonce string `json:"nonce,omitempty"`
+}
+
+func (s *dpopService) VerifyProof(ctx context.Context, proof string, method string, url string, accessToken string) (string, error) {
+	ctx, span := tracer.Start(ctx, "DPoPService.VerifyProof")
+	defer span.End()
+
+	var jkt string
+	claims := &dpopClaims{}
+	_, err := s.parser.ParseWithClaims(proof, claims, func(t *jwt.Token) (interface{}, error) {
+		if typ, _ := t.Header["typ"].(string); typ != dpopProofType {
+			return nil, fmt.Errorf("typ %q is not %s", typ, dpopProofType)
+		}
+		jwk, ok := t.Header["jwk"].(map[string]any)
+		if !ok {
+			return nil, errors.New("missing jwk")
+		}
+
+		key, thumbprint, err := parseDPoPKey(jwk)
+		if err != nil {
+			return nil, err
+		}
+		jkt = thumbprint
+		return key, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", domain.ErrInvalidDPoPProof, err)
+	}
+
+	now := time.Now()
+	switch {
+	case claims.ID == "":
+		return "", fmt.Errorf("%w: missing jti", domain.ErrInvalidDPoPProof)
+	case claims.HTM != method:
+		return "", fmt.Errorf("%w: htm does not match the request", domain.ErrInvalidDPoPProof)
+	case !sameDPoPURL(claims.HTU, url):
+		return "", fmt.Errorf("%w: htu does not match the request", domain.ErrInvalidDPoPProof)
+	case claims.IssuedAt == nil:
+		return "", fmt.Errorf("%w: missing iat", domain.ErrInvalidDPoPProof)
+	case claims.IssuedAt.After(now.Add(s.opts.Leeway)), claims.IssuedAt.Before(now.Add(-s.opts.ProofLifetime - s.opts.Leeway)):
+		return "", fmt.Errorf("%w: iat is out of range", domain.ErrInvalidDPoPProof)
+	}
+
+	if accessToken != "" {
+		h := sha256.Sum256([]byte(accessToken))
+		if subtle.ConstantTimeCompare([]byte(claims.ATH), []byte(base64.RawURLEncoding.EncodeToString(h[:]))) != 1 {
+			return "", fmt.Errorf("%w: ath does not match the access token", domain.ErrInvalidDPoPProof)
+		}
+	}
+
+	if s.opts.RequireNonce && !s.validNonce(claims.Nonce, now) {
+		return "", domain.ErrUseDPoPNonce
+	}
+
+	// A jti only has to be unique per key, so both make up the remembered proof.
+	h := sha256.Sum256([]byte(jkt + ":" + claims.ID))
+	saved, err := s.proofRepo.SaveDPoPProof(ctx, hex.EncodeToString(h[:]), claims.IssuedAt.Add(s.opts.ProofLifetime+s.opts.Leeway))
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return "", domain.ErrGatewayTimeout
+		} else {
+			return "", fmt.Errorf("save dpop proof: %w", err)
+		}
+	}
+	if !saved {
+		return "", fmt.Errorf("%w: proof was already used", domain.ErrInvalidDPoPProof)
+	}
+
+	return jkt, nil
+}
+
+func (s *dpopService) Nonce() string {
+	if !s.opts.RequireNonce {
+		return ""
+	}
+	return s.nonce(s.nonceWindow(time.Now()))
+}
+
+func (s *dpopService) nonceWindow(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(s.opts.NonceTTL/time.Second))
+}
+
+// nonce returns the nonce of a time window: the window itself and its MAC.
+func (s *dpopService) nonce(window uint64) string {
+	b := binary.BigEndian.AppendUint64(nil, window)
+	mac := hmac.New(sha256.New, s.nonceKey)
+	mac.Write(b)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(b)[:8+16])
+}
+
+// validNonce accepts the nonce of the current and the previous window, so that a
+// nonce does not go stale right after it was handed out.
+func (s *dpopService) validNonce(nonce string, now time.Time) bool {
+	window := s.nonceWindow(now)
+	for _, w := range []uint64{window, window - 1} {
+		if hmac.Equal([]byte(nonce), []byte(s.nonce(w))) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameDPoPURL compares the htu of a proof with the URL of the request, ignoring the
+// query, the fragment and the case of the scheme and host.
+func sameDPoPURL(htu string, target string) bool {
+	a, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	b, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(a.Scheme, b.Scheme) && strings.EqualFold(a.Host, b.Host) && a.EscapedPath() == b.EscapedPath()
+}
+
+// parseDPoPKey returns the public key of a JWK and its thumbprint (RFC 7638). Private
+// keys are rejected, as they have no business in a proof.
+func parseDPoPKey(jwk map[string]any) (crypto.PublicKey, string, error) {
+	member := func(name string) string {
+		v, _ := jwk[name].(string)
+		return v
+	}
+	if _, ok := jwk["d"]; ok {
+		return nil, "", errors.New("jwk is a private key")
+	}
+
+	var (
+		key     crypto.PublicKey
+		members any
+	)
+	switch kty := member("kty"); kty {
+	case "EC":
+		crv, x, y := member("crv"), member("x"), member("y")
+		k, err := parseECKey(crv, x, y)
+		if err != nil {
+			return nil, "", err
+		}
+		key = k
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{crv, kty, x, y}
+	case "RSA":
+		e, n := member("e"), member("n")
+		k, err := parseRSAKey(e, n)
+		if err != nil {
+			return nil, "", err
+		}
+		key = k
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{e, kty, n}
+	case "OKP":
+		crv, x := member("crv"), member("x")
+		b, err := base64.RawURLEncoding.DecodeString(x)
+		if crv != "Ed25519" || err != nil || len(b) != ed25519.PublicKeySize {
+			return nil, "", errors.New("invalid okp key")
+		}
+		key = ed25519.PublicKey(b)
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{crv, kty, x}
+	default:
+		return nil, "", fmt.Errorf("unsupported key type %q", kty)
+	}
+
+	b, err := json.Marshal(members)
+	if err != nil {
+		return nil, "", err
+	}
+	h := sha256.Sum256(b)
+
+	return key, base64.RawURLEncoding.EncodeToString(h[:]), nil
+}
+
+func parseECKey(crv string, x string, y string) (*ecdsa.PublicKey, error) {
+	var (
+		curve elliptic.Curve
+		point ecdh.Curve
+	)
+	switch crv {
+	case "P-256":
+		curve, point = elliptic.P256(), ecdh.P256()
+	case "P-384":
+		curve, point = elliptic.P384(), ecdh.P384()
+	case "P-521":
+		curve, point = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", crv)
+	}
+
+	size := (curve.Params().BitSize + 7) / 8
+	xb, errX := base64.RawURLEncoding.DecodeString(x)
+	yb, errY := base64.RawURLEncoding.DecodeString(y)
+	if errX != nil || errY != nil || len(xb) != size || len(yb) != size {
+		return nil, errors.New("invalid ec key")
+	}
+	// ecdh checks that the point is on the curve.
+	if _, err := point.NewPublicKey(append(append([]byte{4}, xb...), yb...)); err != nil {
+		return nil, errors.New("invalid ec key")
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(xb),
+		Y:     new(big.Int).SetBytes(yb),
+	}, nil
+}
+
+func parseRSAKey(e string, n string) (*rsa.PublicKey, error) {
+	eb, errE := base64.RawURLEncoding.DecodeString(e)
+	nb, errN := base64.RawURLEncoding.DecodeString(n)
+	if errE != nil || errN != nil || len(eb) == 0 || len(eb) > 4 {
+		return nil, errors.New("invalid rsa key")
+	}
+
+	key := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nb),
+		E: int(new(big.Int).SetBytes(eb).Int64()),
+	}
+	if key.N.BitLen() < dpopMinRSABits || key.E < 3 || key.E%2 == 0 {
+		return nil, errors.New("invalid rsa key")
+	}
+
+	return key, nil
+}
@@ -0,0 +1,240 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+const dpopURL = "https://auth.example.org/api/v1/oauth/token"
+
+// dpopSigner makes DPoP proofs with an EC P-256 key.
+type dpopSigner struct {
+	key *ecdsa.PrivateKey
+	jwk map[string]any
+}
+
+func newDPoPSigner(t *testing.T) *dpopSigner {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &dpopSigner{
+		key: key,
+		jwk: map[string]any{
+			"kty": "EC",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		},
+	}
+}
+
+func (s *dpopSigner) proof(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = "dpop+jwt"
+	token.Header["jwk"] = s.jwk
+
+	proof, err := token.SignedString(s.key)
+	require.NoError(t, err)
+	return proof
+}
+
+func dpopClaims(method string, url string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"jti": uuid.NewString(),
+		"htm": method,
+		"htu": url,
+		"iat": time.Now().Unix(),
+	}
+}
+
+func TestDPoPService_VerifyProof(t *testing.T) {
+	proofRepo := &mocks.DPoPProofRepositoryMock{}
+	dpopService := usecase.NewDPoPService(proofRepo, usecase.DPoPOptions{ProofLifetime: time.Minute})
+
+	signer := newDPoPSigner(t)
+	proofRepo.On("SaveDPoPProof", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+
+	jkt, err := dpopService.VerifyProof(context.Background(), signer.proof(t, dpopClaims("POST", dpopURL)), "POST", dpopURL, "")
+	require.NoError(t, err)
+	assert.Len(t, jkt, 43, "the thumbprint is an unpadded base64url SHA-256 hash")
+
+	// The thumbprint only depends on the key.
+	again, err := dpopService.VerifyProof(context.Background(), signer.proof(t, dpopClaims("POST", dpopURL)), "POST", dpopURL, "")
+	require.NoError(t, err)
+	assert.Equal(t, jkt, again)
+
+	other, err := dpopService.VerifyProof(context.Background(), newDPoPSigner(t).proof(t, dpopClaims("POST", dpopURL)), "POST", dpopURL, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, jkt, other)
+
+	// The scheme and host are compared case-insensitively, the query is ignored.
+	_, err = dpopService.VerifyProof(context.Background(), signer.proof(t, dpopClaims("POST", "HTTPS://Auth.Example.org/api/v1/oauth/token")), "POST", dpopURL+"?x=1", "")
+	assert.NoError(t, err)
+
+	// The proof for a resource request names the access token it was sent with.
+	h := sha256.Sum256([]byte("access-token"))
+	claims := dpopClaims("GET", dpopURL)
+	claims["ath"] = base64.RawURLEncoding.EncodeToString(h[:])
+	_, err = dpopService.VerifyProof(context.Background(), signer.proof(t, claims), "GET", dpopURL, "access-token")
+	assert.NoError(t, err)
+
+	_, err = dpopService.VerifyProof(context.Background(), signer.proof(t, claims), "GET", dpopURL, "other-token")
+	assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+
+	// An Ed25519 key works as well.
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, dpopClaims("POST", dpopURL))
+	token.Header["typ"] = "dpop+jwt"
+	token.Header["jwk"] = map[string]any{"kty": "OKP", "crv": "Ed25519", "x": base64.RawURLEncoding.EncodeToString(pub)}
+	proof, err := token.SignedString(priv)
+	require.NoError(t, err)
+
+	_, err = dpopService.VerifyProof(context.Background(), proof, "POST", dpopURL, "")
+	assert.NoError(t, err)
+}
+
+func TestDPoPService_VerifyProofInvalid(t *testing.T) {
+	proofRepo := &mocks.DPoPProofRepositoryMock{}
+	dpopService := usecase.NewDPoPService(proofRepo, usecase.DPoPOptions{ProofLifetime: time.Minute, Leeway: time.Second})
+
+	signer := newDPoPSigner(t)
+
+	withClaim := func(name string, value any) string {
+		claims := dpopClaims("POST", dpopURL)
+		claims[name] = value
+		return signer.proof(t, claims)
+	}
+	withoutClaim := func(name string) string {
+		claims := dpopClaims("POST", dpopURL)
+		delete(claims, name)
+		return signer.proof(t, claims)
+	}
+	withHeader := func(name string, value any) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, dpopClaims("POST", dpopURL))
+		token.Header["typ"] = "dpop+jwt"
+		token.Header["jwk"] = signer.jwk
+		token.Header[name] = value
+		proof, err := token.SignedString(signer.key)
+		require.NoError(t, err)
+		return proof
+	}
+
+	hmacProof := jwt.NewWithClaims(jwt.SigningMethodHS256, dpopClaims("POST", dpopURL))
+	hmacProof.Header["typ"] = "dpop+jwt"
+	hmacProof.Header["jwk"] = map[string]any{"kty": "oct", "k": "c2VjcmV0"}
+	symmetric, err := hmacProof.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	privateJWK := map[string]any{"d": "private"}
+	for k, v := range signer.jwk {
+		privateJWK[k] = v
+	}
+
+	testCases := []struct {
+		name  string
+		proof string
+	}{
+		{"not a jwt", "proof"},
+		{"wrong typ", withHeader("typ", "JWT")},
+		{"missing jwk", withHeader("jwk", nil)},
+		{"private key", withHeader("jwk", privateJWK)},
+		{"key of another signer", withHeader("jwk", newDPoPSigner(t).jwk)},
+		{"symmetric algorithm", symmetric},
+		{"missing jti", withoutClaim("jti")},
+		{"wrong method", withClaim("htm", "GET")},
+		{"wrong url", withClaim("htu", "https://auth.example.org/api/v1/auth/login")},
+		{"missing iat", withoutClaim("iat")},
+		{"too old", withClaim("iat", time.Now().Add(-time.Minute*2).Unix())},
+		{"issued in the future", withClaim("iat", time.Now().Add(time.Minute).Unix())},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := dpopService.VerifyProof(context.Background(), tc.proof, "POST", dpopURL, "")
+			assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+		})
+	}
+
+	proofRepo.AssertNotCalled(t, "SaveDPoPProof", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDPoPService_VerifyProofReplay(t *testing.T) {
+	proofRepo := &mocks.DPoPProofRepositoryMock{}
+	dpopService := usecase.NewDPoPService(proofRepo, usecase.DPoPOptions{ProofLifetime: time.Minute, Leeway: time.Second})
+
+	proof := newDPoPSigner(t).proof(t, dpopClaims("POST", dpopURL))
+
+	// A proof is remembered until it is too old to be accepted anyway.
+	proofRepo.On("SaveDPoPProof", mock.Anything, mock.Anything, mock.MatchedBy(func(expiresAt time.Time) bool {
+		return expiresAt.Sub(time.Now().Add(time.Minute+time.Second)).Abs() < time.Second*2
+	})).Return(true, nil).Once()
+
+	_, err := dpopService.VerifyProof(context.Background(), proof, "POST", dpopURL, "")
+	assert.NoError(t, err)
+
+	proofRepo.On("SaveDPoPProof", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+
+	_, err = dpopService.VerifyProof(context.Background(), proof, "POST", dpopURL, "")
+	assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+
+	proofRepo.On("SaveDPoPProof", mock.Anything, mock.Anything, mock.Anything).Return(false, repository.ErrGatewayTimeout).Once()
+
+	_, err = dpopService.VerifyProof(context.Background(), proof, "POST", dpopURL, "")
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	proofRepo.AssertExpectations(t)
+}
+
+func TestDPoPService_Nonce(t *testing.T) {
+	proofRepo := &mocks.DPoPProofRepositoryMock{}
+	proofRepo.On("SaveDPoPProof", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+
+	assert.Empty(t, usecase.NewDPoPService(proofRepo, usecase.DPoPOptions{}).Nonce(), "nonces are not required by default")
+
+	dpopService := usecase.NewDPoPService(proofRepo, usecase.DPoPOptions{RequireNonce: true, Secret: []byte("secret")})
+	signer := newDPoPSigner(t)
+
+	nonce := dpopService.Nonce()
+	assert.NotEmpty(t, nonce)
+
+	_, err := dpopService.VerifyProof(context.Background(), signer.proof(t, dpopClaims("POST", dpopURL)), "POST", dpopURL, "")
+	assert.ErrorIs(t, err, domain.ErrUseDPoPNonce)
+
+	claims := dpopClaims("POST", dpopURL)
+	claims["nonce"] = "made-up"
+	_, err = dpopService.VerifyProof(context.Background(), signer.proof(t, claims), "POST", dpopURL, "")
+	assert.ErrorIs(t, err, domain.ErrUseDPoPNonce)
+
+	claims = dpopClaims("POST", dpopURL)
+	claims["nonce"] = nonce
+	_, err = dpopService.VerifyProof(context.Background(), signer.proof(t, claims), "POST", dpopURL, "")
+	assert.NoError(t, err)
+
+	// Nonces of another secret are not accepted.
+	claims = dpopClaims("POST", dpopURL)
+	claims["nonce"] = usecase.NewDPoPService(proofRepo, usecase.DPoPOptions{RequireNonce: true, Secret: []byte("other")}).Nonce()
+	_, err = dpopService.VerifyProof(context.Background(), signer.proof(t, claims), "POST", dpopURL, "")
+	assert.ErrorIs(t, err, domain.ErrUseDPoPNonce)
+}
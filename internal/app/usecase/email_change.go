@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const defaultEmailChangeTTL = time.Hour * 24
+
+// EmailChangeService changes the email of a user in two steps. A request sends a
+// confirm link to the new address and a cancel link to the current one; the address
+// is swapped only once the confirm link is used.
+type EmailChangeService interface {
+	RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, password string) error
+	ConfirmEmailChange(ctx context.Context, token string) (*domain.User, error)
+	CancelEmailChange(ctx context.Context, token string) error
+}
+
+// EmailChangeOptions sets how long a change can be confirmed and the pages the
+// links sent to users point to.
+type EmailChangeOptions struct {
+	TTL        time.Duration
+	ConfirmURL string
+	CancelURL  string
+}
+
+type emailChangeService struct {
+	authRepo        repository.AuthRepository
+	emailChangeRepo repository.EmailChangeRepository
+	auditService    AuditService
+	outboxRepo      repository.OutboxRepository
+	transactor      repository.Transactor
+	notifier        Notifier
+	opts            EmailChangeOptions
+}
+
+func NewEmailChangeService(authRepo repository.AuthRepository, emailChangeRepo repository.EmailChangeRepository, auditService AuditService, outboxRepo repository.OutboxRepository, transactor repository.Transactor, notifier Notifier, opts EmailChangeOptions) EmailChangeService {
+	opts.TTL = cmp.Or(opts.TTL, defaultEmailChangeTTL)
+
+	return &emailChangeService{
+		authRepo:        authRepo,
+		emailChangeRepo: emailChangeRepo,
+		auditService:    auditService,
+		outboxRepo:      outboxRepo,
+		transactor:      transactor,
+		notifier:        notifier,
+		opts:            opts,
+	}
+}
+
+func (s *emailChangeService) RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, password string) error {
+	ctx, span := tracer.Start(ctx, "EmailChangeService.RequestEmailChange")
+	defer span.End()
+
+	if err := validateEmail(&domain.User{Email: newEmail}); err != nil {
+		return domain.ErrInvalidEmail
+	}
+
+	u, err := s.authRepo.GetUserInfo(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.ErrWrongUserID
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("get user info: %w", err)
+		}
+	}
+	if newEmail == u.Email {
+		return fmt.Errorf("%w: the new email is the current one", domain.ErrInvalidEmail)
+	}
+
+	current, err := s.authRepo.FindUserByEmail(ctx, u.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("find user by email: %w", err)
+		}
+	}
+	if err := comparePasswords(ctx, password, current.PasswordHash); err != nil {
+		s.recordFailure(ctx, userID, domain.ReasonWrongPassword)
+		return domain.ErrWrongPassword
+	}
+
+	// The unique constraint decides on confirmation; this only spares the user a
+	// confirmation that cannot succeed.
+	if _, err := s.authRepo.FindUserByEmail(ctx, newEmail); err == nil {
+		s.recordFailure(ctx, userID, domain.ReasonEmailAlreadyExists)
+		return domain.ErrEmailAlreadyExists
+	} else if errors.Is(err, repository.ErrGatewayTimeout) {
+		return domain.ErrGatewayTimeout
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("find user by email: %w", err)
+	}
+
+	confirmToken, err := generateLinkToken()
+	if err != nil {
+		return fmt.Errorf("generate confirm token: %w", err)
+	}
+	cancelToken, err := generateLinkToken()
+	if err != nil {
+		return fmt.Errorf("generate cancel token: %w", err)
+	}
+	confirmLink, err := tokenLink(s.opts.ConfirmURL, confirmToken)
+	if err != nil {
+		return err
+	}
+	cancelLink, err := tokenLink(s.opts.CancelURL, cancelToken)
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(s.opts.TTL)
+	err = s.emailChangeRepo.SaveEmailChange(ctx, userID, newEmail, HashRefreshTokenFunc(confirmToken), HashRefreshTokenFunc(cancelToken), expiresAt)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("save email change: %w", err)
+		}
+	}
+
+	err = s.notifier.Notify(ctx, domain.Message{
+		To:      newEmail,
+		Subject: "Confirm your new email address",
+		Body: fmt.Sprintf("Use the link below to confirm %s as the new email address of your account. "+
+			"The link expires at %s.\n\n%s\n", newEmail, expiresAt.UTC().Format(time.RFC1123), confirmLink),
+	})
+	if err != nil {
+		return fmt.Errorf("notify new email: %w", err)
+	}
+
+	err = s.notifier.Notify(ctx, domain.Message{
+		To:      u.Email,
+		Subject: "Your email address is about to change",
+		Body: fmt.Sprintf("A change of the email address of your account to %s was requested. "+
+			"If this was not you, cancel it with the link below and change your password.\n\n%s\n", newEmail, cancelLink),
+	})
+	if err != nil {
+		return fmt.Errorf("notify current email: %w", err)
+	}
+
+	return nil
+}
+
+func (s *emailChangeService) ConfirmEmailChange(ctx context.Context, token string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "EmailChangeService.ConfirmEmailChange")
+	defer span.End()
+
+	if token == "" {
+		return nil, domain.ErrInvalidEmailChangeToken
+	}
+
+	var (
+		change *domain.EmailChange
+		res    *domain.User
+	)
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		c, err := s.emailChangeRepo.ConsumeEmailChange(ctx, HashRefreshTokenFunc(token))
+		if err != nil {
+			return err
+		}
+		change = c
+
+		// The expired change is consumed all the same, it is of no use anymore.
+		if time.Now().After(c.ExpiresAt) {
+			return nil
+		}
+
+		previous, err := s.authRepo.GetUserInfo(ctx, c.UserID)
+		if err != nil {
+			return err
+		}
+
+		updated, err := s.authRepo.UpdateUserEmail(ctx, c.UserID, c.NewEmail)
+		if err != nil {
+			return err
+		}
+
+		err = enqueueEvent(ctx, s.outboxRepo, updated.UserID, domain.EventUserEmailChanged, domain.UserLifecyclePayload{
+			UserID:        updated.UserID,
+			Email:         updated.Email,
+			PreviousEmail: previous.Email,
+			OccurredAt:    time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		res = updated
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) || errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrInvalidEmailChangeToken
+		} else if errors.Is(err, repository.ErrEmailAlreadyExists) {
+			s.recordFailure(ctx, change.UserID, domain.ReasonEmailAlreadyExists)
+			return nil, domain.ErrEmailAlreadyExists
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("confirm email change: %w", err)
+		}
+	}
+
+	if res == nil {
+		s.recordFailure(ctx, change.UserID, domain.ReasonExpiredToken)
+		return nil, domain.ErrInvalidEmailChangeToken
+	}
+
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventEmailChange,
+		UserID:    res.UserID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+
+	return res, nil
+}
+
+func (s *emailChangeService) CancelEmailChange(ctx context.Context, token string) error {
+	ctx, span := tracer.Start(ctx, "EmailChangeService.CancelEmailChange")
+	defer span.End()
+
+	if token == "" {
+		return domain.ErrInvalidEmailChangeToken
+	}
+
+	c, err := s.emailChangeRepo.CancelEmailChange(ctx, HashRefreshTokenFunc(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) {
+			return domain.ErrInvalidEmailChangeToken
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("cancel email change: %w", err)
+		}
+	}
+
+	s.recordFailure(ctx, c.UserID, domain.ReasonCancelled)
+
+	return nil
+}
+
+func (s *emailChangeService) recordFailure(ctx context.Context, userID uuid.UUID, reason string) {
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventEmailChange,
+		UserID:    userID,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    reason,
+	})
+}
@@ -0,0 +1,209 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+var emailChangeOptions = usecase.EmailChangeOptions{
+	TTL:        time.Hour,
+	ConfirmURL: "https://app.example.org/email/confirm",
+	CancelURL:  "https://app.example.org/email/cancel?lang=en",
+}
+
+var linkRe = regexp.MustCompile(`https://\S+`)
+
+// linkToken returns the token of the link in a message body.
+func linkToken(t *testing.T, body string) (string, *url.URL) {
+	u, err := url.Parse(linkRe.FindString(body))
+	if !assert.NoError(t, err) {
+		return "", nil
+	}
+	return u.Query().Get("token"), u
+}
+
+func TestEmailChangeService_RequestEmailChange(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	emailChangeRepo := &mocks.EmailChangeRepositoryMock{}
+	auditService := &mocks.AuditServiceMock{}
+	notifier := &mocks.NotifierMock{}
+	service := usecase.NewEmailChangeService(authRepo, emailChangeRepo, auditService, &mocks.OutboxRepositoryMock{}, newTransactorMock(), notifier, emailChangeOptions)
+
+	hash, err := usecase.HashPassword(context.Background(), "password123")
+	assert.NoError(t, err)
+
+	u := &domain.User{UserID: uuid.New(), Email: "old@example.org", IsActive: true}
+	authRepo.On("GetUserInfo", mock.Anything, u.UserID).Return(u, nil)
+	authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(&domain.UserWithPassword{
+		UserID:       u.UserID,
+		Email:        u.Email,
+		PasswordHash: hash,
+		IsActive:     true,
+	}, nil)
+
+	t.Run("sends confirm and cancel links", func(t *testing.T) {
+		var confirmHash, cancelHash string
+		authRepo.On("FindUserByEmail", mock.Anything, "new@example.org").Return(nil, repository.ErrNotFound).Once()
+		emailChangeRepo.On("SaveEmailChange", mock.Anything, u.UserID, "new@example.org", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(expiresAt time.Time) bool {
+			return time.Until(expiresAt) > time.Minute*59 && time.Until(expiresAt) <= time.Hour
+		})).Run(func(args mock.Arguments) {
+			confirmHash, cancelHash = args.String(3), args.String(4)
+		}).Return(nil).Once()
+
+		var sent []domain.Message
+		notifier.On("Notify", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			sent = append(sent, args.Get(1).(domain.Message))
+		}).Return(nil).Twice()
+
+		err := service.RequestEmailChange(context.Background(), u.UserID, "new@example.org", "password123")
+		assert.NoError(t, err)
+
+		if assert.Len(t, sent, 2) {
+			assert.Equal(t, "new@example.org", sent[0].To)
+			confirmToken, link := linkToken(t, sent[0].Body)
+			assert.Equal(t, "/email/confirm", link.Path)
+			assert.Equal(t, usecase.HashRefreshTokenFunc(confirmToken), confirmHash, "only the hash is stored")
+
+			assert.Equal(t, u.Email, sent[1].To)
+			assert.Contains(t, sent[1].Body, "new@example.org")
+			cancelToken, link := linkToken(t, sent[1].Body)
+			assert.Equal(t, "en", link.Query().Get("lang"), "the query of the configured link is kept")
+			assert.Equal(t, usecase.HashRefreshTokenFunc(cancelToken), cancelHash)
+			assert.NotEqual(t, confirmToken, cancelToken)
+		}
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventEmailChange && e.Reason == domain.ReasonWrongPassword
+		})).Once()
+
+		err := service.RequestEmailChange(context.Background(), u.UserID, "new@example.org", "wrong-password")
+		assert.ErrorIs(t, err, domain.ErrWrongPassword)
+	})
+
+	t.Run("email taken", func(t *testing.T) {
+		authRepo.On("FindUserByEmail", mock.Anything, "taken@example.org").Return(&domain.UserWithPassword{UserID: uuid.New()}, nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventEmailChange && e.Reason == domain.ReasonEmailAlreadyExists
+		})).Once()
+
+		err := service.RequestEmailChange(context.Background(), u.UserID, "taken@example.org", "password123")
+		assert.ErrorIs(t, err, domain.ErrEmailAlreadyExists)
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		err := service.RequestEmailChange(context.Background(), u.UserID, "not-an-email", "password123")
+		assert.ErrorIs(t, err, domain.ErrInvalidEmail)
+
+		err = service.RequestEmailChange(context.Background(), u.UserID, u.Email, "password123")
+		assert.ErrorIs(t, err, domain.ErrInvalidEmail)
+	})
+
+	authRepo.AssertExpectations(t)
+	emailChangeRepo.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestEmailChangeService_ConfirmEmailChange(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	emailChangeRepo := &mocks.EmailChangeRepositoryMock{}
+	auditService := &mocks.AuditServiceMock{}
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	service := usecase.NewEmailChangeService(authRepo, emailChangeRepo, auditService, outboxRepo, newTransactorMock(), &mocks.NotifierMock{}, emailChangeOptions)
+
+	userID := uuid.New()
+	pending := func(expiresAt time.Time) *domain.EmailChange {
+		return &domain.EmailChange{UserID: userID, NewEmail: "new@example.org", ExpiresAt: expiresAt}
+	}
+
+	t.Run("swaps the email", func(t *testing.T) {
+		emailChangeRepo.On("ConsumeEmailChange", mock.Anything, usecase.HashRefreshTokenFunc("confirm")).Return(pending(time.Now().Add(time.Hour)), nil).Once()
+		authRepo.On("GetUserInfo", mock.Anything, userID).Return(&domain.User{UserID: userID, Email: "old@example.org"}, nil).Once()
+		authRepo.On("UpdateUserEmail", mock.Anything, userID, "new@example.org").Return(&domain.User{UserID: userID, Email: "new@example.org"}, nil).Once()
+		outboxRepo.On("CreateOutboxEvent", mock.Anything, userID, domain.EventUserEmailChanged, mock.MatchedBy(func(payload []byte) bool {
+			var p domain.UserLifecyclePayload
+			return json.Unmarshal(payload, &p) == nil && p.Email == "new@example.org" && p.PreviousEmail == "old@example.org"
+		})).Return(nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventEmailChange && e.Outcome == domain.OutcomeSuccess
+		})).Once()
+
+		u, err := service.ConfirmEmailChange(context.Background(), "confirm")
+		assert.NoError(t, err)
+		assert.Equal(t, "new@example.org", u.Email)
+	})
+
+	t.Run("email taken in the meantime", func(t *testing.T) {
+		emailChangeRepo.On("ConsumeEmailChange", mock.Anything, usecase.HashRefreshTokenFunc("taken")).Return(pending(time.Now().Add(time.Hour)), nil).Once()
+		authRepo.On("GetUserInfo", mock.Anything, userID).Return(&domain.User{UserID: userID, Email: "old@example.org"}, nil).Once()
+		authRepo.On("UpdateUserEmail", mock.Anything, userID, "new@example.org").Return(nil, repository.ErrEmailAlreadyExists).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.Reason == domain.ReasonEmailAlreadyExists
+		})).Once()
+
+		_, err := service.ConfirmEmailChange(context.Background(), "taken")
+		assert.ErrorIs(t, err, domain.ErrEmailAlreadyExists)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		emailChangeRepo.On("ConsumeEmailChange", mock.Anything, usecase.HashRefreshTokenFunc("expired")).Return(pending(time.Now().Add(-time.Minute)), nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.Reason == domain.ReasonExpiredToken
+		})).Once()
+
+		_, err := service.ConfirmEmailChange(context.Background(), "expired")
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailChangeToken)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		emailChangeRepo.On("ConsumeEmailChange", mock.Anything, usecase.HashRefreshTokenFunc("unknown")).Return(nil, repository.ErrNoRowDeleted).Once()
+
+		_, err := service.ConfirmEmailChange(context.Background(), "unknown")
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailChangeToken)
+
+		_, err = service.ConfirmEmailChange(context.Background(), "")
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailChangeToken)
+	})
+
+	authRepo.AssertExpectations(t)
+	emailChangeRepo.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestEmailChangeService_CancelEmailChange(t *testing.T) {
+	emailChangeRepo := &mocks.EmailChangeRepositoryMock{}
+	auditService := &mocks.AuditServiceMock{}
+	service := usecase.NewEmailChangeService(&mocks.AuthRepositoryMock{}, emailChangeRepo, auditService, &mocks.OutboxRepositoryMock{}, newTransactorMock(), &mocks.NotifierMock{}, emailChangeOptions)
+
+	userID := uuid.New()
+	emailChangeRepo.On("CancelEmailChange", mock.Anything, usecase.HashRefreshTokenFunc("cancel")).Return(&domain.EmailChange{UserID: userID}, nil).Once()
+	auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+		return e.UserID == userID && e.Reason == domain.ReasonCancelled
+	})).Once()
+
+	err := service.CancelEmailChange(context.Background(), "cancel")
+	assert.NoError(t, err)
+
+	emailChangeRepo.On("CancelEmailChange", mock.Anything, usecase.HashRefreshTokenFunc("cancel")).Return(nil, repository.ErrNoRowDeleted).Once()
+
+	err = service.CancelEmailChange(context.Background(), "cancel")
+	assert.ErrorIs(t, err, domain.ErrInvalidEmailChangeToken)
+
+	emailChangeRepo.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
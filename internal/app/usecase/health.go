@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+type HealthService interface {
+	Readiness(ctx context.Context) domain.HealthReport
+	// Drain makes every following readiness check fail, so load balancers stop
+	// routing new requests before the server shuts down.
+	Drain()
+}
+
+type healthService struct {
+	healthRepo     repository.HealthRepository
+	tokenService   TokenService
+	expectedSchema int64
+	draining       atomic.Bool
+}
+
+func NewHealthService(healthRepo repository.HealthRepository, tokenService TokenService, expectedSchema int64) HealthService {
+	return &healthService{
+		healthRepo:     healthRepo,
+		tokenService:   tokenService,
+		expectedSchema: expectedSchema,
+	}
+}
+
+func (s *healthService) Readiness(ctx context.Context) domain.HealthReport {
+	if s.draining.Load() {
+		return domain.HealthReport{Status: domain.HealthStatusDraining}
+	}
+
+	report := domain.HealthReport{
+		Status: domain.HealthStatusOK,
+		Checks: map[string]string{
+			domain.HealthCheckPostgres:    domain.HealthStatusOK,
+			domain.HealthCheckMigrations:  domain.HealthStatusOK,
+			domain.HealthCheckSigningKeys: domain.HealthStatusOK,
+		},
+	}
+
+	fail := func(check string, reason string) {
+		report.Status = domain.HealthStatusUnavailable
+		report.Checks[check] = reason
+	}
+
+	if err := s.healthRepo.Ping(ctx); err != nil {
+		fail(domain.HealthCheckPostgres, healthReason(err))
+		fail(domain.HealthCheckMigrations, "postgres unreachable")
+	} else if err := s.checkSchema(ctx); err != nil {
+		fail(domain.HealthCheckMigrations, healthReason(err))
+	}
+
+	if !s.tokenService.SigningKeyLoaded() {
+		fail(domain.HealthCheckSigningKeys, "no signing key loaded")
+	}
+
+	return report
+}
+
+func (s *healthService) Drain() {
+	s.draining.Store(true)
+}
+
+func (s *healthService) checkSchema(ctx context.Context) error {
+	version, dirty, err := s.healthRepo.SchemaVersion(ctx)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errors.New("no migrations applied")
+		}
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version != s.expectedSchema {
+		return fmt.Errorf("schema version %d, expected %d", version, s.expectedSchema)
+	}
+
+	return nil
+}
+
+func healthReason(err error) string {
+	if errors.Is(err, repository.ErrGatewayTimeout) {
+		return domain.ErrGatewayTimeout.Error()
+	}
+	return err.Error()
+}
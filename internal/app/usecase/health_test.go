@@ -0,0 +1,111 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestHealthService_Readiness(t *testing.T) {
+	const expectedSchema int64 = 20261019093000
+
+	testCases := []struct {
+		name       string
+		pingErr    error
+		version    int64
+		dirty      bool
+		versionErr error
+		keyLoaded  bool
+		expStatus  string
+		expFailing []string
+	}{
+		{
+			name:      "ready",
+			version:   expectedSchema,
+			keyLoaded: true,
+			expStatus: domain.HealthStatusOK,
+		},
+		{
+			name:       "postgres unreachable",
+			pingErr:    errors.New("connection refused"),
+			keyLoaded:  true,
+			expStatus:  domain.HealthStatusUnavailable,
+			expFailing: []string{domain.HealthCheckPostgres, domain.HealthCheckMigrations},
+		},
+		{
+			name:       "schema behind",
+			version:    20261019092000,
+			keyLoaded:  true,
+			expStatus:  domain.HealthStatusUnavailable,
+			expFailing: []string{domain.HealthCheckMigrations},
+		},
+		{
+			name:       "dirty migration",
+			version:    expectedSchema,
+			dirty:      true,
+			keyLoaded:  true,
+			expStatus:  domain.HealthStatusUnavailable,
+			expFailing: []string{domain.HealthCheckMigrations},
+		},
+		{
+			name:       "no migrations",
+			versionErr: repository.ErrNotFound,
+			keyLoaded:  true,
+			expStatus:  domain.HealthStatusUnavailable,
+			expFailing: []string{domain.HealthCheckMigrations},
+		},
+		{
+			name:       "no signing key",
+			version:    expectedSchema,
+			expStatus:  domain.HealthStatusUnavailable,
+			expFailing: []string{domain.HealthCheckSigningKeys},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			healthRepo := &mocks.HealthRepositoryMock{}
+			tokenService := &mocks.TokenServiceMock{}
+			healthService := usecase.NewHealthService(healthRepo, tokenService, expectedSchema)
+
+			healthRepo.On("Ping", mock.Anything).Return(tc.pingErr).Once()
+			if tc.pingErr == nil {
+				healthRepo.On("SchemaVersion", mock.Anything).Return(tc.version, tc.dirty, tc.versionErr).Once()
+			}
+			tokenService.On("SigningKeyLoaded").Return(tc.keyLoaded).Once()
+
+			report := healthService.Readiness(context.Background())
+			assert.Equal(t, tc.expStatus, report.Status)
+
+			assert.Len(t, report.Checks, 3)
+			for check, result := range report.Checks {
+				assert.Equal(t, !slices.Contains(tc.expFailing, check), result == domain.HealthStatusOK, check)
+			}
+
+			healthRepo.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHealthService_Drain(t *testing.T) {
+	healthRepo := &mocks.HealthRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	healthService := usecase.NewHealthService(healthRepo, tokenService, 1)
+
+	healthService.Drain()
+
+	report := healthService.Readiness(context.Background())
+	assert.Equal(t, domain.HealthStatusDraining, report.Status)
+	assert.False(t, report.Ready())
+
+	healthRepo.AssertNotCalled(t, "Ping", mock.Anything)
+}
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	defaultMagicLinkTTL         = time.Minute * 15
+	defaultMagicLinkMaxLinks    = 5
+	defaultMagicLinkLinksWindow = time.Hour
+)
+
+// MagicLinkService logs users in without a password. A request emails a single-use
+// link to the user; the token of the link is exchanged for a session like a login.
+type MagicLinkService interface {
+	// RequestMagicLink sends a login link to the user of email. It returns the nonce
+	// the requesting browser has to present along with the link, or an empty string
+	// if links are not bound to the browser. Unknown and inactive users and users who
+	// got too many links get no link, but the call looks the same to the caller.
+	RequestMagicLink(ctx context.Context, email string, rememberMe bool) (string, error)
+	LoginWithMagicLink(ctx context.Context, token string, binding string) (*domain.Tokens, error)
+}
+
+// MagicLinkOptions sets how long a link can be used, the page it points to, whether
+// it only works in the browser that asked for it and how many links a user gets
+// within LinksWindow.
+type MagicLinkOptions struct {
+	TTL         time.Duration
+	LoginURL    string
+	BindBrowser bool
+	MaxLinks    int
+	LinksWindow time.Duration
+}
+
+type magicLinkService struct {
+	authRepo       repository.AuthRepository
+	magicLinkRepo  repository.MagicLinkRepository
+	tokenRepo      repository.TokenRepository
+	tokenService   TokenService
+	auditService   AuditService
+	notifier       Notifier
+	log            *slog.Logger
+	sessionOptions SessionOptions
+	opts           MagicLinkOptions
+}
+
+func NewMagicLinkService(authRepo repository.AuthRepository, magicLinkRepo repository.MagicLinkRepository, tokenRepo repository.TokenRepository, tokenService TokenService, auditService AuditService, notifier Notifier, log *slog.Logger, sessionOptions SessionOptions, opts MagicLinkOptions) MagicLinkService {
+	opts.TTL = cmp.Or(opts.TTL, defaultMagicLinkTTL)
+	opts.MaxLinks = cmp.Or(opts.MaxLinks, defaultMagicLinkMaxLinks)
+	opts.LinksWindow = cmp.Or(opts.LinksWindow, defaultMagicLinkLinksWindow)
+
+	return &magicLinkService{
+		authRepo:       authRepo,
+		magicLinkRepo:  magicLinkRepo,
+		tokenRepo:      tokenRepo,
+		tokenService:   tokenService,
+		auditService:   auditService,
+		notifier:       notifier,
+		log:            log,
+		sessionOptions: sessionOptions,
+		opts:           opts,
+	}
+}
+
+func (s *magicLinkService) RequestMagicLink(ctx context.Context, email string, rememberMe bool) (string, error) {
+	ctx, span := tracer.Start(ctx, "MagicLinkService.RequestMagicLink")
+	defer span.End()
+
+	if err := validateEmail(&domain.User{Email: email}); err != nil {
+		return "", domain.ErrInvalidEmail
+	}
+
+	// The nonce is handed out for unknown users as well, so that the response does not
+	// tell whether the email is registered.
+	var binding, bindingHash string
+	if s.opts.BindBrowser {
+		b, err := generateLinkToken()
+		if err != nil {
+			return "", fmt.Errorf("generate binding: %w", err)
+		}
+		binding, bindingHash = b, HashRefreshTokenFunc(b)
+	}
+
+	u, err := s.authRepo.FindUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			s.recordFailure(ctx, uuid.Nil, domain.ReasonUserNotFound)
+			return binding, nil
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return "", domain.ErrGatewayTimeout
+		} else {
+			return "", fmt.Errorf("find user by email: %w", err)
+		}
+	}
+	if !u.IsActive {
+		s.recordFailure(ctx, u.UserID, domain.ReasonUserInactive)
+		return binding, nil
+	}
+
+	n, err := s.magicLinkRepo.CountMagicLinkRequest(ctx, u.UserID, s.opts.LinksWindow)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return "", domain.ErrGatewayTimeout
+		} else {
+			return "", fmt.Errorf("count magic link request: %w", err)
+		}
+	}
+	if n > s.opts.MaxLinks {
+		s.recordFailure(ctx, u.UserID, domain.ReasonTooManyLinks)
+		return binding, nil
+	}
+
+	token, err := generateLinkToken()
+	if err != nil {
+		return "", fmt.Errorf("generate magic link token: %w", err)
+	}
+	link, err := tokenLink(s.opts.LoginURL, token)
+	if err != nil {
+		return "", err
+	}
+
+	expiresAt := time.Now().Add(s.opts.TTL)
+	if err := s.magicLinkRepo.SaveMagicLink(ctx, u.UserID, HashRefreshTokenFunc(token), bindingHash, rememberMe, expiresAt); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return "", domain.ErrGatewayTimeout
+		} else {
+			return "", fmt.Errorf("save magic link: %w", err)
+		}
+	}
+
+	// The link is sent in the background, so that neither the time taken to send it
+	// nor a failure to do so tells the caller that the email is registered.
+	msg := domain.Message{
+		To:      u.Email,
+		Subject: "Your login link",
+		Body: fmt.Sprintf("Use the link below to log in. It can be used once and expires at %s. "+
+			"If you did not ask for it, ignore this message.\n\n%s\n", expiresAt.UTC().Format(time.RFC1123), link),
+	}
+	go func(ctx context.Context) {
+		if err := s.notifier.Notify(ctx, msg); err != nil {
+			s.log.ErrorContext(ctx, "magic_link_notify_failed",
+				"request_id", domain.RequestMetaFromContext(ctx).RequestID,
+				"user_id", u.UserID,
+				"error", err,
+			)
+		}
+	}(context.WithoutCancel(ctx))
+
+	return binding, nil
+}
+
+func (s *magicLinkService) LoginWithMagicLink(ctx context.Context, token string, binding string) (*domain.Tokens, error) {
+	ctx, span := tracer.Start(ctx, "MagicLinkService.LoginWithMagicLink")
+	defer span.End()
+
+	if token == "" {
+		return nil, domain.ErrInvalidMagicLink
+	}
+
+	// The link is consumed before it is checked, so that a failed attempt burns it.
+	l, err := s.magicLinkRepo.ConsumeMagicLink(ctx, HashRefreshTokenFunc(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) {
+			s.recordFailure(ctx, uuid.Nil, domain.ReasonUnknownToken)
+			return nil, domain.ErrInvalidMagicLink
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("consume magic link: %w", err)
+		}
+	}
+
+	if time.Now().After(l.ExpiresAt) {
+		s.recordFailure(ctx, l.UserID, domain.ReasonExpiredToken)
+		return nil, domain.ErrInvalidMagicLink
+	}
+	if l.BindingHash != "" && (binding == "" || HashRefreshTokenFunc(binding) != l.BindingHash) {
+		s.recordFailure(ctx, l.UserID, domain.ReasonBindingMismatch)
+		return nil, domain.ErrInvalidMagicLink
+	}
+
+	u, err := s.authRepo.GetUserInfo(ctx, l.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, domain.ErrInvalidMagicLink
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("get user info: %w", err)
+		}
+	}
+	if !u.IsActive {
+		s.recordFailure(ctx, u.UserID, domain.ReasonUserInactive)
+		return nil, domain.ErrInvalidMagicLink
+	}
+
+	tokens, err := startSession(ctx, s.tokenRepo, s.tokenService, s.sessionOptions, u.UserID, l.RememberMe)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventMagicLink,
+		UserID:    u.UserID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+
+	return tokens, nil
+}
+
+func (s *magicLinkService) recordFailure(ctx context.Context, userID uuid.UUID, reason string) {
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventMagicLink,
+		UserID:    userID,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    reason,
+	})
+}
@@ -0,0 +1,248 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+var magicLinkOptions = usecase.MagicLinkOptions{
+	TTL:      time.Minute * 10,
+	LoginURL: "https://app.example.org/login/magic",
+}
+
+func TestMagicLinkService_RequestMagicLink(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	magicLinkRepo := &mocks.MagicLinkRepositoryMock{}
+	auditService := &mocks.AuditServiceMock{}
+	notifier := &mocks.NotifierMock{}
+	service := usecase.NewMagicLinkService(authRepo, magicLinkRepo, &mocks.TokenRepositoryMock{}, &mocks.TokenServiceMock{}, auditService, notifier, slog.Default(), usecase.SessionOptions{}, magicLinkOptions)
+
+	u := &domain.UserWithPassword{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(u, nil)
+
+	t.Run("sends a link", func(t *testing.T) {
+		magicLinkRepo.On("CountMagicLinkRequest", mock.Anything, u.UserID, time.Hour).Return(1, nil).Once()
+		var tokenHash string
+		magicLinkRepo.On("SaveMagicLink", mock.Anything, u.UserID, mock.AnythingOfType("string"), "", true, mock.MatchedBy(func(expiresAt time.Time) bool {
+			return time.Until(expiresAt) > time.Minute*9 && time.Until(expiresAt) <= time.Minute*10
+		})).Run(func(args mock.Arguments) {
+			tokenHash = args.String(2)
+		}).Return(nil).Once()
+
+		notified := make(chan domain.Message, 1)
+		notifier.On("Notify", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			notified <- args.Get(1).(domain.Message)
+		}).Return(nil).Once()
+
+		binding, err := service.RequestMagicLink(context.Background(), u.Email, true)
+		assert.NoError(t, err)
+		assert.Empty(t, binding, "links are not bound to the browser by default")
+
+		sent := <-notified
+		assert.Equal(t, u.Email, sent.To)
+		token, link := linkToken(t, sent.Body)
+		assert.Equal(t, "/login/magic", link.Path)
+		assert.Equal(t, usecase.HashRefreshTokenFunc(token), tokenHash, "only the hash is stored")
+	})
+
+	t.Run("notifier error", func(t *testing.T) {
+		magicLinkRepo.On("CountMagicLinkRequest", mock.Anything, u.UserID, time.Hour).Return(2, nil).Once()
+		magicLinkRepo.On("SaveMagicLink", mock.Anything, u.UserID, mock.AnythingOfType("string"), "", false, mock.Anything).Return(nil).Once()
+		notified := make(chan struct{})
+		notifier.On("Notify", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			close(notified)
+		}).Return(errors.New("smtp unavailable")).Once()
+
+		_, err := service.RequestMagicLink(context.Background(), u.Email, false)
+		assert.NoError(t, err, "the caller must not learn whether the email is registered")
+		<-notified
+	})
+
+	t.Run("too many links", func(t *testing.T) {
+		magicLinkRepo.On("CountMagicLinkRequest", mock.Anything, u.UserID, time.Hour).Return(6, nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventMagicLink && e.UserID == u.UserID && e.Reason == domain.ReasonTooManyLinks
+		})).Once()
+
+		_, err := service.RequestMagicLink(context.Background(), u.Email, false)
+		assert.NoError(t, err, "the caller must not learn whether the email is registered")
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		authRepo.On("FindUserByEmail", mock.Anything, "unknown@example.org").Return(nil, repository.ErrNotFound).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventMagicLink && e.Reason == domain.ReasonUserNotFound
+		})).Once()
+
+		binding, err := service.RequestMagicLink(context.Background(), "unknown@example.org", false)
+		assert.NoError(t, err, "the caller must not learn whether the email is registered")
+		assert.Empty(t, binding)
+	})
+
+	t.Run("inactive user", func(t *testing.T) {
+		inactive := &domain.UserWithPassword{UserID: uuid.New(), Email: "inactive@example.org"}
+		authRepo.On("FindUserByEmail", mock.Anything, inactive.Email).Return(inactive, nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventMagicLink && e.Reason == domain.ReasonUserInactive
+		})).Once()
+
+		_, err := service.RequestMagicLink(context.Background(), inactive.Email, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		_, err := service.RequestMagicLink(context.Background(), "not-an-email", false)
+		assert.ErrorIs(t, err, domain.ErrInvalidEmail)
+	})
+
+	authRepo.AssertExpectations(t)
+	magicLinkRepo.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestMagicLinkService_RequestMagicLinkBindBrowser(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	magicLinkRepo := &mocks.MagicLinkRepositoryMock{}
+	notifier := &mocks.NotifierMock{}
+	opts := magicLinkOptions
+	opts.BindBrowser = true
+	service := usecase.NewMagicLinkService(authRepo, magicLinkRepo, &mocks.TokenRepositoryMock{}, &mocks.TokenServiceMock{}, newAuditServiceMock(), notifier, slog.Default(), usecase.SessionOptions{}, opts)
+
+	u := &domain.UserWithPassword{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(u, nil).Once()
+	magicLinkRepo.On("CountMagicLinkRequest", mock.Anything, u.UserID, time.Hour).Return(1, nil).Once()
+
+	var bindingHash string
+	magicLinkRepo.On("SaveMagicLink", mock.Anything, u.UserID, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false, mock.Anything).Run(func(args mock.Arguments) {
+		bindingHash = args.String(3)
+	}).Return(nil).Once()
+	notified := make(chan struct{})
+	notifier.On("Notify", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		close(notified)
+	}).Return(nil).Once()
+
+	binding, err := service.RequestMagicLink(context.Background(), u.Email, false)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, binding)
+	<-notified
+	assert.Equal(t, usecase.HashRefreshTokenFunc(binding), bindingHash)
+
+	// Unknown emails get a nonce as well, so the response looks the same.
+	authRepo.On("FindUserByEmail", mock.Anything, "unknown@example.org").Return(nil, repository.ErrNotFound).Once()
+
+	other, err := service.RequestMagicLink(context.Background(), "unknown@example.org", false)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, other)
+	assert.NotEqual(t, binding, other)
+
+	authRepo.AssertExpectations(t)
+	magicLinkRepo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestMagicLinkService_LoginWithMagicLink(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	magicLinkRepo := &mocks.MagicLinkRepositoryMock{}
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	service := usecase.NewMagicLinkService(authRepo, magicLinkRepo, tokenRepo, tokenService, auditService, &mocks.NotifierMock{}, slog.Default(), usecase.SessionOptions{}, magicLinkOptions)
+
+	u := &domain.User{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	authRepo.On("GetUserInfo", mock.Anything, u.UserID).Return(u, nil)
+
+	expectFailure := func(reason string) {
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventMagicLink && e.Outcome == domain.OutcomeFailure && e.Reason == reason
+		})).Once()
+	}
+
+	t.Run("starts a session", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour * 24 * 30)
+		magicLinkRepo.On("ConsumeMagicLink", mock.Anything, usecase.HashRefreshTokenFunc("token")).Return(&domain.MagicLink{
+			UserID:     u.UserID,
+			RememberMe: true,
+			ExpiresAt:  time.Now().Add(time.Minute),
+		}, nil).Once()
+		tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "").Return("access-token", nil).Once()
+		tokenService.On("GenerateRefreshToken").Return("refresh-token", nil).Once()
+		tokenService.On("HashRefreshToken", "refresh-token", "").Return("refresh-token-hash", expiresAt).Once()
+		tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, "refresh-token-hash", expiresAt, mock.Anything, true, "", "", "").Return(nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventMagicLink && e.Outcome == domain.OutcomeSuccess && e.UserID == u.UserID
+		})).Once()
+
+		tokens, err := service.LoginWithMagicLink(context.Background(), "token", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", tokens.AccessToken)
+		assert.Equal(t, "refresh-token", tokens.RefreshToken)
+		assert.Equal(t, expiresAt, tokens.RefreshTokenExpiresAt)
+	})
+
+	t.Run("unknown or used link", func(t *testing.T) {
+		magicLinkRepo.On("ConsumeMagicLink", mock.Anything, usecase.HashRefreshTokenFunc("used")).Return(nil, repository.ErrNoRowDeleted).Once()
+		expectFailure(domain.ReasonUnknownToken)
+
+		_, err := service.LoginWithMagicLink(context.Background(), "used", "")
+		assert.ErrorIs(t, err, domain.ErrInvalidMagicLink)
+
+		_, err = service.LoginWithMagicLink(context.Background(), "", "")
+		assert.ErrorIs(t, err, domain.ErrInvalidMagicLink)
+	})
+
+	t.Run("expired link", func(t *testing.T) {
+		magicLinkRepo.On("ConsumeMagicLink", mock.Anything, usecase.HashRefreshTokenFunc("expired")).Return(&domain.MagicLink{
+			UserID:    u.UserID,
+			ExpiresAt: time.Now().Add(-time.Second),
+		}, nil).Once()
+		expectFailure(domain.ReasonExpiredToken)
+
+		_, err := service.LoginWithMagicLink(context.Background(), "expired", "")
+		assert.ErrorIs(t, err, domain.ErrInvalidMagicLink)
+	})
+
+	t.Run("other browser", func(t *testing.T) {
+		for _, binding := range []string{"", "other-binding"} {
+			magicLinkRepo.On("ConsumeMagicLink", mock.Anything, usecase.HashRefreshTokenFunc("bound")).Return(&domain.MagicLink{
+				UserID:      u.UserID,
+				BindingHash: usecase.HashRefreshTokenFunc("binding"),
+				ExpiresAt:   time.Now().Add(time.Minute),
+			}, nil).Once()
+			expectFailure(domain.ReasonBindingMismatch)
+
+			_, err := service.LoginWithMagicLink(context.Background(), "bound", binding)
+			assert.ErrorIs(t, err, domain.ErrInvalidMagicLink)
+		}
+	})
+
+	t.Run("inactive user", func(t *testing.T) {
+		inactive := &domain.User{UserID: uuid.New()}
+		authRepo.On("GetUserInfo", mock.Anything, inactive.UserID).Return(inactive, nil).Once()
+		magicLinkRepo.On("ConsumeMagicLink", mock.Anything, usecase.HashRefreshTokenFunc("inactive")).Return(&domain.MagicLink{
+			UserID:    inactive.UserID,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}, nil).Once()
+		expectFailure(domain.ReasonUserInactive)
+
+		_, err := service.LoginWithMagicLink(context.Background(), "inactive", "")
+		assert.ErrorIs(t, err, domain.ErrInvalidMagicLink)
+	})
+
+	authRepo.AssertExpectations(t)
+	magicLinkRepo.AssertExpectations(t)
+	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
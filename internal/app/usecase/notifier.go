@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/url"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// Notifier delivers messages to users outside of the API, e.g. by email. Notify
+// returns once the message was handed over; an error means the user did not get it.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, msg domain.Message) error
+}
+
+// tokenLink returns base with token added as the "token" query parameter. base is
+// the page of a frontend that posts the token back to the API.
+func tokenLink(base string, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parse link %q: %w", base, err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// generateLinkToken returns a random token to send to users in a link. Only its hash
+// is stored.
+func generateLinkToken() (string, error) {
+	b := make([]byte, 32)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", b), nil
+}
@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	defaultOTPTTL            = time.Minute * 5
+	defaultOTPLength         = 6
+	defaultOTPMaxAttempts    = 5
+	defaultOTPResendInterval = time.Second * 30
+	defaultOTPMaxCodes       = 5
+	defaultOTPCodesWindow    = time.Hour
+	defaultOTPPhoneAttribute = "phone_number"
+)
+
+// OTPService logs users in with a short numeric code sent to them over a channel
+// like email or SMS. Only the hash of a code is stored, a code is discarded once it
+// was entered wrong too often, and only so many codes are sent to a user in a while.
+type OTPService interface {
+	// RequestOTP sends a login code to the user of email over channel. Unknown and
+	// inactive users get no code, but the call looks the same to the caller.
+	RequestOTP(ctx context.Context, email string, channel string, rememberMe bool) error
+	LoginWithOTP(ctx context.Context, email string, code string) (*domain.Tokens, error)
+}
+
+// OTPOptions sets the length and lifetime of codes, how often a code can be tried,
+// how soon a new code can be sent, how many codes a user gets within CodesWindow and
+// the user attribute holding phone numbers for SMS codes.
+type OTPOptions struct {
+	TTL            time.Duration
+	Length         int
+	MaxAttempts    int
+	ResendInterval time.Duration
+	MaxCodes       int
+	CodesWindow    time.Duration
+	PhoneAttribute string
+}
+
+type otpService struct {
+	authRepo       repository.AuthRepository
+	otpRepo        repository.OTPRepository
+	attributeRepo  repository.UserAttributeRepository
+	tokenRepo      repository.TokenRepository
+	tokenService   TokenService
+	auditService   AuditService
+	senders        map[string]Notifier
+	sessionOptions SessionOptions
+	opts           OTPOptions
+}
+
+// NewOTPService returns an OTPService that sends codes through the notifier of each
+// channel in senders. Channels without a notifier are rejected.
+func NewOTPService(authRepo repository.AuthRepository, otpRepo repository.OTPRepository, attributeRepo repository.UserAttributeRepository, tokenRepo repository.TokenRepository, tokenService TokenService, auditService AuditService, senders map[string]Notifier, sessionOptions SessionOptions, opts OTPOptions) OTPService {
+	opts.TTL = cmp.Or(opts.TTL, defaultOTPTTL)
+	opts.Length = cmp.Or(opts.Length, defaultOTPLength)
+	opts.MaxAttempts = cmp.Or(opts.MaxAttempts, defaultOTPMaxAttempts)
+	opts.ResendInterval = cmp.Or(opts.ResendInterval, defaultOTPResendInterval)
+	opts.MaxCodes = cmp.Or(opts.MaxCodes, defaultOTPMaxCodes)
+	opts.CodesWindow = cmp.Or(opts.CodesWindow, defaultOTPCodesWindow)
+	opts.PhoneAttribute = cmp.Or(opts.PhoneAttribute, defaultOTPPhoneAttribute)
+
+	return &otpService{
+		authRepo:       authRepo,
+		otpRepo:        otpRepo,
+		attributeRepo:  attributeRepo,
+		tokenRepo:      tokenRepo,
+		tokenService:   tokenService,
+		auditService:   auditService,
+		senders:        senders,
+		sessionOptions: sessionOptions,
+		opts:           opts,
+	}
+}
+
+func (s *otpService) RequestOTP(ctx context.Context, email string, channel string, rememberMe bool) error {
+	ctx, span := tracer.Start(ctx, "OTPService.RequestOTP")
+	defer span.End()
+
+	if err := validateEmail(&domain.User{Email: email}); err != nil {
+		return domain.ErrInvalidEmail
+	}
+
+	sender, ok := s.senders[channel]
+	if !ok {
+		return domain.ErrInvalidOTPChannel
+	}
+
+	u, err := s.authRepo.FindUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			s.recordFailure(ctx, uuid.Nil, domain.ReasonUserNotFound)
+			return nil
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("find user by email: %w", err)
+		}
+	}
+	if !u.IsActive {
+		s.recordFailure(ctx, u.UserID, domain.ReasonUserInactive)
+		return nil
+	}
+
+	// A new code resets the attempts, so codes are not sent more often than this.
+	pending, err := s.otpRepo.GetOTP(ctx, u.UserID)
+	if err == nil && time.Since(pending.CreatedAt) < s.opts.ResendInterval {
+		s.recordFailure(ctx, u.UserID, domain.ReasonResendTooSoon)
+		return nil
+	} else if errors.Is(err, repository.ErrGatewayTimeout) {
+		return domain.ErrGatewayTimeout
+	} else if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("get otp: %w", err)
+	}
+
+	to := u.Email
+	if channel == domain.OTPChannelSMS {
+		to, err = s.phoneNumber(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		if to == "" {
+			s.recordFailure(ctx, u.UserID, domain.ReasonNoPhoneNumber)
+			return nil
+		}
+	}
+
+	// Each code can be tried MaxAttempts times, so the codes sent in a window bound
+	// the guesses anyone can make at the codes of a user.
+	n, err := s.otpRepo.CountOTPRequest(ctx, u.UserID, s.opts.CodesWindow)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("count otp request: %w", err)
+		}
+	}
+	if n > s.opts.MaxCodes {
+		s.recordFailure(ctx, u.UserID, domain.ReasonTooManyCodes)
+		return nil
+	}
+
+	code, err := generateOTPCode(s.opts.Length)
+	if err != nil {
+		return fmt.Errorf("generate otp: %w", err)
+	}
+	// Codes are short enough to be guessed from a fast hash, so they are hashed like
+	// passwords.
+	hash, err := HashPassword(ctx, code)
+	if err != nil {
+		return fmt.Errorf("hash otp: %w", err)
+	}
+
+	if err := s.otpRepo.SaveOTP(ctx, u.UserID, channel, hash, rememberMe, time.Now().Add(s.opts.TTL)); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("save otp: %w", err)
+		}
+	}
+
+	err = sender.Notify(ctx, domain.Message{
+		To:      to,
+		Subject: "Your login code",
+		Body:    fmt.Sprintf("Your login code is %s. It expires in %d minutes.", code, int(s.opts.TTL.Minutes())),
+	})
+	if err != nil {
+		return fmt.Errorf("send otp over %s: %w", channel, err)
+	}
+
+	return nil
+}
+
+func (s *otpService) LoginWithOTP(ctx context.Context, email string, code string) (*domain.Tokens, error) {
+	ctx, span := tracer.Start(ctx, "OTPService.LoginWithOTP")
+	defer span.End()
+
+	if code == "" {
+		return nil, domain.ErrInvalidOTP
+	}
+
+	u, err := s.authRepo.FindUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			s.recordFailure(ctx, uuid.Nil, domain.ReasonUserNotFound)
+			return nil, domain.ErrInvalidOTP
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("find user by email: %w", err)
+		}
+	}
+	if !u.IsActive {
+		s.recordFailure(ctx, u.UserID, domain.ReasonUserInactive)
+		return nil, domain.ErrInvalidOTP
+	}
+
+	// The attempt is counted before the code is compared, so that concurrent tries
+	// cannot exceed the limit.
+	c, err := s.otpRepo.AttemptOTP(ctx, u.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			s.recordFailure(ctx, u.UserID, domain.ReasonUnknownToken)
+			return nil, domain.ErrInvalidOTP
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("attempt otp: %w", err)
+		}
+	}
+
+	var reason string
+	switch {
+	case time.Now().After(c.ExpiresAt):
+		reason = domain.ReasonExpiredToken
+	case c.Attempts > s.opts.MaxAttempts:
+		reason = domain.ReasonTooManyAttempts
+	case comparePasswords(ctx, code, c.CodeHash) != nil:
+		reason = domain.ReasonWrongCode
+	}
+	if reason != "" {
+		s.recordFailure(ctx, u.UserID, reason)
+		if reason != domain.ReasonWrongCode || c.Attempts >= s.opts.MaxAttempts {
+			if err := s.discard(ctx, c); err != nil {
+				return nil, err
+			}
+		}
+		return nil, domain.ErrInvalidOTP
+	}
+
+	if err := s.otpRepo.DeleteOTP(ctx, u.UserID, c.CodeHash); err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) {
+			s.recordFailure(ctx, u.UserID, domain.ReasonUnknownToken)
+			return nil, domain.ErrInvalidOTP
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("delete otp: %w", err)
+		}
+	}
+
+	tokens, err := startSession(ctx, s.tokenRepo, s.tokenService, s.sessionOptions, u.UserID, c.RememberMe)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventOTP,
+		UserID:    u.UserID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+
+	return tokens, nil
+}
+
+// phoneNumber returns the phone number of the user, or an empty string if the user
+// has none.
+func (s *otpService) phoneNumber(ctx context.Context, userID uuid.UUID) (string, error) {
+	attrs, err := s.attributeRepo.ListUserAttributes(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return "", domain.ErrGatewayTimeout
+		} else {
+			return "", fmt.Errorf("list user attributes: %w", err)
+		}
+	}
+
+	for _, a := range attrs {
+		if a.Name != s.opts.PhoneAttribute {
+			continue
+		}
+		var phone string
+		if err := json.Unmarshal(a.Value, &phone); err != nil {
+			return "", nil
+		}
+		return phone, nil
+	}
+
+	return "", nil
+}
+
+// discard deletes a code that can no longer be used.
+func (s *otpService) discard(ctx context.Context, c *domain.OTP) error {
+	err := s.otpRepo.DeleteOTP(ctx, c.UserID, c.CodeHash)
+	if err != nil && !errors.Is(err, repository.ErrNoRowDeleted) {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("delete otp: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *otpService) recordFailure(ctx context.Context, userID uuid.UUID, reason string) {
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventOTP,
+		UserID:    userID,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    reason,
+	})
+}
+
+// generateOTPCode returns a random code of length digits.
+func generateOTPCode(length int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", length, n), nil
+}
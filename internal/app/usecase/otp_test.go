@@ -0,0 +1,345 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var otpCodeRe = regexp.MustCompile(`\b\d{6}\b`)
+
+var otpOptions = usecase.OTPOptions{
+	TTL:         time.Minute * 5,
+	MaxAttempts: 3,
+}
+
+func TestOTPService_RequestOTP(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	otpRepo := &mocks.OTPRepositoryMock{}
+	attributeRepo := &mocks.UserAttributeRepositoryMock{}
+	auditService := &mocks.AuditServiceMock{}
+	emailSender := &mocks.NotifierMock{}
+	smsSender := &mocks.NotifierMock{}
+	senders := map[string]usecase.Notifier{
+		domain.OTPChannelEmail: emailSender,
+		domain.OTPChannelSMS:   smsSender,
+	}
+	service := usecase.NewOTPService(authRepo, otpRepo, attributeRepo, &mocks.TokenRepositoryMock{}, &mocks.TokenServiceMock{}, auditService, senders, usecase.SessionOptions{}, otpOptions)
+
+	u := &domain.UserWithPassword{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(u, nil)
+
+	expectFailure := func(reason string) {
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventOTP && e.Outcome == domain.OutcomeFailure && e.Reason == reason
+		})).Once()
+	}
+
+	t.Run("sends a code by email", func(t *testing.T) {
+		otpRepo.On("GetOTP", mock.Anything, u.UserID).Return(nil, repository.ErrNotFound).Once()
+		otpRepo.On("CountOTPRequest", mock.Anything, u.UserID, time.Hour).Return(1, nil).Once()
+
+		var codeHash string
+		otpRepo.On("SaveOTP", mock.Anything, u.UserID, domain.OTPChannelEmail, mock.AnythingOfType("string"), true, mock.MatchedBy(func(expiresAt time.Time) bool {
+			return time.Until(expiresAt) > time.Minute*4 && time.Until(expiresAt) <= time.Minute*5
+		})).Run(func(args mock.Arguments) {
+			codeHash = args.String(3)
+		}).Return(nil).Once()
+
+		var sent domain.Message
+		emailSender.On("Notify", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			sent = args.Get(1).(domain.Message)
+		}).Return(nil).Once()
+
+		err := service.RequestOTP(context.Background(), u.Email, domain.OTPChannelEmail, true)
+		assert.NoError(t, err)
+
+		assert.Equal(t, u.Email, sent.To)
+		code := otpCodeRe.FindString(sent.Body)
+		assert.Len(t, code, 6)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(codeHash), []byte(code)), "only the hash is stored")
+	})
+
+	t.Run("sends a code by sms", func(t *testing.T) {
+		otpRepo.On("GetOTP", mock.Anything, u.UserID).Return(&domain.OTP{UserID: u.UserID, CreatedAt: time.Now().Add(-time.Minute)}, nil).Once()
+		attributeRepo.On("ListUserAttributes", mock.Anything, u.UserID).Return([]domain.UserAttribute{
+			{Name: "department", Value: json.RawMessage(`"sales"`)},
+			{Name: "phone_number", Value: json.RawMessage(`"+15551234567"`)},
+		}, nil).Once()
+		otpRepo.On("CountOTPRequest", mock.Anything, u.UserID, time.Hour).Return(2, nil).Once()
+		otpRepo.On("SaveOTP", mock.Anything, u.UserID, domain.OTPChannelSMS, mock.AnythingOfType("string"), false, mock.Anything).Return(nil).Once()
+
+		var sent domain.Message
+		smsSender.On("Notify", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			sent = args.Get(1).(domain.Message)
+		}).Return(nil).Once()
+
+		err := service.RequestOTP(context.Background(), u.Email, domain.OTPChannelSMS, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "+15551234567", sent.To)
+	})
+
+	t.Run("no phone number", func(t *testing.T) {
+		otpRepo.On("GetOTP", mock.Anything, u.UserID).Return(nil, repository.ErrNotFound).Once()
+		attributeRepo.On("ListUserAttributes", mock.Anything, u.UserID).Return(nil, nil).Once()
+		expectFailure(domain.ReasonNoPhoneNumber)
+
+		err := service.RequestOTP(context.Background(), u.Email, domain.OTPChannelSMS, false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("resend too soon", func(t *testing.T) {
+		otpRepo.On("GetOTP", mock.Anything, u.UserID).Return(&domain.OTP{UserID: u.UserID, CreatedAt: time.Now()}, nil).Once()
+		expectFailure(domain.ReasonResendTooSoon)
+
+		err := service.RequestOTP(context.Background(), u.Email, domain.OTPChannelEmail, false)
+		assert.NoError(t, err, "the pending code stays valid")
+	})
+
+	t.Run("too many codes", func(t *testing.T) {
+		otpRepo.On("GetOTP", mock.Anything, u.UserID).Return(nil, repository.ErrNotFound).Once()
+		otpRepo.On("CountOTPRequest", mock.Anything, u.UserID, time.Hour).Return(6, nil).Once()
+		expectFailure(domain.ReasonTooManyCodes)
+
+		err := service.RequestOTP(context.Background(), u.Email, domain.OTPChannelEmail, false)
+		assert.NoError(t, err, "the call looks the same to the caller")
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		authRepo.On("FindUserByEmail", mock.Anything, "unknown@example.org").Return(nil, repository.ErrNotFound).Once()
+		expectFailure(domain.ReasonUserNotFound)
+
+		err := service.RequestOTP(context.Background(), "unknown@example.org", domain.OTPChannelEmail, false)
+		assert.NoError(t, err, "the caller must not learn whether the email is registered")
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := service.RequestOTP(context.Background(), "not-an-email", domain.OTPChannelEmail, false)
+		assert.ErrorIs(t, err, domain.ErrInvalidEmail)
+
+		err = service.RequestOTP(context.Background(), u.Email, "pigeon", false)
+		assert.ErrorIs(t, err, domain.ErrInvalidOTPChannel)
+	})
+
+	authRepo.AssertExpectations(t)
+	otpRepo.AssertExpectations(t)
+	attributeRepo.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+	emailSender.AssertExpectations(t)
+	smsSender.AssertExpectations(t)
+}
+
+func TestOTPService_GuessesAreCapped(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	otpRepo := &mocks.OTPRepositoryMock{}
+	auditService := &mocks.AuditServiceMock{}
+	emailSender := &mocks.NotifierMock{}
+	opts := otpOptions
+	opts.ResendInterval = time.Nanosecond
+	opts.MaxCodes = 3
+	service := usecase.NewOTPService(authRepo, otpRepo, &mocks.UserAttributeRepositoryMock{}, &mocks.TokenRepositoryMock{}, &mocks.TokenServiceMock{}, auditService, map[string]usecase.Notifier{domain.OTPChannelEmail: emailSender}, usecase.SessionOptions{}, opts)
+
+	u := &domain.UserWithPassword{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(u, nil)
+
+	// The repository keeps the pending code and counts the codes sent, like the
+	// storage adapters do.
+	var (
+		pending  *domain.OTP
+		requests int
+	)
+	otpRepo.EXPECT().GetOTP(mock.Anything, u.UserID).RunAndReturn(func(context.Context, uuid.UUID) (*domain.OTP, error) {
+		if pending == nil {
+			return nil, repository.ErrNotFound
+		}
+		c := *pending
+		return &c, nil
+	})
+	otpRepo.EXPECT().CountOTPRequest(mock.Anything, u.UserID, time.Hour).RunAndReturn(func(context.Context, uuid.UUID, time.Duration) (int, error) {
+		requests++
+		return requests, nil
+	})
+	otpRepo.EXPECT().SaveOTP(mock.Anything, u.UserID, domain.OTPChannelEmail, mock.Anything, false, mock.Anything).RunAndReturn(func(_ context.Context, userID uuid.UUID, channel string, codeHash string, rememberMe bool, expiresAt time.Time) error {
+		pending = &domain.OTP{UserID: userID, Channel: channel, CodeHash: codeHash, ExpiresAt: expiresAt, CreatedAt: time.Now().Add(-time.Second)}
+		return nil
+	})
+	otpRepo.EXPECT().AttemptOTP(mock.Anything, u.UserID).RunAndReturn(func(context.Context, uuid.UUID) (*domain.OTP, error) {
+		if pending == nil {
+			return nil, repository.ErrNotFound
+		}
+		pending.Attempts++
+		c := *pending
+		return &c, nil
+	})
+	otpRepo.EXPECT().DeleteOTP(mock.Anything, u.UserID, mock.Anything).RunAndReturn(func(context.Context, uuid.UUID, string) error {
+		pending = nil
+		return nil
+	})
+
+	var codes []string
+	emailSender.On("Notify", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		codes = append(codes, otpCodeRe.FindString(args.Get(1).(domain.Message).Body))
+	}).Return(nil)
+
+	var guesses int
+	auditService.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		if args.Get(1).(domain.AuthEvent).Reason == domain.ReasonWrongCode {
+			guesses++
+		}
+	}).Return()
+
+	// Whoever keeps asking for new codes and guessing each of them wrong until it is
+	// discarded runs out of codes.
+	for range 10 {
+		assert.NoError(t, service.RequestOTP(context.Background(), u.Email, domain.OTPChannelEmail, false))
+		wrong := "000000"
+		if len(codes) > 0 && codes[len(codes)-1] == wrong {
+			wrong = "111111"
+		}
+		for range opts.MaxAttempts {
+			_, err := service.LoginWithOTP(context.Background(), u.Email, wrong)
+			assert.ErrorIs(t, err, domain.ErrInvalidOTP)
+		}
+	}
+
+	assert.Len(t, codes, opts.MaxCodes)
+	assert.Equal(t, opts.MaxCodes*opts.MaxAttempts, guesses)
+}
+
+func TestOTPService_RequestOTPWithoutSMS(t *testing.T) {
+	senders := map[string]usecase.Notifier{domain.OTPChannelEmail: &mocks.NotifierMock{}}
+	service := usecase.NewOTPService(&mocks.AuthRepositoryMock{}, &mocks.OTPRepositoryMock{}, &mocks.UserAttributeRepositoryMock{}, &mocks.TokenRepositoryMock{}, &mocks.TokenServiceMock{}, newAuditServiceMock(), senders, usecase.SessionOptions{}, otpOptions)
+
+	err := service.RequestOTP(context.Background(), "user@example.org", domain.OTPChannelSMS, false)
+	assert.ErrorIs(t, err, domain.ErrInvalidOTPChannel)
+}
+
+func TestOTPService_LoginWithOTP(t *testing.T) {
+	authRepo := &mocks.AuthRepositoryMock{}
+	otpRepo := &mocks.OTPRepositoryMock{}
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	service := usecase.NewOTPService(authRepo, otpRepo, &mocks.UserAttributeRepositoryMock{}, tokenRepo, tokenService, auditService, nil, usecase.SessionOptions{}, otpOptions)
+
+	u := &domain.UserWithPassword{UserID: uuid.New(), Email: "user@example.org", IsActive: true}
+	authRepo.On("FindUserByEmail", mock.Anything, u.Email).Return(u, nil)
+
+	codeHash, err := usecase.HashPassword(context.Background(), "123456")
+	if !assert.NoError(t, err) {
+		return
+	}
+	pending := func(attempts int, expiresIn time.Duration) *domain.OTP {
+		return &domain.OTP{
+			UserID:     u.UserID,
+			Channel:    domain.OTPChannelEmail,
+			CodeHash:   codeHash,
+			Attempts:   attempts,
+			RememberMe: true,
+			ExpiresAt:  time.Now().Add(expiresIn),
+		}
+	}
+	expectFailure := func(reason string) {
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventOTP && e.Outcome == domain.OutcomeFailure && e.Reason == reason
+		})).Once()
+	}
+
+	t.Run("starts a session", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour * 24 * 30)
+		otpRepo.On("AttemptOTP", mock.Anything, u.UserID).Return(pending(1, time.Minute), nil).Once()
+		otpRepo.On("DeleteOTP", mock.Anything, u.UserID, codeHash).Return(nil).Once()
+		tokenService.On("GenerateAccessToken", mock.Anything, u.UserID, "").Return("access-token", nil).Once()
+		tokenService.On("GenerateRefreshToken").Return("refresh-token", nil).Once()
+		tokenService.On("HashRefreshToken", "refresh-token", "").Return("refresh-token-hash", expiresAt).Once()
+		tokenRepo.On("SaveHashedRefreshToken", mock.Anything, u.UserID, "refresh-token-hash", expiresAt, mock.Anything, true, "", "", "").Return(nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventOTP && e.Outcome == domain.OutcomeSuccess && e.UserID == u.UserID
+		})).Once()
+
+		tokens, err := service.LoginWithOTP(context.Background(), u.Email, "123456")
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", tokens.AccessToken)
+		assert.Equal(t, "refresh-token", tokens.RefreshToken)
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		otpRepo.On("AttemptOTP", mock.Anything, u.UserID).Return(pending(1, time.Minute), nil).Once()
+		expectFailure(domain.ReasonWrongCode)
+
+		_, err := service.LoginWithOTP(context.Background(), u.Email, "654321")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP, "the code can be tried again")
+	})
+
+	t.Run("wrong code on the last attempt", func(t *testing.T) {
+		otpRepo.On("AttemptOTP", mock.Anything, u.UserID).Return(pending(3, time.Minute), nil).Once()
+		otpRepo.On("DeleteOTP", mock.Anything, u.UserID, codeHash).Return(nil).Once()
+		expectFailure(domain.ReasonWrongCode)
+
+		_, err := service.LoginWithOTP(context.Background(), u.Email, "654321")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP)
+	})
+
+	t.Run("too many attempts", func(t *testing.T) {
+		otpRepo.On("AttemptOTP", mock.Anything, u.UserID).Return(pending(4, time.Minute), nil).Once()
+		otpRepo.On("DeleteOTP", mock.Anything, u.UserID, codeHash).Return(repository.ErrNoRowDeleted).Once()
+		expectFailure(domain.ReasonTooManyAttempts)
+
+		_, err := service.LoginWithOTP(context.Background(), u.Email, "123456")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP, "even the right code is rejected")
+	})
+
+	t.Run("expired code", func(t *testing.T) {
+		otpRepo.On("AttemptOTP", mock.Anything, u.UserID).Return(pending(1, -time.Second), nil).Once()
+		otpRepo.On("DeleteOTP", mock.Anything, u.UserID, codeHash).Return(nil).Once()
+		expectFailure(domain.ReasonExpiredToken)
+
+		_, err := service.LoginWithOTP(context.Background(), u.Email, "123456")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP)
+	})
+
+	t.Run("no pending code", func(t *testing.T) {
+		otpRepo.On("AttemptOTP", mock.Anything, u.UserID).Return(nil, repository.ErrNotFound).Once()
+		expectFailure(domain.ReasonUnknownToken)
+
+		_, err := service.LoginWithOTP(context.Background(), u.Email, "123456")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP)
+
+		_, err = service.LoginWithOTP(context.Background(), u.Email, "")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP)
+	})
+
+	t.Run("code used concurrently", func(t *testing.T) {
+		otpRepo.On("AttemptOTP", mock.Anything, u.UserID).Return(pending(1, time.Minute), nil).Once()
+		otpRepo.On("DeleteOTP", mock.Anything, u.UserID, codeHash).Return(repository.ErrNoRowDeleted).Once()
+		expectFailure(domain.ReasonUnknownToken)
+
+		_, err := service.LoginWithOTP(context.Background(), u.Email, "123456")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		authRepo.On("FindUserByEmail", mock.Anything, "unknown@example.org").Return(nil, repository.ErrNotFound).Once()
+		expectFailure(domain.ReasonUserNotFound)
+
+		_, err := service.LoginWithOTP(context.Background(), "unknown@example.org", "123456")
+		assert.ErrorIs(t, err, domain.ErrInvalidOTP)
+	})
+
+	authRepo.AssertExpectations(t)
+	otpRepo.AssertExpectations(t)
+	tokenRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
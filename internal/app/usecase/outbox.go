@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// EventSink delivers outbox events to an external system. Delivery is at-least-once,
+// so sinks must pass event.ID on as an idempotency key for consumers to deduplicate.
+type EventSink interface {
+	Name() string
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
+
+type OutboxRelay interface {
+	Run(ctx context.Context)
+	RelayBatch(ctx context.Context) (int, error)
+	// PurgePublished deletes events published longer than Retention ago.
+	PurgePublished(ctx context.Context) (int64, error)
+}
+
+const (
+	defaultOutboxPollInterval = time.Second * 2
+	defaultOutboxBatchSize    = 100
+	defaultOutboxMaxAttempts  = 10
+	defaultOutboxBaseBackoff  = time.Second
+	defaultOutboxMaxBackoff   = time.Hour
+	defaultOutboxLease        = time.Minute * 10
+)
+
+// OutboxRelayOptions sets how often the relay polls, how many events it claims at once
+// and how failed deliveries are retried. Zero values fall back to a poll every 2
+// seconds in batches of 100, with 10 attempts backing off from a second up to an hour.
+type OutboxRelayOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	// Lease is how long claimed events are skipped by other relays while they are
+	// published. Events of a relay that stops midway are claimed again once it runs
+	// out. Zero means 10 minutes.
+	Lease time.Duration
+	// Retention is how long published events are kept. Zero keeps them forever.
+	Retention time.Duration
+}
+
+type outboxRelay struct {
+	transactor repository.Transactor
+	outboxRepo repository.OutboxRepository
+	sinks      []EventSink
+	log        *slog.Logger
+	opts       OutboxRelayOptions
+}
+
+func NewOutboxRelay(transactor repository.Transactor, outboxRepo repository.OutboxRepository, sinks []EventSink, log *slog.Logger, opts OutboxRelayOptions) OutboxRelay {
+	opts.PollInterval = cmp.Or(opts.PollInterval, defaultOutboxPollInterval)
+	opts.BatchSize = cmp.Or(opts.BatchSize, defaultOutboxBatchSize)
+	opts.MaxAttempts = cmp.Or(opts.MaxAttempts, defaultOutboxMaxAttempts)
+	opts.BaseBackoff = cmp.Or(opts.BaseBackoff, defaultOutboxBaseBackoff)
+	opts.MaxBackoff = cmp.Or(opts.MaxBackoff, defaultOutboxMaxBackoff)
+	opts.Lease = cmp.Or(opts.Lease, defaultOutboxLease)
+
+	return &outboxRelay{
+		transactor: transactor,
+		outboxRepo: outboxRepo,
+		sinks:      sinks,
+		log:        log,
+		opts:       opts,
+	}
+}
+
+func (r *outboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := r.RelayBatch(ctx)
+			if err != nil {
+				r.log.Error("outbox relay failed", "error", err)
+				break
+			}
+			if n < r.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayBatch claims up to BatchSize pending events, publishes each of them to every
+// sink and records the result. It returns the number of claimed events. Events are
+// leased for Lease while they are published, so sinks run outside any transaction.
+func (r *outboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	var events []domain.OutboxEvent
+
+	err := r.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		claimed, err := r.outboxRepo.ClaimOutboxEvents(ctx, r.opts.BatchSize, r.opts.MaxAttempts, time.Now().Add(r.opts.Lease))
+		if err != nil {
+			return fmt.Errorf("claim outbox events: %w", err)
+		}
+		events = claimed
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return 0, domain.ErrGatewayTimeout
+		}
+		return 0, err
+	}
+
+	for _, e := range events {
+		if err := r.relay(ctx, e); err != nil {
+			if errors.Is(err, repository.ErrGatewayTimeout) {
+				return 0, domain.ErrGatewayTimeout
+			}
+			return 0, err
+		}
+	}
+
+	return len(events), nil
+}
+
+// relay publishes e and records the result.
+func (r *outboxRelay) relay(ctx context.Context, e domain.OutboxEvent) error {
+	if err := r.publish(ctx, e); err != nil {
+		attempt := e.Attempts + 1
+		r.log.Warn("outbox event delivery failed",
+			"event_id", e.ID,
+			"event_type", e.EventType,
+			"attempt", attempt,
+			"error", err,
+		)
+
+		if attempt >= r.opts.MaxAttempts {
+			r.log.Error("outbox event dead-lettered", "event_id", e.ID, "event_type", e.EventType)
+		}
+
+		next := time.Now().Add(retryBackoff(r.opts.BaseBackoff, r.opts.MaxBackoff, attempt))
+		if err := r.outboxRepo.MarkOutboxEventFailed(ctx, e.ID, next, err.Error()); err != nil {
+			return fmt.Errorf("mark outbox event failed: %w", err)
+		}
+		return nil
+	}
+
+	if err := r.outboxRepo.MarkOutboxEventPublished(ctx, e.ID); err != nil {
+		return fmt.Errorf("mark outbox event published: %w", err)
+	}
+	return nil
+}
+
+// PurgePublished deletes events published longer than Retention ago. A zero retention
+// keeps events forever.
+func (r *outboxRelay) PurgePublished(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "OutboxRelay.PurgePublished")
+	defer span.End()
+
+	if r.opts.Retention <= 0 {
+		return 0, nil
+	}
+
+	n, err := r.outboxRepo.DeletePublishedOutboxEventsBefore(ctx, time.Now().Add(-r.opts.Retention))
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return 0, domain.ErrGatewayTimeout
+		} else {
+			return 0, fmt.Errorf("delete published outbox events: %w", err)
+		}
+	}
+
+	return n, nil
+}
+
+func (r *outboxRelay) publish(ctx context.Context, e domain.OutboxEvent) error {
+	var errs []error
+	for _, sink := range r.sinks {
+		if err := sink.Publish(ctx, e); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// retryBackoff doubles base with every failed attempt, capped at max.
+func retryBackoff(base time.Duration, max time.Duration, attempt int) time.Duration {
+	d := base << (attempt - 1)
+	if d <= 0 || d > max {
+		return max
+	}
+	return d
+}
+
+func enqueueEvent(ctx context.Context, outboxRepo repository.OutboxRepository, aggregateID uuid.UUID, eventType string, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal %s payload: %w", eventType, err)
+	}
+
+	if err := outboxRepo.CreateOutboxEvent(ctx, aggregateID, eventType, b); err != nil {
+		return fmt.Errorf("create outbox event: %w", err)
+	}
+
+	return nil
+}
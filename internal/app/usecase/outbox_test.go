@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestOutboxRelay_RelayBatch(t *testing.T) {
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	sink := &mocks.EventSinkMock{}
+	// Sinks may be slow, so they must not run while the claim transaction is open.
+	var inTx bool
+	transactor := &mocks.TransactorMock{}
+	transactor.EXPECT().WithinTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		inTx = true
+		defer func() { inTx = false }()
+		return fn(ctx)
+	})
+	relay := usecase.NewOutboxRelay(transactor, outboxRepo, []usecase.EventSink{sink}, slog.Default(), usecase.OutboxRelayOptions{
+		PollInterval: time.Second,
+		BatchSize:    10,
+		MaxAttempts:  5,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   time.Minute,
+		Lease:        time.Minute,
+	})
+
+	delivered := domain.OutboxEvent{ID: uuid.New(), AggregateID: uuid.New(), EventType: domain.EventUserRegistered}
+	failed := domain.OutboxEvent{ID: uuid.New(), AggregateID: uuid.New(), EventType: domain.EventUserRegistered, Attempts: 2}
+
+	leased := mock.MatchedBy(func(until time.Time) bool {
+		return until.After(time.Now().Add(time.Second*50)) && until.Before(time.Now().Add(time.Minute))
+	})
+	outboxRepo.On("ClaimOutboxEvents", mock.Anything, 10, 5, leased).Return([]domain.OutboxEvent{delivered, failed}, nil).Once()
+	sink.On("Name").Return("test").Maybe()
+	sink.On("Publish", mock.Anything, delivered).Run(func(mock.Arguments) { assert.False(t, inTx) }).Return(nil).Once()
+	sink.On("Publish", mock.Anything, failed).Run(func(mock.Arguments) { assert.False(t, inTx) }).Return(errors.New("connection refused")).Once()
+	outboxRepo.On("MarkOutboxEventPublished", mock.Anything, delivered.ID).Return(nil).Once()
+
+	before := time.Now()
+	outboxRepo.On("MarkOutboxEventFailed", mock.Anything, failed.ID, mock.MatchedBy(func(next time.Time) bool {
+		// third attempt: 1s << 2
+		return !next.Before(before.Add(time.Second*4)) && next.Before(time.Now().Add(time.Second*5))
+	}), "test: connection refused").Return(nil).Once()
+
+	n, err := relay.RelayBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	outboxRepo.On("ClaimOutboxEvents", mock.Anything, 10, 5, mock.Anything).Return([]domain.OutboxEvent{}, nil).Once()
+
+	n, err = relay.RelayBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	outboxRepo.AssertExpectations(t)
+	sink.AssertExpectations(t)
+}
+
+func TestOutboxRelay_RunDefaults(t *testing.T) {
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	relay := usecase.NewOutboxRelay(newTransactorMock(), outboxRepo, nil, slog.Default(), usecase.OutboxRelayOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Unset options fall back to defaults instead of a ticker without an interval
+	// and batches that claim nothing.
+	outboxRepo.On("ClaimOutboxEvents", mock.Anything, 100, 10, mock.Anything).Run(func(mock.Arguments) { cancel() }).Return([]domain.OutboxEvent{}, nil).Once()
+
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("relay did not stop")
+	}
+
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestOutboxRelay_PurgePublished(t *testing.T) {
+	outboxRepo := &mocks.OutboxRepositoryMock{}
+	relay := usecase.NewOutboxRelay(newTransactorMock(), outboxRepo, nil, slog.Default(), usecase.OutboxRelayOptions{Retention: time.Hour * 24})
+
+	outboxRepo.On("DeletePublishedOutboxEventsBefore", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= time.Hour*24
+	})).Return(int64(3), nil).Once()
+
+	n, err := relay.PurgePublished(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	disabled := usecase.NewOutboxRelay(newTransactorMock(), outboxRepo, nil, slog.Default(), usecase.OutboxRelayOptions{})
+
+	n, err = disabled.PurgePublished(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	outboxRepo.AssertExpectations(t)
+}
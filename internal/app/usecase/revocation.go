@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// revocationSweepInterval is how often expired entries are dropped from the cache.
+const revocationSweepInterval = time.Minute
+
+// RevocationService keeps access tokens from being accepted after logout, revocation of
+// all sessions or deactivation, although they are otherwise verified without storage.
+type RevocationService interface {
+	// RevokeAccessToken revokes a single access token until it expires.
+	RevokeAccessToken(ctx context.Context, claims *jwt.RegisteredClaims) error
+	// RevokeUserAccessTokens revokes every access token issued to the user so far.
+	// Tokens carry their issue time in whole seconds, so a token issued later within
+	// the same second is revoked as well.
+	RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID) error
+	// CheckAccessToken returns domain.ErrRevokedAccessToken if the token was revoked.
+	CheckAccessToken(ctx context.Context, claims *jwt.RegisteredClaims) error
+}
+
+type RevocationOptions struct {
+	// CacheTTL is how long a token found not revoked is accepted without asking storage
+	// again, and so how long a revocation made by another replica or the CLI can take
+	// to apply. Revoked tokens stay cached until they expire.
+	CacheTTL time.Duration
+	// AccessTTL is the longest lifetime of an access token, as returned by
+	// TokenOptions.MaxAccessTTL. Zero means the default lifetime.
+	AccessTTL time.Duration
+	// Leeway is the clock skew the token service allows. Revocations are kept that much
+	// longer than the tokens they revoke are valid.
+	Leeway time.Duration
+}
+
+type revocationService struct {
+	revocationRepo repository.RevocationRepository
+	opts           RevocationOptions
+
+	mu        sync.Mutex
+	cache     map[string]revocationEntry
+	nextSweep time.Time
+}
+
+type revocationEntry struct {
+	userID  uuid.UUID
+	revoked bool
+	until   time.Time
+}
+
+func NewRevocationService(revocationRepo repository.RevocationRepository, opts RevocationOptions) RevocationService {
+	return &revocationService{
+		revocationRepo: revocationRepo,
+		opts:           opts,
+		cache:          make(map[string]revocationEntry),
+	}
+}
+
+func (s *revocationService) RevokeAccessToken(ctx context.Context, claims *jwt.RegisteredClaims) error {
+	ctx, span := tracer.Start(ctx, "RevocationService.RevokeAccessToken")
+	defer span.End()
+
+	// Tokens issued before jti was introduced cannot be told apart and expire shortly.
+	if claims.ID == "" {
+		return nil
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return domain.ErrInvalidAccessToken
+	}
+	expiresAt := s.tokenExpiry(claims)
+
+	if err := s.revocationRepo.RevokeAccessToken(ctx, claims.ID, userID, expiresAt); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("revoke access token: %w", err)
+		}
+	}
+
+	s.store(claims.ID, revocationEntry{userID: userID, revoked: true, until: expiresAt})
+
+	return nil
+}
+
+func (s *revocationService) RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "RevocationService.RevokeUserAccessTokens")
+	defer span.End()
+
+	now := time.Now()
+	expiresAt := now.Add(cmp.Or(s.opts.AccessTTL, defaultAccessTokenTTL) + s.opts.Leeway)
+
+	if err := s.revocationRepo.RevokeUserAccessTokens(ctx, userID, now, expiresAt); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("revoke user access tokens: %w", err)
+		}
+	}
+
+	s.forgetUser(userID)
+
+	return nil
+}
+
+func (s *revocationService) CheckAccessToken(ctx context.Context, claims *jwt.RegisteredClaims) error {
+	ctx, span := tracer.Start(ctx, "RevocationService.CheckAccessToken")
+	defer span.End()
+
+	if e, ok := s.lookup(claims.ID); ok {
+		if e.revoked {
+			return domain.ErrRevokedAccessToken
+		}
+		return nil
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return domain.ErrInvalidAccessToken
+	}
+
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+
+	revoked, err := s.revocationRepo.IsAccessTokenRevoked(ctx, claims.ID, userID, issuedAt)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("check access token: %w", err)
+		}
+	}
+
+	until := s.tokenExpiry(claims)
+	if ttl := time.Now().Add(s.opts.CacheTTL); !revoked && ttl.Before(until) {
+		until = ttl
+	}
+	s.store(claims.ID, revocationEntry{userID: userID, revoked: revoked, until: until})
+
+	if revoked {
+		return domain.ErrRevokedAccessToken
+	}
+
+	return nil
+}
+
+func (s *revocationService) lookup(jti string) (revocationEntry, bool) {
+	if jti == "" {
+		return revocationEntry{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.cache[jti]
+	if !ok || !time.Now().Before(e.until) {
+		return revocationEntry{}, false
+	}
+
+	return e, true
+}
+
+func (s *revocationService) store(jti string, e revocationEntry) {
+	if jti == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.After(s.nextSweep) {
+		for k, v := range s.cache {
+			if !now.Before(v.until) {
+				delete(s.cache, k)
+			}
+		}
+		s.nextSweep = now.Add(revocationSweepInterval)
+	}
+
+	s.cache[jti] = e
+}
+
+// forgetUser drops the cached answers for the user's tokens, so the next check sees a
+// revocation of all of them.
+func (s *revocationService) forgetUser(userID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, v := range s.cache {
+		if v.userID == userID {
+			delete(s.cache, k)
+		}
+	}
+}
+
+// tokenExpiry returns when the token stops being accepted, assuming the longest
+// lifetime for a token without an exp claim.
+func (s *revocationService) tokenExpiry(claims *jwt.RegisteredClaims) time.Time {
+	if claims.ExpiresAt != nil {
+		return claims.ExpiresAt.Add(s.opts.Leeway)
+	}
+	return time.Now().Add(cmp.Or(s.opts.AccessTTL, defaultAccessTokenTTL) + s.opts.Leeway)
+}
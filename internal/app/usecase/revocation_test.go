@@ -0,0 +1,107 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func newAccessClaims(userID uuid.UUID) *jwt.RegisteredClaims {
+	now := time.Now()
+	return &jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		Subject:   userID.String(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute * 15)),
+	}
+}
+
+func TestRevocationService_RevokeAccessToken(t *testing.T) {
+	revocationRepo := &mocks.RevocationRepositoryMock{}
+	revocationService := usecase.NewRevocationService(revocationRepo, usecase.RevocationOptions{CacheTTL: time.Minute})
+
+	userID := uuid.New()
+	claims := newAccessClaims(userID)
+
+	revocationRepo.On("RevokeAccessToken", mock.Anything, claims.ID, userID, claims.ExpiresAt.Time).Return(nil).Once()
+
+	err := revocationService.RevokeAccessToken(context.Background(), claims)
+	assert.NoError(t, err)
+
+	// The revocation is cached, so the check does not reach storage.
+	err = revocationService.CheckAccessToken(context.Background(), claims)
+	assert.ErrorIs(t, err, domain.ErrRevokedAccessToken)
+
+	other := newAccessClaims(userID)
+	revocationRepo.On("RevokeAccessToken", mock.Anything, other.ID, userID, other.ExpiresAt.Time).Return(repository.ErrGatewayTimeout).Once()
+
+	err = revocationService.RevokeAccessToken(context.Background(), other)
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	revocationRepo.AssertExpectations(t)
+	revocationRepo.AssertNotCalled(t, "IsAccessTokenRevoked", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRevocationService_CheckAccessToken(t *testing.T) {
+	revocationRepo := &mocks.RevocationRepositoryMock{}
+	revocationService := usecase.NewRevocationService(revocationRepo, usecase.RevocationOptions{CacheTTL: time.Minute})
+
+	userID := uuid.New()
+	claims := newAccessClaims(userID)
+
+	// A token found not revoked is cached for the cache TTL.
+	revocationRepo.On("IsAccessTokenRevoked", mock.Anything, claims.ID, userID, claims.IssuedAt.Time).Return(false, nil).Once()
+
+	err := revocationService.CheckAccessToken(context.Background(), claims)
+	assert.NoError(t, err)
+	err = revocationService.CheckAccessToken(context.Background(), claims)
+	assert.NoError(t, err)
+
+	// Revoking all tokens of the user drops the cached answer.
+	revocationRepo.On("RevokeUserAccessTokens", mock.Anything, userID, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(nil).Once()
+	revocationRepo.On("IsAccessTokenRevoked", mock.Anything, claims.ID, userID, claims.IssuedAt.Time).Return(true, nil).Once()
+
+	err = revocationService.RevokeUserAccessTokens(context.Background(), userID)
+	assert.NoError(t, err)
+	err = revocationService.CheckAccessToken(context.Background(), claims)
+	assert.ErrorIs(t, err, domain.ErrRevokedAccessToken)
+	err = revocationService.CheckAccessToken(context.Background(), claims)
+	assert.ErrorIs(t, err, domain.ErrRevokedAccessToken)
+
+	other := newAccessClaims(userID)
+	revocationRepo.On("IsAccessTokenRevoked", mock.Anything, other.ID, userID, other.IssuedAt.Time).Return(false, repository.ErrGatewayTimeout).Once()
+
+	err = revocationService.CheckAccessToken(context.Background(), other)
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	revocationRepo.AssertExpectations(t)
+}
+
+func TestRevocationService_CheckAccessTokenCacheTTL(t *testing.T) {
+	revocationRepo := &mocks.RevocationRepositoryMock{}
+	revocationService := usecase.NewRevocationService(revocationRepo, usecase.RevocationOptions{})
+
+	userID := uuid.New()
+	claims := newAccessClaims(userID)
+
+	// Without a cache TTL every check of a token not revoked asks storage, so a
+	// revocation made elsewhere applies immediately.
+	revocationRepo.On("IsAccessTokenRevoked", mock.Anything, claims.ID, userID, claims.IssuedAt.Time).Return(false, nil).Once()
+	revocationRepo.On("IsAccessTokenRevoked", mock.Anything, claims.ID, userID, claims.IssuedAt.Time).Return(true, nil).Once()
+
+	err := revocationService.CheckAccessToken(context.Background(), claims)
+	assert.NoError(t, err)
+	err = revocationService.CheckAccessToken(context.Background(), claims)
+	assert.ErrorIs(t, err, domain.ErrRevokedAccessToken)
+
+	revocationRepo.AssertExpectations(t)
+}
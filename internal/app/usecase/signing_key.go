@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	signingKeySecretLen = 32
+	signingKeyIDLen     = 8
+)
+
+// SigningKeyService manages the keys access tokens are signed with. Rotation retires
+// the previous keys after a grace period instead of immediately, so access tokens
+// already issued keep verifying until they expire.
+type SigningKeyService interface {
+	RotateSigningKey(ctx context.Context) (*domain.SigningKey, error)
+	ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error)
+	// ReloadSigningKeys loads the current keys from storage into the token service.
+	ReloadSigningKeys(ctx context.Context) error
+}
+
+type signingKeyService struct {
+	signingKeyRepo repository.SigningKeyRepository
+	tokenService   TokenService
+	transactor     repository.Transactor
+	retireGrace    time.Duration
+}
+
+func NewSigningKeyService(signingKeyRepo repository.SigningKeyRepository, tokenService TokenService, transactor repository.Transactor, retireGrace time.Duration) SigningKeyService {
+	return &signingKeyService{
+		signingKeyRepo: signingKeyRepo,
+		tokenService:   tokenService,
+		transactor:     transactor,
+		retireGrace:    retireGrace,
+	}
+}
+
+func (s *signingKeyService) RotateSigningKey(ctx context.Context) (*domain.SigningKey, error) {
+	ctx, span := tracer.Start(ctx, "SigningKeyService.RotateSigningKey")
+	defer span.End()
+
+	kid, secret, err := generateSigningKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate signing key: %w", err)
+	}
+
+	var key *domain.SigningKey
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		created, err := s.signingKeyRepo.CreateSigningKey(ctx, kid, secret)
+		if err != nil {
+			return err
+		}
+		key = created
+
+		_, err = s.signingKeyRepo.RetireSigningKeys(ctx, created.KID, time.Now().Add(s.retireGrace))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("rotate signing key: %w", err)
+		}
+	}
+
+	return key, nil
+}
+
+func (s *signingKeyService) ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error) {
+	ctx, span := tracer.Start(ctx, "SigningKeyService.ListSigningKeys")
+	defer span.End()
+
+	keys, err := s.signingKeyRepo.ListSigningKeys(ctx)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list signing keys: %w", err)
+		}
+	}
+
+	return keys, nil
+}
+
+func (s *signingKeyService) ReloadSigningKeys(ctx context.Context) error {
+	keys, err := s.ListSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.tokenService.SetSigningKeys(keys)
+
+	return nil
+}
+
+func generateSigningKey() (string, []byte, error) {
+	id := make([]byte, signingKeyIDLen)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, err
+	}
+
+	secret := make([]byte, signingKeySecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+
+	return hex.EncodeToString(id), secret, nil
+}
@@ -0,0 +1,60 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestSigningKeyService_RotateSigningKey(t *testing.T) {
+	signingKeyRepo := &mocks.SigningKeyRepositoryMock{}
+	signingKeyService := usecase.NewSigningKeyService(signingKeyRepo, &mocks.TokenServiceMock{}, newTransactorMock(), time.Hour)
+
+	var created *domain.SigningKey
+	signingKeyRepo.EXPECT().CreateSigningKey(mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).
+		RunAndReturn(func(_ context.Context, kid string, secret []byte) (*domain.SigningKey, error) {
+			created = &domain.SigningKey{KID: kid, Secret: secret, CreatedAt: time.Now().UTC()}
+			return created, nil
+		}).Once()
+	signingKeyRepo.On("RetireSigningKeys", mock.Anything, mock.AnythingOfType("string"), mock.MatchedBy(func(at time.Time) bool {
+		return at.After(time.Now().Add(time.Minute * 59))
+	})).Return(int64(1), nil).Once()
+
+	res, err := signingKeyService.RotateSigningKey(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+	assert.Len(t, res.Secret, 32)
+	assert.NotEmpty(t, res.KID)
+	signingKeyRepo.AssertCalled(t, "RetireSigningKeys", mock.Anything, res.KID, mock.Anything)
+
+	signingKeyRepo.On("CreateSigningKey", mock.Anything, mock.Anything, mock.Anything).Return(nil, repository.ErrGatewayTimeout).Once()
+
+	_, err = signingKeyService.RotateSigningKey(context.Background())
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	signingKeyRepo.AssertExpectations(t)
+}
+
+func TestSigningKeyService_ReloadSigningKeys(t *testing.T) {
+	signingKeyRepo := &mocks.SigningKeyRepositoryMock{}
+	tokenService := &mocks.TokenServiceMock{}
+	signingKeyService := usecase.NewSigningKeyService(signingKeyRepo, tokenService, newTransactorMock(), time.Hour)
+
+	keys := []domain.SigningKey{{KID: "a", Secret: []byte("secret"), CreatedAt: time.Now().UTC()}}
+
+	signingKeyRepo.On("ListSigningKeys", mock.Anything).Return(keys, nil).Once()
+	tokenService.On("SetSigningKeys", keys).Return().Once()
+
+	err := signingKeyService.ReloadSigningKeys(context.Background())
+	assert.NoError(t, err)
+
+	signingKeyRepo.AssertExpectations(t)
+	tokenService.AssertExpectations(t)
+}
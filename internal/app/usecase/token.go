@@ -1,11 +1,17 @@
 package usecase
 
 import (
+	"cmp"
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
@@ -14,33 +20,299 @@ import (
 	"github.com/vo1dFl0w/auth-service/internal/app/repository"
 )
 
+const (
+	defaultAccessTokenTTL  = time.Minute * 15
+	defaultRefreshTokenTTL = time.Hour * 24 * 7
+	defaultKeyReloadDelay  = time.Second * 10
+	keyReloadTimeout       = time.Second * 2
+)
+
+// TokenService issues and verifies tokens. Lifetimes and audiences can be overridden
+// per client; clientID names the client a token is issued to, and an unknown or empty
+// one gets the defaults.
 type TokenService interface {
-	GenerateAccessToken(userID uuid.UUID) (string, error)
+	// GenerateAccessToken issues an access token carrying the claims of the configured
+	// claims providers besides the registered ones.
+	GenerateAccessToken(ctx context.Context, userID uuid.UUID, clientID string) (string, error)
+	// GenerateExchangedToken issues an access token for userID to audiences, limited to
+	// scopes and acting for actor, the client that exchanged a token for it. It expires
+	// no later than notAfter, the expiry of the exchanged token.
+	GenerateExchangedToken(ctx context.Context, userID uuid.UUID, actor string, audiences []string, scopes []string, notAfter time.Time) (string, time.Time, error)
 	GenerateRefreshToken() (string, error)
-	HashRefreshToken(refreshToken string) (string, time.Time)
-	ValidateAccessToken(accessToken string) (*jwt.RegisteredClaims, error)
+	HashRefreshToken(refreshToken string, clientID string) (string, time.Time)
+	ValidateAccessToken(accessToken string) (*AccessClaims, error)
+	SigningKeyLoaded() bool
+	// SetSigningKeys replaces the keys loaded from storage. The newest unretired key
+	// signs new tokens; every key verifies tokens carrying its kid.
+	SetSigningKeys(keys []domain.SigningKey)
+}
+
+// AccessClaims are the claims of a verified access token the service relies on.
+type AccessClaims struct {
+	jwt.RegisteredClaims
+	// Cnf holds the key the token is bound to, or nil if the token is a bearer token.
+	Cnf *Confirmation `json:"cnf,omitempty"`
+	// Act names the client an exchanged token acts for and Scope the scopes it is
+	// limited to (RFC 8693). Tokens issued to users carry neither.
+	Act   *Actor `json:"act,omitempty"`
+	Scope string `json:"scope,omitempty"`
+}
+
+// Actor is the party an exchanged token was issued to act for the user.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// Exchanged reports whether the token was issued by a token exchange, for the
+// backends of a client rather than for this service.
+func (c *AccessClaims) Exchanged() bool {
+	return c.Act != nil || c.Scope != ""
+}
+
+// Confirmation names the key or certificate a token is bound to.
+type Confirmation struct {
+	// JKT is the JWK thumbprint of the DPoP key of the token.
+	JKT string `json:"jkt,omitempty"`
+	// X5T is the thumbprint of the TLS client certificate of the token (RFC 8705).
+	X5T string `json:"x5t#S256,omitempty"`
+}
+
+// matches reports whether the request of meta was made by the holder of the key and
+// the certificate c binds a token to. A nil c binds nothing.
+func (c *Confirmation) matches(meta domain.RequestMeta) bool {
+	if c == nil {
+		return true
+	}
+	if c.JKT != "" && c.JKT != meta.DPoPJKT {
+		return false
+	}
+	if c.X5T != "" && (meta.ClientCert == nil || meta.ClientCert.Thumbprint != c.X5T) {
+		return false
+	}
+
+	return true
+}
+
+// TokenOptions configures the claims of issued tokens. A zero TTL falls back to the
+// default: 15 minutes for access tokens and 7 days for refresh tokens.
+type TokenOptions struct {
+	// Issuer is put into the iss claim and required from every access token, unless empty.
+	Issuer string
+	// Audiences are put into the aud claim of access tokens of clients without their own.
+	Audiences  []string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	// Leeway is the clock skew allowed when checking exp, nbf and iat.
+	Leeway  time.Duration
+	Clients map[string]ClientTokenOptions
+	// ClaimsProviders add custom claims to access tokens. They are asked in order and a
+	// later provider overrides the claims of an earlier one.
+	ClaimsProviders []ClaimsProvider
+	// MaxClaimsBytes limits the JSON size of the custom claims of a token. Zero means 1 KiB.
+	MaxClaimsBytes int
+	// RetireGrace is how long tokens without a kid, signed with the JWT secret, keep
+	// verifying after the first signing key was created, like the grace rotated keys
+	// get. It must be at least MaxAccessTTL, or such tokens are cut off early.
+	RetireGrace time.Duration
+	// SigningKeys, if set, is asked for the keys when a token names a kid that is not
+	// loaded, e.g. one another instance rotated in since the last reload. It is asked at
+	// most once per KeyReloadDelay, 10 seconds if zero.
+	SigningKeys    repository.SigningKeyRepository
+	KeyReloadDelay time.Duration
+}
+
+// ClientTokenOptions overrides TokenOptions for one client. Zero fields keep the defaults.
+type ClientTokenOptions struct {
+	Audiences  []string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// MaxAccessTTL returns the longest lifetime of any access token issued with opts.
+func (o TokenOptions) MaxAccessTTL() time.Duration {
+	ttl := o.client("").AccessTTL
+	for id := range o.Clients {
+		ttl = max(ttl, o.client(id).AccessTTL)
+	}
+	return ttl
+}
+
+// client resolves the options for clientID with the defaults filled in.
+func (o TokenOptions) client(clientID string) ClientTokenOptions {
+	c := ClientTokenOptions{
+		Audiences:  o.Audiences,
+		AccessTTL:  cmp.Or(o.AccessTTL, defaultAccessTokenTTL),
+		RefreshTTL: cmp.Or(o.RefreshTTL, defaultRefreshTokenTTL),
+	}
+
+	if override, ok := o.Clients[clientID]; ok {
+		if len(override.Audiences) > 0 {
+			c.Audiences = override.Audiences
+		}
+		c.AccessTTL = cmp.Or(override.AccessTTL, c.AccessTTL)
+		c.RefreshTTL = cmp.Or(override.RefreshTTL, c.RefreshTTL)
+	}
+
+	return c
+}
+
+// audiences returns every audience access tokens are issued for. A token naming any of
+// them is accepted.
+func (o TokenOptions) audiences() []string {
+	aud := slices.Clone(o.Audiences)
+	for _, c := range o.Clients {
+		for _, a := range c.Audiences {
+			if !slices.Contains(aud, a) {
+				aud = append(aud, a)
+			}
+		}
+	}
+	return aud
 }
 
 type tokenService struct {
 	jwtSecret []byte
-	tokenRepo repository.TokenRepository
+	keys      atomic.Pointer[signingKeySet]
+	// lastKeyReload is the time in Unix nanoseconds the keys were last loaded for an
+	// unknown kid.
+	lastKeyReload atomic.Int64
+	tokenRepo     repository.TokenRepository
+	opts          TokenOptions
+	parser        *jwt.Parser
+}
+
+// signingKeySet is swapped as a whole, so a token is always signed and verified
+// against a consistent view of the keys.
+type signingKeySet struct {
+	current domain.SigningKey
+	byKID   map[string][]byte
+	// secretRetiredAt is when the JWT secret stops verifying tokens without a kid,
+	// or zero while no signing key exists.
+	secretRetiredAt time.Time
 }
 
-func NewTokenService(jwtSecret []byte, tokenRepo repository.TokenRepository) TokenService {
+func NewTokenService(jwtSecret []byte, tokenRepo repository.TokenRepository, opts TokenOptions) TokenService {
+	opts.KeyReloadDelay = cmp.Or(opts.KeyReloadDelay, defaultKeyReloadDelay)
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithLeeway(opts.Leeway),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if aud := opts.audiences(); len(aud) > 0 {
+		parserOpts = append(parserOpts, jwt.WithAudience(aud...))
+	}
+
 	return &tokenService{
 		jwtSecret: jwtSecret,
 		tokenRepo: tokenRepo,
+		opts:      opts,
+		parser:    jwt.NewParser(parserOpts...),
+	}
+}
+
+func (s *tokenService) GenerateAccessToken(ctx context.Context, userID uuid.UUID, clientID string) (string, error) {
+	client := s.opts.client(clientID)
+	now := time.Now()
+
+	claims, err := s.customClaims(ctx, userID, clientID)
+	if err != nil {
+		return "", err
+	}
+
+	claims["jti"] = uuid.NewString()
+	claims["sub"] = userID.String()
+	claims["exp"] = jwt.NewNumericDate(now.Add(client.AccessTTL))
+	claims["iat"] = jwt.NewNumericDate(now)
+	if s.opts.Issuer != "" {
+		claims["iss"] = s.opts.Issuer
+	}
+	if len(client.Audiences) > 0 {
+		claims["aud"] = jwt.ClaimStrings(client.Audiences)
+	}
+	setConfirmation(ctx, claims)
+
+	return s.sign(claims)
+}
+
+func (s *tokenService) GenerateExchangedToken(ctx context.Context, userID uuid.UUID, actor string, audiences []string, scopes []string, notAfter time.Time) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.opts.client(actor).AccessTTL)
+	if notAfter.Before(expiresAt) {
+		expiresAt = notAfter
+	}
+
+	claims, err := s.customClaims(ctx, userID, actor)
+	if err != nil {
+		return "", time.Time{}, err
 	}
+
+	claims["jti"] = uuid.NewString()
+	claims["sub"] = userID.String()
+	claims["aud"] = jwt.ClaimStrings(audiences)
+	claims["exp"] = jwt.NewNumericDate(expiresAt)
+	claims["iat"] = jwt.NewNumericDate(now)
+	claims["act"] = map[string]any{"sub": actor}
+	if s.opts.Issuer != "" {
+		claims["iss"] = s.opts.Issuer
+	}
+	if len(scopes) > 0 {
+		claims["scope"] = strings.Join(scopes, " ")
+	}
+	setConfirmation(ctx, claims)
+
+	token, err := s.sign(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiresAt, nil
 }
 
-func (s *tokenService) GenerateAccessToken(userID uuid.UUID) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
-		Subject:   userID.String(),
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute * 15)),
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
-	})
+// customClaims collects the claims of the claims providers for a token of userID
+// issued to clientID.
+func (s *tokenService) customClaims(ctx context.Context, userID uuid.UUID, clientID string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	for _, p := range s.opts.ClaimsProviders {
+		custom, err := p.Claims(ctx, userID, clientID)
+		if err != nil {
+			return nil, fmt.Errorf("claims provider %s: %w", p.Name(), err)
+		}
+		maps.Copy(claims, custom)
+	}
+	if err := validateCustomClaims(claims, cmp.Or(s.opts.MaxClaimsBytes, defaultMaxClaimsBytes)); err != nil {
+		return nil, err
+	}
 
-	return token.SignedString(s.jwtSecret)
+	return claims, nil
+}
+
+// setConfirmation binds the token to the DPoP key the request was proven with and the
+// client certificate it was made with, if any.
+func setConfirmation(ctx context.Context, claims jwt.MapClaims) {
+	meta := domain.RequestMetaFromContext(ctx)
+
+	cnf := Confirmation{JKT: meta.DPoPJKT}
+	if meta.ClientCert != nil {
+		cnf.X5T = meta.ClientCert.Thumbprint
+	}
+	if cnf != (Confirmation{}) {
+		claims["cnf"] = cnf
+	}
+}
+
+// sign signs claims with the current key.
+func (s *tokenService) sign(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	key := s.currentKey()
+	if key.KID != "" {
+		token.Header["kid"] = key.KID
+	}
+
+	return token.SignedString(key.Secret)
 }
 
 func (s *tokenService) GenerateRefreshToken() (string, error) {
@@ -54,14 +326,12 @@ func (s *tokenService) GenerateRefreshToken() (string, error) {
 	return fmt.Sprintf("%x", b), nil
 }
 
-func (s *tokenService) ValidateAccessToken(accessToken string) (*jwt.RegisteredClaims, error) {
-	claims := &jwt.RegisteredClaims{}
+func (s *tokenService) ValidateAccessToken(accessToken string) (*AccessClaims, error) {
+	claims := &AccessClaims{}
 
-	t, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (interface{}, error) {
-		return s.jwtSecret, nil
-	})
+	t, err := s.parser.ParseWithClaims(accessToken, claims, s.verificationKey)
 	if err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired){
+		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, domain.ErrExpiredAccessToken
 		} else {
 			return nil, domain.ErrInvalidAccessToken
@@ -75,13 +345,95 @@ func (s *tokenService) ValidateAccessToken(accessToken string) (*jwt.RegisteredC
 	return claims, nil
 }
 
-func (s *tokenService) HashRefreshToken(refreshToken string) (string, time.Time) {
+func (s *tokenService) HashRefreshToken(refreshToken string, clientID string) (string, time.Time) {
 	h := HashRefreshTokenFunc(refreshToken)
-	expiry := time.Now().Add(time.Hour * 24 * 7)
+	expiry := time.Now().Add(s.opts.client(clientID).RefreshTTL)
 
 	return h, expiry
 }
 
+func (s *tokenService) SigningKeyLoaded() bool {
+	return len(s.currentKey().Secret) > 0
+}
+
+func (s *tokenService) SetSigningKeys(keys []domain.SigningKey) {
+	set := &signingKeySet{
+		byKID: make(map[string][]byte, len(keys)),
+	}
+
+	for _, k := range keys {
+		set.byKID[k.KID] = k.Secret
+		if k.RetiredAt == nil && k.CreatedAt.After(set.current.CreatedAt) {
+			set.current = k
+		}
+		if retiredAt := k.CreatedAt.Add(s.opts.RetireGrace); set.secretRetiredAt.IsZero() || retiredAt.Before(set.secretRetiredAt) {
+			set.secretRetiredAt = retiredAt
+		}
+	}
+
+	s.keys.Store(set)
+}
+
+// currentKey returns the key new tokens are signed with. Until keys are rotated for
+// the first time it is the configured JWT secret, which carries no kid.
+func (s *tokenService) currentKey() domain.SigningKey {
+	if set := s.keys.Load(); set != nil && set.current.KID != "" {
+		return set.current
+	}
+	return domain.SigningKey{Secret: s.jwtSecret}
+}
+
+func (s *tokenService) verificationKey(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	set := s.keys.Load()
+
+	if kid == "" {
+		if set != nil && !set.secretRetiredAt.IsZero() && time.Now().After(set.secretRetiredAt) {
+			return nil, fmt.Errorf("signing key without kid retired")
+		}
+		return s.jwtSecret, nil
+	}
+
+	if set != nil {
+		if secret, ok := set.byKID[kid]; ok {
+			return secret, nil
+		}
+	}
+
+	if secret, ok := s.reloadForKID(kid); ok {
+		return secret, nil
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// reloadForKID loads the keys from storage and returns the secret of kid if it is
+// among them. Tokens with made-up kids must not reach storage on every request, so
+// the keys are loaded at most once per KeyReloadDelay.
+func (s *tokenService) reloadForKID(kid string) ([]byte, bool) {
+	if s.opts.SigningKeys == nil {
+		return nil, false
+	}
+
+	now := time.Now().UnixNano()
+	last := s.lastKeyReload.Load()
+	if now-last < int64(s.opts.KeyReloadDelay) || !s.lastKeyReload.CompareAndSwap(last, now) {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyReloadTimeout)
+	defer cancel()
+
+	keys, err := s.opts.SigningKeys.ListSigningKeys(ctx)
+	if err != nil {
+		return nil, false
+	}
+	s.SetSigningKeys(keys)
+
+	secret, ok := s.keys.Load().byKID[kid]
+	return secret, ok
+}
+
 func HashRefreshTokenFunc(refreshToken string) string {
 	h := sha256.Sum256([]byte(refreshToken))
 	return hex.EncodeToString(h[:])
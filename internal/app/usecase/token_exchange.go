@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// TokenTypeAccessToken identifies access tokens in a token exchange (RFC 8693). They
+// are the only tokens that can be exchanged and issued.
+const TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+
+// TokenExchangeService lets a backend service trade the access token of a user for a
+// token to call another service on the user's behalf (RFC 8693). The new token is
+// limited to the audiences and scopes the calling client may ask for and names the
+// client in its act claim.
+type TokenExchangeService interface {
+	// ExchangeToken exchanges subjectToken for a token to audiences. Without scopes the
+	// token gets every scope the client may ask for.
+	ExchangeToken(ctx context.Context, clientID string, subjectToken string, subjectTokenType string, audiences []string, scopes []string) (*domain.ExchangedToken, error)
+}
+
+// TokenExchangeOptions sets the clients that may exchange tokens, by client id.
+type TokenExchangeOptions struct {
+	Clients map[string]TokenExchangePolicy
+}
+
+// TokenExchangePolicy sets the audiences and scopes a client may ask for.
+type TokenExchangePolicy struct {
+	Audiences []string
+	Scopes    []string
+}
+
+type tokenExchangeService struct {
+	tokenService      TokenService
+	revocationService RevocationService
+	auditService      AuditService
+	opts              TokenExchangeOptions
+}
+
+func NewTokenExchangeService(tokenService TokenService, revocationService RevocationService, auditService AuditService, opts TokenExchangeOptions) TokenExchangeService {
+	return &tokenExchangeService{
+		tokenService:      tokenService,
+		revocationService: revocationService,
+		auditService:      auditService,
+		opts:              opts,
+	}
+}
+
+func (s *tokenExchangeService) ExchangeToken(ctx context.Context, clientID string, subjectToken string, subjectTokenType string, audiences []string, scopes []string) (*domain.ExchangedToken, error) {
+	ctx, span := tracer.Start(ctx, "TokenExchangeService.ExchangeToken")
+	defer span.End()
+
+	if clientID == "" {
+		return nil, domain.ErrInvalidClient
+	}
+	if subjectToken == "" || subjectTokenType == "" || len(audiences) == 0 {
+		return nil, domain.ErrInvalidRequest
+	}
+	if subjectTokenType != TokenTypeAccessToken {
+		return nil, domain.ErrUnsupportedTokenType
+	}
+
+	policy, ok := s.opts.Clients[clientID]
+	if !ok {
+		return nil, domain.ErrUnauthorizedClient
+	}
+
+	claims, err := s.tokenService.ValidateAccessToken(subjectToken)
+	if err != nil {
+		s.recordFailure(ctx, uuid.Nil, domain.ReasonInvalidToken)
+		return nil, domain.ErrInvalidGrant
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil || claims.ExpiresAt == nil {
+		s.recordFailure(ctx, uuid.Nil, domain.ReasonInvalidToken)
+		return nil, domain.ErrInvalidGrant
+	}
+	if err := s.revocationService.CheckAccessToken(ctx, &claims.RegisteredClaims); err != nil {
+		if errors.Is(err, domain.ErrRevokedAccessToken) {
+			s.recordFailure(ctx, userID, domain.ReasonInvalidToken)
+			return nil, domain.ErrInvalidGrant
+		}
+		return nil, err
+	}
+	// A sender-constrained subject token can only be exchanged by its holder, or the
+	// exchanged token would be a bearer token for anyone who got hold of it.
+	if !claims.Cnf.matches(domain.RequestMetaFromContext(ctx)) {
+		s.recordFailure(ctx, userID, domain.ReasonBindingMismatch)
+		return nil, domain.ErrInvalidGrant
+	}
+
+	for _, aud := range audiences {
+		if !slices.Contains(policy.Audiences, aud) {
+			s.recordFailure(ctx, userID, domain.ReasonInvalidTarget)
+			return nil, domain.ErrInvalidTarget
+		}
+	}
+	if len(scopes) == 0 {
+		scopes = policy.Scopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(policy.Scopes, scope) {
+			s.recordFailure(ctx, userID, domain.ReasonInvalidScope)
+			return nil, domain.ErrInvalidScope
+		}
+	}
+
+	token, expiresAt, err := s.tokenService.GenerateExchangedToken(ctx, userID, clientID, audiences, scopes, claims.ExpiresAt.Time)
+	if err != nil {
+		return nil, fmt.Errorf("generate exchanged token: %w", err)
+	}
+
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventTokenExchange,
+		UserID:    userID,
+		Outcome:   domain.OutcomeSuccess,
+	})
+
+	return &domain.ExchangedToken{
+		AccessToken: token,
+		Audiences:   audiences,
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+func (s *tokenExchangeService) recordFailure(ctx context.Context, userID uuid.UUID, reason string) {
+	s.auditService.Record(ctx, domain.AuthEvent{
+		EventType: domain.EventTokenExchange,
+		UserID:    userID,
+		Outcome:   domain.OutcomeFailure,
+		Reason:    reason,
+	})
+}
@@ -0,0 +1,151 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestTokenExchangeService_ExchangeToken(t *testing.T) {
+	tokenService := &mocks.TokenServiceMock{}
+	revocationService := &mocks.RevocationServiceMock{}
+	auditService := &mocks.AuditServiceMock{}
+	service := usecase.NewTokenExchangeService(tokenService, revocationService, auditService, usecase.TokenExchangeOptions{
+		Clients: map[string]usecase.TokenExchangePolicy{
+			"orders": {Audiences: []string{"billing", "shipping"}, Scopes: []string{"invoices:read", "invoices:write"}},
+		},
+	})
+
+	userID := uuid.New()
+	expiry := time.Now().Add(time.Minute * 5)
+	claims := &usecase.AccessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   userID.String(),
+			ExpiresAt: jwt.NewNumericDate(expiry),
+		},
+	}
+	tokenService.On("ValidateAccessToken", "subject-token").Return(claims, nil)
+
+	expectFailure := func(reason string) {
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventTokenExchange && e.Outcome == domain.OutcomeFailure && e.Reason == reason
+		})).Once()
+	}
+
+	t.Run("issues a narrowed token", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Minute * 5)
+		revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(nil).Once()
+		tokenService.On("GenerateExchangedToken", mock.Anything, userID, "orders", []string{"billing"}, []string{"invoices:read"}, claims.ExpiresAt.Time).Return("exchanged-token", expiresAt, nil).Once()
+		auditService.On("Record", mock.Anything, mock.MatchedBy(func(e domain.AuthEvent) bool {
+			return e.EventType == domain.EventTokenExchange && e.Outcome == domain.OutcomeSuccess && e.UserID == userID
+		})).Once()
+
+		token, err := service.ExchangeToken(context.Background(), "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, []string{"invoices:read"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, &domain.ExchangedToken{
+				AccessToken: "exchanged-token",
+				Audiences:   []string{"billing"},
+				Scopes:      []string{"invoices:read"},
+				ExpiresAt:   expiresAt,
+			}, token)
+		}
+	})
+
+	t.Run("defaults to the scopes of the client", func(t *testing.T) {
+		revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(nil).Once()
+		tokenService.On("GenerateExchangedToken", mock.Anything, userID, "orders", []string{"shipping"}, []string{"invoices:read", "invoices:write"}, claims.ExpiresAt.Time).Return("exchanged-token", expiry, nil).Once()
+		auditService.On("Record", mock.Anything, mock.Anything).Once()
+
+		_, err := service.ExchangeToken(context.Background(), "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"shipping"}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("audience not allowed", func(t *testing.T) {
+		revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(nil).Once()
+		expectFailure(domain.ReasonInvalidTarget)
+
+		_, err := service.ExchangeToken(context.Background(), "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"billing", "admin"}, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidTarget)
+	})
+
+	t.Run("scope not allowed", func(t *testing.T) {
+		revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(nil).Once()
+		expectFailure(domain.ReasonInvalidScope)
+
+		_, err := service.ExchangeToken(context.Background(), "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, []string{"invoices:delete"})
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("revoked subject token", func(t *testing.T) {
+		revocationService.On("CheckAccessToken", mock.Anything, &claims.RegisteredClaims).Return(domain.ErrRevokedAccessToken).Once()
+		expectFailure(domain.ReasonInvalidToken)
+
+		_, err := service.ExchangeToken(context.Background(), "orders", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidGrant)
+	})
+
+	t.Run("invalid subject token", func(t *testing.T) {
+		tokenService.On("ValidateAccessToken", "expired-token").Return(nil, domain.ErrExpiredAccessToken).Once()
+		expectFailure(domain.ReasonInvalidToken)
+
+		_, err := service.ExchangeToken(context.Background(), "orders", "expired-token", usecase.TokenTypeAccessToken, []string{"billing"}, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidGrant)
+	})
+
+	t.Run("bound subject token", func(t *testing.T) {
+		bound := &usecase.AccessClaims{
+			RegisteredClaims: claims.RegisteredClaims,
+			Cnf:              &usecase.Confirmation{JKT: "jkt", X5T: "x5t"},
+		}
+		tokenService.On("ValidateAccessToken", "bound-token").Return(bound, nil)
+
+		// Only the holder of both the key and the certificate can exchange it.
+		holder := domain.RequestMeta{DPoPJKT: "jkt", ClientCert: &domain.ClientCertificate{Thumbprint: "x5t"}}
+		revocationService.On("CheckAccessToken", mock.Anything, &bound.RegisteredClaims).Return(nil).Once()
+		tokenService.On("GenerateExchangedToken", mock.Anything, userID, "orders", []string{"billing"}, []string{"invoices:read", "invoices:write"}, claims.ExpiresAt.Time).Return("exchanged-token", expiry, nil).Once()
+		auditService.On("Record", mock.Anything, mock.Anything).Once()
+
+		_, err := service.ExchangeToken(domain.WithRequestMeta(context.Background(), holder), "orders", "bound-token", usecase.TokenTypeAccessToken, []string{"billing"}, nil)
+		assert.NoError(t, err)
+
+		for _, meta := range []domain.RequestMeta{
+			{},
+			{DPoPJKT: "jkt"},
+			{ClientCert: &domain.ClientCertificate{Thumbprint: "x5t"}},
+			{DPoPJKT: "other-jkt", ClientCert: &domain.ClientCertificate{Thumbprint: "x5t"}},
+			{DPoPJKT: "jkt", ClientCert: &domain.ClientCertificate{Thumbprint: "other-x5t"}},
+		} {
+			revocationService.On("CheckAccessToken", mock.Anything, &bound.RegisteredClaims).Return(nil).Once()
+			expectFailure(domain.ReasonBindingMismatch)
+
+			_, err := service.ExchangeToken(domain.WithRequestMeta(context.Background(), meta), "orders", "bound-token", usecase.TokenTypeAccessToken, []string{"billing"}, nil)
+			assert.ErrorIs(t, err, domain.ErrInvalidGrant)
+		}
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := service.ExchangeToken(context.Background(), "web", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, nil)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient, "the client may not exchange tokens")
+
+		_, err = service.ExchangeToken(context.Background(), "", "subject-token", usecase.TokenTypeAccessToken, []string{"billing"}, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidClient)
+
+		_, err = service.ExchangeToken(context.Background(), "orders", "subject-token", usecase.TokenTypeAccessToken, nil, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest, "an audience is required")
+
+		_, err = service.ExchangeToken(context.Background(), "orders", "subject-token", "urn:ietf:params:oauth:token-type:refresh_token", []string{"billing"}, nil)
+		assert.ErrorIs(t, err, domain.ErrUnsupportedTokenType)
+	})
+
+	revocationService.AssertExpectations(t)
+	auditService.AssertExpectations(t)
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
+)
+
+// tokenJanitorLockKey identifies the advisory lock that keeps replicas from purging
+// at the same time.
+const tokenJanitorLockKey int64 = 0x61757468_6a616e69
+
+const (
+	defaultTokenJanitorInterval  = time.Minute * 5
+	defaultTokenJanitorBatchSize = 1000
+)
+
+// TokenJanitor deletes expired refresh tokens that were never presented again and
+// would otherwise stay in storage forever, along with revocations of access tokens
+// that have expired since, device authorizations that were never completed and DPoP
+// proofs too old to be replayed.
+type TokenJanitor interface {
+	Run(ctx context.Context)
+	// PurgeExpired deletes expired tokens in batches until none are left. It returns
+	// the number of deleted tokens, and zero without error if another replica holds
+	// the janitor lock.
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// TokenJanitorOptions sets how often the janitor purges and how many rows it deletes
+// at once. Zero values fall back to a purge every 5 minutes in batches of 1000.
+type TokenJanitorOptions struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+type tokenJanitor struct {
+	locker         repository.Locker
+	tokenRepo      repository.TokenRepository
+	revocationRepo repository.RevocationRepository
+	deviceCodeRepo repository.DeviceCodeRepository
+	dpopProofRepo  repository.DPoPProofRepository
+	log            *slog.Logger
+	opts           TokenJanitorOptions
+}
+
+func NewTokenJanitor(locker repository.Locker, tokenRepo repository.TokenRepository, revocationRepo repository.RevocationRepository, deviceCodeRepo repository.DeviceCodeRepository, dpopProofRepo repository.DPoPProofRepository, log *slog.Logger, opts TokenJanitorOptions) TokenJanitor {
+	opts.Interval = cmp.Or(opts.Interval, defaultTokenJanitorInterval)
+	opts.BatchSize = cmp.Or(opts.BatchSize, defaultTokenJanitorBatchSize)
+
+	return &tokenJanitor{
+		locker:         locker,
+		tokenRepo:      tokenRepo,
+		revocationRepo: revocationRepo,
+		deviceCodeRepo: deviceCodeRepo,
+		dpopProofRepo:  dpopProofRepo,
+		log:            log,
+		opts:           opts,
+	}
+}
+
+// Run purges on every interval until ctx is done. A purge in progress stops after its
+// current batch.
+func (j *tokenJanitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := j.PurgeExpired(ctx)
+		if err != nil && ctx.Err() == nil {
+			j.log.ErrorContext(ctx, "expired refresh tokens purge failed", "error", err)
+		} else if n > 0 {
+			j.log.InfoContext(ctx, "expired refresh tokens purged", "rows", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *tokenJanitor) PurgeExpired(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "TokenJanitor.PurgeExpired")
+	defer span.End()
+
+	var purged int64
+
+	acquired, err := j.locker.TryWithLock(ctx, tokenJanitorLockKey, func(ctx context.Context) error {
+		for ctx.Err() == nil {
+			n, err := j.tokenRepo.DeleteExpiredRefreshTokens(ctx, j.opts.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			purged += n
+			metrics.ExpiredTokensPurgedTotal.Add(float64(n))
+
+			if n < int64(j.opts.BatchSize) {
+				break
+			}
+		}
+
+		for ctx.Err() == nil {
+			n, err := j.revocationRepo.DeleteExpiredRevocations(ctx, j.opts.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			if n < int64(j.opts.BatchSize) {
+				break
+			}
+		}
+
+		for ctx.Err() == nil {
+			n, err := j.deviceCodeRepo.DeleteExpiredDeviceCodes(ctx, j.opts.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			if n < int64(j.opts.BatchSize) {
+				break
+			}
+		}
+
+		for ctx.Err() == nil {
+			n, err := j.dpopProofRepo.DeleteExpiredDPoPProofs(ctx, j.opts.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			if n < int64(j.opts.BatchSize) {
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		metrics.JanitorRunsTotal.WithLabelValues(metrics.ResultFailure).Inc()
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return purged, domain.ErrGatewayTimeout
+		} else {
+			return purged, fmt.Errorf("purge expired refresh tokens: %w", err)
+		}
+	}
+
+	if !acquired {
+		metrics.JanitorRunsTotal.WithLabelValues(metrics.ResultSkipped).Inc()
+		return 0, nil
+	}
+
+	metrics.JanitorRunsTotal.WithLabelValues(metrics.ResultSuccess).Inc()
+	return purged, nil
+}
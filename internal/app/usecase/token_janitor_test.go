@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func newLockerMock(acquired bool) *mocks.LockerMock {
+	locker := &mocks.LockerMock{}
+	locker.EXPECT().TryWithLock(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, _ int64, fn func(ctx context.Context) error) (bool, error) {
+		if !acquired {
+			return false, nil
+		}
+		return true, fn(ctx)
+	})
+	return locker
+}
+
+func TestTokenJanitor_PurgeExpired(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	revocationRepo := &mocks.RevocationRepositoryMock{}
+	deviceCodeRepo := &mocks.DeviceCodeRepositoryMock{}
+	dpopProofRepo := &mocks.DPoPProofRepositoryMock{}
+	janitor := usecase.NewTokenJanitor(newLockerMock(true), tokenRepo, revocationRepo, deviceCodeRepo, dpopProofRepo, slog.Default(), usecase.TokenJanitorOptions{
+		Interval:  time.Minute,
+		BatchSize: 100,
+	})
+
+	purgedBefore := testutil.ToFloat64(metrics.ExpiredTokensPurgedTotal)
+
+	// Full batches are followed by another one until a batch comes back short.
+	tokenRepo.On("DeleteExpiredRefreshTokens", mock.Anything, 100).Return(int64(100), nil).Twice()
+	tokenRepo.On("DeleteExpiredRefreshTokens", mock.Anything, 100).Return(int64(42), nil).Once()
+	// Expired revocations are purged after the tokens and do not count towards the result.
+	revocationRepo.On("DeleteExpiredRevocations", mock.Anything, 100).Return(int64(7), nil).Once()
+	// So are abandoned device authorizations.
+	deviceCodeRepo.On("DeleteExpiredDeviceCodes", mock.Anything, 100).Return(int64(3), nil).Once()
+	// And DPoP proofs that can no longer be replayed.
+	dpopProofRepo.On("DeleteExpiredDPoPProofs", mock.Anything, 100).Return(int64(5), nil).Once()
+
+	n, err := janitor.PurgeExpired(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(242), n)
+	assert.Equal(t, purgedBefore+242, testutil.ToFloat64(metrics.ExpiredTokensPurgedTotal))
+
+	tokenRepo.On("DeleteExpiredRefreshTokens", mock.Anything, 100).Return(int64(0), repository.ErrGatewayTimeout).Once()
+
+	_, err = janitor.PurgeExpired(context.Background())
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	tokenRepo.AssertExpectations(t)
+	revocationRepo.AssertExpectations(t)
+	deviceCodeRepo.AssertExpectations(t)
+	dpopProofRepo.AssertExpectations(t)
+}
+
+func TestTokenJanitor_PurgeExpiredLockHeld(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	janitor := usecase.NewTokenJanitor(newLockerMock(false), tokenRepo, &mocks.RevocationRepositoryMock{}, &mocks.DeviceCodeRepositoryMock{}, &mocks.DPoPProofRepositoryMock{}, slog.Default(), usecase.TokenJanitorOptions{
+		Interval:  time.Minute,
+		BatchSize: 100,
+	})
+
+	skipped := metrics.JanitorRunsTotal.WithLabelValues(metrics.ResultSkipped)
+	before := testutil.ToFloat64(skipped)
+
+	n, err := janitor.PurgeExpired(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Equal(t, before+1, testutil.ToFloat64(skipped))
+
+	tokenRepo.AssertNotCalled(t, "DeleteExpiredRefreshTokens", mock.Anything, mock.Anything)
+}
+
+func TestTokenJanitor_RunStops(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	janitor := usecase.NewTokenJanitor(newLockerMock(true), tokenRepo, &mocks.RevocationRepositoryMock{}, &mocks.DeviceCodeRepositoryMock{}, &mocks.DPoPProofRepositoryMock{}, slog.Default(), usecase.TokenJanitorOptions{
+		Interval:  time.Hour,
+		BatchSize: 100,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// A cancelled context ends the batch loop and then Run itself.
+	tokenRepo.On("DeleteExpiredRefreshTokens", mock.Anything, 100).Run(func(mock.Arguments) { cancel() }).Return(int64(100), nil).Once()
+
+	done := make(chan struct{})
+	go func() {
+		janitor.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("janitor did not stop")
+	}
+
+	tokenRepo.AssertExpectations(t)
+}
+
+func TestTokenJanitor_RunDefaults(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	janitor := usecase.NewTokenJanitor(newLockerMock(true), tokenRepo, &mocks.RevocationRepositoryMock{}, &mocks.DeviceCodeRepositoryMock{}, &mocks.DPoPProofRepositoryMock{}, slog.Default(), usecase.TokenJanitorOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Unset options fall back to defaults instead of a ticker without an interval
+	// and batches that delete nothing.
+	tokenRepo.On("DeleteExpiredRefreshTokens", mock.Anything, 1000).Run(func(mock.Arguments) { cancel() }).Return(int64(1000), nil).Once()
+
+	done := make(chan struct{})
+	go func() {
+		janitor.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("janitor did not stop")
+	}
+
+	tokenRepo.AssertExpectations(t)
+}
@@ -1,10 +1,16 @@
 package usecase_test
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/vo1dFl0w/auth-service/internal/app/domain"
 	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
 	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
@@ -12,18 +18,18 @@ import (
 
 func TestTokenRepository_GenerateAccessToken(t *testing.T) {
 	tokenRepo := &mocks.TokenRepositoryMock{}
-	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo)
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo, usecase.TokenOptions{})
 
 	userID := uuid.New()
 
-	accessToken, err := tokenService.GenerateAccessToken(userID)
+	accessToken, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
 	assert.NoError(t, err)
 	assert.NotNil(t, accessToken)
 }
 
 func TestTokenRepository_GenerateRefreshToken(t *testing.T) {
 	tokenRepo := &mocks.TokenRepositoryMock{}
-	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo)
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo, usecase.TokenOptions{})
 
 	refreshToken, err := tokenService.GenerateRefreshToken()
 	assert.NoError(t, err)
@@ -32,30 +38,31 @@ func TestTokenRepository_GenerateRefreshToken(t *testing.T) {
 
 func TestTokenRepository_HashRefreshTokent(t *testing.T) {
 	tokenRepo := &mocks.TokenRepositoryMock{}
-	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo)
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo, usecase.TokenOptions{})
 
 	refreshToken, err := tokenService.GenerateRefreshToken()
 	assert.NoError(t, err)
 	assert.NotNil(t, refreshToken)
 
-	hash, expiresAt := tokenService.HashRefreshToken(refreshToken)
+	hash, expiresAt := tokenService.HashRefreshToken(refreshToken, "")
 	assert.NotNil(t, hash)
 	assert.NotNil(t, expiresAt)
 }
 
 func TestTokenRepository_ValidateAccessToken(t *testing.T) {
 	tokenRepo := &mocks.TokenRepositoryMock{}
-	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo)
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo, usecase.TokenOptions{})
 
 	userID := uuid.New()
 
-	accessToken, err := tokenService.GenerateAccessToken(userID)
+	accessToken, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
 	assert.NoError(t, err)
 	assert.NotNil(t, accessToken)
 
 	res, err := tokenService.ValidateAccessToken(accessToken)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
+	assert.NotEmpty(t, res.ID)
 
 	_, err = tokenService.ValidateAccessToken("fake token")
 	assert.Error(t, err)
@@ -68,3 +75,355 @@ func TestTokenRepository_HashRefreshTokenFunc(t *testing.T) {
 	res := usecase.HashRefreshTokenFunc(refreshToken)
 	assert.NotEqual(t, "", res)
 }
+
+func TestTokenRepository_SigningKeyRotation(t *testing.T) {
+	tokenRepo := &mocks.TokenRepositoryMock{}
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), tokenRepo, usecase.TokenOptions{RetireGrace: time.Hour * 2})
+
+	userID := uuid.New()
+
+	// Tokens issued before the first rotation carry no kid and keep verifying for the
+	// retire grace.
+	legacy, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	now := time.Now().UTC()
+	retiredAt := now.Add(time.Hour)
+	oldKey := domain.SigningKey{KID: "old", Secret: []byte("old-secret"), CreatedAt: now.Add(-time.Hour)}
+
+	tokenService.SetSigningKeys([]domain.SigningKey{oldKey})
+	signedWithOld, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	oldKey.RetiredAt = &retiredAt
+	tokenService.SetSigningKeys([]domain.SigningKey{
+		{KID: "new", Secret: []byte("new-secret"), CreatedAt: now},
+		oldKey,
+	})
+
+	signedWithNew, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	for _, token := range []string{legacy, signedWithOld, signedWithNew} {
+		_, err := tokenService.ValidateAccessToken(token)
+		assert.NoError(t, err)
+	}
+
+	// Once the old key is gone from storage, its tokens are rejected.
+	tokenService.SetSigningKeys([]domain.SigningKey{{KID: "new", Secret: []byte("new-secret"), CreatedAt: now}})
+
+	_, err = tokenService.ValidateAccessToken(signedWithOld)
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken)
+
+	_, err = tokenService.ValidateAccessToken(signedWithNew)
+	assert.NoError(t, err)
+
+	// Once the retire grace has passed since the first key was created, tokens without
+	// a kid are rejected as well.
+	tokenService.SetSigningKeys([]domain.SigningKey{{KID: "new", Secret: []byte("new-secret"), CreatedAt: now.Add(-time.Hour * 3)}})
+
+	_, err = tokenService.ValidateAccessToken(legacy)
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken)
+
+	_, err = tokenService.ValidateAccessToken(signedWithNew)
+	assert.NoError(t, err)
+}
+
+func TestTokenRepository_UnknownSigningKey(t *testing.T) {
+	now := time.Now().UTC()
+	oldKey := domain.SigningKey{KID: "old", Secret: []byte("old-secret"), CreatedAt: now.Add(-time.Hour)}
+	newKey := domain.SigningKey{KID: "new", Secret: []byte("new-secret"), CreatedAt: now}
+
+	signingKeyRepo := &mocks.SigningKeyRepositoryMock{}
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{
+		RetireGrace:    time.Hour * 2,
+		SigningKeys:    signingKeyRepo,
+		KeyReloadDelay: time.Hour,
+	})
+	tokenService.SetSigningKeys([]domain.SigningKey{oldKey})
+
+	// Another instance rotated in a key this one has not loaded yet.
+	rotated := usecase.NewTokenService([]byte("very-secret-key"), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{RetireGrace: time.Hour * 2})
+	rotated.SetSigningKeys([]domain.SigningKey{newKey, oldKey})
+	signedWithNew, err := rotated.GenerateAccessToken(context.Background(), uuid.New(), "")
+	assert.NoError(t, err)
+
+	signingKeyRepo.On("ListSigningKeys", mock.Anything).Return([]domain.SigningKey{newKey, oldKey}, nil).Once()
+
+	_, err = tokenService.ValidateAccessToken(signedWithNew)
+	assert.NoError(t, err, "the keys are reloaded for an unknown kid")
+
+	signed, err := tokenService.GenerateAccessToken(context.Background(), uuid.New(), "")
+	assert.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(signed, jwt.MapClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", parsed.Header["kid"], "the reloaded key signs new tokens")
+
+	// Within the reload delay, unknown kids are rejected without asking storage again.
+	rotated.SetSigningKeys([]domain.SigningKey{{KID: "newer", Secret: []byte("newer-secret"), CreatedAt: now.Add(time.Minute)}})
+	signedWithNewer, err := rotated.GenerateAccessToken(context.Background(), uuid.New(), "")
+	assert.NoError(t, err)
+
+	_, err = tokenService.ValidateAccessToken(signedWithNewer)
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken)
+
+	signingKeyRepo.AssertExpectations(t)
+}
+
+func TestTokenRepository_TokenOptions(t *testing.T) {
+	secret := []byte("very-secret-key")
+	opts := usecase.TokenOptions{
+		Issuer:     "auth-service",
+		Audiences:  []string{"api"},
+		AccessTTL:  time.Minute * 10,
+		RefreshTTL: time.Hour,
+		Leeway:     time.Second * 30,
+		Clients: map[string]usecase.ClientTokenOptions{
+			"mobile": {Audiences: []string{"mobile"}, AccessTTL: time.Minute, RefreshTTL: time.Hour * 24},
+		},
+	}
+	tokenService := usecase.NewTokenService(secret, &mocks.TokenRepositoryMock{}, opts)
+
+	assert.Equal(t, time.Minute*10, opts.MaxAccessTTL())
+
+	userID := uuid.New()
+
+	token, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	claims, err := tokenService.ValidateAccessToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "auth-service", claims.Issuer)
+	assert.Equal(t, jwt.ClaimStrings{"api"}, claims.Audience)
+	assert.Equal(t, time.Minute*10, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+
+	// A client override replaces the audiences, and tokens for every configured
+	// audience are accepted.
+	token, err = tokenService.GenerateAccessToken(context.Background(), userID, "mobile")
+	assert.NoError(t, err)
+
+	claims, err = tokenService.ValidateAccessToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, jwt.ClaimStrings{"mobile"}, claims.Audience)
+	assert.Equal(t, time.Minute, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+
+	_, expiresAt := tokenService.HashRefreshToken("refresh-token", "")
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Second)
+	_, expiresAt = tokenService.HashRefreshToken("refresh-token", "unknown")
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Second)
+	_, expiresAt = tokenService.HashRefreshToken("refresh-token", "mobile")
+	assert.WithinDuration(t, time.Now().Add(time.Hour*24), expiresAt, time.Second)
+
+	sign := func(claims jwt.RegisteredClaims) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+		assert.NoError(t, err)
+		return s
+	}
+
+	_, err = tokenService.ValidateAccessToken(sign(jwt.RegisteredClaims{
+		Issuer:    "someone-else",
+		Audience:  jwt.ClaimStrings{"api"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}))
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken)
+
+	_, err = tokenService.ValidateAccessToken(sign(jwt.RegisteredClaims{
+		Issuer:    "auth-service",
+		Audience:  jwt.ClaimStrings{"billing"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}))
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken)
+
+	// Expiry is checked with the configured leeway.
+	_, err = tokenService.ValidateAccessToken(sign(jwt.RegisteredClaims{
+		Issuer:    "auth-service",
+		Audience:  jwt.ClaimStrings{"api"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Second * 10)),
+	}))
+	assert.NoError(t, err)
+
+	_, err = tokenService.ValidateAccessToken(sign(jwt.RegisteredClaims{
+		Issuer:    "auth-service",
+		Audience:  jwt.ClaimStrings{"api"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	}))
+	assert.ErrorIs(t, err, domain.ErrExpiredAccessToken)
+}
+
+func TestTokenRepository_GenerateExchangedToken(t *testing.T) {
+	secret := []byte("very-secret-key")
+	tokenService := usecase.NewTokenService(secret, &mocks.TokenRepositoryMock{}, usecase.TokenOptions{
+		Issuer:    "auth-service",
+		Audiences: []string{"api"},
+		AccessTTL: time.Minute * 10,
+	})
+
+	userID := uuid.New()
+
+	token, expiresAt, err := tokenService.GenerateExchangedToken(context.Background(), userID, "orders", []string{"billing"}, []string{"invoices:read", "invoices:write"}, time.Now().Add(time.Hour))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.WithinDuration(t, time.Now().Add(time.Minute*10), expiresAt, time.Second)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) { return secret, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, userID.String(), claims["sub"])
+	assert.Equal(t, []any{"billing"}, claims["aud"])
+	assert.Equal(t, "invoices:read invoices:write", claims["scope"])
+	assert.Equal(t, map[string]any{"sub": "orders"}, claims["act"])
+	assert.NotEmpty(t, claims["jti"])
+
+	_, err = tokenService.ValidateAccessToken(token)
+	assert.ErrorIs(t, err, domain.ErrInvalidAccessToken, "the token is not for this service")
+
+	// The token does not outlive the exchanged one.
+	notAfter := time.Now().Add(time.Minute)
+	_, expiresAt, err = tokenService.GenerateExchangedToken(context.Background(), userID, "orders", []string{"billing"}, nil, notAfter)
+	assert.NoError(t, err)
+	assert.Equal(t, notAfter, expiresAt)
+}
+
+func TestTokenRepository_DPoPBinding(t *testing.T) {
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{})
+
+	userID := uuid.New()
+
+	token, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.NoError(t, err)
+
+	claims, err := tokenService.ValidateAccessToken(token)
+	assert.NoError(t, err)
+	assert.Nil(t, claims.Cnf, "tokens are bearer tokens unless proven with a key")
+
+	// A token issued for a request with a DPoP proof is bound to its key.
+	ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{DPoPJKT: "jkt"})
+	token, err = tokenService.GenerateAccessToken(ctx, userID, "")
+	assert.NoError(t, err)
+
+	claims, err = tokenService.ValidateAccessToken(token)
+	assert.NoError(t, err)
+	if assert.NotNil(t, claims.Cnf) {
+		assert.Equal(t, "jkt", claims.Cnf.JKT)
+	}
+
+	token, _, err = tokenService.GenerateExchangedToken(ctx, userID, "orders", []string{"billing"}, nil, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	mapClaims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, mapClaims, func(*jwt.Token) (any, error) { return []byte("very-secret-key"), nil })
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"jkt": "jkt"}, mapClaims["cnf"])
+}
+
+func TestTokenRepository_CertificateBinding(t *testing.T) {
+	tokenService := usecase.NewTokenService([]byte("very-secret-key"), &mocks.TokenRepositoryMock{}, usecase.TokenOptions{})
+
+	// A token issued for a request with a client certificate is bound to it, along with
+	// the DPoP key if there is one.
+	ctx := domain.WithRequestMeta(context.Background(), domain.RequestMeta{
+		DPoPJKT:    "jkt",
+		ClientCert: &domain.ClientCertificate{SubjectDN: "CN=orders", Thumbprint: "x5t"},
+	})
+	token, err := tokenService.GenerateAccessToken(ctx, uuid.New(), "")
+	assert.NoError(t, err)
+
+	claims, err := tokenService.ValidateAccessToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, &usecase.Confirmation{JKT: "jkt", X5T: "x5t"}, claims.Cnf)
+
+	mapClaims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, mapClaims, func(*jwt.Token) (any, error) { return []byte("very-secret-key"), nil })
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"jkt": "jkt", "x5t#S256": "x5t"}, mapClaims["cnf"])
+}
+
+func TestTokenRepository_ClaimsProviders(t *testing.T) {
+	secret := []byte("secret")
+	userID := uuid.New()
+
+	plan := &mocks.ClaimsProviderMock{}
+	plan.On("Name").Return("plan").Maybe()
+	plan.On("Claims", mock.Anything, userID, "mobile").Return(map[string]any{"plan": "free", "org_id": "org-1"}, nil).Once()
+	flags := &mocks.ClaimsProviderMock{}
+	flags.On("Name").Return("flags").Maybe()
+	flags.On("Claims", mock.Anything, userID, "mobile").Return(map[string]any{"plan": "pro", "features": []string{"beta"}}, nil).Once()
+
+	tokenService := usecase.NewTokenService(secret, &mocks.TokenRepositoryMock{}, usecase.TokenOptions{
+		Issuer:          "auth-service",
+		ClaimsProviders: []usecase.ClaimsProvider{plan, flags},
+	})
+
+	token, err := tokenService.GenerateAccessToken(context.Background(), userID, "mobile")
+	assert.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) { return secret, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, "pro", claims["plan"], "later providers override earlier ones")
+	assert.Equal(t, "org-1", claims["org_id"])
+	assert.Equal(t, []any{"beta"}, claims["features"])
+	assert.Equal(t, userID.String(), claims["sub"])
+	assert.Equal(t, "auth-service", claims["iss"])
+
+	_, err = tokenService.ValidateAccessToken(token)
+	assert.NoError(t, err)
+
+	plan.AssertExpectations(t)
+	flags.AssertExpectations(t)
+
+	tests := []struct {
+		name   string
+		claims map[string]any
+		err    error
+	}{
+		{
+			name:   "sub is reserved",
+			claims: map[string]any{"sub": uuid.NewString()},
+			err:    domain.ErrInvalidClaim,
+		},
+		{
+			name:   "exp is reserved",
+			claims: map[string]any{"exp": time.Now().Add(time.Hour * 24 * 365).Unix()},
+			err:    domain.ErrInvalidClaim,
+		},
+		{
+			name:   "empty name",
+			claims: map[string]any{"": "value"},
+			err:    domain.ErrInvalidClaim,
+		},
+		{
+			name:   "too large",
+			claims: map[string]any{"blob": strings.Repeat("x", 100)},
+			err:    domain.ErrClaimsTooLarge,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := &mocks.ClaimsProviderMock{}
+			provider.On("Name").Return("test").Maybe()
+			provider.On("Claims", mock.Anything, userID, "").Return(tc.claims, nil).Once()
+
+			tokenService := usecase.NewTokenService(secret, &mocks.TokenRepositoryMock{}, usecase.TokenOptions{
+				ClaimsProviders: []usecase.ClaimsProvider{provider},
+				MaxClaimsBytes:  64,
+			})
+
+			_, err := tokenService.GenerateAccessToken(context.Background(), userID, "")
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	failing := &mocks.ClaimsProviderMock{}
+	failing.On("Name").Return("failing").Maybe()
+	failing.On("Claims", mock.Anything, userID, "").Return(nil, errors.New("unavailable")).Once()
+
+	tokenService = usecase.NewTokenService(secret, &mocks.TokenRepositoryMock{}, usecase.TokenOptions{
+		ClaimsProviders: []usecase.ClaimsProvider{failing},
+	})
+
+	_, err = tokenService.GenerateAccessToken(context.Background(), userID, "")
+	assert.Error(t, err, "a failing provider fails the token")
+}
@@ -0,0 +1,5 @@
+package usecase
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("github.com/vo1dFl0w/auth-service/internal/app/usecase")
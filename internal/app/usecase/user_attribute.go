@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+// UserAttributeService manages the attributes the attribute claims provider puts into
+// access tokens. Changes show up in tokens issued afterwards.
+type UserAttributeService interface {
+	ListAttributes(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error)
+	SetAttribute(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error
+	DeleteAttribute(ctx context.Context, userID uuid.UUID, name string) error
+}
+
+type userAttributeService struct {
+	attrRepo repository.UserAttributeRepository
+	maxBytes int
+}
+
+// NewUserAttributeService returns the service. maxBytes is the custom claims limit of
+// the token service, 0 for the default; no single value may exceed it.
+func NewUserAttributeService(attrRepo repository.UserAttributeRepository, maxBytes int) UserAttributeService {
+	return &userAttributeService{
+		attrRepo: attrRepo,
+		maxBytes: cmp.Or(maxBytes, defaultMaxClaimsBytes),
+	}
+}
+
+func (s *userAttributeService) ListAttributes(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error) {
+	ctx, span := tracer.Start(ctx, "UserAttributeService.ListAttributes")
+	defer span.End()
+
+	attrs, err := s.attrRepo.ListUserAttributes(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list user attributes: %w", err)
+		}
+	}
+
+	return attrs, nil
+}
+
+func (s *userAttributeService) SetAttribute(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error {
+	ctx, span := tracer.Start(ctx, "UserAttributeService.SetAttribute")
+	defer span.End()
+
+	if err := validateClaimName(name); err != nil {
+		return err
+	}
+	if !json.Valid(value) {
+		return fmt.Errorf("%w: value of %q is not valid JSON", domain.ErrInvalidClaim, name)
+	}
+	if err := validateCustomClaims(map[string]any{name: value}, s.maxBytes); err != nil {
+		return err
+	}
+
+	if err := s.attrRepo.SetUserAttribute(ctx, userID, name, value); err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("set user attribute: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *userAttributeService) DeleteAttribute(ctx context.Context, userID uuid.UUID, name string) error {
+	ctx, span := tracer.Start(ctx, "UserAttributeService.DeleteAttribute")
+	defer span.End()
+
+	if err := s.attrRepo.DeleteUserAttribute(ctx, userID, name); err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) {
+			return domain.ErrNotFound
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("delete user attribute: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestUserAttributeService_SetAttribute(t *testing.T) {
+	attrRepo := &mocks.UserAttributeRepositoryMock{}
+	attrService := usecase.NewUserAttributeService(attrRepo, 64)
+
+	userID := uuid.New()
+
+	attrRepo.On("SetUserAttribute", mock.Anything, userID, "plan", json.RawMessage(`"pro"`)).Return(nil).Once()
+
+	err := attrService.SetAttribute(context.Background(), userID, "plan", json.RawMessage(`"pro"`))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		attribute string
+		value     json.RawMessage
+		err       error
+	}{
+		{
+			name:      "reserved name",
+			attribute: "sub",
+			value:     json.RawMessage(`"someone-else"`),
+			err:       domain.ErrInvalidClaim,
+		},
+		{
+			name:      "empty name",
+			attribute: "",
+			value:     json.RawMessage(`true`),
+			err:       domain.ErrInvalidClaim,
+		},
+		{
+			name:      "invalid json",
+			attribute: "plan",
+			value:     json.RawMessage(`pro`),
+			err:       domain.ErrInvalidClaim,
+		},
+		{
+			name:      "too large",
+			attribute: "plan",
+			value:     json.RawMessage(`"` + strings.Repeat("x", 100) + `"`),
+			err:       domain.ErrClaimsTooLarge,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := attrService.SetAttribute(context.Background(), userID, tc.attribute, tc.value)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	attrRepo.AssertExpectations(t)
+}
+
+func TestUserAttributeService_DeleteAttribute(t *testing.T) {
+	attrRepo := &mocks.UserAttributeRepositoryMock{}
+	attrService := usecase.NewUserAttributeService(attrRepo, 0)
+
+	userID := uuid.New()
+
+	attrRepo.On("DeleteUserAttribute", mock.Anything, userID, "plan").Return(nil).Once()
+
+	err := attrService.DeleteAttribute(context.Background(), userID, "plan")
+	assert.NoError(t, err)
+
+	attrRepo.On("DeleteUserAttribute", mock.Anything, userID, "plan").Return(repository.ErrNoRowDeleted).Once()
+
+	err = attrService.DeleteAttribute(context.Background(), userID, "plan")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	attrRepo.AssertExpectations(t)
+}
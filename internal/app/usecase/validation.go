@@ -1,11 +1,16 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/pkg/metrics"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -23,11 +28,36 @@ func validatePassword(u *domain.User) error {
 	)
 }
 
-func HashPassword(password string) (string, error) {
+func validateAPIKeyName(name string) error {
+	return validation.Validate(name, validation.Required, validation.Length(1, 100))
+}
+
+func validateWebhookURL(rawURL string) error {
+	return validation.Validate(rawURL, validation.Required, validation.Length(1, 2048), is.RequestURL, validation.By(func(value interface{}) error {
+		u, err := url.Parse(value.(string))
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.New("must be an http or https url")
+		}
+		return nil
+	}))
+}
+
+func HashPassword(ctx context.Context, password string) (string, error) {
 	if len(password) == 0 {
 		return "", domain.ErrEmptyPassword
 	}
 
+	_, span := tracer.Start(ctx, "bcrypt.GenerateFromPassword")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.PasswordHashDuration.WithLabelValues(metrics.OperationHash).Observe(time.Since(start).Seconds())
+	}()
+
 	h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate hashed password: %w", err)
@@ -36,7 +66,15 @@ func HashPassword(password string) (string, error) {
 	return string(h), nil
 }
 
-func comparePasswords(password, hashedPassword string) error {
+func comparePasswords(ctx context.Context, password, hashedPassword string) error {
+	_, span := tracer.Start(ctx, "bcrypt.CompareHashAndPassword")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.PasswordHashDuration.WithLabelValues(metrics.OperationCompare).Observe(time.Since(start).Seconds())
+	}()
+
 	if err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)); err != nil {
 		return fmt.Errorf("failed to compare hash and password: %w", err)
 	}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"crypto/rand"
 	"testing"
 
@@ -105,27 +106,27 @@ func TestUsecase_ValidatePassword(t *testing.T) {
 func TestUsecase_HashPassword(t *testing.T) {
 	password := "password"
 
-	hash, err := HashPassword(password)
+	hash, err := HashPassword(context.Background(), password)
 	assert.NoError(t, err)
 	assert.NotEqual(t, "", hash)
 
-	err = comparePasswords(password, hash)
+	err = comparePasswords(context.Background(), password, hash)
 	assert.NoError(t, err)
 
-	_, err = HashPassword("")
+	_, err = HashPassword(context.Background(), "")
 	assert.Error(t, err)
 }
 
 func TestUsecase_ComparePasswords(t *testing.T) {
 	password := "password"
 
-	hash, err := HashPassword(password)
+	hash, err := HashPassword(context.Background(), password)
 	assert.NoError(t, err)
 	assert.NotEqual(t, "", hash)
 
-	err = comparePasswords(password, hash)
+	err = comparePasswords(context.Background(), password, hash)
 	assert.NoError(t, err)
 
-	err = comparePasswords("wrong-password", hash)
+	err = comparePasswords(context.Background(), "wrong-password", hash)
 	assert.Error(t, err)
 }
@@ -0,0 +1,363 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+)
+
+const (
+	webhookSecretPrefix = "whsec_"
+	maxWebhookErrorLen  = 1024
+
+	defaultWebhookPollInterval = time.Second * 2
+	defaultWebhookBatchSize    = 50
+	defaultWebhookMaxAttempts  = 8
+	defaultWebhookBaseBackoff  = time.Second * 5
+	defaultWebhookMaxBackoff   = time.Hour * 6
+	defaultWebhookLease        = time.Minute * 10
+)
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, url string, eventTypes []string) (*domain.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error)
+}
+
+type webhookService struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewWebhookService(webhookRepo repository.WebhookRepository) WebhookService {
+	return &webhookService{
+		webhookRepo: webhookRepo,
+	}
+}
+
+// CreateWebhook subscribes url to the given event types. The returned subscription
+// carries the signing secret, which is not exposed by any other method.
+func (s *webhookService) CreateWebhook(ctx context.Context, url string, eventTypes []string) (*domain.WebhookSubscription, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.CreateWebhook")
+	defer span.End()
+
+	if err := validateWebhookURL(url); err != nil {
+		return nil, domain.ErrInvalidWebhookURL
+	}
+
+	if len(eventTypes) == 0 {
+		return nil, domain.ErrInvalidWebhookEventType
+	}
+	for _, t := range eventTypes {
+		if !domain.IsWebhookEventType(t) {
+			return nil, domain.ErrInvalidWebhookEventType
+		}
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("generate webhook secret: %w", err)
+	}
+
+	eventTypes = slices.Compact(slices.Sorted(slices.Values(eventTypes)))
+
+	sub, err := s.webhookRepo.CreateWebhookSubscription(ctx, url, secret, eventTypes)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("create webhook subscription: %w", err)
+		}
+	}
+
+	return sub, nil
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.ListWebhooks")
+	defer span.End()
+
+	subs, err := s.webhookRepo.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list webhook subscriptions: %w", err)
+		}
+	}
+
+	return subs, nil
+}
+
+func (s *webhookService) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "WebhookService.DeleteWebhook")
+	defer span.End()
+
+	if err := s.webhookRepo.DeleteWebhookSubscription(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNoRowDeleted) {
+			return domain.ErrWebhookNotFound
+		} else if errors.Is(err, repository.ErrGatewayTimeout) {
+			return domain.ErrGatewayTimeout
+		} else {
+			return fmt.Errorf("delete webhook subscription: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, webhookID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.ListDeliveries")
+	defer span.End()
+
+	limit, offset = normalizePage(limit, offset)
+
+	deliveries, err := s.webhookRepo.ListWebhookDeliveries(ctx, webhookID, status, limit, offset)
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return nil, domain.ErrGatewayTimeout
+		} else {
+			return nil, fmt.Errorf("list webhook deliveries: %w", err)
+		}
+	}
+
+	return deliveries, nil
+}
+
+type webhookFanoutSink struct {
+	webhookRepo repository.WebhookRepository
+}
+
+// NewWebhookFanoutSink returns an outbox sink that turns every event into one pending
+// delivery per matching subscription. It runs inside the relay transaction, so an event
+// is marked published only together with its deliveries.
+func NewWebhookFanoutSink(webhookRepo repository.WebhookRepository) EventSink {
+	return &webhookFanoutSink{
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (s *webhookFanoutSink) Name() string {
+	return "webhooks"
+}
+
+func (s *webhookFanoutSink) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	if !domain.IsWebhookEventType(event.EventType) {
+		return nil
+	}
+
+	subs, err := s.webhookRepo.ListWebhookSubscriptionsByEvent(ctx, event.EventType)
+	if err != nil {
+		return fmt.Errorf("list webhook subscriptions: %w", err)
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(event.Envelope())
+	if err != nil {
+		return fmt.Errorf("marshal envelope: %w", err)
+	}
+
+	for _, sub := range subs {
+		if err := s.webhookRepo.CreateWebhookDelivery(ctx, sub.ID, event.ID, event.EventType, body); err != nil {
+			return fmt.Errorf("create webhook delivery: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// WebhookClient sends a signed delivery and returns the HTTP status code of the
+// receiver. A non-nil error means the delivery has to be retried.
+type WebhookClient interface {
+	Deliver(ctx context.Context, delivery domain.PendingWebhookDelivery) (int, error)
+}
+
+type WebhookDispatcher interface {
+	Run(ctx context.Context)
+	DispatchBatch(ctx context.Context) (int, error)
+	// PurgeFinished deletes deliveries that succeeded or died longer than Retention ago.
+	PurgeFinished(ctx context.Context) (int64, error)
+}
+
+// WebhookDispatcherOptions sets how often the dispatcher polls, how many deliveries it
+// claims at once and how failed deliveries are retried. Zero values fall back to a
+// poll every 2 seconds in batches of 50, with 8 attempts backing off from 5 seconds
+// up to 6 hours.
+type WebhookDispatcherOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	// Lease is how long claimed deliveries are skipped by other dispatchers while they
+	// are sent. Deliveries of a dispatcher that stops midway are claimed again once it
+	// runs out. Zero means 10 minutes.
+	Lease time.Duration
+	// Retention is how long succeeded and dead deliveries are kept. Zero keeps them
+	// forever.
+	Retention time.Duration
+}
+
+type webhookDispatcher struct {
+	transactor  repository.Transactor
+	webhookRepo repository.WebhookRepository
+	client      WebhookClient
+	log         *slog.Logger
+	opts        WebhookDispatcherOptions
+}
+
+func NewWebhookDispatcher(transactor repository.Transactor, webhookRepo repository.WebhookRepository, client WebhookClient, log *slog.Logger, opts WebhookDispatcherOptions) WebhookDispatcher {
+	opts.PollInterval = cmp.Or(opts.PollInterval, defaultWebhookPollInterval)
+	opts.BatchSize = cmp.Or(opts.BatchSize, defaultWebhookBatchSize)
+	opts.MaxAttempts = cmp.Or(opts.MaxAttempts, defaultWebhookMaxAttempts)
+	opts.BaseBackoff = cmp.Or(opts.BaseBackoff, defaultWebhookBaseBackoff)
+	opts.MaxBackoff = cmp.Or(opts.MaxBackoff, defaultWebhookMaxBackoff)
+	opts.Lease = cmp.Or(opts.Lease, defaultWebhookLease)
+
+	return &webhookDispatcher{
+		transactor:  transactor,
+		webhookRepo: webhookRepo,
+		client:      client,
+		log:         log,
+		opts:        opts,
+	}
+}
+
+func (d *webhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := d.DispatchBatch(ctx)
+			if err != nil {
+				d.log.Error("webhook dispatch failed", "error", err)
+				break
+			}
+			if n < d.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchBatch sends up to BatchSize due deliveries. Failed deliveries are retried
+// with exponential backoff and moved to the dead state after MaxAttempts. Deliveries
+// are leased for Lease while they are sent, so requests run outside any transaction.
+func (d *webhookDispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	var deliveries []domain.PendingWebhookDelivery
+
+	err := d.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		claimed, err := d.webhookRepo.ClaimWebhookDeliveries(ctx, d.opts.BatchSize, time.Now().Add(d.opts.Lease))
+		if err != nil {
+			return fmt.Errorf("claim webhook deliveries: %w", err)
+		}
+		deliveries = claimed
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return 0, domain.ErrGatewayTimeout
+		}
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if err := d.dispatch(ctx, delivery); err != nil {
+			if errors.Is(err, repository.ErrGatewayTimeout) {
+				return 0, domain.ErrGatewayTimeout
+			}
+			return 0, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// dispatch sends delivery and records the result.
+func (d *webhookDispatcher) dispatch(ctx context.Context, delivery domain.PendingWebhookDelivery) error {
+	code, err := d.client.Deliver(ctx, delivery)
+	if err == nil {
+		if err := d.webhookRepo.MarkWebhookDeliverySucceeded(ctx, delivery.ID, code); err != nil {
+			return fmt.Errorf("mark webhook delivery succeeded: %w", err)
+		}
+		return nil
+	}
+
+	attempt := delivery.Attempts + 1
+	status := domain.WebhookDeliveryPending
+	if attempt >= d.opts.MaxAttempts {
+		status = domain.WebhookDeliveryDead
+	}
+
+	d.log.Warn("webhook delivery failed",
+		"delivery_id", delivery.ID,
+		"subscription_id", delivery.SubscriptionID,
+		"event_type", delivery.EventType,
+		"attempt", attempt,
+		"status", status,
+		"error", err,
+	)
+
+	lastError := err.Error()
+	if len(lastError) > maxWebhookErrorLen {
+		lastError = lastError[:maxWebhookErrorLen]
+	}
+
+	next := time.Now().Add(retryBackoff(d.opts.BaseBackoff, d.opts.MaxBackoff, attempt))
+	if err := d.webhookRepo.MarkWebhookDeliveryFailed(ctx, delivery.ID, status, next, code, lastError); err != nil {
+		return fmt.Errorf("mark webhook delivery failed: %w", err)
+	}
+	return nil
+}
+
+// PurgeFinished deletes deliveries that succeeded or died longer than Retention ago. A
+// zero retention keeps deliveries forever.
+func (d *webhookDispatcher) PurgeFinished(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "WebhookDispatcher.PurgeFinished")
+	defer span.End()
+
+	if d.opts.Retention <= 0 {
+		return 0, nil
+	}
+
+	n, err := d.webhookRepo.DeleteFinishedWebhookDeliveriesBefore(ctx, time.Now().Add(-d.opts.Retention))
+	if err != nil {
+		if errors.Is(err, repository.ErrGatewayTimeout) {
+			return 0, domain.ErrGatewayTimeout
+		} else {
+			return 0, fmt.Errorf("delete finished webhook deliveries: %w", err)
+		}
+	}
+
+	return n, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%x", webhookSecretPrefix, b), nil
+}
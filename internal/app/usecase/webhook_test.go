@@ -0,0 +1,204 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/repository"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
+	"github.com/vo1dFl0w/auth-service/internal/test/mocks"
+)
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	webhookRepo := &mocks.WebhookRepositoryMock{}
+	webhookService := usecase.NewWebhookService(webhookRepo)
+
+	url := "https://example.org/hooks/auth"
+	eventTypes := []string{domain.EventSessionRevoked, domain.EventUserRegistered, domain.EventSessionRevoked}
+
+	webhookRepo.On("CreateWebhookSubscription", mock.Anything, url, mock.MatchedBy(func(secret string) bool {
+		return strings.HasPrefix(secret, "whsec_") && len(secret) == len("whsec_")+64
+	}), []string{domain.EventSessionRevoked, domain.EventUserRegistered}).Return(&domain.WebhookSubscription{
+		ID:         uuid.New(),
+		URL:        url,
+		Secret:     "whsec_secret",
+		EventTypes: []string{domain.EventSessionRevoked, domain.EventUserRegistered},
+		CreatedAt:  time.Now().UTC(),
+	}, nil).Once()
+
+	sub, err := webhookService.CreateWebhook(context.Background(), url, eventTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, "whsec_secret", sub.Secret)
+
+	_, err = webhookService.CreateWebhook(context.Background(), "ftp://example.org", eventTypes)
+	assert.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+
+	_, err = webhookService.CreateWebhook(context.Background(), "not a url", eventTypes)
+	assert.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+
+	_, err = webhookService.CreateWebhook(context.Background(), url, []string{"user.unknown"})
+	assert.ErrorIs(t, err, domain.ErrInvalidWebhookEventType)
+
+	_, err = webhookService.CreateWebhook(context.Background(), url, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidWebhookEventType)
+
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookService_DeleteWebhook(t *testing.T) {
+	webhookRepo := &mocks.WebhookRepositoryMock{}
+	webhookService := usecase.NewWebhookService(webhookRepo)
+
+	id := uuid.New()
+
+	webhookRepo.On("DeleteWebhookSubscription", mock.Anything, id).Return(nil).Once()
+
+	err := webhookService.DeleteWebhook(context.Background(), id)
+	assert.NoError(t, err)
+
+	webhookRepo.On("DeleteWebhookSubscription", mock.Anything, id).Return(repository.ErrNoRowDeleted).Once()
+
+	err = webhookService.DeleteWebhook(context.Background(), id)
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookFanoutSink_Publish(t *testing.T) {
+	webhookRepo := &mocks.WebhookRepositoryMock{}
+	sink := usecase.NewWebhookFanoutSink(webhookRepo)
+
+	event := domain.OutboxEvent{
+		ID:          uuid.New(),
+		AggregateID: uuid.New(),
+		EventType:   domain.EventUserRegistered,
+		Payload:     []byte(`{"email":"user@example.org"}`),
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	subs := []domain.WebhookSubscription{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	webhookRepo.On("ListWebhookSubscriptionsByEvent", mock.Anything, event.EventType).Return(subs, nil).Once()
+	for _, sub := range subs {
+		webhookRepo.On("CreateWebhookDelivery", mock.Anything, sub.ID, event.ID, event.EventType, mock.MatchedBy(func(body []byte) bool {
+			var e domain.EventEnvelope
+			return json.Unmarshal(body, &e) == nil && e.ID == event.ID && e.Type == event.EventType
+		})).Return(nil).Once()
+	}
+
+	err := sink.Publish(context.Background(), event)
+	assert.NoError(t, err)
+
+	// events that cannot be subscribed to are skipped without a lookup
+	err = sink.Publish(context.Background(), domain.OutboxEvent{ID: uuid.New(), EventType: "user.unknown"})
+	assert.NoError(t, err)
+
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookDispatcher_DispatchBatch(t *testing.T) {
+	webhookRepo := &mocks.WebhookRepositoryMock{}
+	client := &mocks.WebhookClientMock{}
+	// Receivers may be slow, so they must not be called while the claim transaction
+	// is open.
+	var inTx bool
+	transactor := &mocks.TransactorMock{}
+	transactor.EXPECT().WithinTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		inTx = true
+		defer func() { inTx = false }()
+		return fn(ctx)
+	})
+	dispatcher := usecase.NewWebhookDispatcher(transactor, webhookRepo, client, slog.Default(), usecase.WebhookDispatcherOptions{
+		PollInterval: time.Second,
+		BatchSize:    10,
+		MaxAttempts:  3,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   time.Minute,
+		Lease:        time.Minute,
+	})
+
+	delivered := domain.PendingWebhookDelivery{WebhookDelivery: domain.WebhookDelivery{ID: uuid.New()}}
+	retried := domain.PendingWebhookDelivery{WebhookDelivery: domain.WebhookDelivery{ID: uuid.New(), Attempts: 0}}
+	dead := domain.PendingWebhookDelivery{WebhookDelivery: domain.WebhookDelivery{ID: uuid.New(), Attempts: 2}}
+
+	leased := mock.MatchedBy(func(until time.Time) bool {
+		return until.After(time.Now().Add(time.Second*50)) && until.Before(time.Now().Add(time.Minute))
+	})
+	webhookRepo.On("ClaimWebhookDeliveries", mock.Anything, 10, leased).Return([]domain.PendingWebhookDelivery{delivered, retried, dead}, nil).Once()
+
+	outsideTx := func(mock.Arguments) { assert.False(t, inTx) }
+	client.On("Deliver", mock.Anything, delivered).Run(outsideTx).Return(204, nil).Once()
+	client.On("Deliver", mock.Anything, retried).Run(outsideTx).Return(503, errors.New("unexpected status code: 503")).Once()
+	client.On("Deliver", mock.Anything, dead).Run(outsideTx).Return(0, errors.New("connection refused")).Once()
+
+	webhookRepo.On("MarkWebhookDeliverySucceeded", mock.Anything, delivered.ID, 204).Return(nil).Once()
+	webhookRepo.On("MarkWebhookDeliveryFailed", mock.Anything, retried.ID, domain.WebhookDeliveryPending, mock.Anything, 503, "unexpected status code: 503").Return(nil).Once()
+	webhookRepo.On("MarkWebhookDeliveryFailed", mock.Anything, dead.ID, domain.WebhookDeliveryDead, mock.Anything, 0, "connection refused").Return(nil).Once()
+
+	n, err := dispatcher.DispatchBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	webhookRepo.On("ClaimWebhookDeliveries", mock.Anything, 10, mock.Anything).Return(nil, repository.ErrGatewayTimeout).Once()
+
+	_, err = dispatcher.DispatchBatch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrGatewayTimeout)
+
+	webhookRepo.AssertExpectations(t)
+	client.AssertExpectations(t)
+}
+
+func TestWebhookDispatcher_RunDefaults(t *testing.T) {
+	webhookRepo := &mocks.WebhookRepositoryMock{}
+	dispatcher := usecase.NewWebhookDispatcher(newTransactorMock(), webhookRepo, &mocks.WebhookClientMock{}, slog.Default(), usecase.WebhookDispatcherOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Unset options fall back to defaults instead of a ticker without an interval
+	// and batches that claim nothing.
+	webhookRepo.On("ClaimWebhookDeliveries", mock.Anything, 50, mock.Anything).Run(func(mock.Arguments) { cancel() }).Return([]domain.PendingWebhookDelivery{}, nil).Once()
+
+	done := make(chan struct{})
+	go func() {
+		dispatcher.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("dispatcher did not stop")
+	}
+
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookDispatcher_PurgeFinished(t *testing.T) {
+	webhookRepo := &mocks.WebhookRepositoryMock{}
+	dispatcher := usecase.NewWebhookDispatcher(newTransactorMock(), webhookRepo, &mocks.WebhookClientMock{}, slog.Default(), usecase.WebhookDispatcherOptions{Retention: time.Hour * 24})
+
+	webhookRepo.On("DeleteFinishedWebhookDeliveriesBefore", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= time.Hour*24
+	})).Return(int64(3), nil).Once()
+
+	n, err := dispatcher.PurgeFinished(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	disabled := usecase.NewWebhookDispatcher(newTransactorMock(), webhookRepo, &mocks.WebhookClientMock{}, slog.Default(), usecase.WebhookDispatcherOptions{})
+
+	n, err = disabled.PurgeFinished(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	webhookRepo.AssertExpectations(t)
+}
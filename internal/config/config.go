@@ -1,8 +1,12 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -10,18 +14,48 @@ import (
 )
 
 type ServerConfig struct {
-	Host            string `yaml:"host"`
-	Port            string `yaml:"port"`
-	RequestDuration int    `yaml:"request_duration"`
+	Host            string    `yaml:"host"`
+	Port            string    `yaml:"port"`
+	RequestDuration int       `yaml:"request_duration"`
+	TLS             TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig makes the HTTP server terminate TLS with the certificate and key in
+// CertFile and KeyFile. With ClientCAFile, clients may present a certificate issued by
+// one of the CAs in it (RFC 8705); certificates are verified if presented but not
+// required, so that clients without one keep working.
+type TLSConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	CertFile     string `yaml:"cert_file"`
+	KeyFile      string `yaml:"key_file"`
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+type GRPCConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Port    string `yaml:"port"`
+}
+
+// StorageConfig selects the storage adapter: "postgres", "sqlite" or "memory". The
+// memory adapter loses all data on restart and is meant for local development and tests.
+type StorageConfig struct {
+	Driver string       `yaml:"driver"`
+	SQLite SQLiteConfig `yaml:"sqlite"`
+}
+
+type SQLiteConfig struct {
+	Path        string `yaml:"path"`
+	AutoMigrate bool   `yaml:"auto_migrate"`
 }
 
 type PostgresConfig struct {
-	Host     string `yaml:"host"`
-	Port     string `yaml:"port"`
-	Username string `yaml:"username"`
-	Password string `yaml:"password"`
-	DBname   string `yaml:"dbname"`
-	Sslmode  string `yaml:"sslmode"`
+	Host        string `yaml:"host"`
+	Port        string `yaml:"port"`
+	Username    string `yaml:"username"`
+	Password    string `yaml:"password"`
+	DBname      string `yaml:"dbname"`
+	Sslmode     string `yaml:"sslmode"`
+	AutoMigrate bool   `yaml:"auto_migrate"`
 }
 
 type CorsConfig struct {
@@ -37,13 +71,265 @@ type CookieConfig struct {
 	CookieSecure bool `yaml:"cookie_secure"`
 }
 
+type AuditConfig struct {
+	RetentionDays int `yaml:"retention_days"`
+}
+
+type OutboxConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	PollInterval   int    `yaml:"poll_interval"`
+	BatchSize      int    `yaml:"batch_size"`
+	MaxAttempts    int    `yaml:"max_attempts"`
+	Stdout         bool   `yaml:"stdout"`
+	WebhookURL     string `yaml:"webhook_url"`
+	WebhookTimeout int    `yaml:"webhook_timeout"`
+	RetentionDays  int    `yaml:"retention_days"`
+}
+
+type WebhooksConfig struct {
+	Enabled       bool `yaml:"enabled"`
+	PollInterval  int  `yaml:"poll_interval"`
+	BatchSize     int  `yaml:"batch_size"`
+	MaxAttempts   int  `yaml:"max_attempts"`
+	Timeout       int  `yaml:"timeout"`
+	RetentionDays int  `yaml:"retention_days"`
+}
+
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
+}
+
+type TracingConfig struct {
+	Enabled     bool    `yaml:"enabled"`
+	ServiceName string  `yaml:"service_name"`
+	Exporter    string  `yaml:"exporter"`
+	Endpoint    string  `yaml:"endpoint"`
+	Insecure    bool    `yaml:"insecure"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+type HealthConfig struct {
+	DrainDelay int `yaml:"drain_delay"`
+}
+
+type SigningKeysConfig struct {
+	ReloadInterval int `yaml:"reload_interval"`
+	RetireGrace    int `yaml:"retire_grace"`
+}
+
+type TokenJanitorConfig struct {
+	Enabled   bool `yaml:"enabled"`
+	Interval  int  `yaml:"interval"`
+	BatchSize int  `yaml:"batch_size"`
+}
+
+// TokensConfig sets the claims of issued tokens. TTLs and the leeway are in seconds.
+// Clients override lifetimes and audiences for the requests they authenticate, so
+// each of them needs credentials in client_auth.
+type TokensConfig struct {
+	Issuer     string              `yaml:"issuer"`
+	Audiences  []string            `yaml:"audiences"`
+	AccessTTL  int                 `yaml:"access_ttl"`
+	RefreshTTL int                 `yaml:"refresh_ttl"`
+	Leeway     int                 `yaml:"leeway"`
+	Clients    []TokenClientConfig `yaml:"clients"`
+}
+
+type TokenClientConfig struct {
+	ID         string   `yaml:"id"`
+	Audiences  []string `yaml:"audiences"`
+	AccessTTL  int      `yaml:"access_ttl"`
+	RefreshTTL int      `yaml:"refresh_ttl"`
+}
+
+// ExchangeConfig sets the clients that may exchange access tokens of users for
+// tokens to other services, and the audiences and scopes each of them may ask for.
+type ExchangeConfig struct {
+	Clients []ExchangeClientConfig `yaml:"clients"`
+}
+
+type ExchangeClientConfig struct {
+	ID        string   `yaml:"id"`
+	Audiences []string `yaml:"audiences"`
+	Scopes    []string `yaml:"scopes"`
+}
+
+// ClientAuthConfig sets the OAuth clients that authenticate, either with a secret or
+// with a TLS client certificate (RFC 8705). SecretHash is the hex encoded SHA-256 hash
+// of the secret. A certificate is named by exactly one of the subject DN, in the form
+// of "CN=orders,O=Example", a DNS name or a URI of it, and has to be issued by the CA
+// in server.tls.client_ca_file. Requests of these clients fail without the credential.
+type ClientAuthConfig struct {
+	Clients []ClientAuthClientConfig `yaml:"clients"`
+}
+
+type ClientAuthClientConfig struct {
+	ID         string `yaml:"id"`
+	SecretHash string `yaml:"secret_hash"`
+	SubjectDN  string `yaml:"subject_dn"`
+	DNSName    string `yaml:"san_dns"`
+	URI        string `yaml:"san_uri"`
+}
+
+// SessionsConfig bounds refresh chains. AbsoluteTTL caps the time since login,
+// IdleTTL the time since the last refresh; both are in seconds and 0 disables
+// the limit. Logins with "remember me" use the RememberMe policy instead.
+type SessionsConfig struct {
+	AbsoluteTTL int                 `yaml:"absolute_ttl"`
+	IdleTTL     int                 `yaml:"idle_ttl"`
+	RememberMe  SessionPolicyConfig `yaml:"remember_me"`
+}
+
+type SessionPolicyConfig struct {
+	AbsoluteTTL int `yaml:"absolute_ttl"`
+	IdleTTL     int `yaml:"idle_ttl"`
+}
+
+// ClaimsConfig enables custom access token claims. Attributes adds the user attributes
+// managed with "auth-service attributes", CallbackURL a local HTTP endpoint asked on
+// every token. MaxBytes limits the JSON size of all custom claims of a token; it and
+// the callback timeout are in bytes and seconds.
+type ClaimsConfig struct {
+	MaxBytes        int    `yaml:"max_bytes"`
+	Attributes      bool   `yaml:"attributes"`
+	CallbackURL     string `yaml:"callback_url"`
+	CallbackTimeout int    `yaml:"callback_timeout"`
+}
+
+// NotifyConfig selects how messages to users are delivered: "stdout" writes them as
+// JSON lines and is meant for local development only, "smtp" sends emails from From.
+// SMS configures text messages, which are only needed for one-time codes.
+type NotifyConfig struct {
+	Driver string     `yaml:"driver"`
+	From   string     `yaml:"from"`
+	SMTP   SMTPConfig `yaml:"smtp"`
+	SMS    SMSConfig  `yaml:"sms"`
+}
+
+// SMTPConfig sets the server emails are sent through. The timeout is in seconds.
+type SMTPConfig struct {
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Timeout  int    `yaml:"timeout"`
+}
+
+// SMSConfig selects how text messages are delivered: "" disables them, "stdout" and
+// "file" write them as JSON lines for local development, "twilio" sends them through
+// Twilio.
+type SMSConfig struct {
+	Driver string       `yaml:"driver"`
+	File   string       `yaml:"file"`
+	Twilio TwilioConfig `yaml:"twilio"`
+}
+
+// TwilioConfig sets the account text messages are sent from. From is a phone number
+// of the account; APIURL defaults to the Twilio API. The timeout is in seconds.
+type TwilioConfig struct {
+	AccountSID string `yaml:"account_sid"`
+	AuthToken  string `yaml:"auth_token"`
+	From       string `yaml:"from"`
+	APIURL     string `yaml:"api_url"`
+	Timeout    int    `yaml:"timeout"`
+}
+
+// EmailChangeConfig sets how long an email change can be confirmed, in seconds, and
+// the pages the confirm and cancel links point to. The token is added to the links as
+// the "token" query parameter; the pages post it back to the API.
+type EmailChangeConfig struct {
+	TTL        int    `yaml:"ttl"`
+	ConfirmURL string `yaml:"confirm_url"`
+	CancelURL  string `yaml:"cancel_url"`
+}
+
+// MagicLinkConfig sets how long a magic link can be used, in seconds, the page it
+// points to and how many links a user gets within links_window seconds. With
+// BindBrowser a link only works in the browser that asked for it, which sends a
+// cookie set on the request along with the token.
+type MagicLinkConfig struct {
+	TTL         int    `yaml:"ttl"`
+	LoginURL    string `yaml:"login_url"`
+	BindBrowser bool   `yaml:"bind_browser"`
+	MaxLinks    int    `yaml:"max_links"`
+	LinksWindow int    `yaml:"links_window"`
+}
+
+// OTPConfig sets how long a one-time code can be used and how soon a new one can be
+// sent, in seconds, the number of digits and wrong tries of a code, how many codes a
+// user gets within codes_window seconds, and the user attribute holding the phone
+// number SMS codes are sent to.
+type OTPConfig struct {
+	TTL            int    `yaml:"ttl"`
+	Length         int    `yaml:"length"`
+	MaxAttempts    int    `yaml:"max_attempts"`
+	ResendInterval int    `yaml:"resend_interval"`
+	MaxCodes       int    `yaml:"max_codes"`
+	CodesWindow    int    `yaml:"codes_window"`
+	PhoneAttribute string `yaml:"phone_attribute"`
+}
+
+// DeviceConfig sets how long a device authorization request can be approved and how
+// often a device may poll for its tokens, in seconds, and the page users enter user
+// codes on.
+type DeviceConfig struct {
+	TTL             int    `yaml:"ttl"`
+	Interval        int    `yaml:"interval"`
+	VerificationURL string `yaml:"verification_url"`
+}
+
+// DPoPConfig sets how old a DPoP proof can be and how long a server-provided nonce
+// stays valid, in seconds, and whether proofs have to carry such a nonce. PublicURL is
+// the URL the service is reached at, which proofs are made for; it is derived from
+// the request if empty, which is wrong behind a proxy rewriting the host or scheme.
+type DPoPConfig struct {
+	ProofLifetime int    `yaml:"proof_lifetime"`
+	RequireNonce  bool   `yaml:"require_nonce"`
+	NonceTTL      int    `yaml:"nonce_ttl"`
+	PublicURL     string `yaml:"public_url"`
+}
+
+// AccountsConfig sets how long, in seconds, a deleted account is kept deactivated
+// before it is deleted for good. Zero deletes accounts right away.
+type AccountsConfig struct {
+	DeletionGracePeriod int `yaml:"deletion_grace_period"`
+}
+
+type RevocationConfig struct {
+	CacheTTL int `yaml:"cache_ttl"`
+}
+
 type Config struct {
-	Env       string         `yaml:"env"`
-	Server    ServerConfig   `yaml:"server"`
-	Postgres  PostgresConfig `yaml:"postgres"`
-	Cors      CorsConfig     `yaml:"cors"`
-	Cookie    CookieConfig   `yaml:"cookie"`
-	JWTsecret string         `yaml:"jwt_secret"`
+	Env         string             `yaml:"env"`
+	Server      ServerConfig       `yaml:"server"`
+	GRPC        GRPCConfig         `yaml:"grpc"`
+	Storage     StorageConfig      `yaml:"storage"`
+	Postgres    PostgresConfig     `yaml:"postgres"`
+	Cors        CorsConfig         `yaml:"cors"`
+	Cookie      CookieConfig       `yaml:"cookie"`
+	Audit       AuditConfig        `yaml:"audit"`
+	Outbox      OutboxConfig       `yaml:"outbox"`
+	Webhooks    WebhooksConfig     `yaml:"webhooks"`
+	Metrics     MetricsConfig      `yaml:"metrics"`
+	Tracing     TracingConfig      `yaml:"tracing"`
+	Health      HealthConfig       `yaml:"health"`
+	SigningKeys SigningKeysConfig  `yaml:"signing_keys"`
+	Janitor     TokenJanitorConfig `yaml:"token_janitor"`
+	Revocation  RevocationConfig   `yaml:"revocation"`
+	Tokens      TokensConfig       `yaml:"tokens"`
+	Exchange    ExchangeConfig     `yaml:"token_exchange"`
+	ClientAuth  ClientAuthConfig   `yaml:"client_auth"`
+	Sessions    SessionsConfig     `yaml:"sessions"`
+	Claims      ClaimsConfig       `yaml:"claims"`
+	Notify      NotifyConfig       `yaml:"notify"`
+	EmailChange EmailChangeConfig  `yaml:"email_change"`
+	MagicLink   MagicLinkConfig    `yaml:"magic_link"`
+	OTP         OTPConfig          `yaml:"otp"`
+	Device      DeviceConfig       `yaml:"device"`
+	DPoP        DPoPConfig         `yaml:"dpop"`
+	Accounts    AccountsConfig     `yaml:"accounts"`
+	JWTsecret   string             `yaml:"jwt_secret"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -84,6 +370,11 @@ func LoadConfig() (*Config, error) {
 	if v := os.Getenv("POSTGRES_SSLMODE"); v != "" {
 		cfg.Postgres.Sslmode = v
 	}
+	if v := os.Getenv("POSTGRES_AUTO_MIGRATE"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Postgres.AutoMigrate = b
+		}
+	}
 
 	if v := os.Getenv("SERVER_HOST"); v != "" {
 		cfg.Server.Host = v
@@ -91,6 +382,29 @@ func LoadConfig() (*Config, error) {
 	if v := os.Getenv("SERVER_PORT"); v != "" {
 		cfg.Server.Port = v
 	}
+	if v := os.Getenv("TLS_ENABLED"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Server.TLS.Enabled = b
+		}
+	}
+	if v := os.Getenv("TLS_CERT_FILE"); v != "" {
+		cfg.Server.TLS.CertFile = v
+	}
+	if v := os.Getenv("TLS_KEY_FILE"); v != "" {
+		cfg.Server.TLS.KeyFile = v
+	}
+	if v := os.Getenv("TLS_CLIENT_CA_FILE"); v != "" {
+		cfg.Server.TLS.ClientCAFile = v
+	}
+
+	if v := os.Getenv("GRPC_ENABLED"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.GRPC.Enabled = b
+		}
+	}
+	if v := os.Getenv("GRPC_PORT"); v != "" {
+		cfg.GRPC.Port = v
+	}
 
 	if v := os.Getenv("COOKIE_SECURE"); v != "" {
 		if b, err := strconv.ParseBool(v); err == nil {
@@ -98,6 +412,305 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	if v := os.Getenv("AUDIT_RETENTION_DAYS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Audit.RetentionDays = n
+		}
+	}
+
+	if v := os.Getenv("OUTBOX_ENABLED"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Outbox.Enabled = b
+		}
+	}
+	if v := os.Getenv("OUTBOX_STDOUT"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Outbox.Stdout = b
+		}
+	}
+	if v := os.Getenv("OUTBOX_WEBHOOK_URL"); v != "" {
+		cfg.Outbox.WebhookURL = v
+	}
+	if v := os.Getenv("OUTBOX_RETENTION_DAYS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Outbox.RetentionDays = n
+		}
+	}
+
+	if v := os.Getenv("WEBHOOKS_ENABLED"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Webhooks.Enabled = b
+		}
+	}
+	if v := os.Getenv("WEBHOOKS_RETENTION_DAYS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Webhooks.RetentionDays = n
+		}
+	}
+
+	if v := os.Getenv("METRICS_ENABLED"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Metrics.Enabled = b
+		}
+	}
+
+	if v := os.Getenv("TRACING_ENABLED"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Tracing.Enabled = b
+		}
+	}
+	if v := os.Getenv("TRACING_EXPORTER"); v != "" {
+		cfg.Tracing.Exporter = v
+	}
+	if v := os.Getenv("TRACING_ENDPOINT"); v != "" {
+		cfg.Tracing.Endpoint = v
+	}
+
+	if v := os.Getenv("STORAGE_DRIVER"); v != "" {
+		cfg.Storage.Driver = v
+	}
+	if v := os.Getenv("SQLITE_PATH"); v != "" {
+		cfg.Storage.SQLite.Path = v
+	}
+
+	if v := os.Getenv("HEALTH_DRAIN_DELAY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Health.DrainDelay = n
+		}
+	}
+
+	if v := os.Getenv("SIGNING_KEYS_RELOAD_INTERVAL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.SigningKeys.ReloadInterval = n
+		}
+	}
+
+	if v := os.Getenv("TOKEN_JANITOR_ENABLED"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Janitor.Enabled = b
+		}
+	}
+	if v := os.Getenv("TOKEN_JANITOR_INTERVAL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Janitor.Interval = n
+		}
+	}
+
+	if v := os.Getenv("TOKENS_ISSUER"); v != "" {
+		cfg.Tokens.Issuer = v
+	}
+	if v := os.Getenv("TOKENS_AUDIENCES"); v != "" {
+		parts := strings.Split(v, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		cfg.Tokens.Audiences = parts
+	}
+	if v := os.Getenv("TOKENS_ACCESS_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Tokens.AccessTTL = n
+		}
+	}
+	if v := os.Getenv("TOKENS_REFRESH_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Tokens.RefreshTTL = n
+		}
+	}
+	if v := os.Getenv("TOKENS_LEEWAY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Tokens.Leeway = n
+		}
+	}
+
+	if v := os.Getenv("SESSIONS_ABSOLUTE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Sessions.AbsoluteTTL = n
+		}
+	}
+	if v := os.Getenv("SESSIONS_IDLE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Sessions.IdleTTL = n
+		}
+	}
+	if v := os.Getenv("SESSIONS_REMEMBER_ME_ABSOLUTE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Sessions.RememberMe.AbsoluteTTL = n
+		}
+	}
+	if v := os.Getenv("SESSIONS_REMEMBER_ME_IDLE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Sessions.RememberMe.IdleTTL = n
+		}
+	}
+
+	if v := os.Getenv("CLAIMS_MAX_BYTES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Claims.MaxBytes = n
+		}
+	}
+	if v := os.Getenv("CLAIMS_ATTRIBUTES"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Claims.Attributes = b
+		}
+	}
+	if v := os.Getenv("CLAIMS_CALLBACK_URL"); v != "" {
+		cfg.Claims.CallbackURL = v
+	}
+	if v := os.Getenv("CLAIMS_CALLBACK_TIMEOUT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Claims.CallbackTimeout = n
+		}
+	}
+
+	if v := os.Getenv("NOTIFY_DRIVER"); v != "" {
+		cfg.Notify.Driver = v
+	}
+	if v := os.Getenv("NOTIFY_FROM"); v != "" {
+		cfg.Notify.From = v
+	}
+	if v := os.Getenv("SMTP_HOST"); v != "" {
+		cfg.Notify.SMTP.Host = v
+	}
+	if v := os.Getenv("SMTP_PORT"); v != "" {
+		cfg.Notify.SMTP.Port = v
+	}
+	if v := os.Getenv("SMTP_USERNAME"); v != "" {
+		cfg.Notify.SMTP.Username = v
+	}
+	if v := os.Getenv("SMTP_PASSWORD"); v != "" {
+		cfg.Notify.SMTP.Password = v
+	}
+	if v := os.Getenv("SMS_DRIVER"); v != "" {
+		cfg.Notify.SMS.Driver = v
+	}
+	if v := os.Getenv("SMS_FILE"); v != "" {
+		cfg.Notify.SMS.File = v
+	}
+	if v := os.Getenv("TWILIO_ACCOUNT_SID"); v != "" {
+		cfg.Notify.SMS.Twilio.AccountSID = v
+	}
+	if v := os.Getenv("TWILIO_AUTH_TOKEN"); v != "" {
+		cfg.Notify.SMS.Twilio.AuthToken = v
+	}
+	if v := os.Getenv("TWILIO_FROM"); v != "" {
+		cfg.Notify.SMS.Twilio.From = v
+	}
+
+	if v := os.Getenv("EMAIL_CHANGE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.EmailChange.TTL = n
+		}
+	}
+	if v := os.Getenv("EMAIL_CHANGE_CONFIRM_URL"); v != "" {
+		cfg.EmailChange.ConfirmURL = v
+	}
+	if v := os.Getenv("EMAIL_CHANGE_CANCEL_URL"); v != "" {
+		cfg.EmailChange.CancelURL = v
+	}
+
+	if v := os.Getenv("MAGIC_LINK_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.MagicLink.TTL = n
+		}
+	}
+	if v := os.Getenv("MAGIC_LINK_LOGIN_URL"); v != "" {
+		cfg.MagicLink.LoginURL = v
+	}
+	if v := os.Getenv("MAGIC_LINK_BIND_BROWSER"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.MagicLink.BindBrowser = b
+		}
+	}
+	if v := os.Getenv("MAGIC_LINK_MAX_LINKS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.MagicLink.MaxLinks = n
+		}
+	}
+	if v := os.Getenv("MAGIC_LINK_LINKS_WINDOW"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.MagicLink.LinksWindow = n
+		}
+	}
+
+	if v := os.Getenv("OTP_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.OTP.TTL = n
+		}
+	}
+	if v := os.Getenv("OTP_LENGTH"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.OTP.Length = n
+		}
+	}
+	if v := os.Getenv("OTP_MAX_ATTEMPTS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.OTP.MaxAttempts = n
+		}
+	}
+	if v := os.Getenv("OTP_RESEND_INTERVAL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.OTP.ResendInterval = n
+		}
+	}
+	if v := os.Getenv("OTP_MAX_CODES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.OTP.MaxCodes = n
+		}
+	}
+	if v := os.Getenv("OTP_CODES_WINDOW"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.OTP.CodesWindow = n
+		}
+	}
+	if v := os.Getenv("OTP_PHONE_ATTRIBUTE"); v != "" {
+		cfg.OTP.PhoneAttribute = v
+	}
+
+	if v := os.Getenv("DEVICE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Device.TTL = n
+		}
+	}
+	if v := os.Getenv("DEVICE_INTERVAL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Device.Interval = n
+		}
+	}
+	if v := os.Getenv("DEVICE_VERIFICATION_URL"); v != "" {
+		cfg.Device.VerificationURL = v
+	}
+
+	if v := os.Getenv("DPOP_PROOF_LIFETIME"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.DPoP.ProofLifetime = n
+		}
+	}
+	if v := os.Getenv("DPOP_REQUIRE_NONCE"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.DPoP.RequireNonce = b
+		}
+	}
+	if v := os.Getenv("DPOP_NONCE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.DPoP.NonceTTL = n
+		}
+	}
+	if v := os.Getenv("DPOP_PUBLIC_URL"); v != "" {
+		cfg.DPoP.PublicURL = v
+	}
+
+	if v := os.Getenv("ACCOUNTS_DELETION_GRACE_PERIOD"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Accounts.DeletionGracePeriod = n
+		}
+	}
+
+	if v := os.Getenv("REVOCATION_CACHE_TTL"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Revocation.CacheTTL = n
+		}
+	}
+
 	if v := os.Getenv("CORS_ALLOWED_HEADERS"); v != "" {
 		parts := strings.Split(v, ",")
 		for i := range parts {
@@ -139,9 +752,322 @@ func LoadConfig() (*Config, error) {
 	if cfg.JWTsecret == "" {
 		return nil, fmt.Errorf("JWT_SECRET not set")
 	}
-	if cfg.Postgres.Password == "" {
-		return nil, fmt.Errorf("POSTGRES_PASSWORD not set")
+	switch cfg.Storage.Driver {
+	case "", "postgres":
+		if cfg.Postgres.Password == "" {
+			return nil, fmt.Errorf("POSTGRES_PASSWORD not set")
+		}
+	case "sqlite":
+		if cfg.Storage.SQLite.Path == "" {
+			return nil, fmt.Errorf("SQLITE_PATH not set")
+		}
+	case "memory":
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+	if err := cfg.Server.TLS.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Janitor.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Outbox.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Webhooks.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Tokens.validate(cfg.ClientAuth); err != nil {
+		return nil, err
+	}
+	if err := cfg.ClientAuth.validate(cfg.Server.TLS); err != nil {
+		return nil, err
+	}
+	if err := cfg.Exchange.validate(cfg.Tokens, cfg.ClientAuth); err != nil {
+		return nil, err
+	}
+	if err := cfg.Sessions.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Claims.MaxBytes < 0 || cfg.Claims.CallbackTimeout < 0 {
+		return nil, fmt.Errorf("claims size limit and callback timeout must not be negative")
+	}
+	if err := cfg.Notify.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.EmailChange.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.MagicLink.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OTP.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Device.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.DPoP.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Accounts.DeletionGracePeriod < 0 {
+		return nil, fmt.Errorf("account deletion grace period must not be negative")
 	}
 
 	return &cfg, nil
 }
+
+func (c TLSConfig) validate() error {
+	if !c.Enabled {
+		if c.ClientCAFile != "" {
+			return fmt.Errorf("TLS_CLIENT_CA_FILE set without TLS_ENABLED")
+		}
+		return nil
+	}
+	if c.CertFile == "" || c.KeyFile == "" {
+		return fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE not set")
+	}
+
+	return nil
+}
+
+func (c TokenJanitorConfig) validate() error {
+	if c.Interval < 0 || c.BatchSize < 0 {
+		return fmt.Errorf("token janitor interval and batch size must not be negative")
+	}
+
+	return nil
+}
+
+func (c OutboxConfig) validate() error {
+	if c.PollInterval < 0 || c.BatchSize < 0 || c.MaxAttempts < 0 || c.WebhookTimeout < 0 {
+		return fmt.Errorf("outbox poll interval, batch size, max attempts and webhook timeout must not be negative")
+	}
+	if c.RetentionDays < 0 {
+		return fmt.Errorf("outbox retention days must not be negative")
+	}
+
+	return nil
+}
+
+func (c WebhooksConfig) validate() error {
+	if c.PollInterval < 0 || c.BatchSize < 0 || c.MaxAttempts < 0 || c.Timeout < 0 {
+		return fmt.Errorf("webhooks poll interval, batch size, max attempts and timeout must not be negative")
+	}
+	if c.RetentionDays < 0 {
+		return fmt.Errorf("webhooks retention days must not be negative")
+	}
+
+	return nil
+}
+
+func (c TokensConfig) validate(clientAuth ClientAuthConfig) error {
+	if c.AccessTTL < 0 || c.RefreshTTL < 0 || c.Leeway < 0 {
+		return fmt.Errorf("token lifetimes and leeway must not be negative")
+	}
+
+	seen := make(map[string]bool, len(c.Clients))
+	for _, client := range c.Clients {
+		if client.ID == "" {
+			return fmt.Errorf("token client without id")
+		}
+		if seen[client.ID] {
+			return fmt.Errorf("duplicate token client %q", client.ID)
+		}
+		if client.AccessTTL < 0 || client.RefreshTTL < 0 {
+			return fmt.Errorf("token lifetimes of client %q must not be negative", client.ID)
+		}
+		if !clientAuth.has(client.ID) {
+			return fmt.Errorf("token client %q has no credentials in client_auth", client.ID)
+		}
+		seen[client.ID] = true
+	}
+
+	return nil
+}
+
+// validate also rejects audiences this service accepts tokens for, since an exchanged
+// token would then work here with every right of the exchanged one. For the same
+// reason the service needs audiences of its own, or it would accept tokens for any.
+// Exchange clients have to authenticate, so each needs credentials in client_auth.
+func (c ExchangeConfig) validate(tokens TokensConfig, clientAuth ClientAuthConfig) error {
+	if len(c.Clients) > 0 && len(tokens.Audiences) == 0 {
+		return fmt.Errorf("token exchange clients set without token audiences")
+	}
+
+	own := slices.Clone(tokens.Audiences)
+	for _, client := range tokens.Clients {
+		own = append(own, client.Audiences...)
+	}
+
+	seen := make(map[string]bool, len(c.Clients))
+	for _, client := range c.Clients {
+		if client.ID == "" {
+			return fmt.Errorf("token exchange client without id")
+		}
+		if seen[client.ID] {
+			return fmt.Errorf("duplicate token exchange client %q", client.ID)
+		}
+		if !clientAuth.has(client.ID) {
+			return fmt.Errorf("token exchange client %q without credentials in client_auth", client.ID)
+		}
+		if len(client.Audiences) == 0 {
+			return fmt.Errorf("token exchange client %q without audiences", client.ID)
+		}
+		for _, aud := range client.Audiences {
+			if aud == "" || slices.Contains(own, aud) {
+				return fmt.Errorf("invalid token exchange audience %q of client %q", aud, client.ID)
+			}
+		}
+		seen[client.ID] = true
+	}
+
+	return nil
+}
+
+// validate requires a client CA for clients authenticating with a certificate, which
+// would never be accepted without one.
+func (c ClientAuthConfig) validate(tls TLSConfig) error {
+	seen := make(map[string]bool, len(c.Clients))
+	for _, client := range c.Clients {
+		if client.ID == "" {
+			return fmt.Errorf("client_auth client without id")
+		}
+		if seen[client.ID] {
+			return fmt.Errorf("duplicate client_auth client %q", client.ID)
+		}
+		names := 0
+		for _, name := range []string{client.SecretHash, client.SubjectDN, client.DNSName, client.URI} {
+			if name != "" {
+				names++
+			}
+		}
+		if names != 1 {
+			return fmt.Errorf("client_auth client %q must set exactly one of secret_hash, subject_dn, san_dns and san_uri", client.ID)
+		}
+		if client.SecretHash != "" {
+			if b, err := hex.DecodeString(client.SecretHash); err != nil || len(b) != sha256.Size {
+				return fmt.Errorf("secret_hash of client_auth client %q is not a hex encoded sha-256 hash", client.ID)
+			}
+		} else if tls.ClientCAFile == "" {
+			return fmt.Errorf("client_auth client %q uses a certificate without TLS_CLIENT_CA_FILE", client.ID)
+		}
+		seen[client.ID] = true
+	}
+
+	return nil
+}
+
+func (c ClientAuthConfig) has(id string) bool {
+	return slices.ContainsFunc(c.Clients, func(client ClientAuthClientConfig) bool {
+		return client.ID == id
+	})
+}
+
+func (c SessionsConfig) validate() error {
+	if c.AbsoluteTTL < 0 || c.IdleTTL < 0 || c.RememberMe.AbsoluteTTL < 0 || c.RememberMe.IdleTTL < 0 {
+		return fmt.Errorf("session lifetimes must not be negative")
+	}
+
+	return nil
+}
+
+func (c NotifyConfig) validate() error {
+	switch c.Driver {
+	case "", "stdout":
+	case "smtp":
+		if c.SMTP.Host == "" || c.SMTP.Port == "" {
+			return fmt.Errorf("SMTP_HOST and SMTP_PORT not set")
+		}
+		if c.From == "" {
+			return fmt.Errorf("NOTIFY_FROM not set")
+		}
+	default:
+		return fmt.Errorf("unknown notify driver %q", c.Driver)
+	}
+	if c.SMTP.Timeout < 0 {
+		return fmt.Errorf("smtp timeout must not be negative")
+	}
+
+	return c.SMS.validate()
+}
+
+func (c SMSConfig) validate() error {
+	switch c.Driver {
+	case "", "stdout":
+	case "file":
+		if c.File == "" {
+			return fmt.Errorf("SMS_FILE not set")
+		}
+	case "twilio":
+		if c.Twilio.AccountSID == "" || c.Twilio.AuthToken == "" || c.Twilio.From == "" {
+			return fmt.Errorf("TWILIO_ACCOUNT_SID, TWILIO_AUTH_TOKEN and TWILIO_FROM not set")
+		}
+	default:
+		return fmt.Errorf("unknown sms driver %q", c.Driver)
+	}
+	if c.Twilio.Timeout < 0 {
+		return fmt.Errorf("twilio timeout must not be negative")
+	}
+
+	return nil
+}
+
+func (c EmailChangeConfig) validate() error {
+	if c.TTL < 0 {
+		return fmt.Errorf("email change ttl must not be negative")
+	}
+	for _, link := range []string{c.ConfirmURL, c.CancelURL} {
+		if u, err := url.Parse(link); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid email change link %q", link)
+		}
+	}
+
+	return nil
+}
+
+func (c MagicLinkConfig) validate() error {
+	if c.TTL < 0 || c.MaxLinks < 0 || c.LinksWindow < 0 {
+		return fmt.Errorf("magic link ttl, max links and links window must not be negative")
+	}
+	if u, err := url.Parse(c.LoginURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid magic link login url %q", c.LoginURL)
+	}
+
+	return nil
+}
+
+func (c OTPConfig) validate() error {
+	if c.TTL < 0 || c.ResendInterval < 0 || c.MaxAttempts < 0 || c.MaxCodes < 0 || c.CodesWindow < 0 {
+		return fmt.Errorf("otp ttl, resend interval, max attempts, max codes and codes window must not be negative")
+	}
+	if c.Length != 0 && (c.Length < 4 || c.Length > 10) {
+		return fmt.Errorf("otp length must be between 4 and 10")
+	}
+
+	return nil
+}
+
+func (c DeviceConfig) validate() error {
+	if c.TTL < 0 || c.Interval < 0 {
+		return fmt.Errorf("device ttl and interval must not be negative")
+	}
+	if u, err := url.Parse(c.VerificationURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid device verification url %q", c.VerificationURL)
+	}
+
+	return nil
+}
+
+func (c DPoPConfig) validate() error {
+	if c.ProofLifetime < 0 || c.NonceTTL < 0 {
+		return fmt.Errorf("dpop proof lifetime and nonce ttl must not be negative")
+	}
+	if c.PublicURL != "" {
+		if u, err := url.Parse(c.PublicURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid dpop public url %q", c.PublicURL)
+		}
+	}
+
+	return nil
+}
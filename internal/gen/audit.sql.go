@@ -0,0 +1,165 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit.sql
+
+package gen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createAuthEvent = `-- name: CreateAuthEvent :exec
+INSERT INTO auth_events (event_type, user_id, ip, user_agent, outcome, reason, request_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateAuthEventParams struct {
+	EventType string
+	UserID    uuid.NullUUID
+	Ip        string
+	UserAgent string
+	Outcome   string
+	Reason    string
+	RequestID string
+}
+
+func (q *Queries) CreateAuthEvent(ctx context.Context, arg CreateAuthEventParams) error {
+	_, err := q.db.ExecContext(ctx, createAuthEvent,
+		arg.EventType,
+		arg.UserID,
+		arg.Ip,
+		arg.UserAgent,
+		arg.Outcome,
+		arg.Reason,
+		arg.RequestID,
+	)
+	return err
+}
+
+const deleteAuthEventsBefore = `-- name: DeleteAuthEventsBefore :execrows
+DELETE FROM auth_events
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteAuthEventsBefore(ctx context.Context, createdAt time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAuthEventsBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listAuthEvents = `-- name: ListAuthEvents :many
+SELECT id, event_type, user_id, ip, user_agent, outcome, reason, request_id, created_at
+FROM auth_events
+WHERE ($1::uuid IS NULL OR user_id = $1)
+  AND ($2::text IS NULL OR event_type = $2)
+  AND ($3::text IS NULL OR outcome = $3)
+  AND ($4::timestamptz IS NULL OR created_at >= $4)
+  AND ($5::timestamptz IS NULL OR created_at < $5)
+ORDER BY created_at DESC
+LIMIT $7 OFFSET $6
+`
+
+type ListAuthEventsParams struct {
+	UserID    uuid.NullUUID
+	EventType sql.NullString
+	Outcome   sql.NullString
+	Since     sql.NullTime
+	Until     sql.NullTime
+	Offset    int32
+	Limit     int32
+}
+
+func (q *Queries) ListAuthEvents(ctx context.Context, arg ListAuthEventsParams) ([]AuthEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthEvents,
+		arg.UserID,
+		arg.EventType,
+		arg.Outcome,
+		arg.Since,
+		arg.Until,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthEvent
+	for rows.Next() {
+		var i AuthEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.UserID,
+			&i.Ip,
+			&i.UserAgent,
+			&i.Outcome,
+			&i.Reason,
+			&i.RequestID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthEventsByUser = `-- name: ListAuthEventsByUser :many
+SELECT id, event_type, user_id, ip, user_agent, outcome, reason, request_id, created_at
+FROM auth_events
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListAuthEventsByUserParams struct {
+	UserID uuid.NullUUID
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) ListAuthEventsByUser(ctx context.Context, arg ListAuthEventsByUserParams) ([]AuthEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthEventsByUser, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthEvent
+	for rows.Next() {
+		var i AuthEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.UserID,
+			&i.Ip,
+			&i.UserAgent,
+			&i.Outcome,
+			&i.Reason,
+			&i.RequestID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -7,15 +7,45 @@ package gen
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const cancelUserDeletion = `-- name: CancelUserDeletion :one
+UPDATE users
+SET is_active = TRUE, delete_after = NULL
+WHERE user_id = $1
+  AND delete_after IS NOT NULL
+RETURNING user_id, email, created_at, is_active, role
+`
+
+type CancelUserDeletionRow struct {
+	UserID    uuid.UUID
+	Email     string
+	CreatedAt time.Time
+	IsActive  bool
+	Role      string
+}
+
+func (q *Queries) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (CancelUserDeletionRow, error) {
+	row := q.db.QueryRowContext(ctx, cancelUserDeletion, userID)
+	var i CancelUserDeletionRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsActive,
+		&i.Role,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (email, password_hash)
 VALUES($1, $2)
-RETURNING user_id, email, created_at, is_active
+RETURNING user_id, email, created_at, is_active, role
 `
 
 type CreateUserParams struct {
@@ -28,6 +58,7 @@ type CreateUserRow struct {
 	Email     string
 	CreatedAt time.Time
 	IsActive  bool
+	Role      string
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
@@ -38,31 +69,89 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateU
 		&i.Email,
 		&i.CreatedAt,
 		&i.IsActive,
+		&i.Role,
 	)
 	return i, err
 }
 
+const deactivateUser = `-- name: DeactivateUser :one
+UPDATE users
+SET is_active = FALSE
+WHERE user_id = $1
+RETURNING user_id, email, created_at, is_active, role
+`
+
+type DeactivateUserRow struct {
+	UserID    uuid.UUID
+	Email     string
+	CreatedAt time.Time
+	IsActive  bool
+	Role      string
+}
+
+func (q *Queries) DeactivateUser(ctx context.Context, userID uuid.UUID) (DeactivateUserRow, error) {
+	row := q.db.QueryRowContext(ctx, deactivateUser, userID)
+	var i DeactivateUserRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsActive,
+		&i.Role,
+	)
+	return i, err
+}
+
+const deleteScheduledUser = `-- name: DeleteScheduledUser :execrows
+DELETE FROM users
+WHERE user_id = $1
+  AND delete_after <= $2
+`
+
+type DeleteScheduledUserParams struct {
+	UserID uuid.UUID
+	Now    sql.NullTime
+}
+
+func (q *Queries) DeleteScheduledUser(ctx context.Context, arg DeleteScheduledUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteScheduledUser, arg.UserID, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const findUserByEmail = `-- name: FindUserByEmail :one
-SELECT user_id, email, password_hash, created_at, is_active
+SELECT user_id, email, password_hash, created_at, is_active, role
 FROM users
 WHERE email = $1
 `
 
-func (q *Queries) FindUserByEmail(ctx context.Context, email string) (User, error) {
+type FindUserByEmailRow struct {
+	UserID       uuid.UUID
+	Email        string
+	PasswordHash string
+	CreatedAt    time.Time
+	IsActive     bool
+	Role         string
+}
+
+func (q *Queries) FindUserByEmail(ctx context.Context, email string) (FindUserByEmailRow, error) {
 	row := q.db.QueryRowContext(ctx, findUserByEmail, email)
-	var i User
+	var i FindUserByEmailRow
 	err := row.Scan(
 		&i.UserID,
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.IsActive,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getUserInfo = `-- name: GetUserInfo :one
-SELECT user_id, email, created_at, is_active
+SELECT user_id, email, created_at, is_active, role
 FROM users
 WHERE user_id = $1
 `
@@ -72,6 +161,7 @@ type GetUserInfoRow struct {
 	Email     string
 	CreatedAt time.Time
 	IsActive  bool
+	Role      string
 }
 
 func (q *Queries) GetUserInfo(ctx context.Context, userID uuid.UUID) (GetUserInfoRow, error) {
@@ -82,6 +172,128 @@ func (q *Queries) GetUserInfo(ctx context.Context, userID uuid.UUID) (GetUserInf
 		&i.Email,
 		&i.CreatedAt,
 		&i.IsActive,
+		&i.Role,
+	)
+	return i, err
+}
+
+const listUsersDueForDeletion = `-- name: ListUsersDueForDeletion :many
+SELECT user_id
+FROM users
+WHERE delete_after <= $1
+ORDER BY delete_after
+LIMIT $2
+`
+
+type ListUsersDueForDeletionParams struct {
+	Now       sql.NullTime
+	BatchSize int32
+}
+
+func (q *Queries) ListUsersDueForDeletion(ctx context.Context, arg ListUsersDueForDeletionParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersDueForDeletion, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const scheduleUserDeletion = `-- name: ScheduleUserDeletion :one
+UPDATE users
+SET is_active = FALSE, delete_after = $2
+WHERE user_id = $1
+RETURNING user_id, email, created_at, is_active, role
+`
+
+type ScheduleUserDeletionParams struct {
+	UserID      uuid.UUID
+	DeleteAfter sql.NullTime
+}
+
+type ScheduleUserDeletionRow struct {
+	UserID    uuid.UUID
+	Email     string
+	CreatedAt time.Time
+	IsActive  bool
+	Role      string
+}
+
+func (q *Queries) ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (ScheduleUserDeletionRow, error) {
+	row := q.db.QueryRowContext(ctx, scheduleUserDeletion, arg.UserID, arg.DeleteAfter)
+	var i ScheduleUserDeletionRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsActive,
+		&i.Role,
 	)
 	return i, err
 }
+
+const updateUserEmail = `-- name: UpdateUserEmail :one
+UPDATE users
+SET email = $2
+WHERE user_id = $1
+RETURNING user_id, email, created_at, is_active, role
+`
+
+type UpdateUserEmailParams struct {
+	UserID uuid.UUID
+	Email  string
+}
+
+type UpdateUserEmailRow struct {
+	UserID    uuid.UUID
+	Email     string
+	CreatedAt time.Time
+	IsActive  bool
+	Role      string
+}
+
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) (UpdateUserEmailRow, error) {
+	row := q.db.QueryRowContext(ctx, updateUserEmail, arg.UserID, arg.Email)
+	var i UpdateUserEmailRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsActive,
+		&i.Role,
+	)
+	return i, err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :execrows
+UPDATE users
+SET password_hash = $2
+WHERE user_id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	UserID       uuid.UUID
+	PasswordHash string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserPassword, arg.UserID, arg.PasswordHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
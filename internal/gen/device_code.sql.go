@@ -0,0 +1,175 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: device_code.sql
+
+package gen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const decideDeviceCode = `-- name: DecideDeviceCode :execrows
+UPDATE device_codes
+SET status = $2,
+    user_id = $3
+WHERE user_code_hash = $1 AND status = 'pending'
+`
+
+type DecideDeviceCodeParams struct {
+	UserCodeHash string
+	Status       string
+	UserID       uuid.NullUUID
+}
+
+func (q *Queries) DecideDeviceCode(ctx context.Context, arg DecideDeviceCodeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, decideDeviceCode, arg.UserCodeHash, arg.Status, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteDeviceCode = `-- name: DeleteDeviceCode :execrows
+DELETE FROM device_codes
+WHERE device_code_hash = $1
+`
+
+func (q *Queries) DeleteDeviceCode(ctx context.Context, deviceCodeHash string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDeviceCode, deviceCodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredDeviceCodes = `-- name: DeleteExpiredDeviceCodes :execrows
+DELETE FROM device_codes
+WHERE device_code_hash IN (
+    SELECT device_code_hash
+    FROM device_codes
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+func (q *Queries) DeleteExpiredDeviceCodes(ctx context.Context, limit int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredDeviceCodes, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getDeviceCode = `-- name: GetDeviceCode :one
+SELECT device_code_hash, user_code_hash, client_id, status, user_id, poll_interval, last_polled_at, expires_at, created_at
+FROM device_codes
+WHERE device_code_hash = $1
+`
+
+func (q *Queries) GetDeviceCode(ctx context.Context, deviceCodeHash string) (DeviceCode, error) {
+	row := q.db.QueryRowContext(ctx, getDeviceCode, deviceCodeHash)
+	var i DeviceCode
+	err := row.Scan(
+		&i.DeviceCodeHash,
+		&i.UserCodeHash,
+		&i.ClientID,
+		&i.Status,
+		&i.UserID,
+		&i.PollInterval,
+		&i.LastPolledAt,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDeviceCodeByUserCode = `-- name: GetDeviceCodeByUserCode :one
+SELECT device_code_hash, user_code_hash, client_id, status, user_id, poll_interval, last_polled_at, expires_at, created_at
+FROM device_codes
+WHERE user_code_hash = $1
+`
+
+func (q *Queries) GetDeviceCodeByUserCode(ctx context.Context, userCodeHash string) (DeviceCode, error) {
+	row := q.db.QueryRowContext(ctx, getDeviceCodeByUserCode, userCodeHash)
+	var i DeviceCode
+	err := row.Scan(
+		&i.DeviceCodeHash,
+		&i.UserCodeHash,
+		&i.ClientID,
+		&i.Status,
+		&i.UserID,
+		&i.PollInterval,
+		&i.LastPolledAt,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const pollDeviceCode = `-- name: PollDeviceCode :execrows
+UPDATE device_codes
+SET last_polled_at = $1
+WHERE device_code_hash = $2
+  AND (last_polled_at IS NULL OR last_polled_at <= $3)
+`
+
+type PollDeviceCodeParams struct {
+	PolledAt       sql.NullTime
+	DeviceCodeHash string
+	PolledBefore   sql.NullTime
+}
+
+func (q *Queries) PollDeviceCode(ctx context.Context, arg PollDeviceCodeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, pollDeviceCode, arg.PolledAt, arg.DeviceCodeHash, arg.PolledBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const saveDeviceCode = `-- name: SaveDeviceCode :exec
+INSERT INTO device_codes (device_code_hash, user_code_hash, client_id, poll_interval, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type SaveDeviceCodeParams struct {
+	DeviceCodeHash string
+	UserCodeHash   string
+	ClientID       string
+	PollInterval   int32
+	ExpiresAt      time.Time
+}
+
+func (q *Queries) SaveDeviceCode(ctx context.Context, arg SaveDeviceCodeParams) error {
+	_, err := q.db.ExecContext(ctx, saveDeviceCode,
+		arg.DeviceCodeHash,
+		arg.UserCodeHash,
+		arg.ClientID,
+		arg.PollInterval,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const slowDownDeviceCode = `-- name: SlowDownDeviceCode :exec
+UPDATE device_codes
+SET poll_interval = poll_interval + $1
+WHERE device_code_hash = $2
+`
+
+type SlowDownDeviceCodeParams struct {
+	Step           int32
+	DeviceCodeHash string
+}
+
+func (q *Queries) SlowDownDeviceCode(ctx context.Context, arg SlowDownDeviceCodeParams) error {
+	_, err := q.db.ExecContext(ctx, slowDownDeviceCode, arg.Step, arg.DeviceCodeHash)
+	return err
+}
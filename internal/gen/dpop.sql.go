@@ -0,0 +1,50 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: dpop.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+)
+
+const deleteExpiredDPoPProofs = `-- name: DeleteExpiredDPoPProofs :execrows
+DELETE FROM dpop_proofs
+WHERE proof_hash IN (
+    SELECT proof_hash
+    FROM dpop_proofs
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+func (q *Queries) DeleteExpiredDPoPProofs(ctx context.Context, limit int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredDPoPProofs, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const saveDPoPProof = `-- name: SaveDPoPProof :execrows
+INSERT INTO dpop_proofs (proof_hash, expires_at)
+VALUES ($1, $2)
+ON CONFLICT (proof_hash) DO NOTHING
+`
+
+type SaveDPoPProofParams struct {
+	ProofHash string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) SaveDPoPProof(ctx context.Context, arg SaveDPoPProofParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, saveDPoPProof, arg.ProofHash, arg.ExpiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: email_change.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const cancelEmailChange = `-- name: CancelEmailChange :one
+DELETE FROM email_changes
+WHERE cancel_token_hash = $1
+RETURNING user_id, new_email, expires_at, created_at
+`
+
+type CancelEmailChangeRow struct {
+	UserID    uuid.UUID
+	NewEmail  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (q *Queries) CancelEmailChange(ctx context.Context, cancelTokenHash string) (CancelEmailChangeRow, error) {
+	row := q.db.QueryRowContext(ctx, cancelEmailChange, cancelTokenHash)
+	var i CancelEmailChangeRow
+	err := row.Scan(
+		&i.UserID,
+		&i.NewEmail,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const consumeEmailChange = `-- name: ConsumeEmailChange :one
+DELETE FROM email_changes
+WHERE confirm_token_hash = $1
+RETURNING user_id, new_email, expires_at, created_at
+`
+
+type ConsumeEmailChangeRow struct {
+	UserID    uuid.UUID
+	NewEmail  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (q *Queries) ConsumeEmailChange(ctx context.Context, confirmTokenHash string) (ConsumeEmailChangeRow, error) {
+	row := q.db.QueryRowContext(ctx, consumeEmailChange, confirmTokenHash)
+	var i ConsumeEmailChangeRow
+	err := row.Scan(
+		&i.UserID,
+		&i.NewEmail,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const saveEmailChange = `-- name: SaveEmailChange :exec
+INSERT INTO email_changes (user_id, new_email, confirm_token_hash, cancel_token_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET new_email = EXCLUDED.new_email,
+    confirm_token_hash = EXCLUDED.confirm_token_hash,
+    cancel_token_hash = EXCLUDED.cancel_token_hash,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW()
+`
+
+type SaveEmailChangeParams struct {
+	UserID           uuid.UUID
+	NewEmail         string
+	ConfirmTokenHash string
+	CancelTokenHash  string
+	ExpiresAt        time.Time
+}
+
+func (q *Queries) SaveEmailChange(ctx context.Context, arg SaveEmailChangeParams) error {
+	_, err := q.db.ExecContext(ctx, saveEmailChange,
+		arg.UserID,
+		arg.NewEmail,
+		arg.ConfirmTokenHash,
+		arg.CancelTokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
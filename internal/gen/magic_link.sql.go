@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: magic_link.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const consumeMagicLink = `-- name: ConsumeMagicLink :one
+DELETE FROM magic_links
+WHERE token_hash = $1
+RETURNING user_id, binding_hash, remember_me, expires_at, created_at
+`
+
+type ConsumeMagicLinkRow struct {
+	UserID      uuid.UUID
+	BindingHash string
+	RememberMe  bool
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func (q *Queries) ConsumeMagicLink(ctx context.Context, tokenHash string) (ConsumeMagicLinkRow, error) {
+	row := q.db.QueryRowContext(ctx, consumeMagicLink, tokenHash)
+	var i ConsumeMagicLinkRow
+	err := row.Scan(
+		&i.UserID,
+		&i.BindingHash,
+		&i.RememberMe,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const countMagicLinkRequest = `-- name: CountMagicLinkRequest :one
+INSERT INTO magic_link_sends (user_id, requests, window_started_at, window_ends_at)
+VALUES ($1, 1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET requests = CASE WHEN magic_link_sends.window_ends_at > EXCLUDED.window_started_at THEN magic_link_sends.requests + 1 ELSE 1 END,
+    window_started_at = CASE WHEN magic_link_sends.window_ends_at > EXCLUDED.window_started_at THEN magic_link_sends.window_started_at ELSE EXCLUDED.window_started_at END,
+    window_ends_at = CASE WHEN magic_link_sends.window_ends_at > EXCLUDED.window_started_at THEN magic_link_sends.window_ends_at ELSE EXCLUDED.window_ends_at END
+RETURNING requests
+`
+
+type CountMagicLinkRequestParams struct {
+	UserID          uuid.UUID
+	WindowStartedAt time.Time
+	WindowEndsAt    time.Time
+}
+
+func (q *Queries) CountMagicLinkRequest(ctx context.Context, arg CountMagicLinkRequestParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countMagicLinkRequest, arg.UserID, arg.WindowStartedAt, arg.WindowEndsAt)
+	var requests int32
+	err := row.Scan(&requests)
+	return requests, err
+}
+
+const saveMagicLink = `-- name: SaveMagicLink :exec
+INSERT INTO magic_links (user_id, token_hash, binding_hash, remember_me, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    binding_hash = EXCLUDED.binding_hash,
+    remember_me = EXCLUDED.remember_me,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW()
+`
+
+type SaveMagicLinkParams struct {
+	UserID      uuid.UUID
+	TokenHash   string
+	BindingHash string
+	RememberMe  bool
+	ExpiresAt   time.Time
+}
+
+func (q *Queries) SaveMagicLink(ctx context.Context, arg SaveMagicLinkParams) error {
+	_, err := q.db.ExecContext(ctx, saveMagicLink,
+		arg.UserID,
+		arg.TokenHash,
+		arg.BindingHash,
+		arg.RememberMe,
+		arg.ExpiresAt,
+	)
+	return err
+}
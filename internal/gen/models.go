@@ -5,17 +5,133 @@
 package gen
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type ApiKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  sql.NullTime
+	LastUsedAt sql.NullTime
+}
+
+type AuthEvent struct {
+	ID        uuid.UUID
+	EventType string
+	UserID    uuid.NullUUID
+	Ip        string
+	UserAgent string
+	Outcome   string
+	Reason    string
+	RequestID string
+	CreatedAt time.Time
+}
+
+type DeviceCode struct {
+	DeviceCodeHash string
+	UserCodeHash   string
+	ClientID       string
+	Status         string
+	UserID         uuid.NullUUID
+	PollInterval   int32
+	LastPolledAt   sql.NullTime
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+}
+
+type DpopProof struct {
+	ProofHash string
+	ExpiresAt time.Time
+}
+
+type EmailChange struct {
+	UserID           uuid.UUID
+	NewEmail         string
+	ConfirmTokenHash string
+	CancelTokenHash  string
+	ExpiresAt        time.Time
+	CreatedAt        time.Time
+}
+
+type MagicLink struct {
+	UserID      uuid.UUID
+	TokenHash   string
+	BindingHash string
+	RememberMe  bool
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+type MagicLinkSend struct {
+	UserID          uuid.UUID
+	Requests        int32
+	WindowStartedAt time.Time
+	WindowEndsAt    time.Time
+}
+
+type OtpCode struct {
+	UserID     uuid.UUID
+	Channel    string
+	CodeHash   string
+	Attempts   int32
+	RememberMe bool
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+type OtpRequest struct {
+	UserID          uuid.UUID
+	Requests        int32
+	WindowStartedAt time.Time
+	WindowEndsAt    time.Time
+}
+
+type OutboxEvent struct {
+	ID            uuid.UUID
+	AggregateID   uuid.UUID
+	EventType     string
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+	PublishedAt   sql.NullTime
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+type RevokedAccessToken struct {
+	Jti       string
+	UserID    uuid.UUID
+	RevokedAt time.Time
+	ExpiresAt time.Time
+}
+
+type SigningKey struct {
+	Kid       string
+	Secret    []byte
+	CreatedAt time.Time
+	RetiredAt sql.NullTime
+}
+
 type Token struct {
 	ID               uuid.UUID
 	UserID           uuid.UUID
 	RefreshTokenHash string
 	CreatedAt        time.Time
 	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+	DpopJkt          string
+	ClientID         string
+	X5t              string
 }
 
 type User struct {
@@ -24,4 +140,42 @@ type User struct {
 	PasswordHash string
 	CreatedAt    time.Time
 	IsActive     bool
+	Role         string
+	DeleteAfter  sql.NullTime
+}
+
+type UserAccessTokenRevocation struct {
+	UserID       uuid.UUID
+	IssuedBefore time.Time
+	ExpiresAt    time.Time
+}
+
+type UserAttribute struct {
+	UserID    uuid.UUID
+	Name      string
+	Value     json.RawMessage
+	UpdatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastStatusCode int32
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	Url        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
 }
@@ -27,6 +27,87 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// APIV1AdminAuthEventsGet invokes GET /api/v1/admin/auth-events operation.
+	//
+	// Returns security audit events of all users filtered by the given parameters, newest first.
+	//
+	// GET /api/v1/admin/auth-events
+	APIV1AdminAuthEventsGet(ctx context.Context, params APIV1AdminAuthEventsGetParams) (APIV1AdminAuthEventsGetRes, error)
+	// APIV1AdminWebhooksGet invokes GET /api/v1/admin/webhooks operation.
+	//
+	// Returns all webhook subscriptions without their signing secrets.
+	//
+	// GET /api/v1/admin/webhooks
+	APIV1AdminWebhooksGet(ctx context.Context) (APIV1AdminWebhooksGetRes, error)
+	// APIV1AdminWebhooksPost invokes POST /api/v1/admin/webhooks operation.
+	//
+	// Subscribes an HTTP endpoint to auth events. The signing secret is returned only once.
+	//
+	// POST /api/v1/admin/webhooks
+	APIV1AdminWebhooksPost(ctx context.Context, request *CreateWebhookRequest) (APIV1AdminWebhooksPostRes, error)
+	// APIV1AdminWebhooksWebhookIDDelete invokes DELETE /api/v1/admin/webhooks/{webhook_id} operation.
+	//
+	// Deletes a webhook subscription together with its delivery log.
+	//
+	// DELETE /api/v1/admin/webhooks/{webhook_id}
+	APIV1AdminWebhooksWebhookIDDelete(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeleteParams) (APIV1AdminWebhooksWebhookIDDeleteRes, error)
+	// APIV1AdminWebhooksWebhookIDDeliveriesGet invokes GET /api/v1/admin/webhooks/{webhook_id}/deliveries operation.
+	//
+	// Returns deliveries of a webhook subscription, newest first.
+	//
+	// GET /api/v1/admin/webhooks/{webhook_id}/deliveries
+	APIV1AdminWebhooksWebhookIDDeliveriesGet(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeliveriesGetParams) (APIV1AdminWebhooksWebhookIDDeliveriesGetRes, error)
+	// APIV1AuthAPIKeysGet invokes GET /api/v1/auth/api-keys operation.
+	//
+	// Returns all API keys of an authorized user without their secret values.
+	//
+	// GET /api/v1/auth/api-keys
+	APIV1AuthAPIKeysGet(ctx context.Context) (APIV1AuthAPIKeysGetRes, error)
+	// APIV1AuthAPIKeysKeyIDDelete invokes DELETE /api/v1/auth/api-keys/{key_id} operation.
+	//
+	// Deletes an API key of an authorized user.
+	//
+	// DELETE /api/v1/auth/api-keys/{key_id}
+	APIV1AuthAPIKeysKeyIDDelete(ctx context.Context, params APIV1AuthAPIKeysKeyIDDeleteParams) (APIV1AuthAPIKeysKeyIDDeleteRes, error)
+	// APIV1AuthAPIKeysPost invokes POST /api/v1/auth/api-keys operation.
+	//
+	// Creates a new long-lived API key for an authorized user. The key is returned only once.
+	//
+	// POST /api/v1/auth/api-keys
+	APIV1AuthAPIKeysPost(ctx context.Context, request *CreateAPIKeyRequest) (APIV1AuthAPIKeysPostRes, error)
+	// APIV1AuthDeviceGet invokes GET /api/v1/auth/device operation.
+	//
+	// Returns the client of a pending device authorization, so that the user can check it before
+	// approving the user code shown on the device.
+	//
+	// GET /api/v1/auth/device
+	APIV1AuthDeviceGet(ctx context.Context, params APIV1AuthDeviceGetParams) (APIV1AuthDeviceGetRes, error)
+	// APIV1AuthDevicePost invokes POST /api/v1/auth/device operation.
+	//
+	// Approves or denies the device authorization of a user code for the authorized user. An approved
+	// device gets tokens for the user on its next poll.
+	//
+	// POST /api/v1/auth/device
+	APIV1AuthDevicePost(ctx context.Context, request *DeviceVerifyRequest) (APIV1AuthDevicePostRes, error)
+	// APIV1AuthEmailCancelPost invokes POST /api/v1/auth/email/cancel operation.
+	//
+	// Cancels a pending email change with the token sent to the current address.
+	//
+	// POST /api/v1/auth/email/cancel
+	APIV1AuthEmailCancelPost(ctx context.Context, request *EmailChangeTokenRequest) (APIV1AuthEmailCancelPostRes, error)
+	// APIV1AuthEmailConfirmPost invokes POST /api/v1/auth/email/confirm operation.
+	//
+	// Swaps the email of the user with the new address the token was sent to. A token can be used once.
+	//
+	// POST /api/v1/auth/email/confirm
+	APIV1AuthEmailConfirmPost(ctx context.Context, request *EmailChangeTokenRequest) (APIV1AuthEmailConfirmPostRes, error)
+	// APIV1AuthEmailPost invokes POST /api/v1/auth/email operation.
+	//
+	// Requests a change of the email of an authorized user. A confirm link is sent to the new address
+	// and a cancel link to the current one; the email changes only once the change is confirmed.
+	//
+	// POST /api/v1/auth/email
+	APIV1AuthEmailPost(ctx context.Context, request *ChangeEmailRequest) (APIV1AuthEmailPostRes, error)
 	// APIV1AuthLoginPost invokes POST /api/v1/auth/login operation.
 	//
 	// Creates a new tokens for user to access secure endpoints.
@@ -35,16 +116,66 @@ type Invoker interface {
 	APIV1AuthLoginPost(ctx context.Context, request *LoginRequest) (APIV1AuthLoginPostRes, error)
 	// APIV1AuthLogoutPost invokes POST /api/v1/auth/logout operation.
 	//
-	// Allows to logout user by refresh token.
+	// Allows to logout user by refresh token. The access token, when sent, is revoked as well.
 	//
 	// POST /api/v1/auth/logout
 	APIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLogoutPostParams) (APIV1AuthLogoutPostRes, error)
+	// APIV1AuthMagicLinkConsumePost invokes POST /api/v1/auth/magic-link/consume operation.
+	//
+	// Exchanges the token of a magic link for new tokens like a login. A link can be used once.
+	//
+	// POST /api/v1/auth/magic-link/consume
+	APIV1AuthMagicLinkConsumePost(ctx context.Context, request *MagicLinkConsumeRequest, params APIV1AuthMagicLinkConsumePostParams) (APIV1AuthMagicLinkConsumePostRes, error)
+	// APIV1AuthMagicLinkPost invokes POST /api/v1/auth/magic-link operation.
+	//
+	// Emails a single-use login link to the user. The response is the same whether the email is
+	// registered or not. If links are bound to the browser, a cookie is set that has to be sent along
+	// when the link is consumed.
+	//
+	// POST /api/v1/auth/magic-link
+	APIV1AuthMagicLinkPost(ctx context.Context, request *MagicLinkRequest) (APIV1AuthMagicLinkPostRes, error)
+	// APIV1AuthMeDelete invokes DELETE /api/v1/auth/me operation.
+	//
+	// Deletes the account of an authorized user after the password is confirmed. The account is
+	// deactivated and signed out everywhere at once and deleted for good, with everything stored about
+	// it, after the configured grace period.
+	//
+	// DELETE /api/v1/auth/me
+	APIV1AuthMeDelete(ctx context.Context, request *DeleteAccountRequest) (APIV1AuthMeDeleteRes, error)
+	// APIV1AuthMeEventsGet invokes GET /api/v1/auth/me/events operation.
+	//
+	// Returns security audit events of an authorized user, newest first.
+	//
+	// GET /api/v1/auth/me/events
+	APIV1AuthMeEventsGet(ctx context.Context, params APIV1AuthMeEventsGetParams) (APIV1AuthMeEventsGetRes, error)
+	// APIV1AuthMeExportGet invokes GET /api/v1/auth/me/export operation.
+	//
+	// Returns everything stored about an authorized user: profile, active sessions, API keys, custom
+	// claim attributes and authentication events.
+	//
+	// GET /api/v1/auth/me/export
+	APIV1AuthMeExportGet(ctx context.Context) (APIV1AuthMeExportGetRes, error)
 	// APIV1AuthMeGet invokes GET /api/v1/auth/me operation.
 	//
 	// Allows to obtaining information about an authorized user by access token.
 	//
 	// GET /api/v1/auth/me
 	APIV1AuthMeGet(ctx context.Context) (APIV1AuthMeGetRes, error)
+	// APIV1AuthOtpPost invokes POST /api/v1/auth/otp operation.
+	//
+	// Sends a numeric login code to the user by email or SMS. SMS codes go to the phone number attribute
+	// of the user. Only a few codes are sent to a user per hour, and a new code is not sent sooner than
+	// the resend interval. The response is the same whether the email is registered or not.
+	//
+	// POST /api/v1/auth/otp
+	APIV1AuthOtpPost(ctx context.Context, request *OTPRequest) (APIV1AuthOtpPostRes, error)
+	// APIV1AuthOtpVerifyPost invokes POST /api/v1/auth/otp/verify operation.
+	//
+	// Exchanges a one-time code for new tokens like a login. A code can be used once and is discarded
+	// after too many wrong tries.
+	//
+	// POST /api/v1/auth/otp/verify
+	APIV1AuthOtpVerifyPost(ctx context.Context, request *OTPVerifyRequest) (APIV1AuthOtpVerifyPostRes, error)
 	// APIV1AuthRefreshPost invokes POST /api/v1/auth/refresh operation.
 	//
 	// Invalidates the previous refresh token and generates new access + refersh token.
@@ -57,6 +188,27 @@ type Invoker interface {
 	//
 	// POST /api/v1/auth/register
 	APIV1AuthRegisterPost(ctx context.Context, request *RegisterRequest) (APIV1AuthRegisterPostRes, error)
+	// APIV1OAuthDeviceAuthorizationPost invokes POST /api/v1/oauth/device_authorization operation.
+	//
+	// Starts the device authorization grant (RFC 8628) for a client without a browser. The client shows
+	// the user code and verification URI to the user and polls the token endpoint with the device code.
+	// Clients registered with a secret or a TLS client certificate (RFC 8705) fail with invalid_client
+	// unless the request carries it.
+	//
+	// POST /api/v1/oauth/device_authorization
+	APIV1OAuthDeviceAuthorizationPost(ctx context.Context, request *DeviceAuthorizationRequest) (APIV1OAuthDeviceAuthorizationPostRes, error)
+	// APIV1OAuthTokenPost invokes POST /api/v1/oauth/token operation.
+	//
+	// Issues tokens for the device code grant (urn:ietf:params:oauth:grant-type:device_code) and the
+	// token exchange grant (urn:ietf:params:oauth:grant-type:token-exchange). Until the user decided,
+	// device code polls fail with authorization_pending, or slow_down when the client polls more often
+	// than the interval allows. A token exchange trades an access token for one to the requested
+	// audiences, limited to the audiences and scopes the client may ask for; only clients authenticated
+	// with a secret or a TLS client certificate may exchange tokens. Clients registered with a secret or
+	// a TLS client certificate (RFC 8705) fail with invalid_client unless the request carries it.
+	//
+	// POST /api/v1/oauth/token
+	APIV1OAuthTokenPost(ctx context.Context, request *TokenRequest) (APIV1OAuthTokenPostRes, error)
 }
 
 // Client implements OAS client.
@@ -104,20 +256,20 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// APIV1AuthLoginPost invokes POST /api/v1/auth/login operation.
+// APIV1AdminAuthEventsGet invokes GET /api/v1/admin/auth-events operation.
 //
-// Creates a new tokens for user to access secure endpoints.
+// Returns security audit events of all users filtered by the given parameters, newest first.
 //
-// POST /api/v1/auth/login
-func (c *Client) APIV1AuthLoginPost(ctx context.Context, request *LoginRequest) (APIV1AuthLoginPostRes, error) {
-	res, err := c.sendAPIV1AuthLoginPost(ctx, request)
+// GET /api/v1/admin/auth-events
+func (c *Client) APIV1AdminAuthEventsGet(ctx context.Context, params APIV1AdminAuthEventsGetParams) (APIV1AdminAuthEventsGetRes, error) {
+	res, err := c.sendAPIV1AdminAuthEventsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAPIV1AuthLoginPost(ctx context.Context, request *LoginRequest) (res APIV1AuthLoginPostRes, err error) {
+func (c *Client) sendAPIV1AdminAuthEventsGet(ctx context.Context, params APIV1AdminAuthEventsGetParams) (res APIV1AdminAuthEventsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/auth/login"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/admin/auth-events"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -133,7 +285,7 @@ func (c *Client) sendAPIV1AuthLoginPost(ctx context.Context, request *LoginReque
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthLoginPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AdminAuthEventsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -151,16 +303,169 @@ func (c *Client) sendAPIV1AuthLoginPost(ctx context.Context, request *LoginReque
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/login"
+	pathParts[0] = "/api/v1/admin/auth-events"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "user_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UserID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "event_type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "event_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EventType.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "outcome" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "outcome",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Outcome.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAPIV1AuthLoginPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AdminAuthEventsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -171,7 +476,7 @@ func (c *Client) sendAPIV1AuthLoginPost(ctx context.Context, request *LoginReque
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAPIV1AuthLoginPostResponse(resp)
+	result, err := decodeAPIV1AdminAuthEventsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -179,20 +484,20 @@ func (c *Client) sendAPIV1AuthLoginPost(ctx context.Context, request *LoginReque
 	return result, nil
 }
 
-// APIV1AuthLogoutPost invokes POST /api/v1/auth/logout operation.
+// APIV1AdminWebhooksGet invokes GET /api/v1/admin/webhooks operation.
 //
-// Allows to logout user by refresh token.
+// Returns all webhook subscriptions without their signing secrets.
 //
-// POST /api/v1/auth/logout
-func (c *Client) APIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLogoutPostParams) (APIV1AuthLogoutPostRes, error) {
-	res, err := c.sendAPIV1AuthLogoutPost(ctx, params)
+// GET /api/v1/admin/webhooks
+func (c *Client) APIV1AdminWebhooksGet(ctx context.Context) (APIV1AdminWebhooksGetRes, error) {
+	res, err := c.sendAPIV1AdminWebhooksGet(ctx)
 	return res, err
 }
 
-func (c *Client) sendAPIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLogoutPostParams) (res APIV1AuthLogoutPostRes, err error) {
+func (c *Client) sendAPIV1AdminWebhooksGet(ctx context.Context) (res APIV1AdminWebhooksGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/auth/logout"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/admin/webhooks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -208,7 +513,7 @@ func (c *Client) sendAPIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthLogoutPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AdminWebhooksGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -226,28 +531,45 @@ func (c *Client) sendAPIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLo
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/logout"
+	pathParts[0] = "/api/v1/admin/webhooks"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
-	stage = "EncodeCookieParams"
-	cookie := uri.NewCookieEncoder(r)
 	{
-		// Encode "refresh_token" parameter.
-		cfg := uri.CookieParameterEncodingConfig{
-			Name:    "refresh_token",
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AdminWebhooksGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
-		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.RefreshToken))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode cookie")
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
 
@@ -259,7 +581,7 @@ func (c *Client) sendAPIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAPIV1AuthLogoutPostResponse(resp)
+	result, err := decodeAPIV1AdminWebhooksGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -267,20 +589,20 @@ func (c *Client) sendAPIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLo
 	return result, nil
 }
 
-// APIV1AuthMeGet invokes GET /api/v1/auth/me operation.
+// APIV1AdminWebhooksPost invokes POST /api/v1/admin/webhooks operation.
 //
-// Allows to obtaining information about an authorized user by access token.
+// Subscribes an HTTP endpoint to auth events. The signing secret is returned only once.
 //
-// GET /api/v1/auth/me
-func (c *Client) APIV1AuthMeGet(ctx context.Context) (APIV1AuthMeGetRes, error) {
-	res, err := c.sendAPIV1AuthMeGet(ctx)
+// POST /api/v1/admin/webhooks
+func (c *Client) APIV1AdminWebhooksPost(ctx context.Context, request *CreateWebhookRequest) (APIV1AdminWebhooksPostRes, error) {
+	res, err := c.sendAPIV1AdminWebhooksPost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendAPIV1AuthMeGet(ctx context.Context) (res APIV1AuthMeGetRes, err error) {
+func (c *Client) sendAPIV1AdminWebhooksPost(ctx context.Context, request *CreateWebhookRequest) (res APIV1AdminWebhooksPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/auth/me"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/admin/webhooks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -296,7 +618,7 @@ func (c *Client) sendAPIV1AuthMeGet(ctx context.Context) (res APIV1AuthMeGetRes,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthMeGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AdminWebhooksPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -314,21 +636,24 @@ func (c *Client) sendAPIV1AuthMeGet(ctx context.Context) (res APIV1AuthMeGetRes,
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/me"
+	pathParts[0] = "/api/v1/admin/webhooks"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAPIV1AdminWebhooksPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, APIV1AuthMeGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, APIV1AdminWebhooksPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -364,7 +689,7 @@ func (c *Client) sendAPIV1AuthMeGet(ctx context.Context) (res APIV1AuthMeGetRes,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAPIV1AuthMeGetResponse(resp)
+	result, err := decodeAPIV1AdminWebhooksPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -372,20 +697,20 @@ func (c *Client) sendAPIV1AuthMeGet(ctx context.Context) (res APIV1AuthMeGetRes,
 	return result, nil
 }
 
-// APIV1AuthRefreshPost invokes POST /api/v1/auth/refresh operation.
+// APIV1AdminWebhooksWebhookIDDelete invokes DELETE /api/v1/admin/webhooks/{webhook_id} operation.
 //
-// Invalidates the previous refresh token and generates new access + refersh token.
+// Deletes a webhook subscription together with its delivery log.
 //
-// POST /api/v1/auth/refresh
-func (c *Client) APIV1AuthRefreshPost(ctx context.Context, params APIV1AuthRefreshPostParams) (APIV1AuthRefreshPostRes, error) {
-	res, err := c.sendAPIV1AuthRefreshPost(ctx, params)
+// DELETE /api/v1/admin/webhooks/{webhook_id}
+func (c *Client) APIV1AdminWebhooksWebhookIDDelete(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeleteParams) (APIV1AdminWebhooksWebhookIDDeleteRes, error) {
+	res, err := c.sendAPIV1AdminWebhooksWebhookIDDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAPIV1AuthRefreshPost(ctx context.Context, params APIV1AuthRefreshPostParams) (res APIV1AuthRefreshPostRes, err error) {
+func (c *Client) sendAPIV1AdminWebhooksWebhookIDDelete(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeleteParams) (res APIV1AdminWebhooksWebhookIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/auth/refresh"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/admin/webhooks/{webhook_id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -401,7 +726,7 @@ func (c *Client) sendAPIV1AuthRefreshPost(ctx context.Context, params APIV1AuthR
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthRefreshPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AdminWebhooksWebhookIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -418,33 +743,2172 @@ func (c *Client) sendAPIV1AuthRefreshPost(ctx context.Context, params APIV1AuthR
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/refresh"
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/admin/webhooks/"
+	{
+		// Encode "webhook_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "webhook_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.WebhookID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
-	stage = "EncodeCookieParams"
-	cookie := uri.NewCookieEncoder(r)
 	{
-		// Encode "refresh_token" parameter.
-		cfg := uri.CookieParameterEncodingConfig{
-			Name:    "refresh_token",
-			Explode: true,
-		}
-
-		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.RefreshToken))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode cookie")
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AdminWebhooksWebhookIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
-	}
 
-	stage = "SendRequest"
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AdminWebhooksWebhookIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AdminWebhooksWebhookIDDeliveriesGet invokes GET /api/v1/admin/webhooks/{webhook_id}/deliveries operation.
+//
+// Returns deliveries of a webhook subscription, newest first.
+//
+// GET /api/v1/admin/webhooks/{webhook_id}/deliveries
+func (c *Client) APIV1AdminWebhooksWebhookIDDeliveriesGet(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeliveriesGetParams) (APIV1AdminWebhooksWebhookIDDeliveriesGetRes, error) {
+	res, err := c.sendAPIV1AdminWebhooksWebhookIDDeliveriesGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AdminWebhooksWebhookIDDeliveriesGet(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeliveriesGetParams) (res APIV1AdminWebhooksWebhookIDDeliveriesGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/admin/webhooks/{webhook_id}/deliveries"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AdminWebhooksWebhookIDDeliveriesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/admin/webhooks/"
+	{
+		// Encode "webhook_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "webhook_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.WebhookID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/deliveries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AdminWebhooksWebhookIDDeliveriesGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AdminWebhooksWebhookIDDeliveriesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthAPIKeysGet invokes GET /api/v1/auth/api-keys operation.
+//
+// Returns all API keys of an authorized user without their secret values.
+//
+// GET /api/v1/auth/api-keys
+func (c *Client) APIV1AuthAPIKeysGet(ctx context.Context) (APIV1AuthAPIKeysGetRes, error) {
+	res, err := c.sendAPIV1AuthAPIKeysGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthAPIKeysGet(ctx context.Context) (res APIV1AuthAPIKeysGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/auth/api-keys"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthAPIKeysGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/api-keys"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthAPIKeysGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthAPIKeysGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthAPIKeysKeyIDDelete invokes DELETE /api/v1/auth/api-keys/{key_id} operation.
+//
+// Deletes an API key of an authorized user.
+//
+// DELETE /api/v1/auth/api-keys/{key_id}
+func (c *Client) APIV1AuthAPIKeysKeyIDDelete(ctx context.Context, params APIV1AuthAPIKeysKeyIDDeleteParams) (APIV1AuthAPIKeysKeyIDDeleteRes, error) {
+	res, err := c.sendAPIV1AuthAPIKeysKeyIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthAPIKeysKeyIDDelete(ctx context.Context, params APIV1AuthAPIKeysKeyIDDeleteParams) (res APIV1AuthAPIKeysKeyIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/auth/api-keys/{key_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthAPIKeysKeyIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/auth/api-keys/"
+	{
+		// Encode "key_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.KeyID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthAPIKeysKeyIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthAPIKeysKeyIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthAPIKeysPost invokes POST /api/v1/auth/api-keys operation.
+//
+// Creates a new long-lived API key for an authorized user. The key is returned only once.
+//
+// POST /api/v1/auth/api-keys
+func (c *Client) APIV1AuthAPIKeysPost(ctx context.Context, request *CreateAPIKeyRequest) (APIV1AuthAPIKeysPostRes, error) {
+	res, err := c.sendAPIV1AuthAPIKeysPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthAPIKeysPost(ctx context.Context, request *CreateAPIKeyRequest) (res APIV1AuthAPIKeysPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/api-keys"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthAPIKeysPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/api-keys"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthAPIKeysPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthAPIKeysPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthAPIKeysPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthDeviceGet invokes GET /api/v1/auth/device operation.
+//
+// Returns the client of a pending device authorization, so that the user can check it before
+// approving the user code shown on the device.
+//
+// GET /api/v1/auth/device
+func (c *Client) APIV1AuthDeviceGet(ctx context.Context, params APIV1AuthDeviceGetParams) (APIV1AuthDeviceGetRes, error) {
+	res, err := c.sendAPIV1AuthDeviceGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthDeviceGet(ctx context.Context, params APIV1AuthDeviceGetParams) (res APIV1AuthDeviceGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/auth/device"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthDeviceGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/device"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "user_code" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "user_code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.UserCode))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthDeviceGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthDeviceGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthDevicePost invokes POST /api/v1/auth/device operation.
+//
+// Approves or denies the device authorization of a user code for the authorized user. An approved
+// device gets tokens for the user on its next poll.
+//
+// POST /api/v1/auth/device
+func (c *Client) APIV1AuthDevicePost(ctx context.Context, request *DeviceVerifyRequest) (APIV1AuthDevicePostRes, error) {
+	res, err := c.sendAPIV1AuthDevicePost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthDevicePost(ctx context.Context, request *DeviceVerifyRequest) (res APIV1AuthDevicePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/device"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthDevicePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/device"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthDevicePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthDevicePostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthDevicePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthEmailCancelPost invokes POST /api/v1/auth/email/cancel operation.
+//
+// Cancels a pending email change with the token sent to the current address.
+//
+// POST /api/v1/auth/email/cancel
+func (c *Client) APIV1AuthEmailCancelPost(ctx context.Context, request *EmailChangeTokenRequest) (APIV1AuthEmailCancelPostRes, error) {
+	res, err := c.sendAPIV1AuthEmailCancelPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthEmailCancelPost(ctx context.Context, request *EmailChangeTokenRequest) (res APIV1AuthEmailCancelPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/email/cancel"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthEmailCancelPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/email/cancel"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthEmailCancelPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthEmailCancelPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthEmailConfirmPost invokes POST /api/v1/auth/email/confirm operation.
+//
+// Swaps the email of the user with the new address the token was sent to. A token can be used once.
+//
+// POST /api/v1/auth/email/confirm
+func (c *Client) APIV1AuthEmailConfirmPost(ctx context.Context, request *EmailChangeTokenRequest) (APIV1AuthEmailConfirmPostRes, error) {
+	res, err := c.sendAPIV1AuthEmailConfirmPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthEmailConfirmPost(ctx context.Context, request *EmailChangeTokenRequest) (res APIV1AuthEmailConfirmPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/email/confirm"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthEmailConfirmPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/email/confirm"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthEmailConfirmPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthEmailConfirmPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthEmailPost invokes POST /api/v1/auth/email operation.
+//
+// Requests a change of the email of an authorized user. A confirm link is sent to the new address
+// and a cancel link to the current one; the email changes only once the change is confirmed.
+//
+// POST /api/v1/auth/email
+func (c *Client) APIV1AuthEmailPost(ctx context.Context, request *ChangeEmailRequest) (APIV1AuthEmailPostRes, error) {
+	res, err := c.sendAPIV1AuthEmailPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthEmailPost(ctx context.Context, request *ChangeEmailRequest) (res APIV1AuthEmailPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/email"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthEmailPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/email"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthEmailPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthEmailPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthEmailPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthLoginPost invokes POST /api/v1/auth/login operation.
+//
+// Creates a new tokens for user to access secure endpoints.
+//
+// POST /api/v1/auth/login
+func (c *Client) APIV1AuthLoginPost(ctx context.Context, request *LoginRequest) (APIV1AuthLoginPostRes, error) {
+	res, err := c.sendAPIV1AuthLoginPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthLoginPost(ctx context.Context, request *LoginRequest) (res APIV1AuthLoginPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/login"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthLoginPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthLoginPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthLoginPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthLogoutPost invokes POST /api/v1/auth/logout operation.
+//
+// Allows to logout user by refresh token. The access token, when sent, is revoked as well.
+//
+// POST /api/v1/auth/logout
+func (c *Client) APIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLogoutPostParams) (APIV1AuthLogoutPostRes, error) {
+	res, err := c.sendAPIV1AuthLogoutPost(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLogoutPostParams) (res APIV1AuthLogoutPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/logout"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthLogoutPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/logout"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeCookieParams"
+	cookie := uri.NewCookieEncoder(r)
+	{
+		// Encode "refresh_token" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "refresh_token",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.RefreshToken))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthLogoutPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthLogoutPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthMagicLinkConsumePost invokes POST /api/v1/auth/magic-link/consume operation.
+//
+// Exchanges the token of a magic link for new tokens like a login. A link can be used once.
+//
+// POST /api/v1/auth/magic-link/consume
+func (c *Client) APIV1AuthMagicLinkConsumePost(ctx context.Context, request *MagicLinkConsumeRequest, params APIV1AuthMagicLinkConsumePostParams) (APIV1AuthMagicLinkConsumePostRes, error) {
+	res, err := c.sendAPIV1AuthMagicLinkConsumePost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthMagicLinkConsumePost(ctx context.Context, request *MagicLinkConsumeRequest, params APIV1AuthMagicLinkConsumePostParams) (res APIV1AuthMagicLinkConsumePostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/magic-link/consume"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthMagicLinkConsumePostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/magic-link/consume"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthMagicLinkConsumePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeCookieParams"
+	cookie := uri.NewCookieEncoder(r)
+	{
+		// Encode "magic_link_binding" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "magic_link_binding",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MagicLinkBinding.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthMagicLinkConsumePostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthMagicLinkPost invokes POST /api/v1/auth/magic-link operation.
+//
+// Emails a single-use login link to the user. The response is the same whether the email is
+// registered or not. If links are bound to the browser, a cookie is set that has to be sent along
+// when the link is consumed.
+//
+// POST /api/v1/auth/magic-link
+func (c *Client) APIV1AuthMagicLinkPost(ctx context.Context, request *MagicLinkRequest) (APIV1AuthMagicLinkPostRes, error) {
+	res, err := c.sendAPIV1AuthMagicLinkPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthMagicLinkPost(ctx context.Context, request *MagicLinkRequest) (res APIV1AuthMagicLinkPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/magic-link"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthMagicLinkPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/magic-link"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthMagicLinkPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthMagicLinkPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthMeDelete invokes DELETE /api/v1/auth/me operation.
+//
+// Deletes the account of an authorized user after the password is confirmed. The account is
+// deactivated and signed out everywhere at once and deleted for good, with everything stored about
+// it, after the configured grace period.
+//
+// DELETE /api/v1/auth/me
+func (c *Client) APIV1AuthMeDelete(ctx context.Context, request *DeleteAccountRequest) (APIV1AuthMeDeleteRes, error) {
+	res, err := c.sendAPIV1AuthMeDelete(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthMeDelete(ctx context.Context, request *DeleteAccountRequest) (res APIV1AuthMeDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/auth/me"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthMeDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/me"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthMeDeleteRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthMeDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthMeDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthMeEventsGet invokes GET /api/v1/auth/me/events operation.
+//
+// Returns security audit events of an authorized user, newest first.
+//
+// GET /api/v1/auth/me/events
+func (c *Client) APIV1AuthMeEventsGet(ctx context.Context, params APIV1AuthMeEventsGetParams) (APIV1AuthMeEventsGetRes, error) {
+	res, err := c.sendAPIV1AuthMeEventsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthMeEventsGet(ctx context.Context, params APIV1AuthMeEventsGetParams) (res APIV1AuthMeEventsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/auth/me/events"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthMeEventsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/me/events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthMeEventsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, APIV1AuthMeEventsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthMeEventsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthMeExportGet invokes GET /api/v1/auth/me/export operation.
+//
+// Returns everything stored about an authorized user: profile, active sessions, API keys, custom
+// claim attributes and authentication events.
+//
+// GET /api/v1/auth/me/export
+func (c *Client) APIV1AuthMeExportGet(ctx context.Context) (APIV1AuthMeExportGetRes, error) {
+	res, err := c.sendAPIV1AuthMeExportGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthMeExportGet(ctx context.Context) (res APIV1AuthMeExportGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/auth/me/export"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthMeExportGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/me/export"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthMeExportGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthMeExportGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthMeGet invokes GET /api/v1/auth/me operation.
+//
+// Allows to obtaining information about an authorized user by access token.
+//
+// GET /api/v1/auth/me
+func (c *Client) APIV1AuthMeGet(ctx context.Context) (APIV1AuthMeGetRes, error) {
+	res, err := c.sendAPIV1AuthMeGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthMeGet(ctx context.Context) (res APIV1AuthMeGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/auth/me"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthMeGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/me"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, APIV1AuthMeGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, APIV1AuthMeGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthMeGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthOtpPost invokes POST /api/v1/auth/otp operation.
+//
+// Sends a numeric login code to the user by email or SMS. SMS codes go to the phone number attribute
+// of the user. Only a few codes are sent to a user per hour, and a new code is not sent sooner than
+// the resend interval. The response is the same whether the email is registered or not.
+//
+// POST /api/v1/auth/otp
+func (c *Client) APIV1AuthOtpPost(ctx context.Context, request *OTPRequest) (APIV1AuthOtpPostRes, error) {
+	res, err := c.sendAPIV1AuthOtpPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthOtpPost(ctx context.Context, request *OTPRequest) (res APIV1AuthOtpPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/otp"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthOtpPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/otp"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthOtpPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthOtpPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthOtpVerifyPost invokes POST /api/v1/auth/otp/verify operation.
+//
+// Exchanges a one-time code for new tokens like a login. A code can be used once and is discarded
+// after too many wrong tries.
+//
+// POST /api/v1/auth/otp/verify
+func (c *Client) APIV1AuthOtpVerifyPost(ctx context.Context, request *OTPVerifyRequest) (APIV1AuthOtpVerifyPostRes, error) {
+	res, err := c.sendAPIV1AuthOtpVerifyPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthOtpVerifyPost(ctx context.Context, request *OTPVerifyRequest) (res APIV1AuthOtpVerifyPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/otp/verify"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthOtpVerifyPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/otp/verify"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1AuthOtpVerifyPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1AuthOtpVerifyPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1AuthRefreshPost invokes POST /api/v1/auth/refresh operation.
+//
+// Invalidates the previous refresh token and generates new access + refersh token.
+//
+// POST /api/v1/auth/refresh
+func (c *Client) APIV1AuthRefreshPost(ctx context.Context, params APIV1AuthRefreshPostParams) (APIV1AuthRefreshPostRes, error) {
+	res, err := c.sendAPIV1AuthRefreshPost(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAPIV1AuthRefreshPost(ctx context.Context, params APIV1AuthRefreshPostParams) (res APIV1AuthRefreshPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/refresh"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1AuthRefreshPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/refresh"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeCookieParams"
+	cookie := uri.NewCookieEncoder(r)
+	{
+		// Encode "refresh_token" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "refresh_token",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.RefreshToken))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
+
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -534,3 +2998,162 @@ func (c *Client) sendAPIV1AuthRegisterPost(ctx context.Context, request *Registe
 
 	return result, nil
 }
+
+// APIV1OAuthDeviceAuthorizationPost invokes POST /api/v1/oauth/device_authorization operation.
+//
+// Starts the device authorization grant (RFC 8628) for a client without a browser. The client shows
+// the user code and verification URI to the user and polls the token endpoint with the device code.
+// Clients registered with a secret or a TLS client certificate (RFC 8705) fail with invalid_client
+// unless the request carries it.
+//
+// POST /api/v1/oauth/device_authorization
+func (c *Client) APIV1OAuthDeviceAuthorizationPost(ctx context.Context, request *DeviceAuthorizationRequest) (APIV1OAuthDeviceAuthorizationPostRes, error) {
+	res, err := c.sendAPIV1OAuthDeviceAuthorizationPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1OAuthDeviceAuthorizationPost(ctx context.Context, request *DeviceAuthorizationRequest) (res APIV1OAuthDeviceAuthorizationPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/oauth/device_authorization"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1OAuthDeviceAuthorizationPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/oauth/device_authorization"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1OAuthDeviceAuthorizationPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1OAuthDeviceAuthorizationPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// APIV1OAuthTokenPost invokes POST /api/v1/oauth/token operation.
+//
+// Issues tokens for the device code grant (urn:ietf:params:oauth:grant-type:device_code) and the
+// token exchange grant (urn:ietf:params:oauth:grant-type:token-exchange). Until the user decided,
+// device code polls fail with authorization_pending, or slow_down when the client polls more often
+// than the interval allows. A token exchange trades an access token for one to the requested
+// audiences, limited to the audiences and scopes the client may ask for; only clients authenticated
+// with a secret or a TLS client certificate may exchange tokens. Clients registered with a secret or
+// a TLS client certificate (RFC 8705) fail with invalid_client unless the request carries it.
+//
+// POST /api/v1/oauth/token
+func (c *Client) APIV1OAuthTokenPost(ctx context.Context, request *TokenRequest) (APIV1OAuthTokenPostRes, error) {
+	res, err := c.sendAPIV1OAuthTokenPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAPIV1OAuthTokenPost(ctx context.Context, request *TokenRequest) (res APIV1OAuthTokenPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/oauth/token"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, APIV1OAuthTokenPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/oauth/token"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAPIV1OAuthTokenPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAPIV1OAuthTokenPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,39 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package gen
+
+// setDefaults set default value of fields.
+func (s *DeviceVerifyRequest) setDefaults() {
+	{
+		val := bool(true)
+		s.Approve.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *LoginRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.RememberMe.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *MagicLinkRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.RememberMe.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *OTPRequest) setDefaults() {
+	{
+		val := OTPRequestChannel("email")
+		s.Channel.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.RememberMe.SetTo(val)
+	}
+}
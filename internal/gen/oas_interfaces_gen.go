@@ -1,6 +1,58 @@
 // Code generated by ogen, DO NOT EDIT.
 package gen
 
+type APIV1AdminAuthEventsGetRes interface {
+	aPIV1AdminAuthEventsGetRes()
+}
+
+type APIV1AdminWebhooksGetRes interface {
+	aPIV1AdminWebhooksGetRes()
+}
+
+type APIV1AdminWebhooksPostRes interface {
+	aPIV1AdminWebhooksPostRes()
+}
+
+type APIV1AdminWebhooksWebhookIDDeleteRes interface {
+	aPIV1AdminWebhooksWebhookIDDeleteRes()
+}
+
+type APIV1AdminWebhooksWebhookIDDeliveriesGetRes interface {
+	aPIV1AdminWebhooksWebhookIDDeliveriesGetRes()
+}
+
+type APIV1AuthAPIKeysGetRes interface {
+	aPIV1AuthAPIKeysGetRes()
+}
+
+type APIV1AuthAPIKeysKeyIDDeleteRes interface {
+	aPIV1AuthAPIKeysKeyIDDeleteRes()
+}
+
+type APIV1AuthAPIKeysPostRes interface {
+	aPIV1AuthAPIKeysPostRes()
+}
+
+type APIV1AuthDeviceGetRes interface {
+	aPIV1AuthDeviceGetRes()
+}
+
+type APIV1AuthDevicePostRes interface {
+	aPIV1AuthDevicePostRes()
+}
+
+type APIV1AuthEmailCancelPostRes interface {
+	aPIV1AuthEmailCancelPostRes()
+}
+
+type APIV1AuthEmailConfirmPostRes interface {
+	aPIV1AuthEmailConfirmPostRes()
+}
+
+type APIV1AuthEmailPostRes interface {
+	aPIV1AuthEmailPostRes()
+}
+
 type APIV1AuthLoginPostRes interface {
 	aPIV1AuthLoginPostRes()
 }
@@ -9,10 +61,38 @@ type APIV1AuthLogoutPostRes interface {
 	aPIV1AuthLogoutPostRes()
 }
 
+type APIV1AuthMagicLinkConsumePostRes interface {
+	aPIV1AuthMagicLinkConsumePostRes()
+}
+
+type APIV1AuthMagicLinkPostRes interface {
+	aPIV1AuthMagicLinkPostRes()
+}
+
+type APIV1AuthMeDeleteRes interface {
+	aPIV1AuthMeDeleteRes()
+}
+
+type APIV1AuthMeEventsGetRes interface {
+	aPIV1AuthMeEventsGetRes()
+}
+
+type APIV1AuthMeExportGetRes interface {
+	aPIV1AuthMeExportGetRes()
+}
+
 type APIV1AuthMeGetRes interface {
 	aPIV1AuthMeGetRes()
 }
 
+type APIV1AuthOtpPostRes interface {
+	aPIV1AuthOtpPostRes()
+}
+
+type APIV1AuthOtpVerifyPostRes interface {
+	aPIV1AuthOtpVerifyPostRes()
+}
+
 type APIV1AuthRefreshPostRes interface {
 	aPIV1AuthRefreshPostRes()
 }
@@ -20,3 +100,11 @@ type APIV1AuthRefreshPostRes interface {
 type APIV1AuthRegisterPostRes interface {
 	aPIV1AuthRegisterPostRes()
 }
+
+type APIV1OAuthDeviceAuthorizationPostRes interface {
+	aPIV1OAuthDeviceAuthorizationPostRes()
+}
+
+type APIV1OAuthTokenPostRes interface {
+	aPIV1OAuthTokenPostRes()
+}
@@ -6,9 +6,31 @@ package gen
 type OperationName = string
 
 const (
-	APIV1AuthLoginPostOperation    OperationName = "APIV1AuthLoginPost"
-	APIV1AuthLogoutPostOperation   OperationName = "APIV1AuthLogoutPost"
-	APIV1AuthMeGetOperation        OperationName = "APIV1AuthMeGet"
-	APIV1AuthRefreshPostOperation  OperationName = "APIV1AuthRefreshPost"
-	APIV1AuthRegisterPostOperation OperationName = "APIV1AuthRegisterPost"
+	APIV1AdminAuthEventsGetOperation                  OperationName = "APIV1AdminAuthEventsGet"
+	APIV1AdminWebhooksGetOperation                    OperationName = "APIV1AdminWebhooksGet"
+	APIV1AdminWebhooksPostOperation                   OperationName = "APIV1AdminWebhooksPost"
+	APIV1AdminWebhooksWebhookIDDeleteOperation        OperationName = "APIV1AdminWebhooksWebhookIDDelete"
+	APIV1AdminWebhooksWebhookIDDeliveriesGetOperation OperationName = "APIV1AdminWebhooksWebhookIDDeliveriesGet"
+	APIV1AuthAPIKeysGetOperation                      OperationName = "APIV1AuthAPIKeysGet"
+	APIV1AuthAPIKeysKeyIDDeleteOperation              OperationName = "APIV1AuthAPIKeysKeyIDDelete"
+	APIV1AuthAPIKeysPostOperation                     OperationName = "APIV1AuthAPIKeysPost"
+	APIV1AuthDeviceGetOperation                       OperationName = "APIV1AuthDeviceGet"
+	APIV1AuthDevicePostOperation                      OperationName = "APIV1AuthDevicePost"
+	APIV1AuthEmailCancelPostOperation                 OperationName = "APIV1AuthEmailCancelPost"
+	APIV1AuthEmailConfirmPostOperation                OperationName = "APIV1AuthEmailConfirmPost"
+	APIV1AuthEmailPostOperation                       OperationName = "APIV1AuthEmailPost"
+	APIV1AuthLoginPostOperation                       OperationName = "APIV1AuthLoginPost"
+	APIV1AuthLogoutPostOperation                      OperationName = "APIV1AuthLogoutPost"
+	APIV1AuthMagicLinkConsumePostOperation            OperationName = "APIV1AuthMagicLinkConsumePost"
+	APIV1AuthMagicLinkPostOperation                   OperationName = "APIV1AuthMagicLinkPost"
+	APIV1AuthMeDeleteOperation                        OperationName = "APIV1AuthMeDelete"
+	APIV1AuthMeEventsGetOperation                     OperationName = "APIV1AuthMeEventsGet"
+	APIV1AuthMeExportGetOperation                     OperationName = "APIV1AuthMeExportGet"
+	APIV1AuthMeGetOperation                           OperationName = "APIV1AuthMeGet"
+	APIV1AuthOtpPostOperation                         OperationName = "APIV1AuthOtpPost"
+	APIV1AuthOtpVerifyPostOperation                   OperationName = "APIV1AuthOtpVerifyPost"
+	APIV1AuthRefreshPostOperation                     OperationName = "APIV1AuthRefreshPost"
+	APIV1AuthRegisterPostOperation                    OperationName = "APIV1AuthRegisterPost"
+	APIV1OAuthDeviceAuthorizationPostOperation        OperationName = "APIV1OAuthDeviceAuthorizationPost"
+	APIV1OAuthTokenPostOperation                      OperationName = "APIV1OAuthTokenPost"
 )
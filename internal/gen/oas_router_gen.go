@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [1]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -48,9 +49,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/auth/"
+		case '/': // Prefix: "/api/v1/"
 
-			if l := len("/api/v1/auth/"); len(elem) >= l && elem[0:l] == "/api/v1/auth/" {
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -60,9 +61,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'l': // Prefix: "log"
+			case 'a': // Prefix: "a"
 
-				if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 					elem = elem[l:]
 				} else {
 					break
@@ -72,71 +73,568 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'i': // Prefix: "in"
+				case 'd': // Prefix: "dmin/"
 
-					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleAPIV1AuthLoginPostRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth-events"
+
+						if l := len("auth-events"); len(elem) >= l && elem[0:l] == "auth-events" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1AdminAuthEventsGetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'w': // Prefix: "webhooks"
+
+						if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1AdminWebhooksGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleAPIV1AdminWebhooksPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "webhook_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleAPIV1AdminWebhooksWebhookIDDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/deliveries"
+
+								if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleAPIV1AdminWebhooksWebhookIDDeliveriesGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
 						}
 
-						return
 					}
 
-				case 'o': // Prefix: "out"
+				case 'u': // Prefix: "uth/"
 
-					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+					if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleAPIV1AuthLogoutPostRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "api-keys"
+
+						if l := len("api-keys"); len(elem) >= l && elem[0:l] == "api-keys" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1AuthAPIKeysGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleAPIV1AuthAPIKeysPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
 
-				}
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "key_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleAPIV1AuthAPIKeysKeyIDDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
 
-			case 'm': // Prefix: "me"
+						}
 
-				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+					case 'd': // Prefix: "device"
+
+						if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAPIV1AuthDeviceGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleAPIV1AuthDevicePostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+
+					case 'e': // Prefix: "email"
+
+						if l := len("email"); len(elem) >= l && elem[0:l] == "email" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "POST":
+								s.handleAPIV1AuthEmailPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/c"
+
+							if l := len("/c"); len(elem) >= l && elem[0:l] == "/c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel"
+
+								if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAPIV1AuthEmailCancelPostRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "onfirm"
+
+								if l := len("onfirm"); len(elem) >= l && elem[0:l] == "onfirm" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAPIV1AuthEmailConfirmPostRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'l': // Prefix: "log"
+
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAPIV1AuthLoginPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAPIV1AuthLogoutPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 'm': // Prefix: "m"
+
+						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "agic-link"
+
+							if l := len("agic-link"); len(elem) >= l && elem[0:l] == "agic-link" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "POST":
+									s.handleAPIV1AuthMagicLinkPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/consume"
+
+								if l := len("/consume"); len(elem) >= l && elem[0:l] == "/consume" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAPIV1AuthMagicLinkConsumePostRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						case 'e': // Prefix: "e"
+
+							if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleAPIV1AuthMeDeleteRequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleAPIV1AuthMeGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/e"
+
+								if l := len("/e"); len(elem) >= l && elem[0:l] == "/e" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'v': // Prefix: "vents"
+
+									if l := len("vents"); len(elem) >= l && elem[0:l] == "vents" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAPIV1AuthMeEventsGetRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'x': // Prefix: "xport"
+
+									if l := len("xport"); len(elem) >= l && elem[0:l] == "xport" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAPIV1AuthMeExportGetRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'o': // Prefix: "otp"
+
+						if l := len("otp"); len(elem) >= l && elem[0:l] == "otp" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "POST":
+								s.handleAPIV1AuthOtpPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/verify"
+
+							if l := len("/verify"); len(elem) >= l && elem[0:l] == "/verify" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAPIV1AuthOtpVerifyPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 'r': // Prefix: "re"
+
+						if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "fresh"
+
+							if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAPIV1AuthRefreshPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'g': // Prefix: "gister"
+
+							if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAPIV1AuthRegisterPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleAPIV1AuthMeGetRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
 					}
 
-					return
 				}
 
-			case 'r': // Prefix: "re"
+			case 'o': // Prefix: "oauth/"
 
-				if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+				if l := len("oauth/"); len(elem) >= l && elem[0:l] == "oauth/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -146,9 +644,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'f': // Prefix: "fresh"
+				case 'd': // Prefix: "device_authorization"
 
-					if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+					if l := len("device_authorization"); len(elem) >= l && elem[0:l] == "device_authorization" {
 						elem = elem[l:]
 					} else {
 						break
@@ -158,7 +656,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "POST":
-							s.handleAPIV1AuthRefreshPostRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleAPIV1OAuthDeviceAuthorizationPostRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "POST")
 						}
@@ -166,9 +664,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 
-				case 'g': // Prefix: "gister"
+				case 't': // Prefix: "token"
 
-					if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+					if l := len("token"); len(elem) >= l && elem[0:l] == "token" {
 						elem = elem[l:]
 					} else {
 						break
@@ -178,7 +676,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "POST":
-							s.handleAPIV1AuthRegisterPostRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleAPIV1OAuthTokenPostRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "POST")
 						}
@@ -203,7 +701,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [0]string
+	args           [1]string
 }
 
 // Name returns ogen operation name.
@@ -276,9 +774,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/v1/auth/"
+		case '/': // Prefix: "/api/v1/"
 
-			if l := len("/api/v1/auth/"); len(elem) >= l && elem[0:l] == "/api/v1/auth/" {
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -288,9 +786,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 'l': // Prefix: "log"
+			case 'a': // Prefix: "a"
 
-				if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 					elem = elem[l:]
 				} else {
 					break
@@ -300,86 +798,695 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'i': // Prefix: "in"
+				case 'd': // Prefix: "dmin/"
 
-					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = APIV1AuthLoginPostOperation
-							r.summary = "Method to login"
-							r.operationID = ""
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/auth/login"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth-events"
+
+						if l := len("auth-events"); len(elem) >= l && elem[0:l] == "auth-events" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = APIV1AdminAuthEventsGetOperation
+								r.summary = "Admin method to query authentication events"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/admin/auth-events"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'w': // Prefix: "webhooks"
+
+						if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = APIV1AdminWebhooksGetOperation
+								r.summary = "Admin method to list webhook subscriptions"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/admin/webhooks"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = APIV1AdminWebhooksPostOperation
+								r.summary = "Admin method to create a webhook subscription"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/admin/webhooks"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "webhook_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = APIV1AdminWebhooksWebhookIDDeleteOperation
+									r.summary = "Admin method to delete a webhook subscription"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/admin/webhooks/{webhook_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/deliveries"
+
+								if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = APIV1AdminWebhooksWebhookIDDeliveriesGetOperation
+										r.summary = "Admin method to query the webhook delivery log"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/webhooks/{webhook_id}/deliveries"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
 						}
+
 					}
 
-				case 'o': // Prefix: "out"
+				case 'u': // Prefix: "uth/"
 
-					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+					if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = APIV1AuthLogoutPostOperation
-							r.summary = "Secured method to logout"
-							r.operationID = ""
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/auth/logout"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
+						break
 					}
+					switch elem[0] {
+					case 'a': // Prefix: "api-keys"
 
-				}
+						if l := len("api-keys"); len(elem) >= l && elem[0:l] == "api-keys" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-			case 'm': // Prefix: "me"
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = APIV1AuthAPIKeysGetOperation
+								r.summary = "Secured method to list API keys"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/auth/api-keys"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = APIV1AuthAPIKeysPostOperation
+								r.summary = "Secured method to create an API key"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/auth/api-keys"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "key_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = APIV1AuthAPIKeysKeyIDDeleteOperation
+									r.summary = "Secured method to revoke an API key"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/api-keys/{key_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
 
-				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						}
+
+					case 'd': // Prefix: "device"
+
+						if l := len("device"); len(elem) >= l && elem[0:l] == "device" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = APIV1AuthDeviceGetOperation
+								r.summary = "Secured method to look up a device authorization"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/auth/device"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = APIV1AuthDevicePostOperation
+								r.summary = "Secured method to approve or deny a device"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/auth/device"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'e': // Prefix: "email"
+
+						if l := len("email"); len(elem) >= l && elem[0:l] == "email" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = APIV1AuthEmailPostOperation
+								r.summary = "Secured method to change the email of user"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/auth/email"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/c"
+
+							if l := len("/c"); len(elem) >= l && elem[0:l] == "/c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel"
+
+								if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = APIV1AuthEmailCancelPostOperation
+										r.summary = "Method to cancel an email change"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/auth/email/cancel"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'o': // Prefix: "onfirm"
+
+								if l := len("onfirm"); len(elem) >= l && elem[0:l] == "onfirm" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = APIV1AuthEmailConfirmPostOperation
+										r.summary = "Method to confirm an email change"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/auth/email/confirm"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'l': // Prefix: "log"
+
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = APIV1AuthLoginPostOperation
+									r.summary = "Method to login"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/login"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = APIV1AuthLogoutPostOperation
+									r.summary = "Secured method to logout"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/logout"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'm': // Prefix: "m"
+
+						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "agic-link"
+
+							if l := len("agic-link"); len(elem) >= l && elem[0:l] == "agic-link" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									r.name = APIV1AuthMagicLinkPostOperation
+									r.summary = "Method to request a magic link"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/magic-link"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/consume"
+
+								if l := len("/consume"); len(elem) >= l && elem[0:l] == "/consume" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = APIV1AuthMagicLinkConsumePostOperation
+										r.summary = "Method to login with a magic link"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/auth/magic-link/consume"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'e': // Prefix: "e"
+
+							if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = APIV1AuthMeDeleteOperation
+									r.summary = "Secured method to delete the account of user"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/me"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "GET":
+									r.name = APIV1AuthMeGetOperation
+									r.summary = "Secured method to get information about user"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/me"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/e"
+
+								if l := len("/e"); len(elem) >= l && elem[0:l] == "/e" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'v': // Prefix: "vents"
+
+									if l := len("vents"); len(elem) >= l && elem[0:l] == "vents" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = APIV1AuthMeEventsGetOperation
+											r.summary = "Secured method to get authentication history of user"
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/auth/me/events"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'x': // Prefix: "xport"
+
+									if l := len("xport"); len(elem) >= l && elem[0:l] == "xport" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = APIV1AuthMeExportGetOperation
+											r.summary = "Secured method to export the data of user"
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/auth/me/export"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'o': // Prefix: "otp"
+
+						if l := len("otp"); len(elem) >= l && elem[0:l] == "otp" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = APIV1AuthOtpPostOperation
+								r.summary = "Method to request a one-time code"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/auth/otp"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/verify"
+
+							if l := len("/verify"); len(elem) >= l && elem[0:l] == "/verify" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = APIV1AuthOtpVerifyPostOperation
+									r.summary = "Method to login with a one-time code"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/otp/verify"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'r': // Prefix: "re"
+
+						if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "fresh"
+
+							if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = APIV1AuthRefreshPostOperation
+									r.summary = "Method to refresh access and refresh tokens"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/refresh"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'g': // Prefix: "gister"
+
+							if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = APIV1AuthRegisterPostOperation
+									r.summary = "Method to register a new user."
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/auth/register"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = APIV1AuthMeGetOperation
-						r.summary = "Secured method to get information about user"
-						r.operationID = ""
-						r.operationGroup = ""
-						r.pathPattern = "/api/v1/auth/me"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
 					}
+
 				}
 
-			case 'r': // Prefix: "re"
+			case 'o': // Prefix: "oauth/"
 
-				if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+				if l := len("oauth/"); len(elem) >= l && elem[0:l] == "oauth/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -389,9 +1496,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'f': // Prefix: "fresh"
+				case 'd': // Prefix: "device_authorization"
 
-					if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+					if l := len("device_authorization"); len(elem) >= l && elem[0:l] == "device_authorization" {
 						elem = elem[l:]
 					} else {
 						break
@@ -401,11 +1508,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "POST":
-							r.name = APIV1AuthRefreshPostOperation
-							r.summary = "Method to refresh access and refresh tokens"
+							r.name = APIV1OAuthDeviceAuthorizationPostOperation
+							r.summary = "Method to start a device authorization"
 							r.operationID = ""
 							r.operationGroup = ""
-							r.pathPattern = "/api/v1/auth/refresh"
+							r.pathPattern = "/api/v1/oauth/device_authorization"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -414,9 +1521,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 
-				case 'g': // Prefix: "gister"
+				case 't': // Prefix: "token"
 
-					if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+					if l := len("token"); len(elem) >= l && elem[0:l] == "token" {
 						elem = elem[l:]
 					} else {
 						break
@@ -426,11 +1533,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "POST":
-							r.name = APIV1AuthRegisterPostOperation
-							r.summary = "Method to register a new user."
+							r.name = APIV1OAuthTokenPostOperation
+							r.summary = "OAuth 2.0 token endpoint"
 							r.operationID = ""
 							r.operationGroup = ""
-							r.pathPattern = "/api/v1/auth/register"
+							r.pathPattern = "/api/v1/oauth/token"
 							r.args = args
 							r.count = 0
 							return r, true
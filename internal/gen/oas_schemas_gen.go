@@ -4,8 +4,342 @@ package gen
 
 import (
 	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/google/uuid"
 )
 
+// Ref: #/components/schemas/APIKey
+type APIKey struct {
+	ID         uuid.UUID   `json:"id"`
+	Name       string      `json:"name"`
+	Prefix     string      `json:"prefix"`
+	Scopes     []string    `json:"scopes"`
+	CreatedAt  time.Time   `json:"created_at"`
+	ExpiresAt  OptDateTime `json:"expires_at"`
+	LastUsedAt OptDateTime `json:"last_used_at"`
+}
+
+// GetID returns the value of ID.
+func (s *APIKey) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *APIKey) GetName() string {
+	return s.Name
+}
+
+// GetPrefix returns the value of Prefix.
+func (s *APIKey) GetPrefix() string {
+	return s.Prefix
+}
+
+// GetScopes returns the value of Scopes.
+func (s *APIKey) GetScopes() []string {
+	return s.Scopes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *APIKey) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APIKey) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *APIKey) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// SetID sets the value of ID.
+func (s *APIKey) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *APIKey) SetName(val string) {
+	s.Name = val
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *APIKey) SetPrefix(val string) {
+	s.Prefix = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *APIKey) SetScopes(val []string) {
+	s.Scopes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *APIKey) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APIKey) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *APIKey) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+type APIKeyList []APIKey
+
+func (*APIKeyList) aPIV1AuthAPIKeysGetRes() {}
+
+type APIV1AdminAuthEventsGetForbidden ErrorResponse
+
+func (*APIV1AdminAuthEventsGetForbidden) aPIV1AdminAuthEventsGetRes() {}
+
+type APIV1AdminAuthEventsGetGatewayTimeout ErrorResponse
+
+func (*APIV1AdminAuthEventsGetGatewayTimeout) aPIV1AdminAuthEventsGetRes() {}
+
+type APIV1AdminAuthEventsGetInternalServerError ErrorResponse
+
+func (*APIV1AdminAuthEventsGetInternalServerError) aPIV1AdminAuthEventsGetRes() {}
+
+type APIV1AdminAuthEventsGetUnauthorized ErrorResponse
+
+func (*APIV1AdminAuthEventsGetUnauthorized) aPIV1AdminAuthEventsGetRes() {}
+
+type APIV1AdminWebhooksGetForbidden ErrorResponse
+
+func (*APIV1AdminWebhooksGetForbidden) aPIV1AdminWebhooksGetRes() {}
+
+type APIV1AdminWebhooksGetGatewayTimeout ErrorResponse
+
+func (*APIV1AdminWebhooksGetGatewayTimeout) aPIV1AdminWebhooksGetRes() {}
+
+type APIV1AdminWebhooksGetInternalServerError ErrorResponse
+
+func (*APIV1AdminWebhooksGetInternalServerError) aPIV1AdminWebhooksGetRes() {}
+
+type APIV1AdminWebhooksGetUnauthorized ErrorResponse
+
+func (*APIV1AdminWebhooksGetUnauthorized) aPIV1AdminWebhooksGetRes() {}
+
+type APIV1AdminWebhooksPostBadRequest ErrorResponse
+
+func (*APIV1AdminWebhooksPostBadRequest) aPIV1AdminWebhooksPostRes() {}
+
+type APIV1AdminWebhooksPostForbidden ErrorResponse
+
+func (*APIV1AdminWebhooksPostForbidden) aPIV1AdminWebhooksPostRes() {}
+
+type APIV1AdminWebhooksPostGatewayTimeout ErrorResponse
+
+func (*APIV1AdminWebhooksPostGatewayTimeout) aPIV1AdminWebhooksPostRes() {}
+
+type APIV1AdminWebhooksPostInternalServerError ErrorResponse
+
+func (*APIV1AdminWebhooksPostInternalServerError) aPIV1AdminWebhooksPostRes() {}
+
+type APIV1AdminWebhooksPostUnauthorized ErrorResponse
+
+func (*APIV1AdminWebhooksPostUnauthorized) aPIV1AdminWebhooksPostRes() {}
+
+type APIV1AdminWebhooksWebhookIDDeleteForbidden ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeleteForbidden) aPIV1AdminWebhooksWebhookIDDeleteRes() {}
+
+type APIV1AdminWebhooksWebhookIDDeleteGatewayTimeout ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeleteGatewayTimeout) aPIV1AdminWebhooksWebhookIDDeleteRes() {}
+
+type APIV1AdminWebhooksWebhookIDDeleteInternalServerError ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeleteInternalServerError) aPIV1AdminWebhooksWebhookIDDeleteRes() {}
+
+// APIV1AdminWebhooksWebhookIDDeleteNoContent is response for APIV1AdminWebhooksWebhookIDDelete operation.
+type APIV1AdminWebhooksWebhookIDDeleteNoContent struct{}
+
+func (*APIV1AdminWebhooksWebhookIDDeleteNoContent) aPIV1AdminWebhooksWebhookIDDeleteRes() {}
+
+type APIV1AdminWebhooksWebhookIDDeleteNotFound ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeleteNotFound) aPIV1AdminWebhooksWebhookIDDeleteRes() {}
+
+type APIV1AdminWebhooksWebhookIDDeleteUnauthorized ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeleteUnauthorized) aPIV1AdminWebhooksWebhookIDDeleteRes() {}
+
+type APIV1AdminWebhooksWebhookIDDeliveriesGetForbidden ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeliveriesGetForbidden) aPIV1AdminWebhooksWebhookIDDeliveriesGetRes() {
+}
+
+type APIV1AdminWebhooksWebhookIDDeliveriesGetGatewayTimeout ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeliveriesGetGatewayTimeout) aPIV1AdminWebhooksWebhookIDDeliveriesGetRes() {
+}
+
+type APIV1AdminWebhooksWebhookIDDeliveriesGetInternalServerError ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeliveriesGetInternalServerError) aPIV1AdminWebhooksWebhookIDDeliveriesGetRes() {
+}
+
+type APIV1AdminWebhooksWebhookIDDeliveriesGetUnauthorized ErrorResponse
+
+func (*APIV1AdminWebhooksWebhookIDDeliveriesGetUnauthorized) aPIV1AdminWebhooksWebhookIDDeliveriesGetRes() {
+}
+
+type APIV1AuthAPIKeysGetGatewayTimeout ErrorResponse
+
+func (*APIV1AuthAPIKeysGetGatewayTimeout) aPIV1AuthAPIKeysGetRes() {}
+
+type APIV1AuthAPIKeysGetInternalServerError ErrorResponse
+
+func (*APIV1AuthAPIKeysGetInternalServerError) aPIV1AuthAPIKeysGetRes() {}
+
+type APIV1AuthAPIKeysGetUnauthorized ErrorResponse
+
+func (*APIV1AuthAPIKeysGetUnauthorized) aPIV1AuthAPIKeysGetRes() {}
+
+type APIV1AuthAPIKeysKeyIDDeleteGatewayTimeout ErrorResponse
+
+func (*APIV1AuthAPIKeysKeyIDDeleteGatewayTimeout) aPIV1AuthAPIKeysKeyIDDeleteRes() {}
+
+type APIV1AuthAPIKeysKeyIDDeleteInternalServerError ErrorResponse
+
+func (*APIV1AuthAPIKeysKeyIDDeleteInternalServerError) aPIV1AuthAPIKeysKeyIDDeleteRes() {}
+
+// APIV1AuthAPIKeysKeyIDDeleteNoContent is response for APIV1AuthAPIKeysKeyIDDelete operation.
+type APIV1AuthAPIKeysKeyIDDeleteNoContent struct{}
+
+func (*APIV1AuthAPIKeysKeyIDDeleteNoContent) aPIV1AuthAPIKeysKeyIDDeleteRes() {}
+
+type APIV1AuthAPIKeysKeyIDDeleteNotFound ErrorResponse
+
+func (*APIV1AuthAPIKeysKeyIDDeleteNotFound) aPIV1AuthAPIKeysKeyIDDeleteRes() {}
+
+type APIV1AuthAPIKeysKeyIDDeleteUnauthorized ErrorResponse
+
+func (*APIV1AuthAPIKeysKeyIDDeleteUnauthorized) aPIV1AuthAPIKeysKeyIDDeleteRes() {}
+
+type APIV1AuthAPIKeysPostBadRequest ErrorResponse
+
+func (*APIV1AuthAPIKeysPostBadRequest) aPIV1AuthAPIKeysPostRes() {}
+
+type APIV1AuthAPIKeysPostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthAPIKeysPostGatewayTimeout) aPIV1AuthAPIKeysPostRes() {}
+
+type APIV1AuthAPIKeysPostInternalServerError ErrorResponse
+
+func (*APIV1AuthAPIKeysPostInternalServerError) aPIV1AuthAPIKeysPostRes() {}
+
+type APIV1AuthAPIKeysPostUnauthorized ErrorResponse
+
+func (*APIV1AuthAPIKeysPostUnauthorized) aPIV1AuthAPIKeysPostRes() {}
+
+type APIV1AuthDeviceGetBadRequest ErrorResponse
+
+func (*APIV1AuthDeviceGetBadRequest) aPIV1AuthDeviceGetRes() {}
+
+type APIV1AuthDeviceGetGatewayTimeout ErrorResponse
+
+func (*APIV1AuthDeviceGetGatewayTimeout) aPIV1AuthDeviceGetRes() {}
+
+type APIV1AuthDeviceGetInternalServerError ErrorResponse
+
+func (*APIV1AuthDeviceGetInternalServerError) aPIV1AuthDeviceGetRes() {}
+
+type APIV1AuthDeviceGetUnauthorized ErrorResponse
+
+func (*APIV1AuthDeviceGetUnauthorized) aPIV1AuthDeviceGetRes() {}
+
+type APIV1AuthDevicePostBadRequest ErrorResponse
+
+func (*APIV1AuthDevicePostBadRequest) aPIV1AuthDevicePostRes() {}
+
+type APIV1AuthDevicePostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthDevicePostGatewayTimeout) aPIV1AuthDevicePostRes() {}
+
+type APIV1AuthDevicePostInternalServerError ErrorResponse
+
+func (*APIV1AuthDevicePostInternalServerError) aPIV1AuthDevicePostRes() {}
+
+// APIV1AuthDevicePostNoContent is response for APIV1AuthDevicePost operation.
+type APIV1AuthDevicePostNoContent struct{}
+
+func (*APIV1AuthDevicePostNoContent) aPIV1AuthDevicePostRes() {}
+
+type APIV1AuthDevicePostUnauthorized ErrorResponse
+
+func (*APIV1AuthDevicePostUnauthorized) aPIV1AuthDevicePostRes() {}
+
+type APIV1AuthEmailCancelPostBadRequest ErrorResponse
+
+func (*APIV1AuthEmailCancelPostBadRequest) aPIV1AuthEmailCancelPostRes() {}
+
+type APIV1AuthEmailCancelPostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthEmailCancelPostGatewayTimeout) aPIV1AuthEmailCancelPostRes() {}
+
+type APIV1AuthEmailCancelPostInternalServerError ErrorResponse
+
+func (*APIV1AuthEmailCancelPostInternalServerError) aPIV1AuthEmailCancelPostRes() {}
+
+// APIV1AuthEmailCancelPostNoContent is response for APIV1AuthEmailCancelPost operation.
+type APIV1AuthEmailCancelPostNoContent struct{}
+
+func (*APIV1AuthEmailCancelPostNoContent) aPIV1AuthEmailCancelPostRes() {}
+
+type APIV1AuthEmailConfirmPostBadRequest ErrorResponse
+
+func (*APIV1AuthEmailConfirmPostBadRequest) aPIV1AuthEmailConfirmPostRes() {}
+
+type APIV1AuthEmailConfirmPostConflict ErrorResponse
+
+func (*APIV1AuthEmailConfirmPostConflict) aPIV1AuthEmailConfirmPostRes() {}
+
+type APIV1AuthEmailConfirmPostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthEmailConfirmPostGatewayTimeout) aPIV1AuthEmailConfirmPostRes() {}
+
+type APIV1AuthEmailConfirmPostInternalServerError ErrorResponse
+
+func (*APIV1AuthEmailConfirmPostInternalServerError) aPIV1AuthEmailConfirmPostRes() {}
+
+// APIV1AuthEmailPostAccepted is response for APIV1AuthEmailPost operation.
+type APIV1AuthEmailPostAccepted struct{}
+
+func (*APIV1AuthEmailPostAccepted) aPIV1AuthEmailPostRes() {}
+
+type APIV1AuthEmailPostBadRequest ErrorResponse
+
+func (*APIV1AuthEmailPostBadRequest) aPIV1AuthEmailPostRes() {}
+
+type APIV1AuthEmailPostConflict ErrorResponse
+
+func (*APIV1AuthEmailPostConflict) aPIV1AuthEmailPostRes() {}
+
+type APIV1AuthEmailPostForbidden ErrorResponse
+
+func (*APIV1AuthEmailPostForbidden) aPIV1AuthEmailPostRes() {}
+
+type APIV1AuthEmailPostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthEmailPostGatewayTimeout) aPIV1AuthEmailPostRes() {}
+
+type APIV1AuthEmailPostInternalServerError ErrorResponse
+
+func (*APIV1AuthEmailPostInternalServerError) aPIV1AuthEmailPostRes() {}
+
+type APIV1AuthEmailPostUnauthorized ErrorResponse
+
+func (*APIV1AuthEmailPostUnauthorized) aPIV1AuthEmailPostRes() {}
+
 type APIV1AuthLoginPostBadRequest ErrorResponse
 
 func (*APIV1AuthLoginPostBadRequest) aPIV1AuthLoginPostRes() {}
@@ -59,6 +393,91 @@ type APIV1AuthLogoutPostUnauthorized ErrorResponse
 
 func (*APIV1AuthLogoutPostUnauthorized) aPIV1AuthLogoutPostRes() {}
 
+type APIV1AuthMagicLinkConsumePostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthMagicLinkConsumePostGatewayTimeout) aPIV1AuthMagicLinkConsumePostRes() {}
+
+type APIV1AuthMagicLinkConsumePostInternalServerError ErrorResponse
+
+func (*APIV1AuthMagicLinkConsumePostInternalServerError) aPIV1AuthMagicLinkConsumePostRes() {}
+
+type APIV1AuthMagicLinkConsumePostUnauthorized ErrorResponse
+
+func (*APIV1AuthMagicLinkConsumePostUnauthorized) aPIV1AuthMagicLinkConsumePostRes() {}
+
+// APIV1AuthMagicLinkPostAccepted is response for APIV1AuthMagicLinkPost operation.
+type APIV1AuthMagicLinkPostAccepted struct {
+	SetCookie OptString
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *APIV1AuthMagicLinkPostAccepted) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *APIV1AuthMagicLinkPostAccepted) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+func (*APIV1AuthMagicLinkPostAccepted) aPIV1AuthMagicLinkPostRes() {}
+
+type APIV1AuthMagicLinkPostBadRequest ErrorResponse
+
+func (*APIV1AuthMagicLinkPostBadRequest) aPIV1AuthMagicLinkPostRes() {}
+
+type APIV1AuthMagicLinkPostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthMagicLinkPostGatewayTimeout) aPIV1AuthMagicLinkPostRes() {}
+
+type APIV1AuthMagicLinkPostInternalServerError ErrorResponse
+
+func (*APIV1AuthMagicLinkPostInternalServerError) aPIV1AuthMagicLinkPostRes() {}
+
+type APIV1AuthMeDeleteBadRequest ErrorResponse
+
+func (*APIV1AuthMeDeleteBadRequest) aPIV1AuthMeDeleteRes() {}
+
+type APIV1AuthMeDeleteForbidden ErrorResponse
+
+func (*APIV1AuthMeDeleteForbidden) aPIV1AuthMeDeleteRes() {}
+
+type APIV1AuthMeDeleteGatewayTimeout ErrorResponse
+
+func (*APIV1AuthMeDeleteGatewayTimeout) aPIV1AuthMeDeleteRes() {}
+
+type APIV1AuthMeDeleteInternalServerError ErrorResponse
+
+func (*APIV1AuthMeDeleteInternalServerError) aPIV1AuthMeDeleteRes() {}
+
+type APIV1AuthMeDeleteUnauthorized ErrorResponse
+
+func (*APIV1AuthMeDeleteUnauthorized) aPIV1AuthMeDeleteRes() {}
+
+type APIV1AuthMeEventsGetGatewayTimeout ErrorResponse
+
+func (*APIV1AuthMeEventsGetGatewayTimeout) aPIV1AuthMeEventsGetRes() {}
+
+type APIV1AuthMeEventsGetInternalServerError ErrorResponse
+
+func (*APIV1AuthMeEventsGetInternalServerError) aPIV1AuthMeEventsGetRes() {}
+
+type APIV1AuthMeEventsGetUnauthorized ErrorResponse
+
+func (*APIV1AuthMeEventsGetUnauthorized) aPIV1AuthMeEventsGetRes() {}
+
+type APIV1AuthMeExportGetGatewayTimeout ErrorResponse
+
+func (*APIV1AuthMeExportGetGatewayTimeout) aPIV1AuthMeExportGetRes() {}
+
+type APIV1AuthMeExportGetInternalServerError ErrorResponse
+
+func (*APIV1AuthMeExportGetInternalServerError) aPIV1AuthMeExportGetRes() {}
+
+type APIV1AuthMeExportGetUnauthorized ErrorResponse
+
+func (*APIV1AuthMeExportGetUnauthorized) aPIV1AuthMeExportGetRes() {}
+
 type APIV1AuthMeGetGatewayTimeout ErrorResponse
 
 func (*APIV1AuthMeGetGatewayTimeout) aPIV1AuthMeGetRes() {}
@@ -71,6 +490,35 @@ type APIV1AuthMeGetUnauthorized ErrorResponse
 
 func (*APIV1AuthMeGetUnauthorized) aPIV1AuthMeGetRes() {}
 
+// APIV1AuthOtpPostAccepted is response for APIV1AuthOtpPost operation.
+type APIV1AuthOtpPostAccepted struct{}
+
+func (*APIV1AuthOtpPostAccepted) aPIV1AuthOtpPostRes() {}
+
+type APIV1AuthOtpPostBadRequest ErrorResponse
+
+func (*APIV1AuthOtpPostBadRequest) aPIV1AuthOtpPostRes() {}
+
+type APIV1AuthOtpPostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthOtpPostGatewayTimeout) aPIV1AuthOtpPostRes() {}
+
+type APIV1AuthOtpPostInternalServerError ErrorResponse
+
+func (*APIV1AuthOtpPostInternalServerError) aPIV1AuthOtpPostRes() {}
+
+type APIV1AuthOtpVerifyPostGatewayTimeout ErrorResponse
+
+func (*APIV1AuthOtpVerifyPostGatewayTimeout) aPIV1AuthOtpVerifyPostRes() {}
+
+type APIV1AuthOtpVerifyPostInternalServerError ErrorResponse
+
+func (*APIV1AuthOtpVerifyPostInternalServerError) aPIV1AuthOtpVerifyPostRes() {}
+
+type APIV1AuthOtpVerifyPostUnauthorized ErrorResponse
+
+func (*APIV1AuthOtpVerifyPostUnauthorized) aPIV1AuthOtpVerifyPostRes() {}
+
 type APIV1AuthRefreshPostBadRequest ErrorResponse
 
 func (*APIV1AuthRefreshPostBadRequest) aPIV1AuthRefreshPostRes() {}
@@ -107,6 +555,22 @@ type APIV1AuthRegisterPostUnprocessableEntity ErrorResponse
 
 func (*APIV1AuthRegisterPostUnprocessableEntity) aPIV1AuthRegisterPostRes() {}
 
+type APIV1OAuthDeviceAuthorizationPostGatewayTimeout ErrorResponse
+
+func (*APIV1OAuthDeviceAuthorizationPostGatewayTimeout) aPIV1OAuthDeviceAuthorizationPostRes() {}
+
+type APIV1OAuthDeviceAuthorizationPostInternalServerError ErrorResponse
+
+func (*APIV1OAuthDeviceAuthorizationPostInternalServerError) aPIV1OAuthDeviceAuthorizationPostRes() {}
+
+type APIV1OAuthTokenPostGatewayTimeout ErrorResponse
+
+func (*APIV1OAuthTokenPostGatewayTimeout) aPIV1OAuthTokenPostRes() {}
+
+type APIV1OAuthTokenPostInternalServerError ErrorResponse
+
+func (*APIV1OAuthTokenPostInternalServerError) aPIV1OAuthTokenPostRes() {}
+
 // Ref: #/components/schemas/AccessToken
 type AccessToken struct {
 	AccessToken string `json:"access_token"`
@@ -148,118 +612,1395 @@ func (s *AccessTokenHeaders) SetResponse(val AccessToken) {
 	s.Response = val
 }
 
-func (*AccessTokenHeaders) aPIV1AuthLoginPostRes()   {}
-func (*AccessTokenHeaders) aPIV1AuthRefreshPostRes() {}
+func (*AccessTokenHeaders) aPIV1AuthLoginPostRes()            {}
+func (*AccessTokenHeaders) aPIV1AuthMagicLinkConsumePostRes() {}
+func (*AccessTokenHeaders) aPIV1AuthOtpVerifyPostRes()        {}
+func (*AccessTokenHeaders) aPIV1AuthRefreshPostRes()          {}
 
-type BearerAuth struct {
-	Token string
-	Roles []string
+// Ref: #/components/schemas/AccountDeletionResponse
+type AccountDeletionResponse struct {
+	DeleteAfter time.Time `json:"delete_after"`
 }
 
-// GetToken returns the value of Token.
-func (s *BearerAuth) GetToken() string {
-	return s.Token
+// GetDeleteAfter returns the value of DeleteAfter.
+func (s *AccountDeletionResponse) GetDeleteAfter() time.Time {
+	return s.DeleteAfter
 }
 
-// GetRoles returns the value of Roles.
-func (s *BearerAuth) GetRoles() []string {
-	return s.Roles
+// SetDeleteAfter sets the value of DeleteAfter.
+func (s *AccountDeletionResponse) SetDeleteAfter(val time.Time) {
+	s.DeleteAfter = val
 }
 
-// SetToken sets the value of Token.
-func (s *BearerAuth) SetToken(val string) {
-	s.Token = val
+// AccountDeletionResponseHeaders wraps AccountDeletionResponse with response headers.
+type AccountDeletionResponseHeaders struct {
+	SetCookie OptString
+	Response  AccountDeletionResponse
 }
 
-// SetRoles sets the value of Roles.
-func (s *BearerAuth) SetRoles(val []string) {
-	s.Roles = val
+// GetSetCookie returns the value of SetCookie.
+func (s *AccountDeletionResponseHeaders) GetSetCookie() OptString {
+	return s.SetCookie
 }
 
-// Ref: #/components/schemas/ErrorResponse
-type ErrorResponse struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
+// GetResponse returns the value of Response.
+func (s *AccountDeletionResponseHeaders) GetResponse() AccountDeletionResponse {
+	return s.Response
 }
 
-// GetStatus returns the value of Status.
-func (s *ErrorResponse) GetStatus() int {
-	return s.Status
+// SetSetCookie sets the value of SetCookie.
+func (s *AccountDeletionResponseHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
 }
 
-// GetMessage returns the value of Message.
-func (s *ErrorResponse) GetMessage() string {
-	return s.Message
+// SetResponse sets the value of Response.
+func (s *AccountDeletionResponseHeaders) SetResponse(val AccountDeletionResponse) {
+	s.Response = val
 }
 
-// SetStatus sets the value of Status.
-func (s *ErrorResponse) SetStatus(val int) {
-	s.Status = val
+func (*AccountDeletionResponseHeaders) aPIV1AuthMeDeleteRes() {}
+
+// Ref: #/components/schemas/AccountExport
+type AccountExport struct {
+	User       UserInfoResponse        `json:"user"`
+	Sessions   []SessionResponse       `json:"sessions"`
+	APIKeys    APIKeyList              `json:"api_keys"`
+	Attributes []UserAttributeResponse `json:"attributes"`
+	AuthEvents AuthEventList           `json:"auth_events"`
+	ExportedAt time.Time               `json:"exported_at"`
 }
 
-// SetMessage sets the value of Message.
-func (s *ErrorResponse) SetMessage(val string) {
-	s.Message = val
+// GetUser returns the value of User.
+func (s *AccountExport) GetUser() UserInfoResponse {
+	return s.User
 }
 
-// Ref: #/components/schemas/LoginRequest
-type LoginRequest struct {
-	Email    string `json:"email"`
+// GetSessions returns the value of Sessions.
+func (s *AccountExport) GetSessions() []SessionResponse {
+	return s.Sessions
+}
+
+// GetAPIKeys returns the value of APIKeys.
+func (s *AccountExport) GetAPIKeys() APIKeyList {
+	return s.APIKeys
+}
+
+// GetAttributes returns the value of Attributes.
+func (s *AccountExport) GetAttributes() []UserAttributeResponse {
+	return s.Attributes
+}
+
+// GetAuthEvents returns the value of AuthEvents.
+func (s *AccountExport) GetAuthEvents() AuthEventList {
+	return s.AuthEvents
+}
+
+// GetExportedAt returns the value of ExportedAt.
+func (s *AccountExport) GetExportedAt() time.Time {
+	return s.ExportedAt
+}
+
+// SetUser sets the value of User.
+func (s *AccountExport) SetUser(val UserInfoResponse) {
+	s.User = val
+}
+
+// SetSessions sets the value of Sessions.
+func (s *AccountExport) SetSessions(val []SessionResponse) {
+	s.Sessions = val
+}
+
+// SetAPIKeys sets the value of APIKeys.
+func (s *AccountExport) SetAPIKeys(val APIKeyList) {
+	s.APIKeys = val
+}
+
+// SetAttributes sets the value of Attributes.
+func (s *AccountExport) SetAttributes(val []UserAttributeResponse) {
+	s.Attributes = val
+}
+
+// SetAuthEvents sets the value of AuthEvents.
+func (s *AccountExport) SetAuthEvents(val AuthEventList) {
+	s.AuthEvents = val
+}
+
+// SetExportedAt sets the value of ExportedAt.
+func (s *AccountExport) SetExportedAt(val time.Time) {
+	s.ExportedAt = val
+}
+
+func (*AccountExport) aPIV1AuthMeExportGetRes() {}
+
+type ApiKeyAuth struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *ApiKeyAuth) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *ApiKeyAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *ApiKeyAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *ApiKeyAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type AuthEventList []AuthEventResponse
+
+func (*AuthEventList) aPIV1AdminAuthEventsGetRes() {}
+func (*AuthEventList) aPIV1AuthMeEventsGetRes()    {}
+
+// Ref: #/components/schemas/AuthEventOutcome
+type AuthEventOutcome string
+
+const (
+	AuthEventOutcomeSuccess AuthEventOutcome = "success"
+	AuthEventOutcomeFailure AuthEventOutcome = "failure"
+)
+
+// AllValues returns all AuthEventOutcome values.
+func (AuthEventOutcome) AllValues() []AuthEventOutcome {
+	return []AuthEventOutcome{
+		AuthEventOutcomeSuccess,
+		AuthEventOutcomeFailure,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuthEventOutcome) MarshalText() ([]byte, error) {
+	switch s {
+	case AuthEventOutcomeSuccess:
+		return []byte(s), nil
+	case AuthEventOutcomeFailure:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuthEventOutcome) UnmarshalText(data []byte) error {
+	switch AuthEventOutcome(data) {
+	case AuthEventOutcomeSuccess:
+		*s = AuthEventOutcomeSuccess
+		return nil
+	case AuthEventOutcomeFailure:
+		*s = AuthEventOutcomeFailure
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/AuthEventResponse
+type AuthEventResponse struct {
+	ID        uuid.UUID        `json:"id"`
+	EventType AuthEventType    `json:"event_type"`
+	UserID    OptUUID          `json:"user_id"`
+	IP        string           `json:"ip"`
+	UserAgent string           `json:"user_agent"`
+	Outcome   AuthEventOutcome `json:"outcome"`
+	Reason    string           `json:"reason"`
+	RequestID string           `json:"request_id"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *AuthEventResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetEventType returns the value of EventType.
+func (s *AuthEventResponse) GetEventType() AuthEventType {
+	return s.EventType
+}
+
+// GetUserID returns the value of UserID.
+func (s *AuthEventResponse) GetUserID() OptUUID {
+	return s.UserID
+}
+
+// GetIP returns the value of IP.
+func (s *AuthEventResponse) GetIP() string {
+	return s.IP
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *AuthEventResponse) GetUserAgent() string {
+	return s.UserAgent
+}
+
+// GetOutcome returns the value of Outcome.
+func (s *AuthEventResponse) GetOutcome() AuthEventOutcome {
+	return s.Outcome
+}
+
+// GetReason returns the value of Reason.
+func (s *AuthEventResponse) GetReason() string {
+	return s.Reason
+}
+
+// GetRequestID returns the value of RequestID.
+func (s *AuthEventResponse) GetRequestID() string {
+	return s.RequestID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AuthEventResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *AuthEventResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *AuthEventResponse) SetEventType(val AuthEventType) {
+	s.EventType = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *AuthEventResponse) SetUserID(val OptUUID) {
+	s.UserID = val
+}
+
+// SetIP sets the value of IP.
+func (s *AuthEventResponse) SetIP(val string) {
+	s.IP = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *AuthEventResponse) SetUserAgent(val string) {
+	s.UserAgent = val
+}
+
+// SetOutcome sets the value of Outcome.
+func (s *AuthEventResponse) SetOutcome(val AuthEventOutcome) {
+	s.Outcome = val
+}
+
+// SetReason sets the value of Reason.
+func (s *AuthEventResponse) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetRequestID sets the value of RequestID.
+func (s *AuthEventResponse) SetRequestID(val string) {
+	s.RequestID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AuthEventResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/AuthEventType
+type AuthEventType string
+
+const (
+	AuthEventTypeRegister      AuthEventType = "register"
+	AuthEventTypeLogin         AuthEventType = "login"
+	AuthEventTypeRefresh       AuthEventType = "refresh"
+	AuthEventTypeLogout        AuthEventType = "logout"
+	AuthEventTypeEmailChange   AuthEventType = "email_change"
+	AuthEventTypeDeleteAccount AuthEventType = "delete_account"
+	AuthEventTypeMagicLink     AuthEventType = "magic_link"
+	AuthEventTypeOtp           AuthEventType = "otp"
+	AuthEventTypeDeviceCode    AuthEventType = "device_code"
+	AuthEventTypeTokenExchange AuthEventType = "token_exchange"
+)
+
+// AllValues returns all AuthEventType values.
+func (AuthEventType) AllValues() []AuthEventType {
+	return []AuthEventType{
+		AuthEventTypeRegister,
+		AuthEventTypeLogin,
+		AuthEventTypeRefresh,
+		AuthEventTypeLogout,
+		AuthEventTypeEmailChange,
+		AuthEventTypeDeleteAccount,
+		AuthEventTypeMagicLink,
+		AuthEventTypeOtp,
+		AuthEventTypeDeviceCode,
+		AuthEventTypeTokenExchange,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuthEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case AuthEventTypeRegister:
+		return []byte(s), nil
+	case AuthEventTypeLogin:
+		return []byte(s), nil
+	case AuthEventTypeRefresh:
+		return []byte(s), nil
+	case AuthEventTypeLogout:
+		return []byte(s), nil
+	case AuthEventTypeEmailChange:
+		return []byte(s), nil
+	case AuthEventTypeDeleteAccount:
+		return []byte(s), nil
+	case AuthEventTypeMagicLink:
+		return []byte(s), nil
+	case AuthEventTypeOtp:
+		return []byte(s), nil
+	case AuthEventTypeDeviceCode:
+		return []byte(s), nil
+	case AuthEventTypeTokenExchange:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuthEventType) UnmarshalText(data []byte) error {
+	switch AuthEventType(data) {
+	case AuthEventTypeRegister:
+		*s = AuthEventTypeRegister
+		return nil
+	case AuthEventTypeLogin:
+		*s = AuthEventTypeLogin
+		return nil
+	case AuthEventTypeRefresh:
+		*s = AuthEventTypeRefresh
+		return nil
+	case AuthEventTypeLogout:
+		*s = AuthEventTypeLogout
+		return nil
+	case AuthEventTypeEmailChange:
+		*s = AuthEventTypeEmailChange
+		return nil
+	case AuthEventTypeDeleteAccount:
+		*s = AuthEventTypeDeleteAccount
+		return nil
+	case AuthEventTypeMagicLink:
+		*s = AuthEventTypeMagicLink
+		return nil
+	case AuthEventTypeOtp:
+		*s = AuthEventTypeOtp
+		return nil
+	case AuthEventTypeDeviceCode:
+		*s = AuthEventTypeDeviceCode
+		return nil
+	case AuthEventTypeTokenExchange:
+		*s = AuthEventTypeTokenExchange
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Ref: #/components/schemas/ChangeEmailRequest
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email"`
+	Password string `json:"password"`
+}
+
+// GetNewEmail returns the value of NewEmail.
+func (s *ChangeEmailRequest) GetNewEmail() string {
+	return s.NewEmail
+}
+
+// GetPassword returns the value of Password.
+func (s *ChangeEmailRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetNewEmail sets the value of NewEmail.
+func (s *ChangeEmailRequest) SetNewEmail(val string) {
+	s.NewEmail = val
+}
+
+// SetPassword sets the value of Password.
+func (s *ChangeEmailRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// Ref: #/components/schemas/CreateAPIKeyRequest
+type CreateAPIKeyRequest struct {
+	Name      string      `json:"name"`
+	Scopes    []string    `json:"scopes"`
+	ExpiresAt OptDateTime `json:"expires_at"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateAPIKeyRequest) GetName() string {
+	return s.Name
+}
+
+// GetScopes returns the value of Scopes.
+func (s *CreateAPIKeyRequest) GetScopes() []string {
+	return s.Scopes
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreateAPIKeyRequest) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// SetName sets the value of Name.
+func (s *CreateAPIKeyRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *CreateAPIKeyRequest) SetScopes(val []string) {
+	s.Scopes = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreateAPIKeyRequest) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/CreateAPIKeyResponse
+type CreateAPIKeyResponse struct {
+	Key    string `json:"key"`
+	APIKey APIKey `json:"api_key"`
+}
+
+// GetKey returns the value of Key.
+func (s *CreateAPIKeyResponse) GetKey() string {
+	return s.Key
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *CreateAPIKeyResponse) GetAPIKey() APIKey {
+	return s.APIKey
+}
+
+// SetKey sets the value of Key.
+func (s *CreateAPIKeyResponse) SetKey(val string) {
+	s.Key = val
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *CreateAPIKeyResponse) SetAPIKey(val APIKey) {
+	s.APIKey = val
+}
+
+func (*CreateAPIKeyResponse) aPIV1AuthAPIKeysPostRes() {}
+
+// Ref: #/components/schemas/CreateWebhookRequest
+type CreateWebhookRequest struct {
+	URL        string             `json:"url"`
+	EventTypes []WebhookEventType `json:"event_types"`
+}
+
+// GetURL returns the value of URL.
+func (s *CreateWebhookRequest) GetURL() string {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *CreateWebhookRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// SetURL sets the value of URL.
+func (s *CreateWebhookRequest) SetURL(val string) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *CreateWebhookRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// Ref: #/components/schemas/CreateWebhookResponse
+type CreateWebhookResponse struct {
+	Secret  string  `json:"secret"`
+	Webhook Webhook `json:"webhook"`
+}
+
+// GetSecret returns the value of Secret.
+func (s *CreateWebhookResponse) GetSecret() string {
+	return s.Secret
+}
+
+// GetWebhook returns the value of Webhook.
+func (s *CreateWebhookResponse) GetWebhook() Webhook {
+	return s.Webhook
+}
+
+// SetSecret sets the value of Secret.
+func (s *CreateWebhookResponse) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetWebhook sets the value of Webhook.
+func (s *CreateWebhookResponse) SetWebhook(val Webhook) {
+	s.Webhook = val
+}
+
+func (*CreateWebhookResponse) aPIV1AdminWebhooksPostRes() {}
+
+// Ref: #/components/schemas/DeleteAccountRequest
+type DeleteAccountRequest struct {
 	Password string `json:"password"`
 }
 
-// GetEmail returns the value of Email.
-func (s *LoginRequest) GetEmail() string {
-	return s.Email
+// GetPassword returns the value of Password.
+func (s *DeleteAccountRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetPassword sets the value of Password.
+func (s *DeleteAccountRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// Ref: #/components/schemas/DeviceAuthorizationInfo
+type DeviceAuthorizationInfo struct {
+	ClientID  string    `json:"client_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetClientID returns the value of ClientID.
+func (s *DeviceAuthorizationInfo) GetClientID() string {
+	return s.ClientID
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *DeviceAuthorizationInfo) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetClientID sets the value of ClientID.
+func (s *DeviceAuthorizationInfo) SetClientID(val string) {
+	s.ClientID = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *DeviceAuthorizationInfo) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+func (*DeviceAuthorizationInfo) aPIV1AuthDeviceGetRes() {}
+
+// Ref: #/components/schemas/DeviceAuthorizationRequest
+type DeviceAuthorizationRequest struct {
+	ClientID string `json:"client_id"`
+	// Secret of a client registered with one.
+	ClientSecret OptString `json:"client_secret"`
+}
+
+// GetClientID returns the value of ClientID.
+func (s *DeviceAuthorizationRequest) GetClientID() string {
+	return s.ClientID
+}
+
+// GetClientSecret returns the value of ClientSecret.
+func (s *DeviceAuthorizationRequest) GetClientSecret() OptString {
+	return s.ClientSecret
+}
+
+// SetClientID sets the value of ClientID.
+func (s *DeviceAuthorizationRequest) SetClientID(val string) {
+	s.ClientID = val
+}
+
+// SetClientSecret sets the value of ClientSecret.
+func (s *DeviceAuthorizationRequest) SetClientSecret(val OptString) {
+	s.ClientSecret = val
+}
+
+// Ref: #/components/schemas/DeviceAuthorizationResponse
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	// Seconds until the device code expires.
+	ExpiresIn int `json:"expires_in"`
+	// Seconds to wait between polls.
+	Interval int `json:"interval"`
+}
+
+// GetDeviceCode returns the value of DeviceCode.
+func (s *DeviceAuthorizationResponse) GetDeviceCode() string {
+	return s.DeviceCode
+}
+
+// GetUserCode returns the value of UserCode.
+func (s *DeviceAuthorizationResponse) GetUserCode() string {
+	return s.UserCode
+}
+
+// GetVerificationURI returns the value of VerificationURI.
+func (s *DeviceAuthorizationResponse) GetVerificationURI() string {
+	return s.VerificationURI
+}
+
+// GetVerificationURIComplete returns the value of VerificationURIComplete.
+func (s *DeviceAuthorizationResponse) GetVerificationURIComplete() string {
+	return s.VerificationURIComplete
+}
+
+// GetExpiresIn returns the value of ExpiresIn.
+func (s *DeviceAuthorizationResponse) GetExpiresIn() int {
+	return s.ExpiresIn
+}
+
+// GetInterval returns the value of Interval.
+func (s *DeviceAuthorizationResponse) GetInterval() int {
+	return s.Interval
+}
+
+// SetDeviceCode sets the value of DeviceCode.
+func (s *DeviceAuthorizationResponse) SetDeviceCode(val string) {
+	s.DeviceCode = val
+}
+
+// SetUserCode sets the value of UserCode.
+func (s *DeviceAuthorizationResponse) SetUserCode(val string) {
+	s.UserCode = val
+}
+
+// SetVerificationURI sets the value of VerificationURI.
+func (s *DeviceAuthorizationResponse) SetVerificationURI(val string) {
+	s.VerificationURI = val
+}
+
+// SetVerificationURIComplete sets the value of VerificationURIComplete.
+func (s *DeviceAuthorizationResponse) SetVerificationURIComplete(val string) {
+	s.VerificationURIComplete = val
+}
+
+// SetExpiresIn sets the value of ExpiresIn.
+func (s *DeviceAuthorizationResponse) SetExpiresIn(val int) {
+	s.ExpiresIn = val
+}
+
+// SetInterval sets the value of Interval.
+func (s *DeviceAuthorizationResponse) SetInterval(val int) {
+	s.Interval = val
+}
+
+func (*DeviceAuthorizationResponse) aPIV1OAuthDeviceAuthorizationPostRes() {}
+
+// Ref: #/components/schemas/DeviceVerifyRequest
+type DeviceVerifyRequest struct {
+	UserCode string `json:"user_code"`
+	// Denies the device when false.
+	Approve OptBool `json:"approve"`
+}
+
+// GetUserCode returns the value of UserCode.
+func (s *DeviceVerifyRequest) GetUserCode() string {
+	return s.UserCode
+}
+
+// GetApprove returns the value of Approve.
+func (s *DeviceVerifyRequest) GetApprove() OptBool {
+	return s.Approve
+}
+
+// SetUserCode sets the value of UserCode.
+func (s *DeviceVerifyRequest) SetUserCode(val string) {
+	s.UserCode = val
+}
+
+// SetApprove sets the value of Approve.
+func (s *DeviceVerifyRequest) SetApprove(val OptBool) {
+	s.Approve = val
+}
+
+// Ref: #/components/schemas/EmailChangeTokenRequest
+type EmailChangeTokenRequest struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *EmailChangeTokenRequest) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *EmailChangeTokenRequest) SetToken(val string) {
+	s.Token = val
+}
+
+// Ref: #/components/schemas/ErrorResponse
+type ErrorResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+// GetStatus returns the value of Status.
+func (s *ErrorResponse) GetStatus() int {
+	return s.Status
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorResponse) GetMessage() string {
+	return s.Message
+}
+
+// SetStatus sets the value of Status.
+func (s *ErrorResponse) SetStatus(val int) {
+	s.Status = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// Ref: #/components/schemas/LoginRequest
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	// Selects the longer session lifetimes.
+	RememberMe OptBool `json:"remember_me"`
+}
+
+// GetEmail returns the value of Email.
+func (s *LoginRequest) GetEmail() string {
+	return s.Email
+}
+
+// GetPassword returns the value of Password.
+func (s *LoginRequest) GetPassword() string {
+	return s.Password
+}
+
+// GetRememberMe returns the value of RememberMe.
+func (s *LoginRequest) GetRememberMe() OptBool {
+	return s.RememberMe
+}
+
+// SetEmail sets the value of Email.
+func (s *LoginRequest) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LoginRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetRememberMe sets the value of RememberMe.
+func (s *LoginRequest) SetRememberMe(val OptBool) {
+	s.RememberMe = val
+}
+
+// Ref: #/components/schemas/MagicLinkConsumeRequest
+type MagicLinkConsumeRequest struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *MagicLinkConsumeRequest) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *MagicLinkConsumeRequest) SetToken(val string) {
+	s.Token = val
+}
+
+// Ref: #/components/schemas/MagicLinkRequest
+type MagicLinkRequest struct {
+	Email string `json:"email"`
+	// Selects the longer session lifetimes.
+	RememberMe OptBool `json:"remember_me"`
+}
+
+// GetEmail returns the value of Email.
+func (s *MagicLinkRequest) GetEmail() string {
+	return s.Email
+}
+
+// GetRememberMe returns the value of RememberMe.
+func (s *MagicLinkRequest) GetRememberMe() OptBool {
+	return s.RememberMe
+}
+
+// SetEmail sets the value of Email.
+func (s *MagicLinkRequest) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRememberMe sets the value of RememberMe.
+func (s *MagicLinkRequest) SetRememberMe(val OptBool) {
+	s.RememberMe = val
+}
+
+// Ref: #/components/schemas/OAuthError
+type OAuthError struct {
+	Error            string    `json:"error"`
+	ErrorDescription OptString `json:"error_description"`
+}
+
+// GetError returns the value of Error.
+func (s *OAuthError) GetError() string {
+	return s.Error
+}
+
+// GetErrorDescription returns the value of ErrorDescription.
+func (s *OAuthError) GetErrorDescription() OptString {
+	return s.ErrorDescription
+}
+
+// SetError sets the value of Error.
+func (s *OAuthError) SetError(val string) {
+	s.Error = val
+}
+
+// SetErrorDescription sets the value of ErrorDescription.
+func (s *OAuthError) SetErrorDescription(val OptString) {
+	s.ErrorDescription = val
+}
+
+func (*OAuthError) aPIV1OAuthDeviceAuthorizationPostRes() {}
+func (*OAuthError) aPIV1OAuthTokenPostRes()               {}
+
+// Ref: #/components/schemas/OTPRequest
+type OTPRequest struct {
+	Email   string               `json:"email"`
+	Channel OptOTPRequestChannel `json:"channel"`
+	// Selects the longer session lifetimes.
+	RememberMe OptBool `json:"remember_me"`
+}
+
+// GetEmail returns the value of Email.
+func (s *OTPRequest) GetEmail() string {
+	return s.Email
+}
+
+// GetChannel returns the value of Channel.
+func (s *OTPRequest) GetChannel() OptOTPRequestChannel {
+	return s.Channel
+}
+
+// GetRememberMe returns the value of RememberMe.
+func (s *OTPRequest) GetRememberMe() OptBool {
+	return s.RememberMe
+}
+
+// SetEmail sets the value of Email.
+func (s *OTPRequest) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *OTPRequest) SetChannel(val OptOTPRequestChannel) {
+	s.Channel = val
+}
+
+// SetRememberMe sets the value of RememberMe.
+func (s *OTPRequest) SetRememberMe(val OptBool) {
+	s.RememberMe = val
+}
+
+type OTPRequestChannel string
+
+const (
+	OTPRequestChannelEmail OTPRequestChannel = "email"
+	OTPRequestChannelSMS   OTPRequestChannel = "sms"
+)
+
+// AllValues returns all OTPRequestChannel values.
+func (OTPRequestChannel) AllValues() []OTPRequestChannel {
+	return []OTPRequestChannel{
+		OTPRequestChannelEmail,
+		OTPRequestChannelSMS,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OTPRequestChannel) MarshalText() ([]byte, error) {
+	switch s {
+	case OTPRequestChannelEmail:
+		return []byte(s), nil
+	case OTPRequestChannelSMS:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OTPRequestChannel) UnmarshalText(data []byte) error {
+	switch OTPRequestChannel(data) {
+	case OTPRequestChannelEmail:
+		*s = OTPRequestChannelEmail
+		return nil
+	case OTPRequestChannelSMS:
+		*s = OTPRequestChannelSMS
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/OTPVerifyRequest
+type OTPVerifyRequest struct {
+	Email string `json:"email"`
+	Code  string `json:"code"`
+}
+
+// GetEmail returns the value of Email.
+func (s *OTPVerifyRequest) GetEmail() string {
+	return s.Email
+}
+
+// GetCode returns the value of Code.
+func (s *OTPVerifyRequest) GetCode() string {
+	return s.Code
+}
+
+// SetEmail sets the value of Email.
+func (s *OTPVerifyRequest) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetCode sets the value of Code.
+func (s *OTPVerifyRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// NewOptAuthEventOutcome returns new OptAuthEventOutcome with value set to v.
+func NewOptAuthEventOutcome(v AuthEventOutcome) OptAuthEventOutcome {
+	return OptAuthEventOutcome{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuthEventOutcome is optional AuthEventOutcome.
+type OptAuthEventOutcome struct {
+	Value AuthEventOutcome
+	Set   bool
+}
+
+// IsSet returns true if OptAuthEventOutcome was set.
+func (o OptAuthEventOutcome) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuthEventOutcome) Reset() {
+	var v AuthEventOutcome
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuthEventOutcome) SetTo(v AuthEventOutcome) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuthEventOutcome) Get() (v AuthEventOutcome, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuthEventOutcome) Or(d AuthEventOutcome) AuthEventOutcome {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAuthEventType returns new OptAuthEventType with value set to v.
+func NewOptAuthEventType(v AuthEventType) OptAuthEventType {
+	return OptAuthEventType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuthEventType is optional AuthEventType.
+type OptAuthEventType struct {
+	Value AuthEventType
+	Set   bool
+}
+
+// IsSet returns true if OptAuthEventType was set.
+func (o OptAuthEventType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuthEventType) Reset() {
+	var v AuthEventType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuthEventType) SetTo(v AuthEventType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuthEventType) Get() (v AuthEventType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuthEventType) Or(d AuthEventType) AuthEventType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOTPRequestChannel returns new OptOTPRequestChannel with value set to v.
+func NewOptOTPRequestChannel(v OTPRequestChannel) OptOTPRequestChannel {
+	return OptOTPRequestChannel{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOTPRequestChannel is optional OTPRequestChannel.
+type OptOTPRequestChannel struct {
+	Value OTPRequestChannel
+	Set   bool
+}
+
+// IsSet returns true if OptOTPRequestChannel was set.
+func (o OptOTPRequestChannel) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOTPRequestChannel) Reset() {
+	var v OTPRequestChannel
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOTPRequestChannel) SetTo(v OTPRequestChannel) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOTPRequestChannel) Get() (v OTPRequestChannel, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOTPRequestChannel) Or(d OTPRequestChannel) OTPRequestChannel {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
 }
 
-// GetPassword returns the value of Password.
-func (s *LoginRequest) GetPassword() string {
-	return s.Password
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
 }
 
-// SetEmail sets the value of Email.
-func (s *LoginRequest) SetEmail(val string) {
-	s.Email = val
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetPassword sets the value of Password.
-func (s *LoginRequest) SetPassword(val string) {
-	s.Password = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWebhookDeliveryStatus returns new OptWebhookDeliveryStatus with value set to v.
+func NewOptWebhookDeliveryStatus(v WebhookDeliveryStatus) OptWebhookDeliveryStatus {
+	return OptWebhookDeliveryStatus{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptWebhookDeliveryStatus is optional WebhookDeliveryStatus.
+type OptWebhookDeliveryStatus struct {
+	Value WebhookDeliveryStatus
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptWebhookDeliveryStatus was set.
+func (o OptWebhookDeliveryStatus) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptWebhookDeliveryStatus) Reset() {
+	var v WebhookDeliveryStatus
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptWebhookDeliveryStatus) SetTo(v WebhookDeliveryStatus) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptWebhookDeliveryStatus) Get() (v WebhookDeliveryStatus, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -267,7 +2008,7 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptWebhookDeliveryStatus) Or(d WebhookDeliveryStatus) WebhookDeliveryStatus {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -339,6 +2080,284 @@ func (s *RegisterResponse) SetCreatedAt(val time.Time) {
 
 func (*RegisterResponse) aPIV1AuthRegisterPostRes() {}
 
+// Ref: #/components/schemas/SessionResponse
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	StartedAt  time.Time `json:"started_at"`
+	RememberMe bool      `json:"remember_me"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// GetID returns the value of ID.
+func (s *SessionResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *SessionResponse) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// GetRememberMe returns the value of RememberMe.
+func (s *SessionResponse) GetRememberMe() bool {
+	return s.RememberMe
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SessionResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *SessionResponse) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetID sets the value of ID.
+func (s *SessionResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *SessionResponse) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetRememberMe sets the value of RememberMe.
+func (s *SessionResponse) SetRememberMe(val bool) {
+	s.RememberMe = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SessionResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *SessionResponse) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/TokenRequest
+type TokenRequest struct {
+	GrantType  string    `json:"grant_type"`
+	DeviceCode OptString `json:"device_code"`
+	ClientID   OptString `json:"client_id"`
+	// Secret of a client registered with one.
+	ClientSecret OptString `json:"client_secret"`
+	// Access token to exchange. A token bound to a DPoP key or a TLS client certificate needs a proof of
+	// the key or the certificate on the request.
+	SubjectToken       OptString `json:"subject_token"`
+	SubjectTokenType   OptString `json:"subject_token_type"`
+	RequestedTokenType OptString `json:"requested_token_type"`
+	// Services the exchanged token is for.
+	Audience []string `json:"audience"`
+	// Space-separated scopes of the exchanged token.
+	Scope OptString `json:"scope"`
+}
+
+// GetGrantType returns the value of GrantType.
+func (s *TokenRequest) GetGrantType() string {
+	return s.GrantType
+}
+
+// GetDeviceCode returns the value of DeviceCode.
+func (s *TokenRequest) GetDeviceCode() OptString {
+	return s.DeviceCode
+}
+
+// GetClientID returns the value of ClientID.
+func (s *TokenRequest) GetClientID() OptString {
+	return s.ClientID
+}
+
+// GetClientSecret returns the value of ClientSecret.
+func (s *TokenRequest) GetClientSecret() OptString {
+	return s.ClientSecret
+}
+
+// GetSubjectToken returns the value of SubjectToken.
+func (s *TokenRequest) GetSubjectToken() OptString {
+	return s.SubjectToken
+}
+
+// GetSubjectTokenType returns the value of SubjectTokenType.
+func (s *TokenRequest) GetSubjectTokenType() OptString {
+	return s.SubjectTokenType
+}
+
+// GetRequestedTokenType returns the value of RequestedTokenType.
+func (s *TokenRequest) GetRequestedTokenType() OptString {
+	return s.RequestedTokenType
+}
+
+// GetAudience returns the value of Audience.
+func (s *TokenRequest) GetAudience() []string {
+	return s.Audience
+}
+
+// GetScope returns the value of Scope.
+func (s *TokenRequest) GetScope() OptString {
+	return s.Scope
+}
+
+// SetGrantType sets the value of GrantType.
+func (s *TokenRequest) SetGrantType(val string) {
+	s.GrantType = val
+}
+
+// SetDeviceCode sets the value of DeviceCode.
+func (s *TokenRequest) SetDeviceCode(val OptString) {
+	s.DeviceCode = val
+}
+
+// SetClientID sets the value of ClientID.
+func (s *TokenRequest) SetClientID(val OptString) {
+	s.ClientID = val
+}
+
+// SetClientSecret sets the value of ClientSecret.
+func (s *TokenRequest) SetClientSecret(val OptString) {
+	s.ClientSecret = val
+}
+
+// SetSubjectToken sets the value of SubjectToken.
+func (s *TokenRequest) SetSubjectToken(val OptString) {
+	s.SubjectToken = val
+}
+
+// SetSubjectTokenType sets the value of SubjectTokenType.
+func (s *TokenRequest) SetSubjectTokenType(val OptString) {
+	s.SubjectTokenType = val
+}
+
+// SetRequestedTokenType sets the value of RequestedTokenType.
+func (s *TokenRequest) SetRequestedTokenType(val OptString) {
+	s.RequestedTokenType = val
+}
+
+// SetAudience sets the value of Audience.
+func (s *TokenRequest) SetAudience(val []string) {
+	s.Audience = val
+}
+
+// SetScope sets the value of Scope.
+func (s *TokenRequest) SetScope(val OptString) {
+	s.Scope = val
+}
+
+// Ref: #/components/schemas/TokenResponse
+type TokenResponse struct {
+	AccessToken     string    `json:"access_token"`
+	TokenType       string    `json:"token_type"`
+	RefreshToken    OptString `json:"refresh_token"`
+	IssuedTokenType OptString `json:"issued_token_type"`
+	// Seconds until the access token expires.
+	ExpiresIn OptInt    `json:"expires_in"`
+	Scope     OptString `json:"scope"`
+}
+
+// GetAccessToken returns the value of AccessToken.
+func (s *TokenResponse) GetAccessToken() string {
+	return s.AccessToken
+}
+
+// GetTokenType returns the value of TokenType.
+func (s *TokenResponse) GetTokenType() string {
+	return s.TokenType
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *TokenResponse) GetRefreshToken() OptString {
+	return s.RefreshToken
+}
+
+// GetIssuedTokenType returns the value of IssuedTokenType.
+func (s *TokenResponse) GetIssuedTokenType() OptString {
+	return s.IssuedTokenType
+}
+
+// GetExpiresIn returns the value of ExpiresIn.
+func (s *TokenResponse) GetExpiresIn() OptInt {
+	return s.ExpiresIn
+}
+
+// GetScope returns the value of Scope.
+func (s *TokenResponse) GetScope() OptString {
+	return s.Scope
+}
+
+// SetAccessToken sets the value of AccessToken.
+func (s *TokenResponse) SetAccessToken(val string) {
+	s.AccessToken = val
+}
+
+// SetTokenType sets the value of TokenType.
+func (s *TokenResponse) SetTokenType(val string) {
+	s.TokenType = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *TokenResponse) SetRefreshToken(val OptString) {
+	s.RefreshToken = val
+}
+
+// SetIssuedTokenType sets the value of IssuedTokenType.
+func (s *TokenResponse) SetIssuedTokenType(val OptString) {
+	s.IssuedTokenType = val
+}
+
+// SetExpiresIn sets the value of ExpiresIn.
+func (s *TokenResponse) SetExpiresIn(val OptInt) {
+	s.ExpiresIn = val
+}
+
+// SetScope sets the value of Scope.
+func (s *TokenResponse) SetScope(val OptString) {
+	s.Scope = val
+}
+
+func (*TokenResponse) aPIV1OAuthTokenPostRes() {}
+
+// Ref: #/components/schemas/UserAttributeResponse
+type UserAttributeResponse struct {
+	Name string `json:"name"`
+	// Any JSON value.
+	Value     jx.Raw    `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetName returns the value of Name.
+func (s *UserAttributeResponse) GetName() string {
+	return s.Name
+}
+
+// GetValue returns the value of Value.
+func (s *UserAttributeResponse) GetValue() jx.Raw {
+	return s.Value
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *UserAttributeResponse) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetName sets the value of Name.
+func (s *UserAttributeResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetValue sets the value of Value.
+func (s *UserAttributeResponse) SetValue(val jx.Raw) {
+	s.Value = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *UserAttributeResponse) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
 // Ref: #/components/schemas/UserInfoResponse
 type UserInfoResponse struct {
 	UserID    string    `json:"user_id"`
@@ -376,4 +2395,305 @@ func (s *UserInfoResponse) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*UserInfoResponse) aPIV1AuthMeGetRes() {}
+func (*UserInfoResponse) aPIV1AuthEmailConfirmPostRes() {}
+func (*UserInfoResponse) aPIV1AuthMeGetRes()            {}
+
+// Ref: #/components/schemas/Webhook
+type Webhook struct {
+	ID         uuid.UUID          `json:"id"`
+	URL        string             `json:"url"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Webhook) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetURL returns the value of URL.
+func (s *Webhook) GetURL() string {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *Webhook) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Webhook) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Webhook) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetURL sets the value of URL.
+func (s *Webhook) SetURL(val string) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *Webhook) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Webhook) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type WebhookDeliveryList []WebhookDeliveryResponse
+
+func (*WebhookDeliveryList) aPIV1AdminWebhooksWebhookIDDeliveriesGetRes() {}
+
+// Ref: #/components/schemas/WebhookDeliveryResponse
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	WebhookID      uuid.UUID             `json:"webhook_id"`
+	EventID        uuid.UUID             `json:"event_id"`
+	EventType      WebhookEventType      `json:"event_type"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	LastStatusCode int                   `json:"last_status_code"`
+	LastError      string                `json:"last_error"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *WebhookDeliveryResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetWebhookID returns the value of WebhookID.
+func (s *WebhookDeliveryResponse) GetWebhookID() uuid.UUID {
+	return s.WebhookID
+}
+
+// GetEventID returns the value of EventID.
+func (s *WebhookDeliveryResponse) GetEventID() uuid.UUID {
+	return s.EventID
+}
+
+// GetEventType returns the value of EventType.
+func (s *WebhookDeliveryResponse) GetEventType() WebhookEventType {
+	return s.EventType
+}
+
+// GetStatus returns the value of Status.
+func (s *WebhookDeliveryResponse) GetStatus() WebhookDeliveryStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDeliveryResponse) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetLastStatusCode returns the value of LastStatusCode.
+func (s *WebhookDeliveryResponse) GetLastStatusCode() int {
+	return s.LastStatusCode
+}
+
+// GetLastError returns the value of LastError.
+func (s *WebhookDeliveryResponse) GetLastError() string {
+	return s.LastError
+}
+
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *WebhookDeliveryResponse) GetNextAttemptAt() time.Time {
+	return s.NextAttemptAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDeliveryResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *WebhookDeliveryResponse) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *WebhookDeliveryResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetWebhookID sets the value of WebhookID.
+func (s *WebhookDeliveryResponse) SetWebhookID(val uuid.UUID) {
+	s.WebhookID = val
+}
+
+// SetEventID sets the value of EventID.
+func (s *WebhookDeliveryResponse) SetEventID(val uuid.UUID) {
+	s.EventID = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *WebhookDeliveryResponse) SetEventType(val WebhookEventType) {
+	s.EventType = val
+}
+
+// SetStatus sets the value of Status.
+func (s *WebhookDeliveryResponse) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDeliveryResponse) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetLastStatusCode sets the value of LastStatusCode.
+func (s *WebhookDeliveryResponse) SetLastStatusCode(val int) {
+	s.LastStatusCode = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *WebhookDeliveryResponse) SetLastError(val string) {
+	s.LastError = val
+}
+
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *WebhookDeliveryResponse) SetNextAttemptAt(val time.Time) {
+	s.NextAttemptAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDeliveryResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *WebhookDeliveryResponse) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/components/schemas/WebhookDeliveryStatus
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusSucceeded,
+		WebhookDeliveryStatusDead,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPending:
+		return []byte(s), nil
+	case WebhookDeliveryStatusSucceeded:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPending:
+		*s = WebhookDeliveryStatusPending
+		return nil
+	case WebhookDeliveryStatusSucceeded:
+		*s = WebhookDeliveryStatusSucceeded
+		return nil
+	case WebhookDeliveryStatusDead:
+		*s = WebhookDeliveryStatusDead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/WebhookEventType
+type WebhookEventType string
+
+const (
+	WebhookEventTypeUserRegistered   WebhookEventType = "user.registered"
+	WebhookEventTypeUserDeactivated  WebhookEventType = "user.deactivated"
+	WebhookEventTypeUserEmailChanged WebhookEventType = "user.email_changed"
+	WebhookEventTypeUserDeleted      WebhookEventType = "user.deleted"
+	WebhookEventTypeUserLoginFailed  WebhookEventType = "user.login_failed"
+	WebhookEventTypeSessionRevoked   WebhookEventType = "session.revoked"
+)
+
+// AllValues returns all WebhookEventType values.
+func (WebhookEventType) AllValues() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventTypeUserRegistered,
+		WebhookEventTypeUserDeactivated,
+		WebhookEventTypeUserEmailChanged,
+		WebhookEventTypeUserDeleted,
+		WebhookEventTypeUserLoginFailed,
+		WebhookEventTypeSessionRevoked,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookEventTypeUserRegistered:
+		return []byte(s), nil
+	case WebhookEventTypeUserDeactivated:
+		return []byte(s), nil
+	case WebhookEventTypeUserEmailChanged:
+		return []byte(s), nil
+	case WebhookEventTypeUserDeleted:
+		return []byte(s), nil
+	case WebhookEventTypeUserLoginFailed:
+		return []byte(s), nil
+	case WebhookEventTypeSessionRevoked:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookEventType) UnmarshalText(data []byte) error {
+	switch WebhookEventType(data) {
+	case WebhookEventTypeUserRegistered:
+		*s = WebhookEventTypeUserRegistered
+		return nil
+	case WebhookEventTypeUserDeactivated:
+		*s = WebhookEventTypeUserDeactivated
+		return nil
+	case WebhookEventTypeUserEmailChanged:
+		*s = WebhookEventTypeUserEmailChanged
+		return nil
+	case WebhookEventTypeUserDeleted:
+		*s = WebhookEventTypeUserDeleted
+		return nil
+	case WebhookEventTypeUserLoginFailed:
+		*s = WebhookEventTypeUserLoginFailed
+		return nil
+	case WebhookEventTypeSessionRevoked:
+		*s = WebhookEventTypeSessionRevoked
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type WebhookList []Webhook
+
+func (*WebhookList) aPIV1AdminWebhooksGetRes() {}
@@ -13,7 +13,13 @@ import (
 
 // SecurityHandler is handler for security parameters.
 type SecurityHandler interface {
+	// HandleApiKeyAuth handles ApiKeyAuth security.
+	HandleApiKeyAuth(ctx context.Context, operationName OperationName, t ApiKeyAuth) (context.Context, error)
 	// HandleBearerAuth handles BearerAuth security.
+	// Access tokens bound to a key with DPoP (RFC 9449) are sent with the DPoP scheme instead, along
+	// with a DPoP header holding a proof of the key. Access tokens issued over a connection with a TLS
+	// client certificate (RFC 8705), and the refresh tokens issued with them, are only accepted over a
+	// connection with the same certificate.
 	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
 }
 
@@ -32,8 +38,60 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 	return "", false
 }
 
+var operationRolesApiKeyAuth = map[string][]string{
+	APIV1AuthMeEventsGetOperation: []string{
+		"audit:read",
+	},
+	APIV1AuthMeGetOperation: []string{
+		"user:read",
+	},
+}
+
+func (s *Server) securityApiKeyAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t ApiKeyAuth
+	const parameterName = "X-API-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesApiKeyAuth[operationName]
+	rctx, err := s.sec.HandleApiKeyAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
 var operationRolesBearerAuth = map[string][]string{
-	APIV1AuthMeGetOperation: []string{},
+	APIV1AdminAuthEventsGetOperation: []string{
+		"admin",
+	},
+	APIV1AdminWebhooksGetOperation: []string{
+		"admin",
+	},
+	APIV1AdminWebhooksPostOperation: []string{
+		"admin",
+	},
+	APIV1AdminWebhooksWebhookIDDeleteOperation: []string{
+		"admin",
+	},
+	APIV1AdminWebhooksWebhookIDDeliveriesGetOperation: []string{
+		"admin",
+	},
+	APIV1AuthAPIKeysGetOperation:         []string{},
+	APIV1AuthAPIKeysKeyIDDeleteOperation: []string{},
+	APIV1AuthAPIKeysPostOperation:        []string{},
+	APIV1AuthDeviceGetOperation:          []string{},
+	APIV1AuthDevicePostOperation:         []string{},
+	APIV1AuthEmailPostOperation:          []string{},
+	APIV1AuthLogoutPostOperation:         []string{},
+	APIV1AuthMeDeleteOperation:           []string{},
+	APIV1AuthMeEventsGetOperation:        []string{},
+	APIV1AuthMeExportGetOperation:        []string{},
+	APIV1AuthMeGetOperation:              []string{},
 }
 
 func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
@@ -55,10 +113,24 @@ func (s *Server) securityBearerAuth(ctx context.Context, operationName Operation
 
 // SecuritySource is provider of security values (tokens, passwords, etc.).
 type SecuritySource interface {
+	// ApiKeyAuth provides ApiKeyAuth security value.
+	ApiKeyAuth(ctx context.Context, operationName OperationName) (ApiKeyAuth, error)
 	// BearerAuth provides BearerAuth security value.
+	// Access tokens bound to a key with DPoP (RFC 9449) are sent with the DPoP scheme instead, along
+	// with a DPoP header holding a proof of the key. Access tokens issued over a connection with a TLS
+	// client certificate (RFC 8705), and the refresh tokens issued with them, are only accepted over a
+	// connection with the same certificate.
 	BearerAuth(ctx context.Context, operationName OperationName) (BearerAuth, error)
 }
 
+func (s *Client) securityApiKeyAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.ApiKeyAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"ApiKeyAuth\"")
+	}
+	req.Header.Set("X-API-Key", t.APIKey)
+	return nil
+}
 func (s *Client) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
 	t, err := s.sec.BearerAuth(ctx, operationName)
 	if err != nil {
@@ -8,6 +8,87 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// APIV1AdminAuthEventsGet implements GET /api/v1/admin/auth-events operation.
+	//
+	// Returns security audit events of all users filtered by the given parameters, newest first.
+	//
+	// GET /api/v1/admin/auth-events
+	APIV1AdminAuthEventsGet(ctx context.Context, params APIV1AdminAuthEventsGetParams) (APIV1AdminAuthEventsGetRes, error)
+	// APIV1AdminWebhooksGet implements GET /api/v1/admin/webhooks operation.
+	//
+	// Returns all webhook subscriptions without their signing secrets.
+	//
+	// GET /api/v1/admin/webhooks
+	APIV1AdminWebhooksGet(ctx context.Context) (APIV1AdminWebhooksGetRes, error)
+	// APIV1AdminWebhooksPost implements POST /api/v1/admin/webhooks operation.
+	//
+	// Subscribes an HTTP endpoint to auth events. The signing secret is returned only once.
+	//
+	// POST /api/v1/admin/webhooks
+	APIV1AdminWebhooksPost(ctx context.Context, req *CreateWebhookRequest) (APIV1AdminWebhooksPostRes, error)
+	// APIV1AdminWebhooksWebhookIDDelete implements DELETE /api/v1/admin/webhooks/{webhook_id} operation.
+	//
+	// Deletes a webhook subscription together with its delivery log.
+	//
+	// DELETE /api/v1/admin/webhooks/{webhook_id}
+	APIV1AdminWebhooksWebhookIDDelete(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeleteParams) (APIV1AdminWebhooksWebhookIDDeleteRes, error)
+	// APIV1AdminWebhooksWebhookIDDeliveriesGet implements GET /api/v1/admin/webhooks/{webhook_id}/deliveries operation.
+	//
+	// Returns deliveries of a webhook subscription, newest first.
+	//
+	// GET /api/v1/admin/webhooks/{webhook_id}/deliveries
+	APIV1AdminWebhooksWebhookIDDeliveriesGet(ctx context.Context, params APIV1AdminWebhooksWebhookIDDeliveriesGetParams) (APIV1AdminWebhooksWebhookIDDeliveriesGetRes, error)
+	// APIV1AuthAPIKeysGet implements GET /api/v1/auth/api-keys operation.
+	//
+	// Returns all API keys of an authorized user without their secret values.
+	//
+	// GET /api/v1/auth/api-keys
+	APIV1AuthAPIKeysGet(ctx context.Context) (APIV1AuthAPIKeysGetRes, error)
+	// APIV1AuthAPIKeysKeyIDDelete implements DELETE /api/v1/auth/api-keys/{key_id} operation.
+	//
+	// Deletes an API key of an authorized user.
+	//
+	// DELETE /api/v1/auth/api-keys/{key_id}
+	APIV1AuthAPIKeysKeyIDDelete(ctx context.Context, params APIV1AuthAPIKeysKeyIDDeleteParams) (APIV1AuthAPIKeysKeyIDDeleteRes, error)
+	// APIV1AuthAPIKeysPost implements POST /api/v1/auth/api-keys operation.
+	//
+	// Creates a new long-lived API key for an authorized user. The key is returned only once.
+	//
+	// POST /api/v1/auth/api-keys
+	APIV1AuthAPIKeysPost(ctx context.Context, req *CreateAPIKeyRequest) (APIV1AuthAPIKeysPostRes, error)
+	// APIV1AuthDeviceGet implements GET /api/v1/auth/device operation.
+	//
+	// Returns the client of a pending device authorization, so that the user can check it before
+	// approving the user code shown on the device.
+	//
+	// GET /api/v1/auth/device
+	APIV1AuthDeviceGet(ctx context.Context, params APIV1AuthDeviceGetParams) (APIV1AuthDeviceGetRes, error)
+	// APIV1AuthDevicePost implements POST /api/v1/auth/device operation.
+	//
+	// Approves or denies the device authorization of a user code for the authorized user. An approved
+	// device gets tokens for the user on its next poll.
+	//
+	// POST /api/v1/auth/device
+	APIV1AuthDevicePost(ctx context.Context, req *DeviceVerifyRequest) (APIV1AuthDevicePostRes, error)
+	// APIV1AuthEmailCancelPost implements POST /api/v1/auth/email/cancel operation.
+	//
+	// Cancels a pending email change with the token sent to the current address.
+	//
+	// POST /api/v1/auth/email/cancel
+	APIV1AuthEmailCancelPost(ctx context.Context, req *EmailChangeTokenRequest) (APIV1AuthEmailCancelPostRes, error)
+	// APIV1AuthEmailConfirmPost implements POST /api/v1/auth/email/confirm operation.
+	//
+	// Swaps the email of the user with the new address the token was sent to. A token can be used once.
+	//
+	// POST /api/v1/auth/email/confirm
+	APIV1AuthEmailConfirmPost(ctx context.Context, req *EmailChangeTokenRequest) (APIV1AuthEmailConfirmPostRes, error)
+	// APIV1AuthEmailPost implements POST /api/v1/auth/email operation.
+	//
+	// Requests a change of the email of an authorized user. A confirm link is sent to the new address
+	// and a cancel link to the current one; the email changes only once the change is confirmed.
+	//
+	// POST /api/v1/auth/email
+	APIV1AuthEmailPost(ctx context.Context, req *ChangeEmailRequest) (APIV1AuthEmailPostRes, error)
 	// APIV1AuthLoginPost implements POST /api/v1/auth/login operation.
 	//
 	// Creates a new tokens for user to access secure endpoints.
@@ -16,16 +97,66 @@ type Handler interface {
 	APIV1AuthLoginPost(ctx context.Context, req *LoginRequest) (APIV1AuthLoginPostRes, error)
 	// APIV1AuthLogoutPost implements POST /api/v1/auth/logout operation.
 	//
-	// Allows to logout user by refresh token.
+	// Allows to logout user by refresh token. The access token, when sent, is revoked as well.
 	//
 	// POST /api/v1/auth/logout
 	APIV1AuthLogoutPost(ctx context.Context, params APIV1AuthLogoutPostParams) (APIV1AuthLogoutPostRes, error)
+	// APIV1AuthMagicLinkConsumePost implements POST /api/v1/auth/magic-link/consume operation.
+	//
+	// Exchanges the token of a magic link for new tokens like a login. A link can be used once.
+	//
+	// POST /api/v1/auth/magic-link/consume
+	APIV1AuthMagicLinkConsumePost(ctx context.Context, req *MagicLinkConsumeRequest, params APIV1AuthMagicLinkConsumePostParams) (APIV1AuthMagicLinkConsumePostRes, error)
+	// APIV1AuthMagicLinkPost implements POST /api/v1/auth/magic-link operation.
+	//
+	// Emails a single-use login link to the user. The response is the same whether the email is
+	// registered or not. If links are bound to the browser, a cookie is set that has to be sent along
+	// when the link is consumed.
+	//
+	// POST /api/v1/auth/magic-link
+	APIV1AuthMagicLinkPost(ctx context.Context, req *MagicLinkRequest) (APIV1AuthMagicLinkPostRes, error)
+	// APIV1AuthMeDelete implements DELETE /api/v1/auth/me operation.
+	//
+	// Deletes the account of an authorized user after the password is confirmed. The account is
+	// deactivated and signed out everywhere at once and deleted for good, with everything stored about
+	// it, after the configured grace period.
+	//
+	// DELETE /api/v1/auth/me
+	APIV1AuthMeDelete(ctx context.Context, req *DeleteAccountRequest) (APIV1AuthMeDeleteRes, error)
+	// APIV1AuthMeEventsGet implements GET /api/v1/auth/me/events operation.
+	//
+	// Returns security audit events of an authorized user, newest first.
+	//
+	// GET /api/v1/auth/me/events
+	APIV1AuthMeEventsGet(ctx context.Context, params APIV1AuthMeEventsGetParams) (APIV1AuthMeEventsGetRes, error)
+	// APIV1AuthMeExportGet implements GET /api/v1/auth/me/export operation.
+	//
+	// Returns everything stored about an authorized user: profile, active sessions, API keys, custom
+	// claim attributes and authentication events.
+	//
+	// GET /api/v1/auth/me/export
+	APIV1AuthMeExportGet(ctx context.Context) (APIV1AuthMeExportGetRes, error)
 	// APIV1AuthMeGet implements GET /api/v1/auth/me operation.
 	//
 	// Allows to obtaining information about an authorized user by access token.
 	//
 	// GET /api/v1/auth/me
 	APIV1AuthMeGet(ctx context.Context) (APIV1AuthMeGetRes, error)
+	// APIV1AuthOtpPost implements POST /api/v1/auth/otp operation.
+	//
+	// Sends a numeric login code to the user by email or SMS. SMS codes go to the phone number attribute
+	// of the user. Only a few codes are sent to a user per hour, and a new code is not sent sooner than
+	// the resend interval. The response is the same whether the email is registered or not.
+	//
+	// POST /api/v1/auth/otp
+	APIV1AuthOtpPost(ctx context.Context, req *OTPRequest) (APIV1AuthOtpPostRes, error)
+	// APIV1AuthOtpVerifyPost implements POST /api/v1/auth/otp/verify operation.
+	//
+	// Exchanges a one-time code for new tokens like a login. A code can be used once and is discarded
+	// after too many wrong tries.
+	//
+	// POST /api/v1/auth/otp/verify
+	APIV1AuthOtpVerifyPost(ctx context.Context, req *OTPVerifyRequest) (APIV1AuthOtpVerifyPostRes, error)
 	// APIV1AuthRefreshPost implements POST /api/v1/auth/refresh operation.
 	//
 	// Invalidates the previous refresh token and generates new access + refersh token.
@@ -38,6 +169,27 @@ type Handler interface {
 	//
 	// POST /api/v1/auth/register
 	APIV1AuthRegisterPost(ctx context.Context, req *RegisterRequest) (APIV1AuthRegisterPostRes, error)
+	// APIV1OAuthDeviceAuthorizationPost implements POST /api/v1/oauth/device_authorization operation.
+	//
+	// Starts the device authorization grant (RFC 8628) for a client without a browser. The client shows
+	// the user code and verification URI to the user and polls the token endpoint with the device code.
+	// Clients registered with a secret or a TLS client certificate (RFC 8705) fail with invalid_client
+	// unless the request carries it.
+	//
+	// POST /api/v1/oauth/device_authorization
+	APIV1OAuthDeviceAuthorizationPost(ctx context.Context, req *DeviceAuthorizationRequest) (APIV1OAuthDeviceAuthorizationPostRes, error)
+	// APIV1OAuthTokenPost implements POST /api/v1/oauth/token operation.
+	//
+	// Issues tokens for the device code grant (urn:ietf:params:oauth:grant-type:device_code) and the
+	// token exchange grant (urn:ietf:params:oauth:grant-type:token-exchange). Until the user decided,
+	// device code polls fail with authorization_pending, or slow_down when the client polls more often
+	// than the interval allows. A token exchange trades an access token for one to the requested
+	// audiences, limited to the audiences and scopes the client may ask for; only clients authenticated
+	// with a secret or a TLS client certificate may exchange tokens. Clients registered with a secret or
+	// a TLS client certificate (RFC 8705) fail with invalid_client unless the request carries it.
+	//
+	// POST /api/v1/oauth/token
+	APIV1OAuthTokenPost(ctx context.Context, req *TokenRequest) (APIV1OAuthTokenPostRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -0,0 +1,128 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: otp.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const attemptOTP = `-- name: AttemptOTP :one
+UPDATE otp_codes
+SET attempts = attempts + 1
+WHERE user_id = $1
+RETURNING user_id, channel, code_hash, attempts, remember_me, expires_at, created_at
+`
+
+func (q *Queries) AttemptOTP(ctx context.Context, userID uuid.UUID) (OtpCode, error) {
+	row := q.db.QueryRowContext(ctx, attemptOTP, userID)
+	var i OtpCode
+	err := row.Scan(
+		&i.UserID,
+		&i.Channel,
+		&i.CodeHash,
+		&i.Attempts,
+		&i.RememberMe,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const countOTPRequest = `-- name: CountOTPRequest :one
+INSERT INTO otp_requests (user_id, requests, window_started_at, window_ends_at)
+VALUES ($1, 1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET requests = CASE WHEN otp_requests.window_ends_at > EXCLUDED.window_started_at THEN otp_requests.requests + 1 ELSE 1 END,
+    window_started_at = CASE WHEN otp_requests.window_ends_at > EXCLUDED.window_started_at THEN otp_requests.window_started_at ELSE EXCLUDED.window_started_at END,
+    window_ends_at = CASE WHEN otp_requests.window_ends_at > EXCLUDED.window_started_at THEN otp_requests.window_ends_at ELSE EXCLUDED.window_ends_at END
+RETURNING requests
+`
+
+type CountOTPRequestParams struct {
+	UserID          uuid.UUID
+	WindowStartedAt time.Time
+	WindowEndsAt    time.Time
+}
+
+func (q *Queries) CountOTPRequest(ctx context.Context, arg CountOTPRequestParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countOTPRequest, arg.UserID, arg.WindowStartedAt, arg.WindowEndsAt)
+	var requests int32
+	err := row.Scan(&requests)
+	return requests, err
+}
+
+const deleteOTP = `-- name: DeleteOTP :execrows
+DELETE FROM otp_codes
+WHERE user_id = $1 AND code_hash = $2
+`
+
+type DeleteOTPParams struct {
+	UserID   uuid.UUID
+	CodeHash string
+}
+
+func (q *Queries) DeleteOTP(ctx context.Context, arg DeleteOTPParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOTP, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getOTP = `-- name: GetOTP :one
+SELECT user_id, channel, code_hash, attempts, remember_me, expires_at, created_at
+FROM otp_codes
+WHERE user_id = $1
+`
+
+func (q *Queries) GetOTP(ctx context.Context, userID uuid.UUID) (OtpCode, error) {
+	row := q.db.QueryRowContext(ctx, getOTP, userID)
+	var i OtpCode
+	err := row.Scan(
+		&i.UserID,
+		&i.Channel,
+		&i.CodeHash,
+		&i.Attempts,
+		&i.RememberMe,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const saveOTP = `-- name: SaveOTP :exec
+INSERT INTO otp_codes (user_id, channel, code_hash, remember_me, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET channel = EXCLUDED.channel,
+    code_hash = EXCLUDED.code_hash,
+    attempts = 0,
+    remember_me = EXCLUDED.remember_me,
+    expires_at = EXCLUDED.expires_at,
+    created_at = NOW()
+`
+
+type SaveOTPParams struct {
+	UserID     uuid.UUID
+	Channel    string
+	CodeHash   string
+	RememberMe bool
+	ExpiresAt  time.Time
+}
+
+func (q *Queries) SaveOTP(ctx context.Context, arg SaveOTPParams) error {
+	_, err := q.db.ExecContext(ctx, saveOTP,
+		arg.UserID,
+		arg.Channel,
+		arg.CodeHash,
+		arg.RememberMe,
+		arg.ExpiresAt,
+	)
+	return err
+}
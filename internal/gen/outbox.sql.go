@@ -0,0 +1,146 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: outbox.sql
+
+package gen
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET next_attempt_at = $1
+WHERE id IN (
+    SELECT e.id
+    FROM outbox_events e
+    WHERE e.published_at IS NULL
+      AND e.next_attempt_at <= NOW()
+      AND e.attempts < $2
+    ORDER BY e.created_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, aggregate_id, event_type, payload, created_at, attempts
+`
+
+type ClaimOutboxEventsParams struct {
+	LeaseUntil  time.Time
+	MaxAttempts int32
+	BatchSize   int32
+}
+
+type ClaimOutboxEventsRow struct {
+	ID          uuid.UUID
+	AggregateID uuid.UUID
+	EventType   string
+	Payload     json.RawMessage
+	CreatedAt   time.Time
+	Attempts    int32
+}
+
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]ClaimOutboxEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, claimOutboxEvents, arg.LeaseUntil, arg.MaxAttempts, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimOutboxEventsRow
+	for rows.Next() {
+		var i ClaimOutboxEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (aggregate_id, event_type, payload)
+VALUES ($1, $2, $3)
+`
+
+type CreateOutboxEventParams struct {
+	AggregateID uuid.UUID
+	EventType   string
+	Payload     json.RawMessage
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error {
+	_, err := q.db.ExecContext(ctx, createOutboxEvent, arg.AggregateID, arg.EventType, arg.Payload)
+	return err
+}
+
+const deleteOutboxEventsByAggregate = `-- name: DeleteOutboxEventsByAggregate :execrows
+DELETE FROM outbox_events
+WHERE aggregate_id = $1
+`
+
+func (q *Queries) DeleteOutboxEventsByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOutboxEventsByAggregate, aggregateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deletePublishedOutboxEventsBefore = `-- name: DeletePublishedOutboxEventsBefore :execrows
+DELETE FROM outbox_events
+WHERE published_at < $1
+`
+
+func (q *Queries) DeletePublishedOutboxEventsBefore(ctx context.Context, publishedAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePublishedOutboxEventsBefore, publishedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+WHERE id = $1
+`
+
+type MarkOutboxEventFailedParams struct {
+	ID            uuid.UUID
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+func (q *Queries) MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEventFailed, arg.ID, arg.NextAttemptAt, arg.LastError)
+	return err
+}
+
+const markOutboxEventPublished = `-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = NOW(), attempts = attempts + 1, last_error = ''
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEventPublished, id)
+	return err
+}
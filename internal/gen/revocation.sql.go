@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: revocation.sql
+
+package gen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteExpiredRevokedAccessTokens = `-- name: DeleteExpiredRevokedAccessTokens :execrows
+DELETE FROM revoked_access_tokens
+WHERE jti IN (
+    SELECT jti
+    FROM revoked_access_tokens
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+func (q *Queries) DeleteExpiredRevokedAccessTokens(ctx context.Context, limit int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredRevokedAccessTokens, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredUserAccessTokenRevocations = `-- name: DeleteExpiredUserAccessTokenRevocations :execrows
+DELETE FROM user_access_token_revocations
+WHERE user_id IN (
+    SELECT user_id
+    FROM user_access_token_revocations
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+func (q *Queries) DeleteExpiredUserAccessTokenRevocations(ctx context.Context, limit int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredUserAccessTokenRevocations, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const isAccessTokenRevoked = `-- name: IsAccessTokenRevoked :one
+SELECT EXISTS (
+    SELECT 1
+    FROM revoked_access_tokens r
+    WHERE r.jti = $1
+) OR EXISTS (
+    SELECT 1
+    FROM user_access_token_revocations u
+    WHERE u.user_id = $2 AND u.issued_before >= $3
+) AS revoked
+`
+
+type IsAccessTokenRevokedParams struct {
+	Jti      string
+	UserID   uuid.UUID
+	IssuedAt time.Time
+}
+
+func (q *Queries) IsAccessTokenRevoked(ctx context.Context, arg IsAccessTokenRevokedParams) (sql.NullBool, error) {
+	row := q.db.QueryRowContext(ctx, isAccessTokenRevoked, arg.Jti, arg.UserID, arg.IssuedAt)
+	var revoked sql.NullBool
+	err := row.Scan(&revoked)
+	return revoked, err
+}
+
+const revokeAccessToken = `-- name: RevokeAccessToken :exec
+INSERT INTO revoked_access_tokens (jti, user_id, expires_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (jti) DO NOTHING
+`
+
+type RevokeAccessTokenParams struct {
+	Jti       string
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+}
+
+func (q *Queries) RevokeAccessToken(ctx context.Context, arg RevokeAccessTokenParams) error {
+	_, err := q.db.ExecContext(ctx, revokeAccessToken, arg.Jti, arg.UserID, arg.ExpiresAt)
+	return err
+}
+
+const revokeUserAccessTokens = `-- name: RevokeUserAccessTokens :exec
+INSERT INTO user_access_token_revocations (user_id, issued_before, expires_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET issued_before = GREATEST(user_access_token_revocations.issued_before, EXCLUDED.issued_before),
+    expires_at = GREATEST(user_access_token_revocations.expires_at, EXCLUDED.expires_at)
+`
+
+type RevokeUserAccessTokensParams struct {
+	UserID       uuid.UUID
+	IssuedBefore time.Time
+	ExpiresAt    time.Time
+}
+
+func (q *Queries) RevokeUserAccessTokens(ctx context.Context, arg RevokeUserAccessTokensParams) error {
+	_, err := q.db.ExecContext(ctx, revokeUserAccessTokens, arg.UserID, arg.IssuedBefore, arg.ExpiresAt)
+	return err
+}
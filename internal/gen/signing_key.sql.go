@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: signing_key.sql
+
+package gen
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createSigningKey = `-- name: CreateSigningKey :one
+INSERT INTO signing_keys (kid, secret)
+VALUES ($1, $2)
+RETURNING kid, secret, created_at, retired_at
+`
+
+type CreateSigningKeyParams struct {
+	Kid    string
+	Secret []byte
+}
+
+func (q *Queries) CreateSigningKey(ctx context.Context, arg CreateSigningKeyParams) (SigningKey, error) {
+	row := q.db.QueryRowContext(ctx, createSigningKey, arg.Kid, arg.Secret)
+	var i SigningKey
+	err := row.Scan(
+		&i.Kid,
+		&i.Secret,
+		&i.CreatedAt,
+		&i.RetiredAt,
+	)
+	return i, err
+}
+
+const listSigningKeys = `-- name: ListSigningKeys :many
+SELECT kid, secret, created_at, retired_at
+FROM signing_keys
+WHERE retired_at IS NULL OR retired_at > now()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListSigningKeys(ctx context.Context) ([]SigningKey, error) {
+	rows, err := q.db.QueryContext(ctx, listSigningKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SigningKey
+	for rows.Next() {
+		var i SigningKey
+		if err := rows.Scan(
+			&i.Kid,
+			&i.Secret,
+			&i.CreatedAt,
+			&i.RetiredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retireSigningKeys = `-- name: RetireSigningKeys :execrows
+UPDATE signing_keys
+SET retired_at = $2
+WHERE retired_at IS NULL AND kid <> $1
+`
+
+type RetireSigningKeysParams struct {
+	Kid       string
+	RetiredAt sql.NullTime
+}
+
+func (q *Queries) RetireSigningKeys(ctx context.Context, arg RetireSigningKeysParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retireSigningKeys, arg.Kid, arg.RetiredAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,54 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: dpop.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"time"
+)
+
+const deleteExpiredDPoPProofs = `-- name: DeleteExpiredDPoPProofs :execrows
+DELETE FROM dpop_proofs
+WHERE proof_hash IN (
+    SELECT p.proof_hash
+    FROM dpop_proofs p
+    WHERE p.expires_at <= ?1
+    ORDER BY p.expires_at
+    LIMIT ?2
+)
+`
+
+type DeleteExpiredDPoPProofsParams struct {
+	Now   time.Time
+	Limit int64
+}
+
+func (q *Queries) DeleteExpiredDPoPProofs(ctx context.Context, arg DeleteExpiredDPoPProofsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredDPoPProofs, arg.Now, arg.Limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const saveDPoPProof = `-- name: SaveDPoPProof :execrows
+INSERT INTO dpop_proofs (proof_hash, expires_at)
+VALUES (?, ?)
+ON CONFLICT (proof_hash) DO NOTHING
+`
+
+type SaveDPoPProofParams struct {
+	ProofHash string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) SaveDPoPProof(ctx context.Context, arg SaveDPoPProofParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, saveDPoPProof, arg.ProofHash, arg.ExpiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: email_change.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const cancelEmailChange = `-- name: CancelEmailChange :one
+DELETE FROM email_changes
+WHERE cancel_token_hash = ?
+RETURNING user_id, new_email, expires_at, created_at
+`
+
+type CancelEmailChangeRow struct {
+	UserID    uuid.UUID
+	NewEmail  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (q *Queries) CancelEmailChange(ctx context.Context, cancelTokenHash string) (CancelEmailChangeRow, error) {
+	row := q.db.QueryRowContext(ctx, cancelEmailChange, cancelTokenHash)
+	var i CancelEmailChangeRow
+	err := row.Scan(
+		&i.UserID,
+		&i.NewEmail,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const consumeEmailChange = `-- name: ConsumeEmailChange :one
+DELETE FROM email_changes
+WHERE confirm_token_hash = ?
+RETURNING user_id, new_email, expires_at, created_at
+`
+
+type ConsumeEmailChangeRow struct {
+	UserID    uuid.UUID
+	NewEmail  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (q *Queries) ConsumeEmailChange(ctx context.Context, confirmTokenHash string) (ConsumeEmailChangeRow, error) {
+	row := q.db.QueryRowContext(ctx, consumeEmailChange, confirmTokenHash)
+	var i ConsumeEmailChangeRow
+	err := row.Scan(
+		&i.UserID,
+		&i.NewEmail,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const saveEmailChange = `-- name: SaveEmailChange :exec
+INSERT INTO email_changes (user_id, new_email, confirm_token_hash, cancel_token_hash, expires_at, created_at)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6)
+ON CONFLICT (user_id) DO UPDATE
+SET new_email = excluded.new_email,
+    confirm_token_hash = excluded.confirm_token_hash,
+    cancel_token_hash = excluded.cancel_token_hash,
+    expires_at = excluded.expires_at,
+    created_at = excluded.created_at
+`
+
+type SaveEmailChangeParams struct {
+	UserID           uuid.UUID
+	NewEmail         string
+	ConfirmTokenHash string
+	CancelTokenHash  string
+	ExpiresAt        time.Time
+	CreatedAt        time.Time
+}
+
+func (q *Queries) SaveEmailChange(ctx context.Context, arg SaveEmailChangeParams) error {
+	_, err := q.db.ExecContext(ctx, saveEmailChange,
+		arg.UserID,
+		arg.NewEmail,
+		arg.ConfirmTokenHash,
+		arg.CancelTokenHash,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
@@ -0,0 +1,163 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: outbox.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET next_attempt_at = ?1
+WHERE id IN (
+    SELECT e.id
+    FROM outbox_events e
+    WHERE e.published_at IS NULL
+      AND e.next_attempt_at <= ?2
+      AND e.attempts < ?3
+    ORDER BY e.created_at
+    LIMIT ?4
+)
+RETURNING id, aggregate_id, event_type, payload, created_at, attempts
+`
+
+type ClaimOutboxEventsParams struct {
+	LeaseUntil  time.Time
+	Now         time.Time
+	MaxAttempts int64
+	BatchSize   int64
+}
+
+type ClaimOutboxEventsRow struct {
+	ID          uuid.UUID
+	AggregateID uuid.UUID
+	EventType   string
+	Payload     []byte
+	CreatedAt   time.Time
+	Attempts    int64
+}
+
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]ClaimOutboxEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, claimOutboxEvents,
+		arg.LeaseUntil,
+		arg.Now,
+		arg.MaxAttempts,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimOutboxEventsRow
+	for rows.Next() {
+		var i ClaimOutboxEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (id, aggregate_id, event_type, payload, created_at, next_attempt_at)
+VALUES (?, ?, ?, ?, ?5, ?5)
+`
+
+type CreateOutboxEventParams struct {
+	ID          uuid.UUID
+	AggregateID uuid.UUID
+	EventType   string
+	Payload     []byte
+	Now         time.Time
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error {
+	_, err := q.db.ExecContext(ctx, createOutboxEvent,
+		arg.ID,
+		arg.AggregateID,
+		arg.EventType,
+		arg.Payload,
+		arg.Now,
+	)
+	return err
+}
+
+const deleteOutboxEventsByAggregate = `-- name: DeleteOutboxEventsByAggregate :execrows
+DELETE FROM outbox_events
+WHERE aggregate_id = ?
+`
+
+func (q *Queries) DeleteOutboxEventsByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOutboxEventsByAggregate, aggregateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deletePublishedOutboxEventsBefore = `-- name: DeletePublishedOutboxEventsBefore :execrows
+DELETE FROM outbox_events
+WHERE published_at < ?1
+`
+
+func (q *Queries) DeletePublishedOutboxEventsBefore(ctx context.Context, before sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePublishedOutboxEventsBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET attempts = attempts + 1, next_attempt_at = ?, last_error = ?
+WHERE id = ?
+`
+
+type MarkOutboxEventFailedParams struct {
+	NextAttemptAt time.Time
+	LastError     string
+	ID            uuid.UUID
+}
+
+func (q *Queries) MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEventFailed, arg.NextAttemptAt, arg.LastError, arg.ID)
+	return err
+}
+
+const markOutboxEventPublished = `-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = ?1, attempts = attempts + 1, last_error = ''
+WHERE id = ?2
+`
+
+type MarkOutboxEventPublishedParams struct {
+	Now sql.NullTime
+	ID  uuid.UUID
+}
+
+func (q *Queries) MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEventPublished, arg.Now, arg.ID)
+	return err
+}
@@ -0,0 +1,129 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: revocation.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteExpiredRevokedAccessTokens = `-- name: DeleteExpiredRevokedAccessTokens :execrows
+DELETE FROM revoked_access_tokens
+WHERE jti IN (
+    SELECT r.jti
+    FROM revoked_access_tokens r
+    WHERE r.expires_at <= ?1
+    ORDER BY r.expires_at
+    LIMIT ?2
+)
+`
+
+type DeleteExpiredRevokedAccessTokensParams struct {
+	Now   time.Time
+	Limit int64
+}
+
+func (q *Queries) DeleteExpiredRevokedAccessTokens(ctx context.Context, arg DeleteExpiredRevokedAccessTokensParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredRevokedAccessTokens, arg.Now, arg.Limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredUserAccessTokenRevocations = `-- name: DeleteExpiredUserAccessTokenRevocations :execrows
+DELETE FROM user_access_token_revocations
+WHERE user_id IN (
+    SELECT r.user_id
+    FROM user_access_token_revocations r
+    WHERE r.expires_at <= ?1
+    ORDER BY r.expires_at
+    LIMIT ?2
+)
+`
+
+type DeleteExpiredUserAccessTokenRevocationsParams struct {
+	Now   time.Time
+	Limit int64
+}
+
+func (q *Queries) DeleteExpiredUserAccessTokenRevocations(ctx context.Context, arg DeleteExpiredUserAccessTokenRevocationsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredUserAccessTokenRevocations, arg.Now, arg.Limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const isAccessTokenRevoked = `-- name: IsAccessTokenRevoked :one
+SELECT EXISTS (
+    SELECT 1
+    FROM revoked_access_tokens r
+    WHERE r.jti = ?1
+) OR EXISTS (
+    SELECT 1
+    FROM user_access_token_revocations u
+    WHERE u.user_id = ?2 AND u.issued_before >= ?3
+) AS revoked
+`
+
+type IsAccessTokenRevokedParams struct {
+	Jti      string
+	UserID   uuid.UUID
+	IssuedAt time.Time
+}
+
+func (q *Queries) IsAccessTokenRevoked(ctx context.Context, arg IsAccessTokenRevokedParams) (sql.NullBool, error) {
+	row := q.db.QueryRowContext(ctx, isAccessTokenRevoked, arg.Jti, arg.UserID, arg.IssuedAt)
+	var revoked sql.NullBool
+	err := row.Scan(&revoked)
+	return revoked, err
+}
+
+const revokeAccessToken = `-- name: RevokeAccessToken :exec
+INSERT INTO revoked_access_tokens (jti, user_id, revoked_at, expires_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (jti) DO NOTHING
+`
+
+type RevokeAccessTokenParams struct {
+	Jti       string
+	UserID    uuid.UUID
+	RevokedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (q *Queries) RevokeAccessToken(ctx context.Context, arg RevokeAccessTokenParams) error {
+	_, err := q.db.ExecContext(ctx, revokeAccessToken,
+		arg.Jti,
+		arg.UserID,
+		arg.RevokedAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const revokeUserAccessTokens = `-- name: RevokeUserAccessTokens :exec
+INSERT INTO user_access_token_revocations (user_id, issued_before, expires_at)
+VALUES (?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE
+SET issued_before = MAX(user_access_token_revocations.issued_before, excluded.issued_before),
+    expires_at = MAX(user_access_token_revocations.expires_at, excluded.expires_at)
+`
+
+type RevokeUserAccessTokensParams struct {
+	UserID       uuid.UUID
+	IssuedBefore time.Time
+	ExpiresAt    time.Time
+}
+
+func (q *Queries) RevokeUserAccessTokens(ctx context.Context, arg RevokeUserAccessTokensParams) error {
+	_, err := q.db.ExecContext(ctx, revokeUserAccessTokens, arg.UserID, arg.IssuedBefore, arg.ExpiresAt)
+	return err
+}
@@ -0,0 +1,90 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: signing_key.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createSigningKey = `-- name: CreateSigningKey :one
+INSERT INTO signing_keys (kid, secret, created_at)
+VALUES (?, ?, ?)
+RETURNING kid, secret, created_at, retired_at
+`
+
+type CreateSigningKeyParams struct {
+	Kid       string
+	Secret    []byte
+	CreatedAt time.Time
+}
+
+func (q *Queries) CreateSigningKey(ctx context.Context, arg CreateSigningKeyParams) (SigningKey, error) {
+	row := q.db.QueryRowContext(ctx, createSigningKey, arg.Kid, arg.Secret, arg.CreatedAt)
+	var i SigningKey
+	err := row.Scan(
+		&i.Kid,
+		&i.Secret,
+		&i.CreatedAt,
+		&i.RetiredAt,
+	)
+	return i, err
+}
+
+const listSigningKeys = `-- name: ListSigningKeys :many
+SELECT kid, secret, created_at, retired_at
+FROM signing_keys
+WHERE retired_at IS NULL OR retired_at > ?1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListSigningKeys(ctx context.Context, now sql.NullTime) ([]SigningKey, error) {
+	rows, err := q.db.QueryContext(ctx, listSigningKeys, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SigningKey
+	for rows.Next() {
+		var i SigningKey
+		if err := rows.Scan(
+			&i.Kid,
+			&i.Secret,
+			&i.CreatedAt,
+			&i.RetiredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retireSigningKeys = `-- name: RetireSigningKeys :execrows
+UPDATE signing_keys
+SET retired_at = ?1
+WHERE retired_at IS NULL AND kid <> ?2
+`
+
+type RetireSigningKeysParams struct {
+	RetiredAt sql.NullTime
+	Kid       string
+}
+
+func (q *Queries) RetireSigningKeys(ctx context.Context, arg RetireSigningKeysParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retireSigningKeys, arg.RetiredAt, arg.Kid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
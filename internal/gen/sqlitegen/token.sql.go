@@ -0,0 +1,221 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: token.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countActiveRefreshTokens = `-- name: CountActiveRefreshTokens :one
+SELECT COUNT(*)
+FROM tokens
+WHERE expires_at > ?1
+`
+
+func (q *Queries) CountActiveRefreshTokens(ctx context.Context, now time.Time) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveRefreshTokens, now)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :execrows
+DELETE FROM tokens
+WHERE id IN (
+    SELECT t.id
+    FROM tokens t
+    WHERE t.expires_at <= ?1
+    ORDER BY t.expires_at
+    LIMIT ?2
+)
+`
+
+type DeleteExpiredRefreshTokensParams struct {
+	Now   time.Time
+	Limit int64
+}
+
+func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context, arg DeleteExpiredRefreshTokensParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredRefreshTokens, arg.Now, arg.Limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteRefreshToken = `-- name: DeleteRefreshToken :one
+DELETE FROM tokens
+WHERE refresh_token_hash = ?
+RETURNING user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
+`
+
+type DeleteRefreshTokenRow struct {
+	UserID           uuid.UUID
+	RefreshTokenHash string
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+	DpopJkt          string
+	ClientID         string
+	X5t              string
+}
+
+func (q *Queries) DeleteRefreshToken(ctx context.Context, refreshTokenHash string) (DeleteRefreshTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, deleteRefreshToken, refreshTokenHash)
+	var i DeleteRefreshTokenRow
+	err := row.Scan(
+		&i.UserID,
+		&i.RefreshTokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.SessionStartedAt,
+		&i.RememberMe,
+		&i.DpopJkt,
+		&i.ClientID,
+		&i.X5t,
+	)
+	return i, err
+}
+
+const deleteRefreshTokensByUser = `-- name: DeleteRefreshTokensByUser :execrows
+DELETE FROM tokens
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteRefreshTokensByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRefreshTokensByUser, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findRefreshToken = `-- name: FindRefreshToken :one
+SELECT id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
+FROM tokens
+WHERE refresh_token_hash = ?
+`
+
+func (q *Queries) FindRefreshToken(ctx context.Context, refreshTokenHash string) (Token, error) {
+	row := q.db.QueryRowContext(ctx, findRefreshToken, refreshTokenHash)
+	var i Token
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RefreshTokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.SessionStartedAt,
+		&i.RememberMe,
+		&i.DpopJkt,
+		&i.ClientID,
+		&i.X5t,
+	)
+	return i, err
+}
+
+const listRefreshTokensByUser = `-- name: ListRefreshTokensByUser :many
+SELECT id, user_id, created_at, expires_at, session_started_at, remember_me
+FROM tokens
+WHERE user_id = ?1 AND expires_at > ?2
+ORDER BY created_at DESC
+`
+
+type ListRefreshTokensByUserParams struct {
+	UserID uuid.UUID
+	Now    time.Time
+}
+
+type ListRefreshTokensByUserRow struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+}
+
+func (q *Queries) ListRefreshTokensByUser(ctx context.Context, arg ListRefreshTokensByUserParams) ([]ListRefreshTokensByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRefreshTokensByUser, arg.UserID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRefreshTokensByUserRow
+	for rows.Next() {
+		var i ListRefreshTokensByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.SessionStartedAt,
+			&i.RememberMe,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveHashedRefreshToken = `-- name: SaveHashedRefreshToken :one
+INSERT INTO tokens (id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
+`
+
+type SaveHashedRefreshTokenParams struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	RefreshTokenHash string
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+	DpopJkt          string
+	ClientID         string
+	X5t              string
+}
+
+func (q *Queries) SaveHashedRefreshToken(ctx context.Context, arg SaveHashedRefreshTokenParams) (Token, error) {
+	row := q.db.QueryRowContext(ctx, saveHashedRefreshToken,
+		arg.ID,
+		arg.UserID,
+		arg.RefreshTokenHash,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.SessionStartedAt,
+		arg.RememberMe,
+		arg.DpopJkt,
+		arg.ClientID,
+		arg.X5t,
+	)
+	var i Token
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RefreshTokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.SessionStartedAt,
+		&i.RememberMe,
+		&i.DpopJkt,
+		&i.ClientID,
+		&i.X5t,
+	)
+	return i, err
+}
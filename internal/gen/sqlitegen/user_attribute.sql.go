@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: user_attribute.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteUserAttribute = `-- name: DeleteUserAttribute :execrows
+DELETE FROM user_attributes
+WHERE user_id = ? AND name = ?
+`
+
+type DeleteUserAttributeParams struct {
+	UserID uuid.UUID
+	Name   string
+}
+
+func (q *Queries) DeleteUserAttribute(ctx context.Context, arg DeleteUserAttributeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserAttribute, arg.UserID, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listUserAttributes = `-- name: ListUserAttributes :many
+SELECT name, value, updated_at
+FROM user_attributes
+WHERE user_id = ?
+ORDER BY name
+`
+
+type ListUserAttributesRow struct {
+	Name      string
+	Value     []byte
+	UpdatedAt time.Time
+}
+
+func (q *Queries) ListUserAttributes(ctx context.Context, userID uuid.UUID) ([]ListUserAttributesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserAttributes, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserAttributesRow
+	for rows.Next() {
+		var i ListUserAttributesRow
+		if err := rows.Scan(&i.Name, &i.Value, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserAttribute = `-- name: SetUserAttribute :exec
+INSERT INTO user_attributes (user_id, name, value, updated_at)
+VALUES (?1, ?2, ?3, ?4)
+ON CONFLICT (user_id, name) DO UPDATE
+SET value = excluded.value, updated_at = excluded.updated_at
+`
+
+type SetUserAttributeParams struct {
+	UserID    uuid.UUID
+	Name      string
+	Value     []byte
+	UpdatedAt time.Time
+}
+
+func (q *Queries) SetUserAttribute(ctx context.Context, arg SetUserAttributeParams) error {
+	_, err := q.db.ExecContext(ctx, setUserAttribute,
+		arg.UserID,
+		arg.Name,
+		arg.Value,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,352 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: webhook.sql
+
+package sqlitegen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const claimWebhookDeliveries = `-- name: ClaimWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = ?1
+WHERE id IN (
+    SELECT c.id
+    FROM webhook_deliveries c
+    JOIN webhook_subscriptions s ON s.id = c.subscription_id
+    WHERE c.status = 'pending'
+      AND c.next_attempt_at <= ?2
+    ORDER BY c.next_attempt_at
+    LIMIT ?3
+)
+RETURNING id, subscription_id, event_id, event_type, payload, attempts, created_at,
+    (SELECT s.url FROM webhook_subscriptions s WHERE s.id = subscription_id) AS url,
+    (SELECT s.secret FROM webhook_subscriptions s WHERE s.id = subscription_id) AS secret
+`
+
+type ClaimWebhookDeliveriesParams struct {
+	LeaseUntil time.Time
+	Now        time.Time
+	BatchSize  int64
+}
+
+type ClaimWebhookDeliveriesRow struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        []byte
+	Attempts       int64
+	CreatedAt      time.Time
+	Url            string
+	Secret         string
+}
+
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]ClaimWebhookDeliveriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, claimWebhookDeliveries, arg.LeaseUntil, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.CreatedAt,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, next_attempt_at, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?6, ?6, ?6)
+ON CONFLICT (subscription_id, event_id) DO NOTHING
+`
+
+type CreateWebhookDeliveryParams struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        []byte
+	Now            time.Time
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, createWebhookDelivery,
+		arg.ID,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.Now,
+	)
+	return err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (id, url, secret, event_types, created_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, url, secret, event_types, created_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	ID         uuid.UUID
+	Url        string
+	Secret     string
+	EventTypes string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRowContext(ctx, createWebhookSubscription,
+		arg.ID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.CreatedAt,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteFinishedWebhookDeliveriesBefore = `-- name: DeleteFinishedWebhookDeliveriesBefore :execrows
+DELETE FROM webhook_deliveries
+WHERE status <> 'pending'
+  AND updated_at < ?1
+`
+
+func (q *Queries) DeleteFinishedWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteFinishedWebhookDeliveriesBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWebhookDeliveriesByAggregate = `-- name: DeleteWebhookDeliveriesByAggregate :execrows
+DELETE FROM webhook_deliveries
+WHERE event_id IN (SELECT id FROM outbox_events WHERE aggregate_id = ?)
+`
+
+func (q *Queries) DeleteWebhookDeliveriesByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWebhookDeliveriesByAggregate, aggregateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :one
+DELETE FROM webhook_subscriptions
+WHERE id = ?
+RETURNING id
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, deleteWebhookSubscription, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, created_at, updated_at
+FROM webhook_deliveries
+WHERE subscription_id = ?1
+  AND (?2 IS NULL OR status = ?2)
+ORDER BY created_at DESC
+LIMIT ?4 OFFSET ?3
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID uuid.UUID
+	Status         interface{}
+	Offset         int64
+	Limit          int64
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveries,
+		arg.SubscriptionID,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT id, url, secret, event_types, created_at
+FROM webhook_subscriptions
+ORDER BY created_at
+`
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookSubscriptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptionsByEvent = `-- name: ListWebhookSubscriptionsByEvent :many
+SELECT id, url, secret, event_types, created_at
+FROM webhook_subscriptions
+WHERE instr(event_types, json_quote(?1)) > 0
+ORDER BY created_at
+`
+
+func (q *Queries) ListWebhookSubscriptionsByEvent(ctx context.Context, eventType interface{}) ([]WebhookSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookSubscriptionsByEvent, eventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = ?1, attempts = attempts + 1, next_attempt_at = ?2, last_status_code = ?3, last_error = ?4, updated_at = ?5
+WHERE id = ?6
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	Status         string
+	NextAttemptAt  time.Time
+	LastStatusCode int64
+	LastError      string
+	Now            time.Time
+	ID             uuid.UUID
+}
+
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.ExecContext(ctx, markWebhookDeliveryFailed,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.Now,
+		arg.ID,
+	)
+	return err
+}
+
+const markWebhookDeliverySucceeded = `-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded', attempts = attempts + 1, last_status_code = ?1, last_error = '', updated_at = ?2
+WHERE id = ?3
+`
+
+type MarkWebhookDeliverySucceededParams struct {
+	LastStatusCode int64
+	Now            time.Time
+	ID             uuid.UUID
+}
+
+func (q *Queries) MarkWebhookDeliverySucceeded(ctx context.Context, arg MarkWebhookDeliverySucceededParams) error {
+	_, err := q.db.ExecContext(ctx, markWebhookDeliverySucceeded, arg.LastStatusCode, arg.Now, arg.ID)
+	return err
+}
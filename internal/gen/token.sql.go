@@ -12,27 +12,89 @@ import (
 	"github.com/google/uuid"
 )
 
+const countActiveRefreshTokens = `-- name: CountActiveRefreshTokens :one
+SELECT COUNT(*)
+FROM tokens
+WHERE expires_at > now()
+`
+
+func (q *Queries) CountActiveRefreshTokens(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveRefreshTokens)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :execrows
+DELETE FROM tokens
+WHERE id IN (
+    SELECT id
+    FROM tokens
+    WHERE expires_at <= now()
+    ORDER BY expires_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context, limit int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredRefreshTokens, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteRefreshToken = `-- name: DeleteRefreshToken :one
 DELETE FROM tokens
 WHERE refresh_token_hash = $1
-RETURNING user_id, refresh_token_hash, expires_at
+RETURNING user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
 `
 
 type DeleteRefreshTokenRow struct {
 	UserID           uuid.UUID
 	RefreshTokenHash string
+	CreatedAt        time.Time
 	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+	DpopJkt          string
+	ClientID         string
+	X5t              string
 }
 
 func (q *Queries) DeleteRefreshToken(ctx context.Context, refreshTokenHash string) (DeleteRefreshTokenRow, error) {
 	row := q.db.QueryRowContext(ctx, deleteRefreshToken, refreshTokenHash)
 	var i DeleteRefreshTokenRow
-	err := row.Scan(&i.UserID, &i.RefreshTokenHash, &i.ExpiresAt)
+	err := row.Scan(
+		&i.UserID,
+		&i.RefreshTokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.SessionStartedAt,
+		&i.RememberMe,
+		&i.DpopJkt,
+		&i.ClientID,
+		&i.X5t,
+	)
 	return i, err
 }
 
+const deleteRefreshTokensByUser = `-- name: DeleteRefreshTokensByUser :execrows
+DELETE FROM tokens
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRefreshTokensByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRefreshTokensByUser, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const findRefreshToken = `-- name: FindRefreshToken :one
-SELECT id, user_id, refresh_token_hash, created_at, expires_at
+SELECT id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
 FROM tokens
 WHERE refresh_token_hash = $1
 `
@@ -46,24 +108,89 @@ func (q *Queries) FindRefreshToken(ctx context.Context, refreshTokenHash string)
 		&i.RefreshTokenHash,
 		&i.CreatedAt,
 		&i.ExpiresAt,
+		&i.SessionStartedAt,
+		&i.RememberMe,
+		&i.DpopJkt,
+		&i.ClientID,
+		&i.X5t,
 	)
 	return i, err
 }
 
+const listRefreshTokensByUser = `-- name: ListRefreshTokensByUser :many
+SELECT id, user_id, created_at, expires_at, session_started_at, remember_me
+FROM tokens
+WHERE user_id = $1 AND expires_at > now()
+ORDER BY created_at DESC
+`
+
+type ListRefreshTokensByUserRow struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+}
+
+func (q *Queries) ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]ListRefreshTokensByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRefreshTokensByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRefreshTokensByUserRow
+	for rows.Next() {
+		var i ListRefreshTokensByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.SessionStartedAt,
+			&i.RememberMe,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const saveHashedRefreshToken = `-- name: SaveHashedRefreshToken :one
-INSERT INTO tokens (user_id, refresh_token_hash, expires_at)
-VALUES ($1, $2, $3)
-RETURNING id, user_id, refresh_token_hash, created_at, expires_at
+INSERT INTO tokens (user_id, refresh_token_hash, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, user_id, refresh_token_hash, created_at, expires_at, session_started_at, remember_me, dpop_jkt, client_id, x5t
 `
 
 type SaveHashedRefreshTokenParams struct {
 	UserID           uuid.UUID
 	RefreshTokenHash string
 	ExpiresAt        time.Time
+	SessionStartedAt time.Time
+	RememberMe       bool
+	DpopJkt          string
+	ClientID         string
+	X5t              string
 }
 
 func (q *Queries) SaveHashedRefreshToken(ctx context.Context, arg SaveHashedRefreshTokenParams) (Token, error) {
-	row := q.db.QueryRowContext(ctx, saveHashedRefreshToken, arg.UserID, arg.RefreshTokenHash, arg.ExpiresAt)
+	row := q.db.QueryRowContext(ctx, saveHashedRefreshToken,
+		arg.UserID,
+		arg.RefreshTokenHash,
+		arg.ExpiresAt,
+		arg.SessionStartedAt,
+		arg.RememberMe,
+		arg.DpopJkt,
+		arg.ClientID,
+		arg.X5t,
+	)
 	var i Token
 	err := row.Scan(
 		&i.ID,
@@ -71,6 +198,11 @@ func (q *Queries) SaveHashedRefreshToken(ctx context.Context, arg SaveHashedRefr
 		&i.RefreshTokenHash,
 		&i.CreatedAt,
 		&i.ExpiresAt,
+		&i.SessionStartedAt,
+		&i.RememberMe,
+		&i.DpopJkt,
+		&i.ClientID,
+		&i.X5t,
 	)
 	return i, err
 }
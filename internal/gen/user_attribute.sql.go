@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: user_attribute.sql
+
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteUserAttribute = `-- name: DeleteUserAttribute :execrows
+DELETE FROM user_attributes
+WHERE user_id = $1 AND name = $2
+`
+
+type DeleteUserAttributeParams struct {
+	UserID uuid.UUID
+	Name   string
+}
+
+func (q *Queries) DeleteUserAttribute(ctx context.Context, arg DeleteUserAttributeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserAttribute, arg.UserID, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listUserAttributes = `-- name: ListUserAttributes :many
+SELECT name, value, updated_at
+FROM user_attributes
+WHERE user_id = $1
+ORDER BY name
+`
+
+type ListUserAttributesRow struct {
+	Name      string
+	Value     json.RawMessage
+	UpdatedAt time.Time
+}
+
+func (q *Queries) ListUserAttributes(ctx context.Context, userID uuid.UUID) ([]ListUserAttributesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserAttributes, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserAttributesRow
+	for rows.Next() {
+		var i ListUserAttributesRow
+		if err := rows.Scan(&i.Name, &i.Value, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserAttribute = `-- name: SetUserAttribute :exec
+INSERT INTO user_attributes (user_id, name, value)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, name) DO UPDATE
+SET value = EXCLUDED.value, updated_at = NOW()
+`
+
+type SetUserAttributeParams struct {
+	UserID uuid.UUID
+	Name   string
+	Value  json.RawMessage
+}
+
+func (q *Queries) SetUserAttribute(ctx context.Context, arg SetUserAttributeParams) error {
+	_, err := q.db.ExecContext(ctx, setUserAttribute, arg.UserID, arg.Name, arg.Value)
+	return err
+}
@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "auth_service"
+
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+	// ResultSkipped marks a janitor run left to another replica holding the lock.
+	ResultSkipped = "skipped"
+
+	OperationHash    = "hash"
+	OperationCompare = "compare"
+
+	// UnknownOperation labels requests that did not match any route.
+	UnknownOperation = "unknown"
+)
+
+var (
+	Registry = prometheus.NewRegistry()
+
+	HTTPRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by ogen operation and status code.",
+	}, []string{"operation", "code"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by ogen operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	LoginsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "logins_total",
+		Help:      "Login attempts by result and failure reason.",
+	}, []string{"result", "reason"})
+
+	RefreshRotationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "refresh_rotations_total",
+		Help:      "Refresh token rotations by result and failure reason.",
+	}, []string{"result", "reason"})
+
+	ActiveRefreshTokens = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "active_refresh_tokens",
+		Help:      "Refresh tokens that have not expired yet.",
+	})
+
+	PasswordHashDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "bcrypt",
+		Name:      "duration_seconds",
+		Help:      "Time spent hashing and comparing passwords with bcrypt.",
+		Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2},
+	}, []string{"operation"})
+
+	ExpiredTokensPurgedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "janitor",
+		Name:      "expired_tokens_purged_total",
+		Help:      "Expired refresh tokens deleted by the token janitor.",
+	})
+
+	JanitorRunsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "janitor",
+		Name:      "runs_total",
+		Help:      "Token janitor runs by result.",
+	}, []string{"result"})
+
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Database latency by sqlc query name.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"query", "result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestsTotal,
+		HTTPRequestDuration,
+		LoginsTotal,
+		RefreshRotationsTotal,
+		ActiveRefreshTokens,
+		PasswordHashDuration,
+		ExpiredTokensPurgedTotal,
+		JanitorRunsTotal,
+		DBQueryDuration,
+	)
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
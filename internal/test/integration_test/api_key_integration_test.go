@@ -0,0 +1,100 @@
+package integrationtest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+
+	expiresAt := time.Now().UTC().Add(time.Hour * 24 * 30).Truncate(time.Microsecond)
+
+	k, err := q.CreateAPIKey(ctx, gen.CreateAPIKeyParams{
+		UserID:    u.UserID,
+		Name:      "ci-deploy",
+		KeyPrefix: "ak_12345678",
+		KeyHash:   "api-key-hash",
+		Scopes:    []string{"user:read"},
+		ExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, u.UserID, k.UserID)
+	assert.Equal(t, []string{"user:read"}, k.Scopes)
+	assert.False(t, k.LastUsedAt.Valid)
+
+	res, err := q.FindAPIKeyByHash(ctx, "api-key-hash")
+	assert.NoError(t, err)
+	assert.Equal(t, k.ID, res.ID)
+	assert.True(t, expiresAt.Equal(res.ExpiresAt.Time))
+
+	_, err = q.FindAPIKeyByHash(ctx, "not-exists-hash")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListAndTouchAPIKeys(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+
+	k := createAPIKeyHelper(t, q, u.UserID, "api-key-hash")
+	createAPIKeyHelper(t, q, u.UserID, "api-key-hash-2")
+
+	keys, err := q.ListAPIKeysByUser(ctx, u.UserID)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	err = q.TouchAPIKey(ctx, k.ID)
+	assert.NoError(t, err)
+
+	res, err := q.FindAPIKeyByHash(ctx, "api-key-hash")
+	assert.NoError(t, err)
+	assert.True(t, res.LastUsedAt.Valid)
+}
+
+func TestDeleteAPIKey(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+	other := createUserHelper(t, q, "other@example.org", "password-hash")
+
+	k := createAPIKeyHelper(t, q, u.UserID, "api-key-hash")
+
+	_, err = q.DeleteAPIKey(ctx, gen.DeleteAPIKeyParams{ID: k.ID, UserID: other.UserID})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	id, err := q.DeleteAPIKey(ctx, gen.DeleteAPIKeyParams{ID: k.ID, UserID: u.UserID})
+	assert.NoError(t, err)
+	assert.Equal(t, k.ID, id)
+
+	_, err = q.FindAPIKeyByHash(ctx, "api-key-hash")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,104 @@
+package integrationtest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func TestCreateAndListAuthEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+
+	err = q.CreateAuthEvent(ctx, gen.CreateAuthEventParams{
+		EventType: "login",
+		UserID:    uuid.NullUUID{UUID: u.UserID, Valid: true},
+		Ip:        "203.0.113.7",
+		UserAgent: "test-agent",
+		Outcome:   "success",
+		RequestID: "request-id",
+	})
+	assert.NoError(t, err)
+
+	err = q.CreateAuthEvent(ctx, gen.CreateAuthEventParams{
+		EventType: "login",
+		Outcome:   "failure",
+		Reason:    "user_not_found",
+	})
+	assert.NoError(t, err)
+
+	events, err := q.ListAuthEventsByUser(ctx, gen.ListAuthEventsByUserParams{
+		UserID: uuid.NullUUID{UUID: u.UserID, Valid: true},
+		Limit:  10,
+		Offset: 0,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "203.0.113.7", events[0].Ip)
+
+	failures, err := q.ListAuthEvents(ctx, gen.ListAuthEventsParams{
+		Outcome: sql.NullString{String: "failure", Valid: true},
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, failures, 1)
+	assert.False(t, failures[0].UserID.Valid)
+}
+
+func TestAuthEventsAreAppendOnly(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	err = q.CreateAuthEvent(ctx, gen.CreateAuthEventParams{
+		EventType: "logout",
+		Outcome:   "success",
+	})
+	assert.NoError(t, err)
+
+	_, err = tx.ExecContext(ctx, "UPDATE auth_events SET outcome = 'failure'")
+	assert.Error(t, err)
+}
+
+func TestDeleteAuthEventsBefore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	err = q.CreateAuthEvent(ctx, gen.CreateAuthEventParams{
+		EventType: "logout",
+		Outcome:   "success",
+	})
+	assert.NoError(t, err)
+
+	n, err := q.DeleteAuthEventsBefore(ctx, time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	n, err = q.DeleteAuthEventsBefore(ctx, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+}
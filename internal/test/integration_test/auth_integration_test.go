@@ -86,3 +86,30 @@ func TestFindUserByEmail(t *testing.T) {
 	assert.Equal(t, u.CreatedAt, res.CreatedAt)
 	assert.Equal(t, u.IsActive, res.IsActive)
 }
+
+func TestDeactivateUser(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+
+	n, err := q.UpdateUserPassword(ctx, gen.UpdateUserPasswordParams{UserID: u.UserID, PasswordHash: "new-password-hash"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	res, err := q.DeactivateUser(ctx, u.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, u.UserID, res.UserID)
+	assert.False(t, res.IsActive)
+
+	found, err := q.FindUserByEmail(ctx, u.Email)
+	assert.NoError(t, err)
+	assert.False(t, found.IsActive)
+	assert.Equal(t, "new-password-hash", found.PasswordHash)
+}
@@ -0,0 +1,27 @@
+package integrationtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/postgres"
+	"github.com/vo1dFl0w/auth-service/migrations"
+)
+
+func TestHealthSchemaVersion(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	repo := postgres.NewPostgresHealthRepo(TestDB)
+
+	assert.NoError(t, repo.Ping(ctx))
+
+	version, dirty, err := repo.SchemaVersion(ctx)
+	assert.NoError(t, err)
+	assert.False(t, dirty)
+	latest, err := migrations.Latest()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(latest), version)
+}
@@ -46,3 +46,20 @@ func saveHashedRefreshTokenHelper(t *testing.T, q *gen.Queries, userID uuid.UUID
 
 	return token
 }
+
+func createAPIKeyHelper(t *testing.T, q *gen.Queries, userID uuid.UUID, hash string) gen.CreateAPIKeyRow {
+	t.Helper()
+
+	k, err := q.CreateAPIKey(context.Background(), gen.CreateAPIKeyParams{
+		UserID:    userID,
+		Name:      "api-key",
+		KeyPrefix: "ak_12345678",
+		KeyHash:   hash,
+		Scopes:    []string{"user:read"},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, k)
+
+	return k
+}
@@ -0,0 +1,40 @@
+package integrationtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/postgres"
+)
+
+func TestTryWithLock(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	storage := postgres.New(TestDB)
+	key := int64(0x74657374)
+
+	var ran bool
+	acquired, err := storage.TryWithLock(ctx, key, func(ctx context.Context) error {
+		ran = true
+
+		// A second session, as on another replica, must not get the lock.
+		nestedAcquired, err := storage.TryWithLock(ctx, key, func(context.Context) error {
+			t.Error("lock acquired twice")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.False(t, nestedAcquired)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	assert.True(t, ran)
+
+	// Released after fn returned.
+	acquired, err = storage.TryWithLock(ctx, key, func(context.Context) error { return nil })
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+}
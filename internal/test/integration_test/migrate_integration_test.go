@@ -0,0 +1,58 @@
+package integrationtest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/postgres"
+	"github.com/vo1dFl0w/auth-service/migrations"
+)
+
+func TestMigratorUpIsSerializedAndIdempotent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	latest, err := migrations.Latest()
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			migrator, err := postgres.NewMigrator(ctx, TestDB)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer migrator.Close()
+
+			errs <- migrator.WithLock(ctx, migrator.Up)
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	migrator, err := postgres.NewMigrator(ctx, TestDB)
+	assert.NoError(t, err)
+	defer migrator.Close()
+
+	version, dirty, err := migrator.Version()
+	assert.NoError(t, err)
+	assert.False(t, dirty)
+	assert.Equal(t, latest, version)
+
+	// The lock is released after WithLock returns, so TestDB still serves queries.
+	assert.NoError(t, TestDB.PingContext(ctx))
+}
@@ -0,0 +1,47 @@
+package integrationtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func TestClaimAndMarkOutboxEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+
+	err = q.CreateOutboxEvent(ctx, gen.CreateOutboxEventParams{
+		AggregateID: u.UserID,
+		EventType:   "user.registered",
+		Payload:     []byte(`{"email":"user@example.org"}`),
+	})
+	assert.NoError(t, err)
+
+	events, err := q.ClaimOutboxEvents(ctx, gen.ClaimOutboxEventsParams{LeaseUntil: time.Now().Add(time.Minute), MaxAttempts: 5, BatchSize: 10})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, u.UserID, events[0].AggregateID)
+	assert.JSONEq(t, `{"email":"user@example.org"}`, string(events[0].Payload))
+
+	err = q.MarkOutboxEventFailed(ctx, gen.MarkOutboxEventFailedParams{
+		ID:            events[0].ID,
+		NextAttemptAt: time.Now().Add(time.Hour),
+		LastError:     "connection refused",
+	})
+	assert.NoError(t, err)
+
+	events, err = q.ClaimOutboxEvents(ctx, gen.ClaimOutboxEventsParams{LeaseUntil: time.Now().Add(time.Minute), MaxAttempts: 5, BatchSize: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,49 @@
+package integrationtest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func TestSigningKeyRotation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	oldKey, err := q.CreateSigningKey(ctx, gen.CreateSigningKeyParams{Kid: "old", Secret: []byte("old-secret")})
+	assert.NoError(t, err)
+	assert.False(t, oldKey.RetiredAt.Valid)
+
+	_, err = q.CreateSigningKey(ctx, gen.CreateSigningKeyParams{Kid: "new", Secret: []byte("new-secret")})
+	assert.NoError(t, err)
+
+	// Retired in the future: still listed so it verifies tokens during the grace period.
+	n, err := q.RetireSigningKeys(ctx, gen.RetireSigningKeysParams{
+		Kid:       "new",
+		RetiredAt: sql.NullTime{Time: time.Now().UTC().Add(time.Hour), Valid: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	keys, err := q.ListSigningKeys(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	// Already retired keys are not retired again.
+	n, err = q.RetireSigningKeys(ctx, gen.RetireSigningKeysParams{
+		Kid:       "new",
+		RetiredAt: sql.NullTime{Time: time.Now().UTC().Add(-time.Minute), Valid: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+}
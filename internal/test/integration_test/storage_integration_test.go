@@ -0,0 +1,15 @@
+package integrationtest
+
+import (
+	"testing"
+
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/postgres"
+	"github.com/vo1dFl0w/auth-service/internal/app/adapters/storage/storagetest"
+)
+
+func TestPostgresStorageConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Storage {
+		return postgres.New(TestDB)
+	})
+}
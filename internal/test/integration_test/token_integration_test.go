@@ -95,3 +95,60 @@ func TestDeleteRefreshToken(t *testing.T) {
 	_, err = q.DeleteRefreshToken(ctx, "not-exists-hash")
 	assert.Error(t, err)
 }
+
+func TestRefreshTokensByUser(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+
+	saveHashedRefreshTokenHelper(t, q, u.UserID, "hash-1", time.Now().UTC().Add(time.Hour))
+	saveHashedRefreshTokenHelper(t, q, u.UserID, "hash-2", time.Now().UTC().Add(time.Hour))
+	saveHashedRefreshTokenHelper(t, q, u.UserID, "expired-hash", time.Now().UTC().Add(-time.Hour))
+
+	sessions, err := q.ListRefreshTokensByUser(ctx, u.UserID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+
+	n, err := q.DeleteRefreshTokensByUser(ctx, u.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	sessions, err = q.ListRefreshTokensByUser(ctx, u.UserID)
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestDeleteExpiredRefreshTokens(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	u := createUserHelper(t, q, "user@example.org", "password-hash")
+
+	saveHashedRefreshTokenHelper(t, q, u.UserID, "active-hash", time.Now().UTC().Add(time.Hour))
+	saveHashedRefreshTokenHelper(t, q, u.UserID, "expired-hash-1", time.Now().UTC().Add(-time.Hour))
+	saveHashedRefreshTokenHelper(t, q, u.UserID, "expired-hash-2", time.Now().UTC().Add(-time.Minute))
+
+	n, err := q.DeleteExpiredRefreshTokens(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	n, err = q.DeleteExpiredRefreshTokens(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	_, err = q.FindRefreshToken(ctx, "active-hash")
+	assert.NoError(t, err)
+}
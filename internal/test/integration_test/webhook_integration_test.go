@@ -0,0 +1,76 @@
+package integrationtest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/internal/gen"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+	defer tx.Rollback()
+
+	q := gen.New(tx)
+
+	sub, err := q.CreateWebhookSubscription(ctx, gen.CreateWebhookSubscriptionParams{
+		Url:        "https://example.org/hooks/auth",
+		Secret:     "whsec_secret",
+		EventTypes: []string{"user.registered", "session.revoked"},
+	})
+	assert.NoError(t, err)
+
+	subs, err := q.ListWebhookSubscriptionsByEvent(ctx, "session.revoked")
+	assert.NoError(t, err)
+	assert.Len(t, subs, 1)
+
+	subs, err = q.ListWebhookSubscriptionsByEvent(ctx, "user.login_failed")
+	assert.NoError(t, err)
+	assert.Empty(t, subs)
+
+	eventID := uuid.New()
+	for range 2 {
+		err = q.CreateWebhookDelivery(ctx, gen.CreateWebhookDeliveryParams{
+			SubscriptionID: sub.ID,
+			EventID:        eventID,
+			EventType:      "user.registered",
+			Payload:        []byte(`{}`),
+		})
+		assert.NoError(t, err)
+	}
+
+	claimed, err := q.ClaimWebhookDeliveries(ctx, gen.ClaimWebhookDeliveriesParams{LeaseUntil: time.Now().Add(time.Minute), BatchSize: 10})
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, sub.Url, claimed[0].Url)
+
+	err = q.MarkWebhookDeliveryFailed(ctx, gen.MarkWebhookDeliveryFailedParams{
+		ID:             claimed[0].ID,
+		Status:         "dead",
+		NextAttemptAt:  time.Now(),
+		LastStatusCode: 500,
+		LastError:      "unexpected status code: 500",
+	})
+	assert.NoError(t, err)
+
+	claimed, err = q.ClaimWebhookDeliveries(ctx, gen.ClaimWebhookDeliveriesParams{LeaseUntil: time.Now().Add(time.Minute), BatchSize: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	dead, err := q.ListWebhookDeliveries(ctx, gen.ListWebhookDeliveriesParams{
+		SubscriptionID: sub.ID,
+		Status:         sql.NullString{String: "dead", Valid: true},
+		Limit:          10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, dead, 1)
+	assert.Equal(t, int32(1), dead[0].Attempts)
+}
@@ -0,0 +1,241 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewAccountServiceMock creates a new instance of AccountServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountServiceMock {
+	mock := &AccountServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// AccountServiceMock is an autogenerated mock type for the AccountService type
+type AccountServiceMock struct {
+	mock.Mock
+}
+
+type AccountServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountServiceMock) EXPECT() *AccountServiceMock_Expecter {
+	return &AccountServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAccount provides a mock function for the type AccountServiceMock
+func (_mock *AccountServiceMock) DeleteAccount(ctx context.Context, userID uuid.UUID, password string) (time.Time, error) {
+	ret := _mock.Called(ctx, userID, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (time.Time, error)); ok {
+		return returnFunc(ctx, userID, password)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) time.Time); ok {
+		r0 = returnFunc(ctx, userID, password)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userID, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AccountServiceMock_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type AccountServiceMock_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - password string
+func (_e *AccountServiceMock_Expecter) DeleteAccount(ctx interface{}, userID interface{}, password interface{}) *AccountServiceMock_DeleteAccount_Call {
+	return &AccountServiceMock_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, userID, password)}
+}
+
+func (_c *AccountServiceMock_DeleteAccount_Call) Run(run func(ctx context.Context, userID uuid.UUID, password string)) *AccountServiceMock_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_DeleteAccount_Call) Return(time1 time.Time, err error) *AccountServiceMock_DeleteAccount_Call {
+	_c.Call.Return(time1, err)
+	return _c
+}
+
+func (_c *AccountServiceMock_DeleteAccount_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, password string) (time.Time, error)) *AccountServiceMock_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportAccount provides a mock function for the type AccountServiceMock
+func (_mock *AccountServiceMock) ExportAccount(ctx context.Context, userID uuid.UUID) (*domain.AccountExport, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportAccount")
+	}
+
+	var r0 *domain.AccountExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.AccountExport, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.AccountExport); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AccountExport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AccountServiceMock_ExportAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportAccount'
+type AccountServiceMock_ExportAccount_Call struct {
+	*mock.Call
+}
+
+// ExportAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AccountServiceMock_Expecter) ExportAccount(ctx interface{}, userID interface{}) *AccountServiceMock_ExportAccount_Call {
+	return &AccountServiceMock_ExportAccount_Call{Call: _e.mock.On("ExportAccount", ctx, userID)}
+}
+
+func (_c *AccountServiceMock_ExportAccount_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AccountServiceMock_ExportAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_ExportAccount_Call) Return(accountExport *domain.AccountExport, err error) *AccountServiceMock_ExportAccount_Call {
+	_c.Call.Return(accountExport, err)
+	return _c
+}
+
+func (_c *AccountServiceMock_ExportAccount_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*domain.AccountExport, error)) *AccountServiceMock_ExportAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedAccounts provides a mock function for the type AccountServiceMock
+func (_mock *AccountServiceMock) PurgeDeletedAccounts(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedAccounts")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AccountServiceMock_PurgeDeletedAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedAccounts'
+type AccountServiceMock_PurgeDeletedAccounts_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AccountServiceMock_Expecter) PurgeDeletedAccounts(ctx interface{}) *AccountServiceMock_PurgeDeletedAccounts_Call {
+	return &AccountServiceMock_PurgeDeletedAccounts_Call{Call: _e.mock.On("PurgeDeletedAccounts", ctx)}
+}
+
+func (_c *AccountServiceMock_PurgeDeletedAccounts_Call) Run(run func(ctx context.Context)) *AccountServiceMock_PurgeDeletedAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_PurgeDeletedAccounts_Call) Return(n int64, err error) *AccountServiceMock_PurgeDeletedAccounts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *AccountServiceMock_PurgeDeletedAccounts_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *AccountServiceMock_PurgeDeletedAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewAdminServiceMock creates a new instance of AdminServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAdminServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AdminServiceMock {
+	mock := &AdminServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// AdminServiceMock is an autogenerated mock type for the AdminService type
+type AdminServiceMock struct {
+	mock.Mock
+}
+
+type AdminServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AdminServiceMock) EXPECT() *AdminServiceMock_Expecter {
+	return &AdminServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// DeactivateUser provides a mock function for the type AdminServiceMock
+func (_mock *AdminServiceMock) DeactivateUser(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AdminServiceMock_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type AdminServiceMock_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AdminServiceMock_Expecter) DeactivateUser(ctx interface{}, userID interface{}) *AdminServiceMock_DeactivateUser_Call {
+	return &AdminServiceMock_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", ctx, userID)}
+}
+
+func (_c *AdminServiceMock_DeactivateUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AdminServiceMock_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AdminServiceMock_DeactivateUser_Call) Return(err error) *AdminServiceMock_DeactivateUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AdminServiceMock_DeactivateUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *AdminServiceMock_DeactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUser provides a mock function for the type AdminServiceMock
+func (_mock *AdminServiceMock) FindUser(ctx context.Context, ref string) (*domain.User, error) {
+	ret := _mock.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, ref)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, ref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AdminServiceMock_FindUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUser'
+type AdminServiceMock_FindUser_Call struct {
+	*mock.Call
+}
+
+// FindUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref string
+func (_e *AdminServiceMock_Expecter) FindUser(ctx interface{}, ref interface{}) *AdminServiceMock_FindUser_Call {
+	return &AdminServiceMock_FindUser_Call{Call: _e.mock.On("FindUser", ctx, ref)}
+}
+
+func (_c *AdminServiceMock_FindUser_Call) Run(run func(ctx context.Context, ref string)) *AdminServiceMock_FindUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AdminServiceMock_FindUser_Call) Return(user *domain.User, err error) *AdminServiceMock_FindUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *AdminServiceMock_FindUser_Call) RunAndReturn(run func(ctx context.Context, ref string) (*domain.User, error)) *AdminServiceMock_FindUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type AdminServiceMock
+func (_mock *AdminServiceMock) ListSessions(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []domain.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Session, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Session); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AdminServiceMock_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AdminServiceMock_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AdminServiceMock_Expecter) ListSessions(ctx interface{}, userID interface{}) *AdminServiceMock_ListSessions_Call {
+	return &AdminServiceMock_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID)}
+}
+
+func (_c *AdminServiceMock_ListSessions_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AdminServiceMock_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AdminServiceMock_ListSessions_Call) Return(sessions []domain.Session, err error) *AdminServiceMock_ListSessions_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *AdminServiceMock_ListSessions_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]domain.Session, error)) *AdminServiceMock_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type AdminServiceMock
+func (_mock *AdminServiceMock) ResetPassword(ctx context.Context, userID uuid.UUID, password string) error {
+	ret := _mock.Called(ctx, userID, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AdminServiceMock_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type AdminServiceMock_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - password string
+func (_e *AdminServiceMock_Expecter) ResetPassword(ctx interface{}, userID interface{}, password interface{}) *AdminServiceMock_ResetPassword_Call {
+	return &AdminServiceMock_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, userID, password)}
+}
+
+func (_c *AdminServiceMock_ResetPassword_Call) Run(run func(ctx context.Context, userID uuid.UUID, password string)) *AdminServiceMock_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AdminServiceMock_ResetPassword_Call) Return(err error) *AdminServiceMock_ResetPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AdminServiceMock_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, password string) error) *AdminServiceMock_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreUser provides a mock function for the type AdminServiceMock
+func (_mock *AdminServiceMock) RestoreUser(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AdminServiceMock_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type AdminServiceMock_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AdminServiceMock_Expecter) RestoreUser(ctx interface{}, userID interface{}) *AdminServiceMock_RestoreUser_Call {
+	return &AdminServiceMock_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, userID)}
+}
+
+func (_c *AdminServiceMock_RestoreUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AdminServiceMock_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AdminServiceMock_RestoreUser_Call) Return(user *domain.User, err error) *AdminServiceMock_RestoreUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *AdminServiceMock_RestoreUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*domain.User, error)) *AdminServiceMock_RestoreUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessions provides a mock function for the type AdminServiceMock
+func (_mock *AdminServiceMock) RevokeAllSessions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AdminServiceMock_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type AdminServiceMock_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AdminServiceMock_Expecter) RevokeAllSessions(ctx interface{}, userID interface{}) *AdminServiceMock_RevokeAllSessions_Call {
+	return &AdminServiceMock_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions", ctx, userID)}
+}
+
+func (_c *AdminServiceMock_RevokeAllSessions_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AdminServiceMock_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AdminServiceMock_RevokeAllSessions_Call) Return(n int64, err error) *AdminServiceMock_RevokeAllSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *AdminServiceMock_RevokeAllSessions_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (int64, error)) *AdminServiceMock_RevokeAllSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
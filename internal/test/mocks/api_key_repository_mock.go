@@ -0,0 +1,395 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewAPIKeyRepositoryMock creates a new instance of APIKeyRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyRepositoryMock {
+	mock := &APIKeyRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// APIKeyRepositoryMock is an autogenerated mock type for the APIKeyRepository type
+type APIKeyRepositoryMock struct {
+	mock.Mock
+}
+
+type APIKeyRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyRepositoryMock) EXPECT() *APIKeyRepositoryMock_Expecter {
+	return &APIKeyRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateAPIKey provides a mock function for the type APIKeyRepositoryMock
+func (_mock *APIKeyRepositoryMock) CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, userID, name, prefix, keyHash, scopes, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, []string, *time.Time) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, userID, name, prefix, keyHash, scopes, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, []string, *time.Time) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, userID, name, prefix, keyHash, scopes, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, string, []string, *time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, name, prefix, keyHash, scopes, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type APIKeyRepositoryMock_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - name string
+//   - prefix string
+//   - keyHash string
+//   - scopes []string
+//   - expiresAt *time.Time
+func (_e *APIKeyRepositoryMock_Expecter) CreateAPIKey(ctx interface{}, userID interface{}, name interface{}, prefix interface{}, keyHash interface{}, scopes interface{}, expiresAt interface{}) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	return &APIKeyRepositoryMock_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, userID, name, prefix, keyHash, scopes, expiresAt)}
+}
+
+func (_c *APIKeyRepositoryMock_CreateAPIKey_Call) Run(run func(ctx context.Context, userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time)) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 []string
+		if args[5] != nil {
+			arg5 = args[5].([]string)
+		}
+		var arg6 *time.Time
+		if args[6] != nil {
+			arg6 = args[6].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_CreateAPIKey_Call) Return(aPIKey *domain.APIKey, err error) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_CreateAPIKey_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, name string, prefix string, keyHash string, scopes []string, expiresAt *time.Time) (*domain.APIKey, error)) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAPIKey provides a mock function for the type APIKeyRepositoryMock
+func (_mock *APIKeyRepositoryMock) DeleteAPIKey(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// APIKeyRepositoryMock_DeleteAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAPIKey'
+type APIKeyRepositoryMock_DeleteAPIKey_Call struct {
+	*mock.Call
+}
+
+// DeleteAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userID uuid.UUID
+func (_e *APIKeyRepositoryMock_Expecter) DeleteAPIKey(ctx interface{}, id interface{}, userID interface{}) *APIKeyRepositoryMock_DeleteAPIKey_Call {
+	return &APIKeyRepositoryMock_DeleteAPIKey_Call{Call: _e.mock.On("DeleteAPIKey", ctx, id, userID)}
+}
+
+func (_c *APIKeyRepositoryMock_DeleteAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *APIKeyRepositoryMock_DeleteAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_DeleteAPIKey_Call) Return(err error) *APIKeyRepositoryMock_DeleteAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_DeleteAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID) error) *APIKeyRepositoryMock_DeleteAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeyByHash provides a mock function for the type APIKeyRepositoryMock
+func (_mock *APIKeyRepositoryMock) FindAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeyByHash")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, keyHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_FindAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeyByHash'
+type APIKeyRepositoryMock_FindAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeyByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyHash string
+func (_e *APIKeyRepositoryMock_Expecter) FindAPIKeyByHash(ctx interface{}, keyHash interface{}) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	return &APIKeyRepositoryMock_FindAPIKeyByHash_Call{Call: _e.mock.On("FindAPIKeyByHash", ctx, keyHash)}
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByHash_Call) Run(run func(ctx context.Context, keyHash string)) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByHash_Call) Return(aPIKey *domain.APIKey, err error) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByHash_Call) RunAndReturn(run func(ctx context.Context, keyHash string) (*domain.APIKey, error)) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function for the type APIKeyRepositoryMock
+func (_mock *APIKeyRepositoryMock) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 []domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.APIKey, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.APIKey); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type APIKeyRepositoryMock_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *APIKeyRepositoryMock_Expecter) ListAPIKeys(ctx interface{}, userID interface{}) *APIKeyRepositoryMock_ListAPIKeys_Call {
+	return &APIKeyRepositoryMock_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx, userID)}
+}
+
+func (_c *APIKeyRepositoryMock_ListAPIKeys_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *APIKeyRepositoryMock_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_ListAPIKeys_Call) Return(aPIKeys []domain.APIKey, err error) *APIKeyRepositoryMock_ListAPIKeys_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_ListAPIKeys_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error)) *APIKeyRepositoryMock_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchAPIKey provides a mock function for the type APIKeyRepositoryMock
+func (_mock *APIKeyRepositoryMock) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// APIKeyRepositoryMock_TouchAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAPIKey'
+type APIKeyRepositoryMock_TouchAPIKey_Call struct {
+	*mock.Call
+}
+
+// TouchAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *APIKeyRepositoryMock_Expecter) TouchAPIKey(ctx interface{}, id interface{}) *APIKeyRepositoryMock_TouchAPIKey_Call {
+	return &APIKeyRepositoryMock_TouchAPIKey_Call{Call: _e.mock.On("TouchAPIKey", ctx, id)}
+}
+
+func (_c *APIKeyRepositoryMock_TouchAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *APIKeyRepositoryMock_TouchAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_TouchAPIKey_Call) Return(err error) *APIKeyRepositoryMock_TouchAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_TouchAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *APIKeyRepositoryMock_TouchAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,326 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewAPIKeyServiceMock creates a new instance of APIKeyServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyServiceMock {
+	mock := &APIKeyServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// APIKeyServiceMock is an autogenerated mock type for the APIKeyService type
+type APIKeyServiceMock struct {
+	mock.Mock
+}
+
+type APIKeyServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyServiceMock) EXPECT() *APIKeyServiceMock_Expecter {
+	return &APIKeyServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateAPIKey provides a mock function for the type APIKeyServiceMock
+func (_mock *APIKeyServiceMock) CreateAPIKey(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresAt *time.Time) (*domain.CreatedAPIKey, error) {
+	ret := _mock.Called(ctx, userID, name, scopes, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *domain.CreatedAPIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, *time.Time) (*domain.CreatedAPIKey, error)); ok {
+		return returnFunc(ctx, userID, name, scopes, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, *time.Time) *domain.CreatedAPIKey); ok {
+		r0 = returnFunc(ctx, userID, name, scopes, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CreatedAPIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, *time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, name, scopes, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APIKeyServiceMock_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type APIKeyServiceMock_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - name string
+//   - scopes []string
+//   - expiresAt *time.Time
+func (_e *APIKeyServiceMock_Expecter) CreateAPIKey(ctx interface{}, userID interface{}, name interface{}, scopes interface{}, expiresAt interface{}) *APIKeyServiceMock_CreateAPIKey_Call {
+	return &APIKeyServiceMock_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, userID, name, scopes, expiresAt)}
+}
+
+func (_c *APIKeyServiceMock_CreateAPIKey_Call) Run(run func(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresAt *time.Time)) *APIKeyServiceMock_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		var arg4 *time.Time
+		if args[4] != nil {
+			arg4 = args[4].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyServiceMock_CreateAPIKey_Call) Return(createdAPIKey *domain.CreatedAPIKey, err error) *APIKeyServiceMock_CreateAPIKey_Call {
+	_c.Call.Return(createdAPIKey, err)
+	return _c
+}
+
+func (_c *APIKeyServiceMock_CreateAPIKey_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresAt *time.Time) (*domain.CreatedAPIKey, error)) *APIKeyServiceMock_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function for the type APIKeyServiceMock
+func (_mock *APIKeyServiceMock) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 []domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.APIKey, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.APIKey); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APIKeyServiceMock_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type APIKeyServiceMock_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *APIKeyServiceMock_Expecter) ListAPIKeys(ctx interface{}, userID interface{}) *APIKeyServiceMock_ListAPIKeys_Call {
+	return &APIKeyServiceMock_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx, userID)}
+}
+
+func (_c *APIKeyServiceMock_ListAPIKeys_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *APIKeyServiceMock_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyServiceMock_ListAPIKeys_Call) Return(aPIKeys []domain.APIKey, err error) *APIKeyServiceMock_ListAPIKeys_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *APIKeyServiceMock_ListAPIKeys_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]domain.APIKey, error)) *APIKeyServiceMock_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function for the type APIKeyServiceMock
+func (_mock *APIKeyServiceMock) RevokeAPIKey(ctx context.Context, userID uuid.UUID, keyID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// APIKeyServiceMock_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type APIKeyServiceMock_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - keyID uuid.UUID
+func (_e *APIKeyServiceMock_Expecter) RevokeAPIKey(ctx interface{}, userID interface{}, keyID interface{}) *APIKeyServiceMock_RevokeAPIKey_Call {
+	return &APIKeyServiceMock_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, userID, keyID)}
+}
+
+func (_c *APIKeyServiceMock_RevokeAPIKey_Call) Run(run func(ctx context.Context, userID uuid.UUID, keyID uuid.UUID)) *APIKeyServiceMock_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyServiceMock_RevokeAPIKey_Call) Return(err error) *APIKeyServiceMock_RevokeAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *APIKeyServiceMock_RevokeAPIKey_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, keyID uuid.UUID) error) *APIKeyServiceMock_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateAPIKey provides a mock function for the type APIKeyServiceMock
+func (_mock *APIKeyServiceMock) ValidateAPIKey(ctx context.Context, key string) (*domain.APIKey, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateAPIKey")
+	}
+
+	var r0 *domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.APIKey, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.APIKey); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// APIKeyServiceMock_ValidateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateAPIKey'
+type APIKeyServiceMock_ValidateAPIKey_Call struct {
+	*mock.Call
+}
+
+// ValidateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *APIKeyServiceMock_Expecter) ValidateAPIKey(ctx interface{}, key interface{}) *APIKeyServiceMock_ValidateAPIKey_Call {
+	return &APIKeyServiceMock_ValidateAPIKey_Call{Call: _e.mock.On("ValidateAPIKey", ctx, key)}
+}
+
+func (_c *APIKeyServiceMock_ValidateAPIKey_Call) Run(run func(ctx context.Context, key string)) *APIKeyServiceMock_ValidateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *APIKeyServiceMock_ValidateAPIKey_Call) Return(aPIKey *domain.APIKey, err error) *APIKeyServiceMock_ValidateAPIKey_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *APIKeyServiceMock_ValidateAPIKey_Call) RunAndReturn(run func(ctx context.Context, key string) (*domain.APIKey, error)) *APIKeyServiceMock_ValidateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
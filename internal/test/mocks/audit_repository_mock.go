@@ -0,0 +1,312 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewAuditRepositoryMock creates a new instance of AuditRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepositoryMock {
+	mock := &AuditRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// AuditRepositoryMock is an autogenerated mock type for the AuditRepository type
+type AuditRepositoryMock struct {
+	mock.Mock
+}
+
+type AuditRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditRepositoryMock) EXPECT() *AuditRepositoryMock_Expecter {
+	return &AuditRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateAuthEvent provides a mock function for the type AuditRepositoryMock
+func (_mock *AuditRepositoryMock) CreateAuthEvent(ctx context.Context, event *domain.AuthEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuthEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AuthEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuditRepositoryMock_CreateAuthEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthEvent'
+type AuditRepositoryMock_CreateAuthEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuthEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.AuthEvent
+func (_e *AuditRepositoryMock_Expecter) CreateAuthEvent(ctx interface{}, event interface{}) *AuditRepositoryMock_CreateAuthEvent_Call {
+	return &AuditRepositoryMock_CreateAuthEvent_Call{Call: _e.mock.On("CreateAuthEvent", ctx, event)}
+}
+
+func (_c *AuditRepositoryMock_CreateAuthEvent_Call) Run(run func(ctx context.Context, event *domain.AuthEvent)) *AuditRepositoryMock_CreateAuthEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AuthEvent
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AuthEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditRepositoryMock_CreateAuthEvent_Call) Return(err error) *AuditRepositoryMock_CreateAuthEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuditRepositoryMock_CreateAuthEvent_Call) RunAndReturn(run func(ctx context.Context, event *domain.AuthEvent) error) *AuditRepositoryMock_CreateAuthEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAuthEventsBefore provides a mock function for the type AuditRepositoryMock
+func (_mock *AuditRepositoryMock) DeleteAuthEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAuthEventsBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuditRepositoryMock_DeleteAuthEventsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthEventsBefore'
+type AuditRepositoryMock_DeleteAuthEventsBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteAuthEventsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *AuditRepositoryMock_Expecter) DeleteAuthEventsBefore(ctx interface{}, before interface{}) *AuditRepositoryMock_DeleteAuthEventsBefore_Call {
+	return &AuditRepositoryMock_DeleteAuthEventsBefore_Call{Call: _e.mock.On("DeleteAuthEventsBefore", ctx, before)}
+}
+
+func (_c *AuditRepositoryMock_DeleteAuthEventsBefore_Call) Run(run func(ctx context.Context, before time.Time)) *AuditRepositoryMock_DeleteAuthEventsBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditRepositoryMock_DeleteAuthEventsBefore_Call) Return(n int64, err error) *AuditRepositoryMock_DeleteAuthEventsBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *AuditRepositoryMock_DeleteAuthEventsBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, error)) *AuditRepositoryMock_DeleteAuthEventsBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuthEvents provides a mock function for the type AuditRepositoryMock
+func (_mock *AuditRepositoryMock) ListAuthEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthEvents")
+	}
+
+	var r0 []domain.AuthEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthEventFilter) ([]domain.AuthEvent, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthEventFilter) []domain.AuthEvent); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuthEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthEventFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuditRepositoryMock_ListAuthEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthEvents'
+type AuditRepositoryMock_ListAuthEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuthEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AuthEventFilter
+func (_e *AuditRepositoryMock_Expecter) ListAuthEvents(ctx interface{}, filter interface{}) *AuditRepositoryMock_ListAuthEvents_Call {
+	return &AuditRepositoryMock_ListAuthEvents_Call{Call: _e.mock.On("ListAuthEvents", ctx, filter)}
+}
+
+func (_c *AuditRepositoryMock_ListAuthEvents_Call) Run(run func(ctx context.Context, filter domain.AuthEventFilter)) *AuditRepositoryMock_ListAuthEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthEventFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthEventFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditRepositoryMock_ListAuthEvents_Call) Return(authEvents []domain.AuthEvent, err error) *AuditRepositoryMock_ListAuthEvents_Call {
+	_c.Call.Return(authEvents, err)
+	return _c
+}
+
+func (_c *AuditRepositoryMock_ListAuthEvents_Call) RunAndReturn(run func(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error)) *AuditRepositoryMock_ListAuthEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuthEventsByUser provides a mock function for the type AuditRepositoryMock
+func (_mock *AuditRepositoryMock) ListAuthEventsByUser(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error) {
+	ret := _mock.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthEventsByUser")
+	}
+
+	var r0 []domain.AuthEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]domain.AuthEvent, error)); ok {
+		return returnFunc(ctx, userID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []domain.AuthEvent); ok {
+		r0 = returnFunc(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuthEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuditRepositoryMock_ListAuthEventsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthEventsByUser'
+type AuditRepositoryMock_ListAuthEventsByUser_Call struct {
+	*mock.Call
+}
+
+// ListAuthEventsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *AuditRepositoryMock_Expecter) ListAuthEventsByUser(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *AuditRepositoryMock_ListAuthEventsByUser_Call {
+	return &AuditRepositoryMock_ListAuthEventsByUser_Call{Call: _e.mock.On("ListAuthEventsByUser", ctx, userID, limit, offset)}
+}
+
+func (_c *AuditRepositoryMock_ListAuthEventsByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *AuditRepositoryMock_ListAuthEventsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditRepositoryMock_ListAuthEventsByUser_Call) Return(authEvents []domain.AuthEvent, err error) *AuditRepositoryMock_ListAuthEventsByUser_Call {
+	_c.Call.Return(authEvents, err)
+	return _c
+}
+
+func (_c *AuditRepositoryMock_ListAuthEventsByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error)) *AuditRepositoryMock_ListAuthEventsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
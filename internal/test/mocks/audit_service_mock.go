@@ -0,0 +1,294 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewAuditServiceMock creates a new instance of AuditServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditServiceMock {
+	mock := &AuditServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// AuditServiceMock is an autogenerated mock type for the AuditService type
+type AuditServiceMock struct {
+	mock.Mock
+}
+
+type AuditServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditServiceMock) EXPECT() *AuditServiceMock_Expecter {
+	return &AuditServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// ListEvents provides a mock function for the type AuditServiceMock
+func (_mock *AuditServiceMock) ListEvents(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 []domain.AuthEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthEventFilter) ([]domain.AuthEvent, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthEventFilter) []domain.AuthEvent); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuthEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthEventFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuditServiceMock_ListEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEvents'
+type AuditServiceMock_ListEvents_Call struct {
+	*mock.Call
+}
+
+// ListEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.AuthEventFilter
+func (_e *AuditServiceMock_Expecter) ListEvents(ctx interface{}, filter interface{}) *AuditServiceMock_ListEvents_Call {
+	return &AuditServiceMock_ListEvents_Call{Call: _e.mock.On("ListEvents", ctx, filter)}
+}
+
+func (_c *AuditServiceMock_ListEvents_Call) Run(run func(ctx context.Context, filter domain.AuthEventFilter)) *AuditServiceMock_ListEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthEventFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthEventFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditServiceMock_ListEvents_Call) Return(authEvents []domain.AuthEvent, err error) *AuditServiceMock_ListEvents_Call {
+	_c.Call.Return(authEvents, err)
+	return _c
+}
+
+func (_c *AuditServiceMock_ListEvents_Call) RunAndReturn(run func(ctx context.Context, filter domain.AuthEventFilter) ([]domain.AuthEvent, error)) *AuditServiceMock_ListEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserEvents provides a mock function for the type AuditServiceMock
+func (_mock *AuditServiceMock) ListUserEvents(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error) {
+	ret := _mock.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserEvents")
+	}
+
+	var r0 []domain.AuthEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]domain.AuthEvent, error)); ok {
+		return returnFunc(ctx, userID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []domain.AuthEvent); ok {
+		r0 = returnFunc(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuthEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuditServiceMock_ListUserEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserEvents'
+type AuditServiceMock_ListUserEvents_Call struct {
+	*mock.Call
+}
+
+// ListUserEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *AuditServiceMock_Expecter) ListUserEvents(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *AuditServiceMock_ListUserEvents_Call {
+	return &AuditServiceMock_ListUserEvents_Call{Call: _e.mock.On("ListUserEvents", ctx, userID, limit, offset)}
+}
+
+func (_c *AuditServiceMock_ListUserEvents_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *AuditServiceMock_ListUserEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditServiceMock_ListUserEvents_Call) Return(authEvents []domain.AuthEvent, err error) *AuditServiceMock_ListUserEvents_Call {
+	_c.Call.Return(authEvents, err)
+	return _c
+}
+
+func (_c *AuditServiceMock_ListUserEvents_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]domain.AuthEvent, error)) *AuditServiceMock_ListUserEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpired provides a mock function for the type AuditServiceMock
+func (_mock *AuditServiceMock) PurgeExpired(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuditServiceMock_PurgeExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpired'
+type AuditServiceMock_PurgeExpired_Call struct {
+	*mock.Call
+}
+
+// PurgeExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuditServiceMock_Expecter) PurgeExpired(ctx interface{}) *AuditServiceMock_PurgeExpired_Call {
+	return &AuditServiceMock_PurgeExpired_Call{Call: _e.mock.On("PurgeExpired", ctx)}
+}
+
+func (_c *AuditServiceMock_PurgeExpired_Call) Run(run func(ctx context.Context)) *AuditServiceMock_PurgeExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditServiceMock_PurgeExpired_Call) Return(n int64, err error) *AuditServiceMock_PurgeExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *AuditServiceMock_PurgeExpired_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *AuditServiceMock_PurgeExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function for the type AuditServiceMock
+func (_mock *AuditServiceMock) Record(ctx context.Context, event domain.AuthEvent) {
+	_mock.Called(ctx, event)
+	return
+}
+
+// AuditServiceMock_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type AuditServiceMock_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.AuthEvent
+func (_e *AuditServiceMock_Expecter) Record(ctx interface{}, event interface{}) *AuditServiceMock_Record_Call {
+	return &AuditServiceMock_Record_Call{Call: _e.mock.On("Record", ctx, event)}
+}
+
+func (_c *AuditServiceMock_Record_Call) Run(run func(ctx context.Context, event domain.AuthEvent)) *AuditServiceMock_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthEvent
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuditServiceMock_Record_Call) Return() *AuditServiceMock_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuditServiceMock_Record_Call) RunAndReturn(run func(ctx context.Context, event domain.AuthEvent)) *AuditServiceMock_Record_Call {
+	_c.Run(run)
+	return _c
+}
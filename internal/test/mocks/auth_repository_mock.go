@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -39,6 +40,74 @@ func (_m *AuthRepositoryMock) EXPECT() *AuthRepositoryMock_Expecter {
 	return &AuthRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// CancelUserDeletion provides a mock function for the type AuthRepositoryMock
+func (_mock *AuthRepositoryMock) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelUserDeletion")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthRepositoryMock_CancelUserDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelUserDeletion'
+type AuthRepositoryMock_CancelUserDeletion_Call struct {
+	*mock.Call
+}
+
+// CancelUserDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AuthRepositoryMock_Expecter) CancelUserDeletion(ctx interface{}, userID interface{}) *AuthRepositoryMock_CancelUserDeletion_Call {
+	return &AuthRepositoryMock_CancelUserDeletion_Call{Call: _e.mock.On("CancelUserDeletion", ctx, userID)}
+}
+
+func (_c *AuthRepositoryMock_CancelUserDeletion_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AuthRepositoryMock_CancelUserDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthRepositoryMock_CancelUserDeletion_Call) Return(user *domain.User, err error) *AuthRepositoryMock_CancelUserDeletion_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *AuthRepositoryMock_CancelUserDeletion_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*domain.User, error)) *AuthRepositoryMock_CancelUserDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function for the type AuthRepositoryMock
 func (_mock *AuthRepositoryMock) CreateUser(ctx context.Context, email string, passwordHash string) (*domain.User, error) {
 	ret := _mock.Called(ctx, email, passwordHash)
@@ -113,6 +182,131 @@ func (_c *AuthRepositoryMock_CreateUser_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// DeactivateUser provides a mock function for the type AuthRepositoryMock
+func (_mock *AuthRepositoryMock) DeactivateUser(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthRepositoryMock_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type AuthRepositoryMock_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AuthRepositoryMock_Expecter) DeactivateUser(ctx interface{}, userID interface{}) *AuthRepositoryMock_DeactivateUser_Call {
+	return &AuthRepositoryMock_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", ctx, userID)}
+}
+
+func (_c *AuthRepositoryMock_DeactivateUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AuthRepositoryMock_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthRepositoryMock_DeactivateUser_Call) Return(user *domain.User, err error) *AuthRepositoryMock_DeactivateUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *AuthRepositoryMock_DeactivateUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*domain.User, error)) *AuthRepositoryMock_DeactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteScheduledUser provides a mock function for the type AuthRepositoryMock
+func (_mock *AuthRepositoryMock) DeleteScheduledUser(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteScheduledUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthRepositoryMock_DeleteScheduledUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteScheduledUser'
+type AuthRepositoryMock_DeleteScheduledUser_Call struct {
+	*mock.Call
+}
+
+// DeleteScheduledUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *AuthRepositoryMock_Expecter) DeleteScheduledUser(ctx interface{}, userID interface{}) *AuthRepositoryMock_DeleteScheduledUser_Call {
+	return &AuthRepositoryMock_DeleteScheduledUser_Call{Call: _e.mock.On("DeleteScheduledUser", ctx, userID)}
+}
+
+func (_c *AuthRepositoryMock_DeleteScheduledUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *AuthRepositoryMock_DeleteScheduledUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthRepositoryMock_DeleteScheduledUser_Call) Return(err error) *AuthRepositoryMock_DeleteScheduledUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthRepositoryMock_DeleteScheduledUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *AuthRepositoryMock_DeleteScheduledUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindUserByEmail provides a mock function for the type AuthRepositoryMock
 func (_mock *AuthRepositoryMock) FindUserByEmail(ctx context.Context, email string) (*domain.UserWithPassword, error) {
 	ret := _mock.Called(ctx, email)
@@ -248,3 +442,282 @@ func (_c *AuthRepositoryMock_GetUserInfo_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListUsersDueForDeletion provides a mock function for the type AuthRepositoryMock
+func (_mock *AuthRepositoryMock) ListUsersDueForDeletion(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersDueForDeletion")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthRepositoryMock_ListUsersDueForDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersDueForDeletion'
+type AuthRepositoryMock_ListUsersDueForDeletion_Call struct {
+	*mock.Call
+}
+
+// ListUsersDueForDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *AuthRepositoryMock_Expecter) ListUsersDueForDeletion(ctx interface{}, limit interface{}) *AuthRepositoryMock_ListUsersDueForDeletion_Call {
+	return &AuthRepositoryMock_ListUsersDueForDeletion_Call{Call: _e.mock.On("ListUsersDueForDeletion", ctx, limit)}
+}
+
+func (_c *AuthRepositoryMock_ListUsersDueForDeletion_Call) Run(run func(ctx context.Context, limit int)) *AuthRepositoryMock_ListUsersDueForDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthRepositoryMock_ListUsersDueForDeletion_Call) Return(uUIDs []uuid.UUID, err error) *AuthRepositoryMock_ListUsersDueForDeletion_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *AuthRepositoryMock_ListUsersDueForDeletion_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]uuid.UUID, error)) *AuthRepositoryMock_ListUsersDueForDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleUserDeletion provides a mock function for the type AuthRepositoryMock
+func (_mock *AuthRepositoryMock) ScheduleUserDeletion(ctx context.Context, userID uuid.UUID, deleteAfter time.Time) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID, deleteAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleUserDeletion")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID, deleteAfter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *domain.User); ok {
+		r0 = returnFunc(ctx, userID, deleteAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, deleteAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthRepositoryMock_ScheduleUserDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleUserDeletion'
+type AuthRepositoryMock_ScheduleUserDeletion_Call struct {
+	*mock.Call
+}
+
+// ScheduleUserDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - deleteAfter time.Time
+func (_e *AuthRepositoryMock_Expecter) ScheduleUserDeletion(ctx interface{}, userID interface{}, deleteAfter interface{}) *AuthRepositoryMock_ScheduleUserDeletion_Call {
+	return &AuthRepositoryMock_ScheduleUserDeletion_Call{Call: _e.mock.On("ScheduleUserDeletion", ctx, userID, deleteAfter)}
+}
+
+func (_c *AuthRepositoryMock_ScheduleUserDeletion_Call) Run(run func(ctx context.Context, userID uuid.UUID, deleteAfter time.Time)) *AuthRepositoryMock_ScheduleUserDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthRepositoryMock_ScheduleUserDeletion_Call) Return(user *domain.User, err error) *AuthRepositoryMock_ScheduleUserDeletion_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *AuthRepositoryMock_ScheduleUserDeletion_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, deleteAfter time.Time) (*domain.User, error)) *AuthRepositoryMock_ScheduleUserDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserEmail provides a mock function for the type AuthRepositoryMock
+func (_mock *AuthRepositoryMock) UpdateUserEmail(ctx context.Context, userID uuid.UUID, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.User); ok {
+		r0 = returnFunc(ctx, userID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthRepositoryMock_UpdateUserEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserEmail'
+type AuthRepositoryMock_UpdateUserEmail_Call struct {
+	*mock.Call
+}
+
+// UpdateUserEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - email string
+func (_e *AuthRepositoryMock_Expecter) UpdateUserEmail(ctx interface{}, userID interface{}, email interface{}) *AuthRepositoryMock_UpdateUserEmail_Call {
+	return &AuthRepositoryMock_UpdateUserEmail_Call{Call: _e.mock.On("UpdateUserEmail", ctx, userID, email)}
+}
+
+func (_c *AuthRepositoryMock_UpdateUserEmail_Call) Run(run func(ctx context.Context, userID uuid.UUID, email string)) *AuthRepositoryMock_UpdateUserEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthRepositoryMock_UpdateUserEmail_Call) Return(user *domain.User, err error) *AuthRepositoryMock_UpdateUserEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *AuthRepositoryMock_UpdateUserEmail_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, email string) (*domain.User, error)) *AuthRepositoryMock_UpdateUserEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserPassword provides a mock function for the type AuthRepositoryMock
+func (_mock *AuthRepositoryMock) UpdateUserPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	ret := _mock.Called(ctx, userID, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserPassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthRepositoryMock_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type AuthRepositoryMock_UpdateUserPassword_Call struct {
+	*mock.Call
+}
+
+// UpdateUserPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - passwordHash string
+func (_e *AuthRepositoryMock_Expecter) UpdateUserPassword(ctx interface{}, userID interface{}, passwordHash interface{}) *AuthRepositoryMock_UpdateUserPassword_Call {
+	return &AuthRepositoryMock_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, userID, passwordHash)}
+}
+
+func (_c *AuthRepositoryMock_UpdateUserPassword_Call) Run(run func(ctx context.Context, userID uuid.UUID, passwordHash string)) *AuthRepositoryMock_UpdateUserPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthRepositoryMock_UpdateUserPassword_Call) Return(err error) *AuthRepositoryMock_UpdateUserPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthRepositoryMock_UpdateUserPassword_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, passwordHash string) error) *AuthRepositoryMock_UpdateUserPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -40,8 +40,8 @@ func (_m *AuthServiceMock) EXPECT() *AuthServiceMock_Expecter {
 }
 
 // Login provides a mock function for the type AuthServiceMock
-func (_mock *AuthServiceMock) Login(ctx context.Context, email string, password string) (*domain.Tokens, error) {
-	ret := _mock.Called(ctx, email, password)
+func (_mock *AuthServiceMock) Login(ctx context.Context, email string, password string, rememberMe bool) (*domain.Tokens, error) {
+	ret := _mock.Called(ctx, email, password, rememberMe)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
@@ -49,18 +49,18 @@ func (_mock *AuthServiceMock) Login(ctx context.Context, email string, password
 
 	var r0 *domain.Tokens
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Tokens, error)); ok {
-		return returnFunc(ctx, email, password)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) (*domain.Tokens, error)); ok {
+		return returnFunc(ctx, email, password, rememberMe)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Tokens); ok {
-		r0 = returnFunc(ctx, email, password)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) *domain.Tokens); ok {
+		r0 = returnFunc(ctx, email, password, rememberMe)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Tokens)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, email, password)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = returnFunc(ctx, email, password, rememberMe)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -76,11 +76,12 @@ type AuthServiceMock_Login_Call struct {
 //   - ctx context.Context
 //   - email string
 //   - password string
-func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *AuthServiceMock_Login_Call {
-	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+//   - rememberMe bool
+func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, email interface{}, password interface{}, rememberMe interface{}) *AuthServiceMock_Login_Call {
+	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, email, password, rememberMe)}
 }
 
-func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email string, password string)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email string, password string, rememberMe bool)) *AuthServiceMock_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -94,10 +95,15 @@ func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email st
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -108,22 +114,22 @@ func (_c *AuthServiceMock_Login_Call) Return(tokens *domain.Tokens, err error) *
 	return _c
 }
 
-func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(ctx context.Context, email string, password string) (*domain.Tokens, error)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(ctx context.Context, email string, password string, rememberMe bool) (*domain.Tokens, error)) *AuthServiceMock_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Logout provides a mock function for the type AuthServiceMock
-func (_mock *AuthServiceMock) Logout(ctx context.Context, token string) error {
-	ret := _mock.Called(ctx, token)
+func (_mock *AuthServiceMock) Logout(ctx context.Context, token string, accessToken string) error {
+	ret := _mock.Called(ctx, token, accessToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Logout")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, token, accessToken)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -138,11 +144,12 @@ type AuthServiceMock_Logout_Call struct {
 // Logout is a helper method to define mock.On call
 //   - ctx context.Context
 //   - token string
-func (_e *AuthServiceMock_Expecter) Logout(ctx interface{}, token interface{}) *AuthServiceMock_Logout_Call {
-	return &AuthServiceMock_Logout_Call{Call: _e.mock.On("Logout", ctx, token)}
+//   - accessToken string
+func (_e *AuthServiceMock_Expecter) Logout(ctx interface{}, token interface{}, accessToken interface{}) *AuthServiceMock_Logout_Call {
+	return &AuthServiceMock_Logout_Call{Call: _e.mock.On("Logout", ctx, token, accessToken)}
 }
 
-func (_c *AuthServiceMock_Logout_Call) Run(run func(ctx context.Context, token string)) *AuthServiceMock_Logout_Call {
+func (_c *AuthServiceMock_Logout_Call) Run(run func(ctx context.Context, token string, accessToken string)) *AuthServiceMock_Logout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -152,9 +159,14 @@ func (_c *AuthServiceMock_Logout_Call) Run(run func(ctx context.Context, token s
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -165,7 +177,7 @@ func (_c *AuthServiceMock_Logout_Call) Return(err error) *AuthServiceMock_Logout
 	return _c
 }
 
-func (_c *AuthServiceMock_Logout_Call) RunAndReturn(run func(ctx context.Context, token string) error) *AuthServiceMock_Logout_Call {
+func (_c *AuthServiceMock_Logout_Call) RunAndReturn(run func(ctx context.Context, token string, accessToken string) error) *AuthServiceMock_Logout_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,157 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewClaimsProviderMock creates a new instance of ClaimsProviderMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClaimsProviderMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClaimsProviderMock {
+	mock := &ClaimsProviderMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// ClaimsProviderMock is an autogenerated mock type for the ClaimsProvider type
+type ClaimsProviderMock struct {
+	mock.Mock
+}
+
+type ClaimsProviderMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClaimsProviderMock) EXPECT() *ClaimsProviderMock_Expecter {
+	return &ClaimsProviderMock_Expecter{mock: &_m.Mock}
+}
+
+// Claims provides a mock function for the type ClaimsProviderMock
+func (_mock *ClaimsProviderMock) Claims(ctx context.Context, userID uuid.UUID, clientID string) (map[string]any, error) {
+	ret := _mock.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claims")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (map[string]any, error)); ok {
+		return returnFunc(ctx, userID, clientID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) map[string]any); ok {
+		r0 = returnFunc(ctx, userID, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userID, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ClaimsProviderMock_Claims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claims'
+type ClaimsProviderMock_Claims_Call struct {
+	*mock.Call
+}
+
+// Claims is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - clientID string
+func (_e *ClaimsProviderMock_Expecter) Claims(ctx interface{}, userID interface{}, clientID interface{}) *ClaimsProviderMock_Claims_Call {
+	return &ClaimsProviderMock_Claims_Call{Call: _e.mock.On("Claims", ctx, userID, clientID)}
+}
+
+func (_c *ClaimsProviderMock_Claims_Call) Run(run func(ctx context.Context, userID uuid.UUID, clientID string)) *ClaimsProviderMock_Claims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ClaimsProviderMock_Claims_Call) Return(stringToV map[string]any, err error) *ClaimsProviderMock_Claims_Call {
+	_c.Call.Return(stringToV, err)
+	return _c
+}
+
+func (_c *ClaimsProviderMock_Claims_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, clientID string) (map[string]any, error)) *ClaimsProviderMock_Claims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function for the type ClaimsProviderMock
+func (_mock *ClaimsProviderMock) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// ClaimsProviderMock_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type ClaimsProviderMock_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *ClaimsProviderMock_Expecter) Name() *ClaimsProviderMock_Name_Call {
+	return &ClaimsProviderMock_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *ClaimsProviderMock_Name_Call) Run(run func()) *ClaimsProviderMock_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClaimsProviderMock_Name_Call) Return(s string) *ClaimsProviderMock_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *ClaimsProviderMock_Name_Call) RunAndReturn(run func() string) *ClaimsProviderMock_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
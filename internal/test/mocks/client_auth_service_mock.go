@@ -0,0 +1,110 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewClientAuthServiceMock creates a new instance of ClientAuthServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClientAuthServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClientAuthServiceMock {
+	mock := &ClientAuthServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// ClientAuthServiceMock is an autogenerated mock type for the ClientAuthService type
+type ClientAuthServiceMock struct {
+	mock.Mock
+}
+
+type ClientAuthServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClientAuthServiceMock) EXPECT() *ClientAuthServiceMock_Expecter {
+	return &ClientAuthServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// AuthenticateClient provides a mock function for the type ClientAuthServiceMock
+func (_mock *ClientAuthServiceMock) AuthenticateClient(ctx context.Context, clientID string, clientSecret string) (bool, error) {
+	ret := _mock.Called(ctx, clientID, clientSecret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateClient")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, clientID, clientSecret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, clientID, clientSecret)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, clientID, clientSecret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ClientAuthServiceMock_AuthenticateClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateClient'
+type ClientAuthServiceMock_AuthenticateClient_Call struct {
+	*mock.Call
+}
+
+// AuthenticateClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - clientSecret string
+func (_e *ClientAuthServiceMock_Expecter) AuthenticateClient(ctx interface{}, clientID interface{}, clientSecret interface{}) *ClientAuthServiceMock_AuthenticateClient_Call {
+	return &ClientAuthServiceMock_AuthenticateClient_Call{Call: _e.mock.On("AuthenticateClient", ctx, clientID, clientSecret)}
+}
+
+func (_c *ClientAuthServiceMock_AuthenticateClient_Call) Run(run func(ctx context.Context, clientID string, clientSecret string)) *ClientAuthServiceMock_AuthenticateClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ClientAuthServiceMock_AuthenticateClient_Call) Return(b bool, err error) *ClientAuthServiceMock_AuthenticateClient_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *ClientAuthServiceMock_AuthenticateClient_Call) RunAndReturn(run func(ctx context.Context, clientID string, clientSecret string) (bool, error)) *ClientAuthServiceMock_AuthenticateClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,591 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewDeviceCodeRepositoryMock creates a new instance of DeviceCodeRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeviceCodeRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeviceCodeRepositoryMock {
+	mock := &DeviceCodeRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// DeviceCodeRepositoryMock is an autogenerated mock type for the DeviceCodeRepository type
+type DeviceCodeRepositoryMock struct {
+	mock.Mock
+}
+
+type DeviceCodeRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeviceCodeRepositoryMock) EXPECT() *DeviceCodeRepositoryMock_Expecter {
+	return &DeviceCodeRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// DecideDeviceCode provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) DecideDeviceCode(ctx context.Context, userCodeHash string, userID uuid.UUID, status string) error {
+	ret := _mock.Called(ctx, userCodeHash, userID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideDeviceCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userCodeHash, userID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeviceCodeRepositoryMock_DecideDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecideDeviceCode'
+type DeviceCodeRepositoryMock_DecideDeviceCode_Call struct {
+	*mock.Call
+}
+
+// DecideDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCodeHash string
+//   - userID uuid.UUID
+//   - status string
+func (_e *DeviceCodeRepositoryMock_Expecter) DecideDeviceCode(ctx interface{}, userCodeHash interface{}, userID interface{}, status interface{}) *DeviceCodeRepositoryMock_DecideDeviceCode_Call {
+	return &DeviceCodeRepositoryMock_DecideDeviceCode_Call{Call: _e.mock.On("DecideDeviceCode", ctx, userCodeHash, userID, status)}
+}
+
+func (_c *DeviceCodeRepositoryMock_DecideDeviceCode_Call) Run(run func(ctx context.Context, userCodeHash string, userID uuid.UUID, status string)) *DeviceCodeRepositoryMock_DecideDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DecideDeviceCode_Call) Return(err error) *DeviceCodeRepositoryMock_DecideDeviceCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DecideDeviceCode_Call) RunAndReturn(run func(ctx context.Context, userCodeHash string, userID uuid.UUID, status string) error) *DeviceCodeRepositoryMock_DecideDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDeviceCode provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) DeleteDeviceCode(ctx context.Context, deviceCodeHash string) error {
+	ret := _mock.Called(ctx, deviceCodeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeviceCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, deviceCodeHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeviceCodeRepositoryMock_DeleteDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDeviceCode'
+type DeviceCodeRepositoryMock_DeleteDeviceCode_Call struct {
+	*mock.Call
+}
+
+// DeleteDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCodeHash string
+func (_e *DeviceCodeRepositoryMock_Expecter) DeleteDeviceCode(ctx interface{}, deviceCodeHash interface{}) *DeviceCodeRepositoryMock_DeleteDeviceCode_Call {
+	return &DeviceCodeRepositoryMock_DeleteDeviceCode_Call{Call: _e.mock.On("DeleteDeviceCode", ctx, deviceCodeHash)}
+}
+
+func (_c *DeviceCodeRepositoryMock_DeleteDeviceCode_Call) Run(run func(ctx context.Context, deviceCodeHash string)) *DeviceCodeRepositoryMock_DeleteDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DeleteDeviceCode_Call) Return(err error) *DeviceCodeRepositoryMock_DeleteDeviceCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DeleteDeviceCode_Call) RunAndReturn(run func(ctx context.Context, deviceCodeHash string) error) *DeviceCodeRepositoryMock_DeleteDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredDeviceCodes provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) DeleteExpiredDeviceCodes(ctx context.Context, limit int) (int64, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredDeviceCodes")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredDeviceCodes'
+type DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredDeviceCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *DeviceCodeRepositoryMock_Expecter) DeleteExpiredDeviceCodes(ctx interface{}, limit interface{}) *DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call {
+	return &DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call{Call: _e.mock.On("DeleteExpiredDeviceCodes", ctx, limit)}
+}
+
+func (_c *DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call) Run(run func(ctx context.Context, limit int)) *DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call) Return(n int64, err error) *DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call) RunAndReturn(run func(ctx context.Context, limit int) (int64, error)) *DeviceCodeRepositoryMock_DeleteExpiredDeviceCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeviceCode provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) GetDeviceCode(ctx context.Context, deviceCodeHash string) (*domain.DeviceCode, error) {
+	ret := _mock.Called(ctx, deviceCodeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeviceCode")
+	}
+
+	var r0 *domain.DeviceCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.DeviceCode, error)); ok {
+		return returnFunc(ctx, deviceCodeHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.DeviceCode); ok {
+		r0 = returnFunc(ctx, deviceCodeHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeviceCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, deviceCodeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeviceCodeRepositoryMock_GetDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceCode'
+type DeviceCodeRepositoryMock_GetDeviceCode_Call struct {
+	*mock.Call
+}
+
+// GetDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCodeHash string
+func (_e *DeviceCodeRepositoryMock_Expecter) GetDeviceCode(ctx interface{}, deviceCodeHash interface{}) *DeviceCodeRepositoryMock_GetDeviceCode_Call {
+	return &DeviceCodeRepositoryMock_GetDeviceCode_Call{Call: _e.mock.On("GetDeviceCode", ctx, deviceCodeHash)}
+}
+
+func (_c *DeviceCodeRepositoryMock_GetDeviceCode_Call) Run(run func(ctx context.Context, deviceCodeHash string)) *DeviceCodeRepositoryMock_GetDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_GetDeviceCode_Call) Return(deviceCode *domain.DeviceCode, err error) *DeviceCodeRepositoryMock_GetDeviceCode_Call {
+	_c.Call.Return(deviceCode, err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_GetDeviceCode_Call) RunAndReturn(run func(ctx context.Context, deviceCodeHash string) (*domain.DeviceCode, error)) *DeviceCodeRepositoryMock_GetDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeviceCodeByUserCode provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) GetDeviceCodeByUserCode(ctx context.Context, userCodeHash string) (*domain.DeviceCode, error) {
+	ret := _mock.Called(ctx, userCodeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeviceCodeByUserCode")
+	}
+
+	var r0 *domain.DeviceCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.DeviceCode, error)); ok {
+		return returnFunc(ctx, userCodeHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.DeviceCode); ok {
+		r0 = returnFunc(ctx, userCodeHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeviceCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userCodeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceCodeByUserCode'
+type DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call struct {
+	*mock.Call
+}
+
+// GetDeviceCodeByUserCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCodeHash string
+func (_e *DeviceCodeRepositoryMock_Expecter) GetDeviceCodeByUserCode(ctx interface{}, userCodeHash interface{}) *DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call {
+	return &DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call{Call: _e.mock.On("GetDeviceCodeByUserCode", ctx, userCodeHash)}
+}
+
+func (_c *DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call) Run(run func(ctx context.Context, userCodeHash string)) *DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call) Return(deviceCode *domain.DeviceCode, err error) *DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call {
+	_c.Call.Return(deviceCode, err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call) RunAndReturn(run func(ctx context.Context, userCodeHash string) (*domain.DeviceCode, error)) *DeviceCodeRepositoryMock_GetDeviceCodeByUserCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollDeviceCode provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) PollDeviceCode(ctx context.Context, deviceCodeHash string, polledAt time.Time, interval time.Duration) (bool, error) {
+	ret := _mock.Called(ctx, deviceCodeHash, polledAt, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollDeviceCode")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration) (bool, error)); ok {
+		return returnFunc(ctx, deviceCodeHash, polledAt, interval)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration) bool); ok {
+		r0 = returnFunc(ctx, deviceCodeHash, polledAt, interval)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Duration) error); ok {
+		r1 = returnFunc(ctx, deviceCodeHash, polledAt, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeviceCodeRepositoryMock_PollDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollDeviceCode'
+type DeviceCodeRepositoryMock_PollDeviceCode_Call struct {
+	*mock.Call
+}
+
+// PollDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCodeHash string
+//   - polledAt time.Time
+//   - interval time.Duration
+func (_e *DeviceCodeRepositoryMock_Expecter) PollDeviceCode(ctx interface{}, deviceCodeHash interface{}, polledAt interface{}, interval interface{}) *DeviceCodeRepositoryMock_PollDeviceCode_Call {
+	return &DeviceCodeRepositoryMock_PollDeviceCode_Call{Call: _e.mock.On("PollDeviceCode", ctx, deviceCodeHash, polledAt, interval)}
+}
+
+func (_c *DeviceCodeRepositoryMock_PollDeviceCode_Call) Run(run func(ctx context.Context, deviceCodeHash string, polledAt time.Time, interval time.Duration)) *DeviceCodeRepositoryMock_PollDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_PollDeviceCode_Call) Return(b bool, err error) *DeviceCodeRepositoryMock_PollDeviceCode_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_PollDeviceCode_Call) RunAndReturn(run func(ctx context.Context, deviceCodeHash string, polledAt time.Time, interval time.Duration) (bool, error)) *DeviceCodeRepositoryMock_PollDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDeviceCode provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) SaveDeviceCode(ctx context.Context, deviceCodeHash string, userCodeHash string, clientID string, interval time.Duration, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, deviceCodeHash, userCodeHash, clientID, interval, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDeviceCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, time.Time) error); ok {
+		r0 = returnFunc(ctx, deviceCodeHash, userCodeHash, clientID, interval, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeviceCodeRepositoryMock_SaveDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDeviceCode'
+type DeviceCodeRepositoryMock_SaveDeviceCode_Call struct {
+	*mock.Call
+}
+
+// SaveDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCodeHash string
+//   - userCodeHash string
+//   - clientID string
+//   - interval time.Duration
+//   - expiresAt time.Time
+func (_e *DeviceCodeRepositoryMock_Expecter) SaveDeviceCode(ctx interface{}, deviceCodeHash interface{}, userCodeHash interface{}, clientID interface{}, interval interface{}, expiresAt interface{}) *DeviceCodeRepositoryMock_SaveDeviceCode_Call {
+	return &DeviceCodeRepositoryMock_SaveDeviceCode_Call{Call: _e.mock.On("SaveDeviceCode", ctx, deviceCodeHash, userCodeHash, clientID, interval, expiresAt)}
+}
+
+func (_c *DeviceCodeRepositoryMock_SaveDeviceCode_Call) Run(run func(ctx context.Context, deviceCodeHash string, userCodeHash string, clientID string, interval time.Duration, expiresAt time.Time)) *DeviceCodeRepositoryMock_SaveDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Duration
+		if args[4] != nil {
+			arg4 = args[4].(time.Duration)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_SaveDeviceCode_Call) Return(err error) *DeviceCodeRepositoryMock_SaveDeviceCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_SaveDeviceCode_Call) RunAndReturn(run func(ctx context.Context, deviceCodeHash string, userCodeHash string, clientID string, interval time.Duration, expiresAt time.Time) error) *DeviceCodeRepositoryMock_SaveDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SlowDownDeviceCode provides a mock function for the type DeviceCodeRepositoryMock
+func (_mock *DeviceCodeRepositoryMock) SlowDownDeviceCode(ctx context.Context, deviceCodeHash string, step time.Duration) error {
+	ret := _mock.Called(ctx, deviceCodeHash, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SlowDownDeviceCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) error); ok {
+		r0 = returnFunc(ctx, deviceCodeHash, step)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeviceCodeRepositoryMock_SlowDownDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SlowDownDeviceCode'
+type DeviceCodeRepositoryMock_SlowDownDeviceCode_Call struct {
+	*mock.Call
+}
+
+// SlowDownDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCodeHash string
+//   - step time.Duration
+func (_e *DeviceCodeRepositoryMock_Expecter) SlowDownDeviceCode(ctx interface{}, deviceCodeHash interface{}, step interface{}) *DeviceCodeRepositoryMock_SlowDownDeviceCode_Call {
+	return &DeviceCodeRepositoryMock_SlowDownDeviceCode_Call{Call: _e.mock.On("SlowDownDeviceCode", ctx, deviceCodeHash, step)}
+}
+
+func (_c *DeviceCodeRepositoryMock_SlowDownDeviceCode_Call) Run(run func(ctx context.Context, deviceCodeHash string, step time.Duration)) *DeviceCodeRepositoryMock_SlowDownDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_SlowDownDeviceCode_Call) Return(err error) *DeviceCodeRepositoryMock_SlowDownDeviceCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_SlowDownDeviceCode_Call) RunAndReturn(run func(ctx context.Context, deviceCodeHash string, step time.Duration) error) *DeviceCodeRepositoryMock_SlowDownDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,319 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewDeviceServiceMock creates a new instance of DeviceServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeviceServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeviceServiceMock {
+	mock := &DeviceServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// DeviceServiceMock is an autogenerated mock type for the DeviceService type
+type DeviceServiceMock struct {
+	mock.Mock
+}
+
+type DeviceServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeviceServiceMock) EXPECT() *DeviceServiceMock_Expecter {
+	return &DeviceServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// AuthorizeDevice provides a mock function for the type DeviceServiceMock
+func (_mock *DeviceServiceMock) AuthorizeDevice(ctx context.Context, clientID string) (*domain.DeviceAuthorization, error) {
+	ret := _mock.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthorizeDevice")
+	}
+
+	var r0 *domain.DeviceAuthorization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.DeviceAuthorization, error)); ok {
+		return returnFunc(ctx, clientID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.DeviceAuthorization); ok {
+		r0 = returnFunc(ctx, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeviceAuthorization)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeviceServiceMock_AuthorizeDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthorizeDevice'
+type DeviceServiceMock_AuthorizeDevice_Call struct {
+	*mock.Call
+}
+
+// AuthorizeDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+func (_e *DeviceServiceMock_Expecter) AuthorizeDevice(ctx interface{}, clientID interface{}) *DeviceServiceMock_AuthorizeDevice_Call {
+	return &DeviceServiceMock_AuthorizeDevice_Call{Call: _e.mock.On("AuthorizeDevice", ctx, clientID)}
+}
+
+func (_c *DeviceServiceMock_AuthorizeDevice_Call) Run(run func(ctx context.Context, clientID string)) *DeviceServiceMock_AuthorizeDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceServiceMock_AuthorizeDevice_Call) Return(deviceAuthorization *domain.DeviceAuthorization, err error) *DeviceServiceMock_AuthorizeDevice_Call {
+	_c.Call.Return(deviceAuthorization, err)
+	return _c
+}
+
+func (_c *DeviceServiceMock_AuthorizeDevice_Call) RunAndReturn(run func(ctx context.Context, clientID string) (*domain.DeviceAuthorization, error)) *DeviceServiceMock_AuthorizeDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeviceAuthorization provides a mock function for the type DeviceServiceMock
+func (_mock *DeviceServiceMock) GetDeviceAuthorization(ctx context.Context, userCode string) (*domain.DeviceCode, error) {
+	ret := _mock.Called(ctx, userCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeviceAuthorization")
+	}
+
+	var r0 *domain.DeviceCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.DeviceCode, error)); ok {
+		return returnFunc(ctx, userCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.DeviceCode); ok {
+		r0 = returnFunc(ctx, userCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeviceCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeviceServiceMock_GetDeviceAuthorization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceAuthorization'
+type DeviceServiceMock_GetDeviceAuthorization_Call struct {
+	*mock.Call
+}
+
+// GetDeviceAuthorization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCode string
+func (_e *DeviceServiceMock_Expecter) GetDeviceAuthorization(ctx interface{}, userCode interface{}) *DeviceServiceMock_GetDeviceAuthorization_Call {
+	return &DeviceServiceMock_GetDeviceAuthorization_Call{Call: _e.mock.On("GetDeviceAuthorization", ctx, userCode)}
+}
+
+func (_c *DeviceServiceMock_GetDeviceAuthorization_Call) Run(run func(ctx context.Context, userCode string)) *DeviceServiceMock_GetDeviceAuthorization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceServiceMock_GetDeviceAuthorization_Call) Return(deviceCode *domain.DeviceCode, err error) *DeviceServiceMock_GetDeviceAuthorization_Call {
+	_c.Call.Return(deviceCode, err)
+	return _c
+}
+
+func (_c *DeviceServiceMock_GetDeviceAuthorization_Call) RunAndReturn(run func(ctx context.Context, userCode string) (*domain.DeviceCode, error)) *DeviceServiceMock_GetDeviceAuthorization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollDevice provides a mock function for the type DeviceServiceMock
+func (_mock *DeviceServiceMock) PollDevice(ctx context.Context, clientID string, deviceCode string) (*domain.Tokens, error) {
+	ret := _mock.Called(ctx, clientID, deviceCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollDevice")
+	}
+
+	var r0 *domain.Tokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Tokens, error)); ok {
+		return returnFunc(ctx, clientID, deviceCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Tokens); ok {
+		r0 = returnFunc(ctx, clientID, deviceCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tokens)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, clientID, deviceCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeviceServiceMock_PollDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollDevice'
+type DeviceServiceMock_PollDevice_Call struct {
+	*mock.Call
+}
+
+// PollDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - deviceCode string
+func (_e *DeviceServiceMock_Expecter) PollDevice(ctx interface{}, clientID interface{}, deviceCode interface{}) *DeviceServiceMock_PollDevice_Call {
+	return &DeviceServiceMock_PollDevice_Call{Call: _e.mock.On("PollDevice", ctx, clientID, deviceCode)}
+}
+
+func (_c *DeviceServiceMock_PollDevice_Call) Run(run func(ctx context.Context, clientID string, deviceCode string)) *DeviceServiceMock_PollDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceServiceMock_PollDevice_Call) Return(tokens *domain.Tokens, err error) *DeviceServiceMock_PollDevice_Call {
+	_c.Call.Return(tokens, err)
+	return _c
+}
+
+func (_c *DeviceServiceMock_PollDevice_Call) RunAndReturn(run func(ctx context.Context, clientID string, deviceCode string) (*domain.Tokens, error)) *DeviceServiceMock_PollDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyDevice provides a mock function for the type DeviceServiceMock
+func (_mock *DeviceServiceMock) VerifyDevice(ctx context.Context, userID uuid.UUID, userCode string, approve bool) error {
+	ret := _mock.Called(ctx, userID, userCode, approve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyDevice")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, bool) error); ok {
+		r0 = returnFunc(ctx, userID, userCode, approve)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeviceServiceMock_VerifyDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyDevice'
+type DeviceServiceMock_VerifyDevice_Call struct {
+	*mock.Call
+}
+
+// VerifyDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - userCode string
+//   - approve bool
+func (_e *DeviceServiceMock_Expecter) VerifyDevice(ctx interface{}, userID interface{}, userCode interface{}, approve interface{}) *DeviceServiceMock_VerifyDevice_Call {
+	return &DeviceServiceMock_VerifyDevice_Call{Call: _e.mock.On("VerifyDevice", ctx, userID, userCode, approve)}
+}
+
+func (_c *DeviceServiceMock_VerifyDevice_Call) Run(run func(ctx context.Context, userID uuid.UUID, userCode string, approve bool)) *DeviceServiceMock_VerifyDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DeviceServiceMock_VerifyDevice_Call) Return(err error) *DeviceServiceMock_VerifyDevice_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeviceServiceMock_VerifyDevice_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, userCode string, approve bool) error) *DeviceServiceMock_VerifyDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
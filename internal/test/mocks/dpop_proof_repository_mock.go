@@ -0,0 +1,177 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewDPoPProofRepositoryMock creates a new instance of DPoPProofRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDPoPProofRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DPoPProofRepositoryMock {
+	mock := &DPoPProofRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// DPoPProofRepositoryMock is an autogenerated mock type for the DPoPProofRepository type
+type DPoPProofRepositoryMock struct {
+	mock.Mock
+}
+
+type DPoPProofRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DPoPProofRepositoryMock) EXPECT() *DPoPProofRepositoryMock_Expecter {
+	return &DPoPProofRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExpiredDPoPProofs provides a mock function for the type DPoPProofRepositoryMock
+func (_mock *DPoPProofRepositoryMock) DeleteExpiredDPoPProofs(ctx context.Context, limit int) (int64, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredDPoPProofs")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredDPoPProofs'
+type DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredDPoPProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *DPoPProofRepositoryMock_Expecter) DeleteExpiredDPoPProofs(ctx interface{}, limit interface{}) *DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call {
+	return &DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call{Call: _e.mock.On("DeleteExpiredDPoPProofs", ctx, limit)}
+}
+
+func (_c *DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call) Run(run func(ctx context.Context, limit int)) *DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call) Return(n int64, err error) *DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call) RunAndReturn(run func(ctx context.Context, limit int) (int64, error)) *DPoPProofRepositoryMock_DeleteExpiredDPoPProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDPoPProof provides a mock function for the type DPoPProofRepositoryMock
+func (_mock *DPoPProofRepositoryMock) SaveDPoPProof(ctx context.Context, proofHash string, expiresAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, proofHash, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDPoPProof")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, proofHash, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, proofHash, expiresAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, proofHash, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DPoPProofRepositoryMock_SaveDPoPProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDPoPProof'
+type DPoPProofRepositoryMock_SaveDPoPProof_Call struct {
+	*mock.Call
+}
+
+// SaveDPoPProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proofHash string
+//   - expiresAt time.Time
+func (_e *DPoPProofRepositoryMock_Expecter) SaveDPoPProof(ctx interface{}, proofHash interface{}, expiresAt interface{}) *DPoPProofRepositoryMock_SaveDPoPProof_Call {
+	return &DPoPProofRepositoryMock_SaveDPoPProof_Call{Call: _e.mock.On("SaveDPoPProof", ctx, proofHash, expiresAt)}
+}
+
+func (_c *DPoPProofRepositoryMock_SaveDPoPProof_Call) Run(run func(ctx context.Context, proofHash string, expiresAt time.Time)) *DPoPProofRepositoryMock_SaveDPoPProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DPoPProofRepositoryMock_SaveDPoPProof_Call) Return(b bool, err error) *DPoPProofRepositoryMock_SaveDPoPProof_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *DPoPProofRepositoryMock_SaveDPoPProof_Call) RunAndReturn(run func(ctx context.Context, proofHash string, expiresAt time.Time) (bool, error)) *DPoPProofRepositoryMock_SaveDPoPProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,166 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewDPoPServiceMock creates a new instance of DPoPServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDPoPServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DPoPServiceMock {
+	mock := &DPoPServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// DPoPServiceMock is an autogenerated mock type for the DPoPService type
+type DPoPServiceMock struct {
+	mock.Mock
+}
+
+type DPoPServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DPoPServiceMock) EXPECT() *DPoPServiceMock_Expecter {
+	return &DPoPServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// Nonce provides a mock function for the type DPoPServiceMock
+func (_mock *DPoPServiceMock) Nonce() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Nonce")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// DPoPServiceMock_Nonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Nonce'
+type DPoPServiceMock_Nonce_Call struct {
+	*mock.Call
+}
+
+// Nonce is a helper method to define mock.On call
+func (_e *DPoPServiceMock_Expecter) Nonce() *DPoPServiceMock_Nonce_Call {
+	return &DPoPServiceMock_Nonce_Call{Call: _e.mock.On("Nonce")}
+}
+
+func (_c *DPoPServiceMock_Nonce_Call) Run(run func()) *DPoPServiceMock_Nonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DPoPServiceMock_Nonce_Call) Return(s string) *DPoPServiceMock_Nonce_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *DPoPServiceMock_Nonce_Call) RunAndReturn(run func() string) *DPoPServiceMock_Nonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyProof provides a mock function for the type DPoPServiceMock
+func (_mock *DPoPServiceMock) VerifyProof(ctx context.Context, proof string, method string, url string, accessToken string) (string, error) {
+	ret := _mock.Called(ctx, proof, method, url, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyProof")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, proof, method, url, accessToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = returnFunc(ctx, proof, method, url, accessToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, proof, method, url, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DPoPServiceMock_VerifyProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyProof'
+type DPoPServiceMock_VerifyProof_Call struct {
+	*mock.Call
+}
+
+// VerifyProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proof string
+//   - method string
+//   - url string
+//   - accessToken string
+func (_e *DPoPServiceMock_Expecter) VerifyProof(ctx interface{}, proof interface{}, method interface{}, url interface{}, accessToken interface{}) *DPoPServiceMock_VerifyProof_Call {
+	return &DPoPServiceMock_VerifyProof_Call{Call: _e.mock.On("VerifyProof", ctx, proof, method, url, accessToken)}
+}
+
+func (_c *DPoPServiceMock_VerifyProof_Call) Run(run func(ctx context.Context, proof string, method string, url string, accessToken string)) *DPoPServiceMock_VerifyProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *DPoPServiceMock_VerifyProof_Call) Return(s string, err error) *DPoPServiceMock_VerifyProof_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *DPoPServiceMock_VerifyProof_Call) RunAndReturn(run func(ctx context.Context, proof string, method string, url string, accessToken string) (string, error)) *DPoPServiceMock_VerifyProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,258 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewEmailChangeRepositoryMock creates a new instance of EmailChangeRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailChangeRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailChangeRepositoryMock {
+	mock := &EmailChangeRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// EmailChangeRepositoryMock is an autogenerated mock type for the EmailChangeRepository type
+type EmailChangeRepositoryMock struct {
+	mock.Mock
+}
+
+type EmailChangeRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmailChangeRepositoryMock) EXPECT() *EmailChangeRepositoryMock_Expecter {
+	return &EmailChangeRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CancelEmailChange provides a mock function for the type EmailChangeRepositoryMock
+func (_mock *EmailChangeRepositoryMock) CancelEmailChange(ctx context.Context, cancelTokenHash string) (*domain.EmailChange, error) {
+	ret := _mock.Called(ctx, cancelTokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelEmailChange")
+	}
+
+	var r0 *domain.EmailChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EmailChange, error)); ok {
+		return returnFunc(ctx, cancelTokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EmailChange); ok {
+		r0 = returnFunc(ctx, cancelTokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EmailChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, cancelTokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EmailChangeRepositoryMock_CancelEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelEmailChange'
+type EmailChangeRepositoryMock_CancelEmailChange_Call struct {
+	*mock.Call
+}
+
+// CancelEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cancelTokenHash string
+func (_e *EmailChangeRepositoryMock_Expecter) CancelEmailChange(ctx interface{}, cancelTokenHash interface{}) *EmailChangeRepositoryMock_CancelEmailChange_Call {
+	return &EmailChangeRepositoryMock_CancelEmailChange_Call{Call: _e.mock.On("CancelEmailChange", ctx, cancelTokenHash)}
+}
+
+func (_c *EmailChangeRepositoryMock_CancelEmailChange_Call) Run(run func(ctx context.Context, cancelTokenHash string)) *EmailChangeRepositoryMock_CancelEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EmailChangeRepositoryMock_CancelEmailChange_Call) Return(emailChange *domain.EmailChange, err error) *EmailChangeRepositoryMock_CancelEmailChange_Call {
+	_c.Call.Return(emailChange, err)
+	return _c
+}
+
+func (_c *EmailChangeRepositoryMock_CancelEmailChange_Call) RunAndReturn(run func(ctx context.Context, cancelTokenHash string) (*domain.EmailChange, error)) *EmailChangeRepositoryMock_CancelEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeEmailChange provides a mock function for the type EmailChangeRepositoryMock
+func (_mock *EmailChangeRepositoryMock) ConsumeEmailChange(ctx context.Context, confirmTokenHash string) (*domain.EmailChange, error) {
+	ret := _mock.Called(ctx, confirmTokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeEmailChange")
+	}
+
+	var r0 *domain.EmailChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.EmailChange, error)); ok {
+		return returnFunc(ctx, confirmTokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.EmailChange); ok {
+		r0 = returnFunc(ctx, confirmTokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EmailChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, confirmTokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EmailChangeRepositoryMock_ConsumeEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeEmailChange'
+type EmailChangeRepositoryMock_ConsumeEmailChange_Call struct {
+	*mock.Call
+}
+
+// ConsumeEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - confirmTokenHash string
+func (_e *EmailChangeRepositoryMock_Expecter) ConsumeEmailChange(ctx interface{}, confirmTokenHash interface{}) *EmailChangeRepositoryMock_ConsumeEmailChange_Call {
+	return &EmailChangeRepositoryMock_ConsumeEmailChange_Call{Call: _e.mock.On("ConsumeEmailChange", ctx, confirmTokenHash)}
+}
+
+func (_c *EmailChangeRepositoryMock_ConsumeEmailChange_Call) Run(run func(ctx context.Context, confirmTokenHash string)) *EmailChangeRepositoryMock_ConsumeEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EmailChangeRepositoryMock_ConsumeEmailChange_Call) Return(emailChange *domain.EmailChange, err error) *EmailChangeRepositoryMock_ConsumeEmailChange_Call {
+	_c.Call.Return(emailChange, err)
+	return _c
+}
+
+func (_c *EmailChangeRepositoryMock_ConsumeEmailChange_Call) RunAndReturn(run func(ctx context.Context, confirmTokenHash string) (*domain.EmailChange, error)) *EmailChangeRepositoryMock_ConsumeEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveEmailChange provides a mock function for the type EmailChangeRepositoryMock
+func (_mock *EmailChangeRepositoryMock) SaveEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, confirmTokenHash string, cancelTokenHash string, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, userID, newEmail, confirmTokenHash, cancelTokenHash, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEmailChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, newEmail, confirmTokenHash, cancelTokenHash, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EmailChangeRepositoryMock_SaveEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveEmailChange'
+type EmailChangeRepositoryMock_SaveEmailChange_Call struct {
+	*mock.Call
+}
+
+// SaveEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - newEmail string
+//   - confirmTokenHash string
+//   - cancelTokenHash string
+//   - expiresAt time.Time
+func (_e *EmailChangeRepositoryMock_Expecter) SaveEmailChange(ctx interface{}, userID interface{}, newEmail interface{}, confirmTokenHash interface{}, cancelTokenHash interface{}, expiresAt interface{}) *EmailChangeRepositoryMock_SaveEmailChange_Call {
+	return &EmailChangeRepositoryMock_SaveEmailChange_Call{Call: _e.mock.On("SaveEmailChange", ctx, userID, newEmail, confirmTokenHash, cancelTokenHash, expiresAt)}
+}
+
+func (_c *EmailChangeRepositoryMock_SaveEmailChange_Call) Run(run func(ctx context.Context, userID uuid.UUID, newEmail string, confirmTokenHash string, cancelTokenHash string, expiresAt time.Time)) *EmailChangeRepositoryMock_SaveEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *EmailChangeRepositoryMock_SaveEmailChange_Call) Return(err error) *EmailChangeRepositoryMock_SaveEmailChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EmailChangeRepositoryMock_SaveEmailChange_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, newEmail string, confirmTokenHash string, cancelTokenHash string, expiresAt time.Time) error) *EmailChangeRepositoryMock_SaveEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
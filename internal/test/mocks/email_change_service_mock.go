@@ -0,0 +1,234 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewEmailChangeServiceMock creates a new instance of EmailChangeServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailChangeServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailChangeServiceMock {
+	mock := &EmailChangeServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// EmailChangeServiceMock is an autogenerated mock type for the EmailChangeService type
+type EmailChangeServiceMock struct {
+	mock.Mock
+}
+
+type EmailChangeServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmailChangeServiceMock) EXPECT() *EmailChangeServiceMock_Expecter {
+	return &EmailChangeServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// CancelEmailChange provides a mock function for the type EmailChangeServiceMock
+func (_mock *EmailChangeServiceMock) CancelEmailChange(ctx context.Context, token string) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelEmailChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EmailChangeServiceMock_CancelEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelEmailChange'
+type EmailChangeServiceMock_CancelEmailChange_Call struct {
+	*mock.Call
+}
+
+// CancelEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *EmailChangeServiceMock_Expecter) CancelEmailChange(ctx interface{}, token interface{}) *EmailChangeServiceMock_CancelEmailChange_Call {
+	return &EmailChangeServiceMock_CancelEmailChange_Call{Call: _e.mock.On("CancelEmailChange", ctx, token)}
+}
+
+func (_c *EmailChangeServiceMock_CancelEmailChange_Call) Run(run func(ctx context.Context, token string)) *EmailChangeServiceMock_CancelEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EmailChangeServiceMock_CancelEmailChange_Call) Return(err error) *EmailChangeServiceMock_CancelEmailChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EmailChangeServiceMock_CancelEmailChange_Call) RunAndReturn(run func(ctx context.Context, token string) error) *EmailChangeServiceMock_CancelEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmEmailChange provides a mock function for the type EmailChangeServiceMock
+func (_mock *EmailChangeServiceMock) ConfirmEmailChange(ctx context.Context, token string) (*domain.User, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmEmailChange")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EmailChangeServiceMock_ConfirmEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmailChange'
+type EmailChangeServiceMock_ConfirmEmailChange_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *EmailChangeServiceMock_Expecter) ConfirmEmailChange(ctx interface{}, token interface{}) *EmailChangeServiceMock_ConfirmEmailChange_Call {
+	return &EmailChangeServiceMock_ConfirmEmailChange_Call{Call: _e.mock.On("ConfirmEmailChange", ctx, token)}
+}
+
+func (_c *EmailChangeServiceMock_ConfirmEmailChange_Call) Run(run func(ctx context.Context, token string)) *EmailChangeServiceMock_ConfirmEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EmailChangeServiceMock_ConfirmEmailChange_Call) Return(user *domain.User, err error) *EmailChangeServiceMock_ConfirmEmailChange_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *EmailChangeServiceMock_ConfirmEmailChange_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.User, error)) *EmailChangeServiceMock_ConfirmEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestEmailChange provides a mock function for the type EmailChangeServiceMock
+func (_mock *EmailChangeServiceMock) RequestEmailChange(ctx context.Context, userID uuid.UUID, newEmail string, password string) error {
+	ret := _mock.Called(ctx, userID, newEmail, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestEmailChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, newEmail, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EmailChangeServiceMock_RequestEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestEmailChange'
+type EmailChangeServiceMock_RequestEmailChange_Call struct {
+	*mock.Call
+}
+
+// RequestEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - newEmail string
+//   - password string
+func (_e *EmailChangeServiceMock_Expecter) RequestEmailChange(ctx interface{}, userID interface{}, newEmail interface{}, password interface{}) *EmailChangeServiceMock_RequestEmailChange_Call {
+	return &EmailChangeServiceMock_RequestEmailChange_Call{Call: _e.mock.On("RequestEmailChange", ctx, userID, newEmail, password)}
+}
+
+func (_c *EmailChangeServiceMock_RequestEmailChange_Call) Run(run func(ctx context.Context, userID uuid.UUID, newEmail string, password string)) *EmailChangeServiceMock_RequestEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *EmailChangeServiceMock_RequestEmailChange_Call) Return(err error) *EmailChangeServiceMock_RequestEmailChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EmailChangeServiceMock_RequestEmailChange_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, newEmail string, password string) error) *EmailChangeServiceMock_RequestEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,140 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewEventSinkMock creates a new instance of EventSinkMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventSinkMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventSinkMock {
+	mock := &EventSinkMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// EventSinkMock is an autogenerated mock type for the EventSink type
+type EventSinkMock struct {
+	mock.Mock
+}
+
+type EventSinkMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventSinkMock) EXPECT() *EventSinkMock_Expecter {
+	return &EventSinkMock_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function for the type EventSinkMock
+func (_mock *EventSinkMock) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// EventSinkMock_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type EventSinkMock_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *EventSinkMock_Expecter) Name() *EventSinkMock_Name_Call {
+	return &EventSinkMock_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *EventSinkMock_Name_Call) Run(run func()) *EventSinkMock_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EventSinkMock_Name_Call) Return(s string) *EventSinkMock_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *EventSinkMock_Name_Call) RunAndReturn(run func() string) *EventSinkMock_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type EventSinkMock
+func (_mock *EventSinkMock) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.OutboxEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EventSinkMock_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type EventSinkMock_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.OutboxEvent
+func (_e *EventSinkMock_Expecter) Publish(ctx interface{}, event interface{}) *EventSinkMock_Publish_Call {
+	return &EventSinkMock_Publish_Call{Call: _e.mock.On("Publish", ctx, event)}
+}
+
+func (_c *EventSinkMock_Publish_Call) Run(run func(ctx context.Context, event domain.OutboxEvent)) *EventSinkMock_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.OutboxEvent
+		if args[1] != nil {
+			arg1 = args[1].(domain.OutboxEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EventSinkMock_Publish_Call) Return(err error) *EventSinkMock_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EventSinkMock_Publish_Call) RunAndReturn(run func(ctx context.Context, event domain.OutboxEvent) error) *EventSinkMock_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
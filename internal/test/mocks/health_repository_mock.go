@@ -0,0 +1,155 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewHealthRepositoryMock creates a new instance of HealthRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthRepositoryMock {
+	mock := &HealthRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// HealthRepositoryMock is an autogenerated mock type for the HealthRepository type
+type HealthRepositoryMock struct {
+	mock.Mock
+}
+
+type HealthRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthRepositoryMock) EXPECT() *HealthRepositoryMock_Expecter {
+	return &HealthRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type HealthRepositoryMock
+func (_mock *HealthRepositoryMock) Ping(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// HealthRepositoryMock_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type HealthRepositoryMock_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthRepositoryMock_Expecter) Ping(ctx interface{}) *HealthRepositoryMock_Ping_Call {
+	return &HealthRepositoryMock_Ping_Call{Call: _e.mock.On("Ping", ctx)}
+}
+
+func (_c *HealthRepositoryMock_Ping_Call) Run(run func(ctx context.Context)) *HealthRepositoryMock_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *HealthRepositoryMock_Ping_Call) Return(err error) *HealthRepositoryMock_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *HealthRepositoryMock_Ping_Call) RunAndReturn(run func(ctx context.Context) error) *HealthRepositoryMock_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SchemaVersion provides a mock function for the type HealthRepositoryMock
+func (_mock *HealthRepositoryMock) SchemaVersion(ctx context.Context) (int64, bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchemaVersion")
+	}
+
+	var r0 int64
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = returnFunc(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// HealthRepositoryMock_SchemaVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchemaVersion'
+type HealthRepositoryMock_SchemaVersion_Call struct {
+	*mock.Call
+}
+
+// SchemaVersion is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthRepositoryMock_Expecter) SchemaVersion(ctx interface{}) *HealthRepositoryMock_SchemaVersion_Call {
+	return &HealthRepositoryMock_SchemaVersion_Call{Call: _e.mock.On("SchemaVersion", ctx)}
+}
+
+func (_c *HealthRepositoryMock_SchemaVersion_Call) Run(run func(ctx context.Context)) *HealthRepositoryMock_SchemaVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *HealthRepositoryMock_SchemaVersion_Call) Return(version int64, dirty bool, err error) *HealthRepositoryMock_SchemaVersion_Call {
+	_c.Call.Return(version, dirty, err)
+	return _c
+}
+
+func (_c *HealthRepositoryMock_SchemaVersion_Call) RunAndReturn(run func(ctx context.Context) (int64, bool, error)) *HealthRepositoryMock_SchemaVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
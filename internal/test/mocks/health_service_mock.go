@@ -0,0 +1,123 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewHealthServiceMock creates a new instance of HealthServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthServiceMock {
+	mock := &HealthServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// HealthServiceMock is an autogenerated mock type for the HealthService type
+type HealthServiceMock struct {
+	mock.Mock
+}
+
+type HealthServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthServiceMock) EXPECT() *HealthServiceMock_Expecter {
+	return &HealthServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// Drain provides a mock function for the type HealthServiceMock
+func (_mock *HealthServiceMock) Drain() {
+	_mock.Called()
+	return
+}
+
+// HealthServiceMock_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type HealthServiceMock_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+func (_e *HealthServiceMock_Expecter) Drain() *HealthServiceMock_Drain_Call {
+	return &HealthServiceMock_Drain_Call{Call: _e.mock.On("Drain")}
+}
+
+func (_c *HealthServiceMock_Drain_Call) Run(run func()) *HealthServiceMock_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthServiceMock_Drain_Call) Return() *HealthServiceMock_Drain_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HealthServiceMock_Drain_Call) RunAndReturn(run func()) *HealthServiceMock_Drain_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Readiness provides a mock function for the type HealthServiceMock
+func (_mock *HealthServiceMock) Readiness(ctx context.Context) domain.HealthReport {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Readiness")
+	}
+
+	var r0 domain.HealthReport
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.HealthReport); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.HealthReport)
+	}
+	return r0
+}
+
+// HealthServiceMock_Readiness_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Readiness'
+type HealthServiceMock_Readiness_Call struct {
+	*mock.Call
+}
+
+// Readiness is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthServiceMock_Expecter) Readiness(ctx interface{}) *HealthServiceMock_Readiness_Call {
+	return &HealthServiceMock_Readiness_Call{Call: _e.mock.On("Readiness", ctx)}
+}
+
+func (_c *HealthServiceMock_Readiness_Call) Run(run func(ctx context.Context)) *HealthServiceMock_Readiness_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *HealthServiceMock_Readiness_Call) Return(healthReport domain.HealthReport) *HealthServiceMock_Readiness_Call {
+	_c.Call.Return(healthReport)
+	return _c
+}
+
+func (_c *HealthServiceMock_Readiness_Call) RunAndReturn(run func(ctx context.Context) domain.HealthReport) *HealthServiceMock_Readiness_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,110 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewLockerMock creates a new instance of LockerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLockerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LockerMock {
+	mock := &LockerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// LockerMock is an autogenerated mock type for the Locker type
+type LockerMock struct {
+	mock.Mock
+}
+
+type LockerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LockerMock) EXPECT() *LockerMock_Expecter {
+	return &LockerMock_Expecter{mock: &_m.Mock}
+}
+
+// TryWithLock provides a mock function for the type LockerMock
+func (_mock *LockerMock) TryWithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	ret := _mock.Called(ctx, key, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryWithLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, func(ctx context.Context) error) (bool, error)); ok {
+		return returnFunc(ctx, key, fn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, func(ctx context.Context) error) bool); ok {
+		r0 = returnFunc(ctx, key, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, func(ctx context.Context) error) error); ok {
+		r1 = returnFunc(ctx, key, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// LockerMock_TryWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryWithLock'
+type LockerMock_TryWithLock_Call struct {
+	*mock.Call
+}
+
+// TryWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key int64
+//   - fn func(ctx context.Context) error
+func (_e *LockerMock_Expecter) TryWithLock(ctx interface{}, key interface{}, fn interface{}) *LockerMock_TryWithLock_Call {
+	return &LockerMock_TryWithLock_Call{Call: _e.mock.On("TryWithLock", ctx, key, fn)}
+}
+
+func (_c *LockerMock_TryWithLock_Call) Run(run func(ctx context.Context, key int64, fn func(ctx context.Context) error)) *LockerMock_TryWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 func(ctx context.Context) error
+		if args[2] != nil {
+			arg2 = args[2].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *LockerMock_TryWithLock_Call) Return(acquired bool, err error) *LockerMock_TryWithLock_Call {
+	_c.Call.Return(acquired, err)
+	return _c
+}
+
+func (_c *LockerMock_TryWithLock_Call) RunAndReturn(run func(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error)) *LockerMock_TryWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
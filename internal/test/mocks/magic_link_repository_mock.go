@@ -0,0 +1,262 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewMagicLinkRepositoryMock creates a new instance of MagicLinkRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMagicLinkRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MagicLinkRepositoryMock {
+	mock := &MagicLinkRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MagicLinkRepositoryMock is an autogenerated mock type for the MagicLinkRepository type
+type MagicLinkRepositoryMock struct {
+	mock.Mock
+}
+
+type MagicLinkRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MagicLinkRepositoryMock) EXPECT() *MagicLinkRepositoryMock_Expecter {
+	return &MagicLinkRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeMagicLink provides a mock function for the type MagicLinkRepositoryMock
+func (_mock *MagicLinkRepositoryMock) ConsumeMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeMagicLink")
+	}
+
+	var r0 *domain.MagicLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.MagicLink, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.MagicLink); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MagicLink)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MagicLinkRepositoryMock_ConsumeMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeMagicLink'
+type MagicLinkRepositoryMock_ConsumeMagicLink_Call struct {
+	*mock.Call
+}
+
+// ConsumeMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MagicLinkRepositoryMock_Expecter) ConsumeMagicLink(ctx interface{}, tokenHash interface{}) *MagicLinkRepositoryMock_ConsumeMagicLink_Call {
+	return &MagicLinkRepositoryMock_ConsumeMagicLink_Call{Call: _e.mock.On("ConsumeMagicLink", ctx, tokenHash)}
+}
+
+func (_c *MagicLinkRepositoryMock_ConsumeMagicLink_Call) Run(run func(ctx context.Context, tokenHash string)) *MagicLinkRepositoryMock_ConsumeMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MagicLinkRepositoryMock_ConsumeMagicLink_Call) Return(magicLink *domain.MagicLink, err error) *MagicLinkRepositoryMock_ConsumeMagicLink_Call {
+	_c.Call.Return(magicLink, err)
+	return _c
+}
+
+func (_c *MagicLinkRepositoryMock_ConsumeMagicLink_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.MagicLink, error)) *MagicLinkRepositoryMock_ConsumeMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountMagicLinkRequest provides a mock function for the type MagicLinkRepositoryMock
+func (_mock *MagicLinkRepositoryMock) CountMagicLinkRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error) {
+	ret := _mock.Called(ctx, userID, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountMagicLinkRequest")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) (int, error)); ok {
+		return returnFunc(ctx, userID, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) int); ok {
+		r0 = returnFunc(ctx, userID, window)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration) error); ok {
+		r1 = returnFunc(ctx, userID, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MagicLinkRepositoryMock_CountMagicLinkRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountMagicLinkRequest'
+type MagicLinkRepositoryMock_CountMagicLinkRequest_Call struct {
+	*mock.Call
+}
+
+// CountMagicLinkRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - window time.Duration
+func (_e *MagicLinkRepositoryMock_Expecter) CountMagicLinkRequest(ctx interface{}, userID interface{}, window interface{}) *MagicLinkRepositoryMock_CountMagicLinkRequest_Call {
+	return &MagicLinkRepositoryMock_CountMagicLinkRequest_Call{Call: _e.mock.On("CountMagicLinkRequest", ctx, userID, window)}
+}
+
+func (_c *MagicLinkRepositoryMock_CountMagicLinkRequest_Call) Run(run func(ctx context.Context, userID uuid.UUID, window time.Duration)) *MagicLinkRepositoryMock_CountMagicLinkRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MagicLinkRepositoryMock_CountMagicLinkRequest_Call) Return(n int, err error) *MagicLinkRepositoryMock_CountMagicLinkRequest_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MagicLinkRepositoryMock_CountMagicLinkRequest_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error)) *MagicLinkRepositoryMock_CountMagicLinkRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMagicLink provides a mock function for the type MagicLinkRepositoryMock
+func (_mock *MagicLinkRepositoryMock) SaveMagicLink(ctx context.Context, userID uuid.UUID, tokenHash string, bindingHash string, rememberMe bool, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, userID, tokenHash, bindingHash, rememberMe, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMagicLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, bool, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, tokenHash, bindingHash, rememberMe, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MagicLinkRepositoryMock_SaveMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMagicLink'
+type MagicLinkRepositoryMock_SaveMagicLink_Call struct {
+	*mock.Call
+}
+
+// SaveMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - tokenHash string
+//   - bindingHash string
+//   - rememberMe bool
+//   - expiresAt time.Time
+func (_e *MagicLinkRepositoryMock_Expecter) SaveMagicLink(ctx interface{}, userID interface{}, tokenHash interface{}, bindingHash interface{}, rememberMe interface{}, expiresAt interface{}) *MagicLinkRepositoryMock_SaveMagicLink_Call {
+	return &MagicLinkRepositoryMock_SaveMagicLink_Call{Call: _e.mock.On("SaveMagicLink", ctx, userID, tokenHash, bindingHash, rememberMe, expiresAt)}
+}
+
+func (_c *MagicLinkRepositoryMock_SaveMagicLink_Call) Run(run func(ctx context.Context, userID uuid.UUID, tokenHash string, bindingHash string, rememberMe bool, expiresAt time.Time)) *MagicLinkRepositoryMock_SaveMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MagicLinkRepositoryMock_SaveMagicLink_Call) Return(err error) *MagicLinkRepositoryMock_SaveMagicLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MagicLinkRepositoryMock_SaveMagicLink_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, tokenHash string, bindingHash string, rememberMe bool, expiresAt time.Time) error) *MagicLinkRepositoryMock_SaveMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,185 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewMagicLinkServiceMock creates a new instance of MagicLinkServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMagicLinkServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MagicLinkServiceMock {
+	mock := &MagicLinkServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MagicLinkServiceMock is an autogenerated mock type for the MagicLinkService type
+type MagicLinkServiceMock struct {
+	mock.Mock
+}
+
+type MagicLinkServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MagicLinkServiceMock) EXPECT() *MagicLinkServiceMock_Expecter {
+	return &MagicLinkServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// LoginWithMagicLink provides a mock function for the type MagicLinkServiceMock
+func (_mock *MagicLinkServiceMock) LoginWithMagicLink(ctx context.Context, token string, binding string) (*domain.Tokens, error) {
+	ret := _mock.Called(ctx, token, binding)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginWithMagicLink")
+	}
+
+	var r0 *domain.Tokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Tokens, error)); ok {
+		return returnFunc(ctx, token, binding)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Tokens); ok {
+		r0 = returnFunc(ctx, token, binding)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tokens)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, token, binding)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MagicLinkServiceMock_LoginWithMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginWithMagicLink'
+type MagicLinkServiceMock_LoginWithMagicLink_Call struct {
+	*mock.Call
+}
+
+// LoginWithMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - binding string
+func (_e *MagicLinkServiceMock_Expecter) LoginWithMagicLink(ctx interface{}, token interface{}, binding interface{}) *MagicLinkServiceMock_LoginWithMagicLink_Call {
+	return &MagicLinkServiceMock_LoginWithMagicLink_Call{Call: _e.mock.On("LoginWithMagicLink", ctx, token, binding)}
+}
+
+func (_c *MagicLinkServiceMock_LoginWithMagicLink_Call) Run(run func(ctx context.Context, token string, binding string)) *MagicLinkServiceMock_LoginWithMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MagicLinkServiceMock_LoginWithMagicLink_Call) Return(tokens *domain.Tokens, err error) *MagicLinkServiceMock_LoginWithMagicLink_Call {
+	_c.Call.Return(tokens, err)
+	return _c
+}
+
+func (_c *MagicLinkServiceMock_LoginWithMagicLink_Call) RunAndReturn(run func(ctx context.Context, token string, binding string) (*domain.Tokens, error)) *MagicLinkServiceMock_LoginWithMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestMagicLink provides a mock function for the type MagicLinkServiceMock
+func (_mock *MagicLinkServiceMock) RequestMagicLink(ctx context.Context, email string, rememberMe bool) (string, error) {
+	ret := _mock.Called(ctx, email, rememberMe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestMagicLink")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) (string, error)); ok {
+		return returnFunc(ctx, email, rememberMe)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) string); ok {
+		r0 = returnFunc(ctx, email, rememberMe)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = returnFunc(ctx, email, rememberMe)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MagicLinkServiceMock_RequestMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestMagicLink'
+type MagicLinkServiceMock_RequestMagicLink_Call struct {
+	*mock.Call
+}
+
+// RequestMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - rememberMe bool
+func (_e *MagicLinkServiceMock_Expecter) RequestMagicLink(ctx interface{}, email interface{}, rememberMe interface{}) *MagicLinkServiceMock_RequestMagicLink_Call {
+	return &MagicLinkServiceMock_RequestMagicLink_Call{Call: _e.mock.On("RequestMagicLink", ctx, email, rememberMe)}
+}
+
+func (_c *MagicLinkServiceMock_RequestMagicLink_Call) Run(run func(ctx context.Context, email string, rememberMe bool)) *MagicLinkServiceMock_RequestMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MagicLinkServiceMock_RequestMagicLink_Call) Return(s string, err error) *MagicLinkServiceMock_RequestMagicLink_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MagicLinkServiceMock_RequestMagicLink_Call) RunAndReturn(run func(ctx context.Context, email string, rememberMe bool) (string, error)) *MagicLinkServiceMock_RequestMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
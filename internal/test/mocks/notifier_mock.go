@@ -0,0 +1,140 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewNotifierMock creates a new instance of NotifierMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotifierMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotifierMock {
+	mock := &NotifierMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// NotifierMock is an autogenerated mock type for the Notifier type
+type NotifierMock struct {
+	mock.Mock
+}
+
+type NotifierMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotifierMock) EXPECT() *NotifierMock_Expecter {
+	return &NotifierMock_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function for the type NotifierMock
+func (_mock *NotifierMock) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// NotifierMock_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type NotifierMock_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *NotifierMock_Expecter) Name() *NotifierMock_Name_Call {
+	return &NotifierMock_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *NotifierMock_Name_Call) Run(run func()) *NotifierMock_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *NotifierMock_Name_Call) Return(s string) *NotifierMock_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *NotifierMock_Name_Call) RunAndReturn(run func() string) *NotifierMock_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Notify provides a mock function for the type NotifierMock
+func (_mock *NotifierMock) Notify(ctx context.Context, msg domain.Message) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Message) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotifierMock_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type NotifierMock_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg domain.Message
+func (_e *NotifierMock_Expecter) Notify(ctx interface{}, msg interface{}) *NotifierMock_Notify_Call {
+	return &NotifierMock_Notify_Call{Call: _e.mock.On("Notify", ctx, msg)}
+}
+
+func (_c *NotifierMock_Notify_Call) Run(run func(ctx context.Context, msg domain.Message)) *NotifierMock_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Message
+		if args[1] != nil {
+			arg1 = args[1].(domain.Message)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotifierMock_Notify_Call) Return(err error) *NotifierMock_Notify_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotifierMock_Notify_Call) RunAndReturn(run func(ctx context.Context, msg domain.Message) error) *NotifierMock_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,393 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewOTPRepositoryMock creates a new instance of OTPRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOTPRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OTPRepositoryMock {
+	mock := &OTPRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// OTPRepositoryMock is an autogenerated mock type for the OTPRepository type
+type OTPRepositoryMock struct {
+	mock.Mock
+}
+
+type OTPRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OTPRepositoryMock) EXPECT() *OTPRepositoryMock_Expecter {
+	return &OTPRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// AttemptOTP provides a mock function for the type OTPRepositoryMock
+func (_mock *OTPRepositoryMock) AttemptOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttemptOTP")
+	}
+
+	var r0 *domain.OTP
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.OTP, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.OTP); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OTP)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OTPRepositoryMock_AttemptOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttemptOTP'
+type OTPRepositoryMock_AttemptOTP_Call struct {
+	*mock.Call
+}
+
+// AttemptOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *OTPRepositoryMock_Expecter) AttemptOTP(ctx interface{}, userID interface{}) *OTPRepositoryMock_AttemptOTP_Call {
+	return &OTPRepositoryMock_AttemptOTP_Call{Call: _e.mock.On("AttemptOTP", ctx, userID)}
+}
+
+func (_c *OTPRepositoryMock_AttemptOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *OTPRepositoryMock_AttemptOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OTPRepositoryMock_AttemptOTP_Call) Return(oTP *domain.OTP, err error) *OTPRepositoryMock_AttemptOTP_Call {
+	_c.Call.Return(oTP, err)
+	return _c
+}
+
+func (_c *OTPRepositoryMock_AttemptOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*domain.OTP, error)) *OTPRepositoryMock_AttemptOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOTPRequest provides a mock function for the type OTPRepositoryMock
+func (_mock *OTPRepositoryMock) CountOTPRequest(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error) {
+	ret := _mock.Called(ctx, userID, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOTPRequest")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) (int, error)); ok {
+		return returnFunc(ctx, userID, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) int); ok {
+		r0 = returnFunc(ctx, userID, window)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration) error); ok {
+		r1 = returnFunc(ctx, userID, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OTPRepositoryMock_CountOTPRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOTPRequest'
+type OTPRepositoryMock_CountOTPRequest_Call struct {
+	*mock.Call
+}
+
+// CountOTPRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - window time.Duration
+func (_e *OTPRepositoryMock_Expecter) CountOTPRequest(ctx interface{}, userID interface{}, window interface{}) *OTPRepositoryMock_CountOTPRequest_Call {
+	return &OTPRepositoryMock_CountOTPRequest_Call{Call: _e.mock.On("CountOTPRequest", ctx, userID, window)}
+}
+
+func (_c *OTPRepositoryMock_CountOTPRequest_Call) Run(run func(ctx context.Context, userID uuid.UUID, window time.Duration)) *OTPRepositoryMock_CountOTPRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *OTPRepositoryMock_CountOTPRequest_Call) Return(n int, err error) *OTPRepositoryMock_CountOTPRequest_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *OTPRepositoryMock_CountOTPRequest_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, window time.Duration) (int, error)) *OTPRepositoryMock_CountOTPRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOTP provides a mock function for the type OTPRepositoryMock
+func (_mock *OTPRepositoryMock) DeleteOTP(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	ret := _mock.Called(ctx, userID, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOTP")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, codeHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// OTPRepositoryMock_DeleteOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOTP'
+type OTPRepositoryMock_DeleteOTP_Call struct {
+	*mock.Call
+}
+
+// DeleteOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - codeHash string
+func (_e *OTPRepositoryMock_Expecter) DeleteOTP(ctx interface{}, userID interface{}, codeHash interface{}) *OTPRepositoryMock_DeleteOTP_Call {
+	return &OTPRepositoryMock_DeleteOTP_Call{Call: _e.mock.On("DeleteOTP", ctx, userID, codeHash)}
+}
+
+func (_c *OTPRepositoryMock_DeleteOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID, codeHash string)) *OTPRepositoryMock_DeleteOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *OTPRepositoryMock_DeleteOTP_Call) Return(err error) *OTPRepositoryMock_DeleteOTP_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *OTPRepositoryMock_DeleteOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, codeHash string) error) *OTPRepositoryMock_DeleteOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOTP provides a mock function for the type OTPRepositoryMock
+func (_mock *OTPRepositoryMock) GetOTP(ctx context.Context, userID uuid.UUID) (*domain.OTP, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOTP")
+	}
+
+	var r0 *domain.OTP
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.OTP, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.OTP); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OTP)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OTPRepositoryMock_GetOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOTP'
+type OTPRepositoryMock_GetOTP_Call struct {
+	*mock.Call
+}
+
+// GetOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *OTPRepositoryMock_Expecter) GetOTP(ctx interface{}, userID interface{}) *OTPRepositoryMock_GetOTP_Call {
+	return &OTPRepositoryMock_GetOTP_Call{Call: _e.mock.On("GetOTP", ctx, userID)}
+}
+
+func (_c *OTPRepositoryMock_GetOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *OTPRepositoryMock_GetOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OTPRepositoryMock_GetOTP_Call) Return(oTP *domain.OTP, err error) *OTPRepositoryMock_GetOTP_Call {
+	_c.Call.Return(oTP, err)
+	return _c
+}
+
+func (_c *OTPRepositoryMock_GetOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*domain.OTP, error)) *OTPRepositoryMock_GetOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveOTP provides a mock function for the type OTPRepositoryMock
+func (_mock *OTPRepositoryMock) SaveOTP(ctx context.Context, userID uuid.UUID, channel string, codeHash string, rememberMe bool, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, userID, channel, codeHash, rememberMe, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOTP")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, bool, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, channel, codeHash, rememberMe, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// OTPRepositoryMock_SaveOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveOTP'
+type OTPRepositoryMock_SaveOTP_Call struct {
+	*mock.Call
+}
+
+// SaveOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - channel string
+//   - codeHash string
+//   - rememberMe bool
+//   - expiresAt time.Time
+func (_e *OTPRepositoryMock_Expecter) SaveOTP(ctx interface{}, userID interface{}, channel interface{}, codeHash interface{}, rememberMe interface{}, expiresAt interface{}) *OTPRepositoryMock_SaveOTP_Call {
+	return &OTPRepositoryMock_SaveOTP_Call{Call: _e.mock.On("SaveOTP", ctx, userID, channel, codeHash, rememberMe, expiresAt)}
+}
+
+func (_c *OTPRepositoryMock_SaveOTP_Call) Run(run func(ctx context.Context, userID uuid.UUID, channel string, codeHash string, rememberMe bool, expiresAt time.Time)) *OTPRepositoryMock_SaveOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *OTPRepositoryMock_SaveOTP_Call) Return(err error) *OTPRepositoryMock_SaveOTP_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *OTPRepositoryMock_SaveOTP_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, channel string, codeHash string, rememberMe bool, expiresAt time.Time) error) *OTPRepositoryMock_SaveOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
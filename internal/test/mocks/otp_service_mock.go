@@ -0,0 +1,182 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewOTPServiceMock creates a new instance of OTPServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOTPServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OTPServiceMock {
+	mock := &OTPServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// OTPServiceMock is an autogenerated mock type for the OTPService type
+type OTPServiceMock struct {
+	mock.Mock
+}
+
+type OTPServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OTPServiceMock) EXPECT() *OTPServiceMock_Expecter {
+	return &OTPServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// LoginWithOTP provides a mock function for the type OTPServiceMock
+func (_mock *OTPServiceMock) LoginWithOTP(ctx context.Context, email string, code string) (*domain.Tokens, error) {
+	ret := _mock.Called(ctx, email, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginWithOTP")
+	}
+
+	var r0 *domain.Tokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Tokens, error)); ok {
+		return returnFunc(ctx, email, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Tokens); ok {
+		r0 = returnFunc(ctx, email, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tokens)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, email, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OTPServiceMock_LoginWithOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginWithOTP'
+type OTPServiceMock_LoginWithOTP_Call struct {
+	*mock.Call
+}
+
+// LoginWithOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - code string
+func (_e *OTPServiceMock_Expecter) LoginWithOTP(ctx interface{}, email interface{}, code interface{}) *OTPServiceMock_LoginWithOTP_Call {
+	return &OTPServiceMock_LoginWithOTP_Call{Call: _e.mock.On("LoginWithOTP", ctx, email, code)}
+}
+
+func (_c *OTPServiceMock_LoginWithOTP_Call) Run(run func(ctx context.Context, email string, code string)) *OTPServiceMock_LoginWithOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_LoginWithOTP_Call) Return(tokens *domain.Tokens, err error) *OTPServiceMock_LoginWithOTP_Call {
+	_c.Call.Return(tokens, err)
+	return _c
+}
+
+func (_c *OTPServiceMock_LoginWithOTP_Call) RunAndReturn(run func(ctx context.Context, email string, code string) (*domain.Tokens, error)) *OTPServiceMock_LoginWithOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestOTP provides a mock function for the type OTPServiceMock
+func (_mock *OTPServiceMock) RequestOTP(ctx context.Context, email string, channel string, rememberMe bool) error {
+	ret := _mock.Called(ctx, email, channel, rememberMe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestOTP")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = returnFunc(ctx, email, channel, rememberMe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// OTPServiceMock_RequestOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestOTP'
+type OTPServiceMock_RequestOTP_Call struct {
+	*mock.Call
+}
+
+// RequestOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - channel string
+//   - rememberMe bool
+func (_e *OTPServiceMock_Expecter) RequestOTP(ctx interface{}, email interface{}, channel interface{}, rememberMe interface{}) *OTPServiceMock_RequestOTP_Call {
+	return &OTPServiceMock_RequestOTP_Call{Call: _e.mock.On("RequestOTP", ctx, email, channel, rememberMe)}
+}
+
+func (_c *OTPServiceMock_RequestOTP_Call) Run(run func(ctx context.Context, email string, channel string, rememberMe bool)) *OTPServiceMock_RequestOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_RequestOTP_Call) Return(err error) *OTPServiceMock_RequestOTP_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *OTPServiceMock_RequestOTP_Call) RunAndReturn(run func(ctx context.Context, email string, channel string, rememberMe bool) error) *OTPServiceMock_RequestOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,448 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewOutboxRepositoryMock creates a new instance of OutboxRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepositoryMock {
+	mock := &OutboxRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// OutboxRepositoryMock is an autogenerated mock type for the OutboxRepository type
+type OutboxRepositoryMock struct {
+	mock.Mock
+}
+
+type OutboxRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxRepositoryMock) EXPECT() *OutboxRepositoryMock_Expecter {
+	return &OutboxRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ClaimOutboxEvents provides a mock function for the type OutboxRepositoryMock
+func (_mock *OutboxRepositoryMock) ClaimOutboxEvents(ctx context.Context, batchSize int, maxAttempts int, leaseUntil time.Time) ([]domain.OutboxEvent, error) {
+	ret := _mock.Called(ctx, batchSize, maxAttempts, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutboxEvents")
+	}
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, time.Time) ([]domain.OutboxEvent, error)); ok {
+		return returnFunc(ctx, batchSize, maxAttempts, leaseUntil)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, time.Time) []domain.OutboxEvent); ok {
+		r0 = returnFunc(ctx, batchSize, maxAttempts, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, time.Time) error); ok {
+		r1 = returnFunc(ctx, batchSize, maxAttempts, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OutboxRepositoryMock_ClaimOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimOutboxEvents'
+type OutboxRepositoryMock_ClaimOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ClaimOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchSize int
+//   - maxAttempts int
+//   - leaseUntil time.Time
+func (_e *OutboxRepositoryMock_Expecter) ClaimOutboxEvents(ctx interface{}, batchSize interface{}, maxAttempts interface{}, leaseUntil interface{}) *OutboxRepositoryMock_ClaimOutboxEvents_Call {
+	return &OutboxRepositoryMock_ClaimOutboxEvents_Call{Call: _e.mock.On("ClaimOutboxEvents", ctx, batchSize, maxAttempts, leaseUntil)}
+}
+
+func (_c *OutboxRepositoryMock_ClaimOutboxEvents_Call) Run(run func(ctx context.Context, batchSize int, maxAttempts int, leaseUntil time.Time)) *OutboxRepositoryMock_ClaimOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_ClaimOutboxEvents_Call) Return(outboxEvents []domain.OutboxEvent, err error) *OutboxRepositoryMock_ClaimOutboxEvents_Call {
+	_c.Call.Return(outboxEvents, err)
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_ClaimOutboxEvents_Call) RunAndReturn(run func(ctx context.Context, batchSize int, maxAttempts int, leaseUntil time.Time) ([]domain.OutboxEvent, error)) *OutboxRepositoryMock_ClaimOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOutboxEvent provides a mock function for the type OutboxRepositoryMock
+func (_mock *OutboxRepositoryMock) CreateOutboxEvent(ctx context.Context, aggregateID uuid.UUID, eventType string, payload []byte) error {
+	ret := _mock.Called(ctx, aggregateID, eventType, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOutboxEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []byte) error); ok {
+		r0 = returnFunc(ctx, aggregateID, eventType, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// OutboxRepositoryMock_CreateOutboxEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOutboxEvent'
+type OutboxRepositoryMock_CreateOutboxEvent_Call struct {
+	*mock.Call
+}
+
+// CreateOutboxEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aggregateID uuid.UUID
+//   - eventType string
+//   - payload []byte
+func (_e *OutboxRepositoryMock_Expecter) CreateOutboxEvent(ctx interface{}, aggregateID interface{}, eventType interface{}, payload interface{}) *OutboxRepositoryMock_CreateOutboxEvent_Call {
+	return &OutboxRepositoryMock_CreateOutboxEvent_Call{Call: _e.mock.On("CreateOutboxEvent", ctx, aggregateID, eventType, payload)}
+}
+
+func (_c *OutboxRepositoryMock_CreateOutboxEvent_Call) Run(run func(ctx context.Context, aggregateID uuid.UUID, eventType string, payload []byte)) *OutboxRepositoryMock_CreateOutboxEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []byte
+		if args[3] != nil {
+			arg3 = args[3].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_CreateOutboxEvent_Call) Return(err error) *OutboxRepositoryMock_CreateOutboxEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_CreateOutboxEvent_Call) RunAndReturn(run func(ctx context.Context, aggregateID uuid.UUID, eventType string, payload []byte) error) *OutboxRepositoryMock_CreateOutboxEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOutboxEventsByAggregate provides a mock function for the type OutboxRepositoryMock
+func (_mock *OutboxRepositoryMock) DeleteOutboxEventsByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, aggregateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOutboxEventsByAggregate")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, aggregateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, aggregateID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, aggregateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOutboxEventsByAggregate'
+type OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call struct {
+	*mock.Call
+}
+
+// DeleteOutboxEventsByAggregate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aggregateID uuid.UUID
+func (_e *OutboxRepositoryMock_Expecter) DeleteOutboxEventsByAggregate(ctx interface{}, aggregateID interface{}) *OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call {
+	return &OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call{Call: _e.mock.On("DeleteOutboxEventsByAggregate", ctx, aggregateID)}
+}
+
+func (_c *OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call) Run(run func(ctx context.Context, aggregateID uuid.UUID)) *OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call) Return(n int64, err error) *OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call) RunAndReturn(run func(ctx context.Context, aggregateID uuid.UUID) (int64, error)) *OutboxRepositoryMock_DeleteOutboxEventsByAggregate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePublishedOutboxEventsBefore provides a mock function for the type OutboxRepositoryMock
+func (_mock *OutboxRepositoryMock) DeletePublishedOutboxEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePublishedOutboxEventsBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePublishedOutboxEventsBefore'
+type OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call struct {
+	*mock.Call
+}
+
+// DeletePublishedOutboxEventsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *OutboxRepositoryMock_Expecter) DeletePublishedOutboxEventsBefore(ctx interface{}, before interface{}) *OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call {
+	return &OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call{Call: _e.mock.On("DeletePublishedOutboxEventsBefore", ctx, before)}
+}
+
+func (_c *OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call) Run(run func(ctx context.Context, before time.Time)) *OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call) Return(n int64, err error) *OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, error)) *OutboxRepositoryMock_DeletePublishedOutboxEventsBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventFailed provides a mock function for the type OutboxRepositoryMock
+func (_mock *OutboxRepositoryMock) MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error {
+	ret := _mock.Called(ctx, id, nextAttemptAt, lastError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, string) error); ok {
+		r0 = returnFunc(ctx, id, nextAttemptAt, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// OutboxRepositoryMock_MarkOutboxEventFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventFailed'
+type OutboxRepositoryMock_MarkOutboxEventFailed_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - nextAttemptAt time.Time
+//   - lastError string
+func (_e *OutboxRepositoryMock_Expecter) MarkOutboxEventFailed(ctx interface{}, id interface{}, nextAttemptAt interface{}, lastError interface{}) *OutboxRepositoryMock_MarkOutboxEventFailed_Call {
+	return &OutboxRepositoryMock_MarkOutboxEventFailed_Call{Call: _e.mock.On("MarkOutboxEventFailed", ctx, id, nextAttemptAt, lastError)}
+}
+
+func (_c *OutboxRepositoryMock_MarkOutboxEventFailed_Call) Run(run func(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string)) *OutboxRepositoryMock_MarkOutboxEventFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_MarkOutboxEventFailed_Call) Return(err error) *OutboxRepositoryMock_MarkOutboxEventFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_MarkOutboxEventFailed_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error) *OutboxRepositoryMock_MarkOutboxEventFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventPublished provides a mock function for the type OutboxRepositoryMock
+func (_mock *OutboxRepositoryMock) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventPublished")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// OutboxRepositoryMock_MarkOutboxEventPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventPublished'
+type OutboxRepositoryMock_MarkOutboxEventPublished_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventPublished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *OutboxRepositoryMock_Expecter) MarkOutboxEventPublished(ctx interface{}, id interface{}) *OutboxRepositoryMock_MarkOutboxEventPublished_Call {
+	return &OutboxRepositoryMock_MarkOutboxEventPublished_Call{Call: _e.mock.On("MarkOutboxEventPublished", ctx, id)}
+}
+
+func (_c *OutboxRepositoryMock_MarkOutboxEventPublished_Call) Run(run func(ctx context.Context, id uuid.UUID)) *OutboxRepositoryMock_MarkOutboxEventPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_MarkOutboxEventPublished_Call) Return(err error) *OutboxRepositoryMock_MarkOutboxEventPublished_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *OutboxRepositoryMock_MarkOutboxEventPublished_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *OutboxRepositoryMock_MarkOutboxEventPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
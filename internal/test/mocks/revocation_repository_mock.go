@@ -0,0 +1,322 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewRevocationRepositoryMock creates a new instance of RevocationRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevocationRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevocationRepositoryMock {
+	mock := &RevocationRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// RevocationRepositoryMock is an autogenerated mock type for the RevocationRepository type
+type RevocationRepositoryMock struct {
+	mock.Mock
+}
+
+type RevocationRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RevocationRepositoryMock) EXPECT() *RevocationRepositoryMock_Expecter {
+	return &RevocationRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExpiredRevocations provides a mock function for the type RevocationRepositoryMock
+func (_mock *RevocationRepositoryMock) DeleteExpiredRevocations(ctx context.Context, limit int) (int64, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredRevocations")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RevocationRepositoryMock_DeleteExpiredRevocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredRevocations'
+type RevocationRepositoryMock_DeleteExpiredRevocations_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredRevocations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *RevocationRepositoryMock_Expecter) DeleteExpiredRevocations(ctx interface{}, limit interface{}) *RevocationRepositoryMock_DeleteExpiredRevocations_Call {
+	return &RevocationRepositoryMock_DeleteExpiredRevocations_Call{Call: _e.mock.On("DeleteExpiredRevocations", ctx, limit)}
+}
+
+func (_c *RevocationRepositoryMock_DeleteExpiredRevocations_Call) Run(run func(ctx context.Context, limit int)) *RevocationRepositoryMock_DeleteExpiredRevocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_DeleteExpiredRevocations_Call) Return(n int64, err error) *RevocationRepositoryMock_DeleteExpiredRevocations_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_DeleteExpiredRevocations_Call) RunAndReturn(run func(ctx context.Context, limit int) (int64, error)) *RevocationRepositoryMock_DeleteExpiredRevocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAccessTokenRevoked provides a mock function for the type RevocationRepositoryMock
+func (_mock *RevocationRepositoryMock) IsAccessTokenRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, jti, userID, issuedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAccessTokenRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, jti, userID, issuedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) bool); ok {
+		r0 = returnFunc(ctx, jti, userID, issuedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, jti, userID, issuedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RevocationRepositoryMock_IsAccessTokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAccessTokenRevoked'
+type RevocationRepositoryMock_IsAccessTokenRevoked_Call struct {
+	*mock.Call
+}
+
+// IsAccessTokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - userID uuid.UUID
+//   - issuedAt time.Time
+func (_e *RevocationRepositoryMock_Expecter) IsAccessTokenRevoked(ctx interface{}, jti interface{}, userID interface{}, issuedAt interface{}) *RevocationRepositoryMock_IsAccessTokenRevoked_Call {
+	return &RevocationRepositoryMock_IsAccessTokenRevoked_Call{Call: _e.mock.On("IsAccessTokenRevoked", ctx, jti, userID, issuedAt)}
+}
+
+func (_c *RevocationRepositoryMock_IsAccessTokenRevoked_Call) Run(run func(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time)) *RevocationRepositoryMock_IsAccessTokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_IsAccessTokenRevoked_Call) Return(b bool, err error) *RevocationRepositoryMock_IsAccessTokenRevoked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_IsAccessTokenRevoked_Call) RunAndReturn(run func(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error)) *RevocationRepositoryMock_IsAccessTokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAccessToken provides a mock function for the type RevocationRepositoryMock
+func (_mock *RevocationRepositoryMock) RevokeAccessToken(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, jti, userID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccessToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, jti, userID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationRepositoryMock_RevokeAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccessToken'
+type RevocationRepositoryMock_RevokeAccessToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - userID uuid.UUID
+//   - expiresAt time.Time
+func (_e *RevocationRepositoryMock_Expecter) RevokeAccessToken(ctx interface{}, jti interface{}, userID interface{}, expiresAt interface{}) *RevocationRepositoryMock_RevokeAccessToken_Call {
+	return &RevocationRepositoryMock_RevokeAccessToken_Call{Call: _e.mock.On("RevokeAccessToken", ctx, jti, userID, expiresAt)}
+}
+
+func (_c *RevocationRepositoryMock_RevokeAccessToken_Call) Run(run func(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time)) *RevocationRepositoryMock_RevokeAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_RevokeAccessToken_Call) Return(err error) *RevocationRepositoryMock_RevokeAccessToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_RevokeAccessToken_Call) RunAndReturn(run func(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time) error) *RevocationRepositoryMock_RevokeAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserAccessTokens provides a mock function for the type RevocationRepositoryMock
+func (_mock *RevocationRepositoryMock) RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID, issuedBefore time.Time, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, userID, issuedBefore, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserAccessTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, issuedBefore, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationRepositoryMock_RevokeUserAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserAccessTokens'
+type RevocationRepositoryMock_RevokeUserAccessTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserAccessTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - issuedBefore time.Time
+//   - expiresAt time.Time
+func (_e *RevocationRepositoryMock_Expecter) RevokeUserAccessTokens(ctx interface{}, userID interface{}, issuedBefore interface{}, expiresAt interface{}) *RevocationRepositoryMock_RevokeUserAccessTokens_Call {
+	return &RevocationRepositoryMock_RevokeUserAccessTokens_Call{Call: _e.mock.On("RevokeUserAccessTokens", ctx, userID, issuedBefore, expiresAt)}
+}
+
+func (_c *RevocationRepositoryMock_RevokeUserAccessTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID, issuedBefore time.Time, expiresAt time.Time)) *RevocationRepositoryMock_RevokeUserAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_RevokeUserAccessTokens_Call) Return(err error) *RevocationRepositoryMock_RevokeUserAccessTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationRepositoryMock_RevokeUserAccessTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, issuedBefore time.Time, expiresAt time.Time) error) *RevocationRepositoryMock_RevokeUserAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,211 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewRevocationServiceMock creates a new instance of RevocationServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevocationServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevocationServiceMock {
+	mock := &RevocationServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// RevocationServiceMock is an autogenerated mock type for the RevocationService type
+type RevocationServiceMock struct {
+	mock.Mock
+}
+
+type RevocationServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RevocationServiceMock) EXPECT() *RevocationServiceMock_Expecter {
+	return &RevocationServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// CheckAccessToken provides a mock function for the type RevocationServiceMock
+func (_mock *RevocationServiceMock) CheckAccessToken(ctx context.Context, claims *jwt.RegisteredClaims) error {
+	ret := _mock.Called(ctx, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckAccessToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *jwt.RegisteredClaims) error); ok {
+		r0 = returnFunc(ctx, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationServiceMock_CheckAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAccessToken'
+type RevocationServiceMock_CheckAccessToken_Call struct {
+	*mock.Call
+}
+
+// CheckAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claims *jwt.RegisteredClaims
+func (_e *RevocationServiceMock_Expecter) CheckAccessToken(ctx interface{}, claims interface{}) *RevocationServiceMock_CheckAccessToken_Call {
+	return &RevocationServiceMock_CheckAccessToken_Call{Call: _e.mock.On("CheckAccessToken", ctx, claims)}
+}
+
+func (_c *RevocationServiceMock_CheckAccessToken_Call) Run(run func(ctx context.Context, claims *jwt.RegisteredClaims)) *RevocationServiceMock_CheckAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *jwt.RegisteredClaims
+		if args[1] != nil {
+			arg1 = args[1].(*jwt.RegisteredClaims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceMock_CheckAccessToken_Call) Return(err error) *RevocationServiceMock_CheckAccessToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationServiceMock_CheckAccessToken_Call) RunAndReturn(run func(ctx context.Context, claims *jwt.RegisteredClaims) error) *RevocationServiceMock_CheckAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAccessToken provides a mock function for the type RevocationServiceMock
+func (_mock *RevocationServiceMock) RevokeAccessToken(ctx context.Context, claims *jwt.RegisteredClaims) error {
+	ret := _mock.Called(ctx, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccessToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *jwt.RegisteredClaims) error); ok {
+		r0 = returnFunc(ctx, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationServiceMock_RevokeAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccessToken'
+type RevocationServiceMock_RevokeAccessToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claims *jwt.RegisteredClaims
+func (_e *RevocationServiceMock_Expecter) RevokeAccessToken(ctx interface{}, claims interface{}) *RevocationServiceMock_RevokeAccessToken_Call {
+	return &RevocationServiceMock_RevokeAccessToken_Call{Call: _e.mock.On("RevokeAccessToken", ctx, claims)}
+}
+
+func (_c *RevocationServiceMock_RevokeAccessToken_Call) Run(run func(ctx context.Context, claims *jwt.RegisteredClaims)) *RevocationServiceMock_RevokeAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *jwt.RegisteredClaims
+		if args[1] != nil {
+			arg1 = args[1].(*jwt.RegisteredClaims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceMock_RevokeAccessToken_Call) Return(err error) *RevocationServiceMock_RevokeAccessToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationServiceMock_RevokeAccessToken_Call) RunAndReturn(run func(ctx context.Context, claims *jwt.RegisteredClaims) error) *RevocationServiceMock_RevokeAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserAccessTokens provides a mock function for the type RevocationServiceMock
+func (_mock *RevocationServiceMock) RevokeUserAccessTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserAccessTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationServiceMock_RevokeUserAccessTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserAccessTokens'
+type RevocationServiceMock_RevokeUserAccessTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserAccessTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RevocationServiceMock_Expecter) RevokeUserAccessTokens(ctx interface{}, userID interface{}) *RevocationServiceMock_RevokeUserAccessTokens_Call {
+	return &RevocationServiceMock_RevokeUserAccessTokens_Call{Call: _e.mock.On("RevokeUserAccessTokens", ctx, userID)}
+}
+
+func (_c *RevocationServiceMock_RevokeUserAccessTokens_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RevocationServiceMock_RevokeUserAccessTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceMock_RevokeUserAccessTokens_Call) Return(err error) *RevocationServiceMock_RevokeUserAccessTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationServiceMock_RevokeUserAccessTokens_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *RevocationServiceMock_RevokeUserAccessTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,248 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewSigningKeyRepositoryMock creates a new instance of SigningKeyRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSigningKeyRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SigningKeyRepositoryMock {
+	mock := &SigningKeyRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// SigningKeyRepositoryMock is an autogenerated mock type for the SigningKeyRepository type
+type SigningKeyRepositoryMock struct {
+	mock.Mock
+}
+
+type SigningKeyRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SigningKeyRepositoryMock) EXPECT() *SigningKeyRepositoryMock_Expecter {
+	return &SigningKeyRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateSigningKey provides a mock function for the type SigningKeyRepositoryMock
+func (_mock *SigningKeyRepositoryMock) CreateSigningKey(ctx context.Context, kid string, secret []byte) (*domain.SigningKey, error) {
+	ret := _mock.Called(ctx, kid, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSigningKey")
+	}
+
+	var r0 *domain.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) (*domain.SigningKey, error)); ok {
+		return returnFunc(ctx, kid, secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) *domain.SigningKey); ok {
+		r0 = returnFunc(ctx, kid, secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = returnFunc(ctx, kid, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_CreateSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSigningKey'
+type SigningKeyRepositoryMock_CreateSigningKey_Call struct {
+	*mock.Call
+}
+
+// CreateSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kid string
+//   - secret []byte
+func (_e *SigningKeyRepositoryMock_Expecter) CreateSigningKey(ctx interface{}, kid interface{}, secret interface{}) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	return &SigningKeyRepositoryMock_CreateSigningKey_Call{Call: _e.mock.On("CreateSigningKey", ctx, kid, secret)}
+}
+
+func (_c *SigningKeyRepositoryMock_CreateSigningKey_Call) Run(run func(ctx context.Context, kid string, secret []byte)) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_CreateSigningKey_Call) Return(signingKey *domain.SigningKey, err error) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_CreateSigningKey_Call) RunAndReturn(run func(ctx context.Context, kid string, secret []byte) (*domain.SigningKey, error)) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSigningKeys provides a mock function for the type SigningKeyRepositoryMock
+func (_mock *SigningKeyRepositoryMock) ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSigningKeys")
+	}
+
+	var r0 []domain.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.SigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.SigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_ListSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSigningKeys'
+type SigningKeyRepositoryMock_ListSigningKeys_Call struct {
+	*mock.Call
+}
+
+// ListSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyRepositoryMock_Expecter) ListSigningKeys(ctx interface{}) *SigningKeyRepositoryMock_ListSigningKeys_Call {
+	return &SigningKeyRepositoryMock_ListSigningKeys_Call{Call: _e.mock.On("ListSigningKeys", ctx)}
+}
+
+func (_c *SigningKeyRepositoryMock_ListSigningKeys_Call) Run(run func(ctx context.Context)) *SigningKeyRepositoryMock_ListSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_ListSigningKeys_Call) Return(signingKeys []domain.SigningKey, err error) *SigningKeyRepositoryMock_ListSigningKeys_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_ListSigningKeys_Call) RunAndReturn(run func(ctx context.Context) ([]domain.SigningKey, error)) *SigningKeyRepositoryMock_ListSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetireSigningKeys provides a mock function for the type SigningKeyRepositoryMock
+func (_mock *SigningKeyRepositoryMock) RetireSigningKeys(ctx context.Context, keepKID string, retireAt time.Time) (int64, error) {
+	ret := _mock.Called(ctx, keepKID, retireAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetireSigningKeys")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, keepKID, retireAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) int64); ok {
+		r0 = returnFunc(ctx, keepKID, retireAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, keepKID, retireAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_RetireSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetireSigningKeys'
+type SigningKeyRepositoryMock_RetireSigningKeys_Call struct {
+	*mock.Call
+}
+
+// RetireSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keepKID string
+//   - retireAt time.Time
+func (_e *SigningKeyRepositoryMock_Expecter) RetireSigningKeys(ctx interface{}, keepKID interface{}, retireAt interface{}) *SigningKeyRepositoryMock_RetireSigningKeys_Call {
+	return &SigningKeyRepositoryMock_RetireSigningKeys_Call{Call: _e.mock.On("RetireSigningKeys", ctx, keepKID, retireAt)}
+}
+
+func (_c *SigningKeyRepositoryMock_RetireSigningKeys_Call) Run(run func(ctx context.Context, keepKID string, retireAt time.Time)) *SigningKeyRepositoryMock_RetireSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_RetireSigningKeys_Call) Return(n int64, err error) *SigningKeyRepositoryMock_RetireSigningKeys_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_RetireSigningKeys_Call) RunAndReturn(run func(ctx context.Context, keepKID string, retireAt time.Time) (int64, error)) *SigningKeyRepositoryMock_RetireSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,214 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewSigningKeyServiceMock creates a new instance of SigningKeyServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSigningKeyServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SigningKeyServiceMock {
+	mock := &SigningKeyServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// SigningKeyServiceMock is an autogenerated mock type for the SigningKeyService type
+type SigningKeyServiceMock struct {
+	mock.Mock
+}
+
+type SigningKeyServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SigningKeyServiceMock) EXPECT() *SigningKeyServiceMock_Expecter {
+	return &SigningKeyServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// ListSigningKeys provides a mock function for the type SigningKeyServiceMock
+func (_mock *SigningKeyServiceMock) ListSigningKeys(ctx context.Context) ([]domain.SigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSigningKeys")
+	}
+
+	var r0 []domain.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.SigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.SigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SigningKeyServiceMock_ListSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSigningKeys'
+type SigningKeyServiceMock_ListSigningKeys_Call struct {
+	*mock.Call
+}
+
+// ListSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyServiceMock_Expecter) ListSigningKeys(ctx interface{}) *SigningKeyServiceMock_ListSigningKeys_Call {
+	return &SigningKeyServiceMock_ListSigningKeys_Call{Call: _e.mock.On("ListSigningKeys", ctx)}
+}
+
+func (_c *SigningKeyServiceMock_ListSigningKeys_Call) Run(run func(ctx context.Context)) *SigningKeyServiceMock_ListSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyServiceMock_ListSigningKeys_Call) Return(signingKeys []domain.SigningKey, err error) *SigningKeyServiceMock_ListSigningKeys_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *SigningKeyServiceMock_ListSigningKeys_Call) RunAndReturn(run func(ctx context.Context) ([]domain.SigningKey, error)) *SigningKeyServiceMock_ListSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReloadSigningKeys provides a mock function for the type SigningKeyServiceMock
+func (_mock *SigningKeyServiceMock) ReloadSigningKeys(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadSigningKeys")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// SigningKeyServiceMock_ReloadSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadSigningKeys'
+type SigningKeyServiceMock_ReloadSigningKeys_Call struct {
+	*mock.Call
+}
+
+// ReloadSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyServiceMock_Expecter) ReloadSigningKeys(ctx interface{}) *SigningKeyServiceMock_ReloadSigningKeys_Call {
+	return &SigningKeyServiceMock_ReloadSigningKeys_Call{Call: _e.mock.On("ReloadSigningKeys", ctx)}
+}
+
+func (_c *SigningKeyServiceMock_ReloadSigningKeys_Call) Run(run func(ctx context.Context)) *SigningKeyServiceMock_ReloadSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyServiceMock_ReloadSigningKeys_Call) Return(err error) *SigningKeyServiceMock_ReloadSigningKeys_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *SigningKeyServiceMock_ReloadSigningKeys_Call) RunAndReturn(run func(ctx context.Context) error) *SigningKeyServiceMock_ReloadSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSigningKey provides a mock function for the type SigningKeyServiceMock
+func (_mock *SigningKeyServiceMock) RotateSigningKey(ctx context.Context) (*domain.SigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSigningKey")
+	}
+
+	var r0 *domain.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.SigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.SigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SigningKeyServiceMock_RotateSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSigningKey'
+type SigningKeyServiceMock_RotateSigningKey_Call struct {
+	*mock.Call
+}
+
+// RotateSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyServiceMock_Expecter) RotateSigningKey(ctx interface{}) *SigningKeyServiceMock_RotateSigningKey_Call {
+	return &SigningKeyServiceMock_RotateSigningKey_Call{Call: _e.mock.On("RotateSigningKey", ctx)}
+}
+
+func (_c *SigningKeyServiceMock_RotateSigningKey_Call) Run(run func(ctx context.Context)) *SigningKeyServiceMock_RotateSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyServiceMock_RotateSigningKey_Call) Return(signingKey *domain.SigningKey, err error) *SigningKeyServiceMock_RotateSigningKey_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *SigningKeyServiceMock_RotateSigningKey_Call) RunAndReturn(run func(ctx context.Context) (*domain.SigningKey, error)) *SigningKeyServiceMock_RotateSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
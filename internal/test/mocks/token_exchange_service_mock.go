@@ -0,0 +1,131 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewTokenExchangeServiceMock creates a new instance of TokenExchangeServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenExchangeServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenExchangeServiceMock {
+	mock := &TokenExchangeServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// TokenExchangeServiceMock is an autogenerated mock type for the TokenExchangeService type
+type TokenExchangeServiceMock struct {
+	mock.Mock
+}
+
+type TokenExchangeServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenExchangeServiceMock) EXPECT() *TokenExchangeServiceMock_Expecter {
+	return &TokenExchangeServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// ExchangeToken provides a mock function for the type TokenExchangeServiceMock
+func (_mock *TokenExchangeServiceMock) ExchangeToken(ctx context.Context, clientID string, subjectToken string, subjectTokenType string, audiences []string, scopes []string) (*domain.ExchangedToken, error) {
+	ret := _mock.Called(ctx, clientID, subjectToken, subjectTokenType, audiences, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeToken")
+	}
+
+	var r0 *domain.ExchangedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string) (*domain.ExchangedToken, error)); ok {
+		return returnFunc(ctx, clientID, subjectToken, subjectTokenType, audiences, scopes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string) *domain.ExchangedToken); ok {
+		r0 = returnFunc(ctx, clientID, subjectToken, subjectTokenType, audiences, scopes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExchangedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, []string, []string) error); ok {
+		r1 = returnFunc(ctx, clientID, subjectToken, subjectTokenType, audiences, scopes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenExchangeServiceMock_ExchangeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeToken'
+type TokenExchangeServiceMock_ExchangeToken_Call struct {
+	*mock.Call
+}
+
+// ExchangeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - subjectToken string
+//   - subjectTokenType string
+//   - audiences []string
+//   - scopes []string
+func (_e *TokenExchangeServiceMock_Expecter) ExchangeToken(ctx interface{}, clientID interface{}, subjectToken interface{}, subjectTokenType interface{}, audiences interface{}, scopes interface{}) *TokenExchangeServiceMock_ExchangeToken_Call {
+	return &TokenExchangeServiceMock_ExchangeToken_Call{Call: _e.mock.On("ExchangeToken", ctx, clientID, subjectToken, subjectTokenType, audiences, scopes)}
+}
+
+func (_c *TokenExchangeServiceMock_ExchangeToken_Call) Run(run func(ctx context.Context, clientID string, subjectToken string, subjectTokenType string, audiences []string, scopes []string)) *TokenExchangeServiceMock_ExchangeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []string
+		if args[4] != nil {
+			arg4 = args[4].([]string)
+		}
+		var arg5 []string
+		if args[5] != nil {
+			arg5 = args[5].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenExchangeServiceMock_ExchangeToken_Call) Return(exchangedToken *domain.ExchangedToken, err error) *TokenExchangeServiceMock_ExchangeToken_Call {
+	_c.Call.Return(exchangedToken, err)
+	return _c
+}
+
+func (_c *TokenExchangeServiceMock_ExchangeToken_Call) RunAndReturn(run func(ctx context.Context, clientID string, subjectToken string, subjectTokenType string, audiences []string, scopes []string) (*domain.ExchangedToken, error)) *TokenExchangeServiceMock_ExchangeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -40,6 +40,132 @@ func (_m *TokenRepositoryMock) EXPECT() *TokenRepositoryMock_Expecter {
 	return &TokenRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// CountActiveRefreshTokens provides a mock function for the type TokenRepositoryMock
+func (_mock *TokenRepositoryMock) CountActiveRefreshTokens(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountActiveRefreshTokens")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenRepositoryMock_CountActiveRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountActiveRefreshTokens'
+type TokenRepositoryMock_CountActiveRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// CountActiveRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TokenRepositoryMock_Expecter) CountActiveRefreshTokens(ctx interface{}) *TokenRepositoryMock_CountActiveRefreshTokens_Call {
+	return &TokenRepositoryMock_CountActiveRefreshTokens_Call{Call: _e.mock.On("CountActiveRefreshTokens", ctx)}
+}
+
+func (_c *TokenRepositoryMock_CountActiveRefreshTokens_Call) Run(run func(ctx context.Context)) *TokenRepositoryMock_CountActiveRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenRepositoryMock_CountActiveRefreshTokens_Call) Return(n int64, err error) *TokenRepositoryMock_CountActiveRefreshTokens_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *TokenRepositoryMock_CountActiveRefreshTokens_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *TokenRepositoryMock_CountActiveRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredRefreshTokens provides a mock function for the type TokenRepositoryMock
+func (_mock *TokenRepositoryMock) DeleteExpiredRefreshTokens(ctx context.Context, limit int) (int64, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredRefreshTokens")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenRepositoryMock_DeleteExpiredRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredRefreshTokens'
+type TokenRepositoryMock_DeleteExpiredRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *TokenRepositoryMock_Expecter) DeleteExpiredRefreshTokens(ctx interface{}, limit interface{}) *TokenRepositoryMock_DeleteExpiredRefreshTokens_Call {
+	return &TokenRepositoryMock_DeleteExpiredRefreshTokens_Call{Call: _e.mock.On("DeleteExpiredRefreshTokens", ctx, limit)}
+}
+
+func (_c *TokenRepositoryMock_DeleteExpiredRefreshTokens_Call) Run(run func(ctx context.Context, limit int)) *TokenRepositoryMock_DeleteExpiredRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenRepositoryMock_DeleteExpiredRefreshTokens_Call) Return(n int64, err error) *TokenRepositoryMock_DeleteExpiredRefreshTokens_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *TokenRepositoryMock_DeleteExpiredRefreshTokens_Call) RunAndReturn(run func(ctx context.Context, limit int) (int64, error)) *TokenRepositoryMock_DeleteExpiredRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRefreshToken provides a mock function for the type TokenRepositoryMock
 func (_mock *TokenRepositoryMock) DeleteRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
 	ret := _mock.Called(ctx, tokenHash)
@@ -108,6 +234,72 @@ func (_c *TokenRepositoryMock_DeleteRefreshToken_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// DeleteRefreshTokensByUser provides a mock function for the type TokenRepositoryMock
+func (_mock *TokenRepositoryMock) DeleteRefreshTokensByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRefreshTokensByUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenRepositoryMock_DeleteRefreshTokensByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRefreshTokensByUser'
+type TokenRepositoryMock_DeleteRefreshTokensByUser_Call struct {
+	*mock.Call
+}
+
+// DeleteRefreshTokensByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *TokenRepositoryMock_Expecter) DeleteRefreshTokensByUser(ctx interface{}, userID interface{}) *TokenRepositoryMock_DeleteRefreshTokensByUser_Call {
+	return &TokenRepositoryMock_DeleteRefreshTokensByUser_Call{Call: _e.mock.On("DeleteRefreshTokensByUser", ctx, userID)}
+}
+
+func (_c *TokenRepositoryMock_DeleteRefreshTokensByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *TokenRepositoryMock_DeleteRefreshTokensByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenRepositoryMock_DeleteRefreshTokensByUser_Call) Return(n int64, err error) *TokenRepositoryMock_DeleteRefreshTokensByUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *TokenRepositoryMock_DeleteRefreshTokensByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (int64, error)) *TokenRepositoryMock_DeleteRefreshTokensByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindRefreshToken provides a mock function for the type TokenRepositoryMock
 func (_mock *TokenRepositoryMock) FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
 	ret := _mock.Called(ctx, tokenHash)
@@ -176,17 +368,85 @@ func (_c *TokenRepositoryMock_FindRefreshToken_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// ListRefreshTokensByUser provides a mock function for the type TokenRepositoryMock
+func (_mock *TokenRepositoryMock) ListRefreshTokensByUser(ctx context.Context, userID uuid.UUID) ([]domain.Session, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRefreshTokensByUser")
+	}
+
+	var r0 []domain.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.Session, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.Session); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenRepositoryMock_ListRefreshTokensByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRefreshTokensByUser'
+type TokenRepositoryMock_ListRefreshTokensByUser_Call struct {
+	*mock.Call
+}
+
+// ListRefreshTokensByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *TokenRepositoryMock_Expecter) ListRefreshTokensByUser(ctx interface{}, userID interface{}) *TokenRepositoryMock_ListRefreshTokensByUser_Call {
+	return &TokenRepositoryMock_ListRefreshTokensByUser_Call{Call: _e.mock.On("ListRefreshTokensByUser", ctx, userID)}
+}
+
+func (_c *TokenRepositoryMock_ListRefreshTokensByUser_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *TokenRepositoryMock_ListRefreshTokensByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenRepositoryMock_ListRefreshTokensByUser_Call) Return(sessions []domain.Session, err error) *TokenRepositoryMock_ListRefreshTokensByUser_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *TokenRepositoryMock_ListRefreshTokensByUser_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]domain.Session, error)) *TokenRepositoryMock_ListRefreshTokensByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveHashedRefreshToken provides a mock function for the type TokenRepositoryMock
-func (_mock *TokenRepositoryMock) SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error {
-	ret := _mock.Called(ctx, userID, tokenHash, expiresAt)
+func (_mock *TokenRepositoryMock) SaveHashedRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time, sessionStartedAt time.Time, rememberMe bool, dpopJKT string, clientID string, x5t string) error {
+	ret := _mock.Called(ctx, userID, tokenHash, expiresAt, sessionStartedAt, rememberMe, dpopJKT, clientID, x5t)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveHashedRefreshToken")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
-		r0 = returnFunc(ctx, userID, tokenHash, expiresAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time, bool, string, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, tokenHash, expiresAt, sessionStartedAt, rememberMe, dpopJKT, clientID, x5t)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -203,11 +463,16 @@ type TokenRepositoryMock_SaveHashedRefreshToken_Call struct {
 //   - userID uuid.UUID
 //   - tokenHash string
 //   - expiresAt time.Time
-func (_e *TokenRepositoryMock_Expecter) SaveHashedRefreshToken(ctx interface{}, userID interface{}, tokenHash interface{}, expiresAt interface{}) *TokenRepositoryMock_SaveHashedRefreshToken_Call {
-	return &TokenRepositoryMock_SaveHashedRefreshToken_Call{Call: _e.mock.On("SaveHashedRefreshToken", ctx, userID, tokenHash, expiresAt)}
+//   - sessionStartedAt time.Time
+//   - rememberMe bool
+//   - dpopJKT string
+//   - clientID string
+//   - x5t string
+func (_e *TokenRepositoryMock_Expecter) SaveHashedRefreshToken(ctx interface{}, userID interface{}, tokenHash interface{}, expiresAt interface{}, sessionStartedAt interface{}, rememberMe interface{}, dpopJKT interface{}, clientID interface{}, x5t interface{}) *TokenRepositoryMock_SaveHashedRefreshToken_Call {
+	return &TokenRepositoryMock_SaveHashedRefreshToken_Call{Call: _e.mock.On("SaveHashedRefreshToken", ctx, userID, tokenHash, expiresAt, sessionStartedAt, rememberMe, dpopJKT, clientID, x5t)}
 }
 
-func (_c *TokenRepositoryMock_SaveHashedRefreshToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time)) *TokenRepositoryMock_SaveHashedRefreshToken_Call {
+func (_c *TokenRepositoryMock_SaveHashedRefreshToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time, sessionStartedAt time.Time, rememberMe bool, dpopJKT string, clientID string, x5t string)) *TokenRepositoryMock_SaveHashedRefreshToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -225,11 +490,36 @@ func (_c *TokenRepositoryMock_SaveHashedRefreshToken_Call) Run(run func(ctx cont
 		if args[3] != nil {
 			arg3 = args[3].(time.Time)
 		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		var arg5 bool
+		if args[5] != nil {
+			arg5 = args[5].(bool)
+		}
+		var arg6 string
+		if args[6] != nil {
+			arg6 = args[6].(string)
+		}
+		var arg7 string
+		if args[7] != nil {
+			arg7 = args[7].(string)
+		}
+		var arg8 string
+		if args[8] != nil {
+			arg8 = args[8].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
+			arg5,
+			arg6,
+			arg7,
+			arg8,
 		)
 	})
 	return _c
@@ -240,7 +530,7 @@ func (_c *TokenRepositoryMock_SaveHashedRefreshToken_Call) Return(err error) *To
 	return _c
 }
 
-func (_c *TokenRepositoryMock_SaveHashedRefreshToken_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error) *TokenRepositoryMock_SaveHashedRefreshToken_Call {
+func (_c *TokenRepositoryMock_SaveHashedRefreshToken_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time, sessionStartedAt time.Time, rememberMe bool, dpopJKT string, clientID string, x5t string) error) *TokenRepositoryMock_SaveHashedRefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,11 +5,13 @@
 package mocks
 
 import (
+	"context"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+	"github.com/vo1dFl0w/auth-service/internal/app/usecase"
 )
 
 // NewTokenServiceMock creates a new instance of TokenServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -40,8 +42,8 @@ func (_m *TokenServiceMock) EXPECT() *TokenServiceMock_Expecter {
 }
 
 // GenerateAccessToken provides a mock function for the type TokenServiceMock
-func (_mock *TokenServiceMock) GenerateAccessToken(userID uuid.UUID) (string, error) {
-	ret := _mock.Called(userID)
+func (_mock *TokenServiceMock) GenerateAccessToken(ctx context.Context, userID uuid.UUID, clientID string) (string, error) {
+	ret := _mock.Called(ctx, userID, clientID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateAccessToken")
@@ -49,16 +51,16 @@ func (_mock *TokenServiceMock) GenerateAccessToken(userID uuid.UUID) (string, er
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) (string, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
+		return returnFunc(ctx, userID, clientID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) string); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
+		r0 = returnFunc(ctx, userID, clientID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userID, clientID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -71,19 +73,31 @@ type TokenServiceMock_GenerateAccessToken_Call struct {
 }
 
 // GenerateAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *TokenServiceMock_Expecter) GenerateAccessToken(userID interface{}) *TokenServiceMock_GenerateAccessToken_Call {
-	return &TokenServiceMock_GenerateAccessToken_Call{Call: _e.mock.On("GenerateAccessToken", userID)}
+//   - clientID string
+func (_e *TokenServiceMock_Expecter) GenerateAccessToken(ctx interface{}, userID interface{}, clientID interface{}) *TokenServiceMock_GenerateAccessToken_Call {
+	return &TokenServiceMock_GenerateAccessToken_Call{Call: _e.mock.On("GenerateAccessToken", ctx, userID, clientID)}
 }
 
-func (_c *TokenServiceMock_GenerateAccessToken_Call) Run(run func(userID uuid.UUID)) *TokenServiceMock_GenerateAccessToken_Call {
+func (_c *TokenServiceMock_GenerateAccessToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, clientID string)) *TokenServiceMock_GenerateAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -94,7 +108,103 @@ func (_c *TokenServiceMock_GenerateAccessToken_Call) Return(s string, err error)
 	return _c
 }
 
-func (_c *TokenServiceMock_GenerateAccessToken_Call) RunAndReturn(run func(userID uuid.UUID) (string, error)) *TokenServiceMock_GenerateAccessToken_Call {
+func (_c *TokenServiceMock_GenerateAccessToken_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, clientID string) (string, error)) *TokenServiceMock_GenerateAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateExchangedToken provides a mock function for the type TokenServiceMock
+func (_mock *TokenServiceMock) GenerateExchangedToken(ctx context.Context, userID uuid.UUID, actor string, audiences []string, scopes []string, notAfter time.Time) (string, time.Time, error) {
+	ret := _mock.Called(ctx, userID, actor, audiences, scopes, notAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateExchangedToken")
+	}
+
+	var r0 string
+	var r1 time.Time
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, []string, time.Time) (string, time.Time, error)); ok {
+		return returnFunc(ctx, userID, actor, audiences, scopes, notAfter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, []string, time.Time) string); ok {
+		r0 = returnFunc(ctx, userID, actor, audiences, scopes, notAfter)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, []string, time.Time) time.Time); ok {
+		r1 = returnFunc(ctx, userID, actor, audiences, scopes, notAfter)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, []string, []string, time.Time) error); ok {
+		r2 = returnFunc(ctx, userID, actor, audiences, scopes, notAfter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// TokenServiceMock_GenerateExchangedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateExchangedToken'
+type TokenServiceMock_GenerateExchangedToken_Call struct {
+	*mock.Call
+}
+
+// GenerateExchangedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - actor string
+//   - audiences []string
+//   - scopes []string
+//   - notAfter time.Time
+func (_e *TokenServiceMock_Expecter) GenerateExchangedToken(ctx interface{}, userID interface{}, actor interface{}, audiences interface{}, scopes interface{}, notAfter interface{}) *TokenServiceMock_GenerateExchangedToken_Call {
+	return &TokenServiceMock_GenerateExchangedToken_Call{Call: _e.mock.On("GenerateExchangedToken", ctx, userID, actor, audiences, scopes, notAfter)}
+}
+
+func (_c *TokenServiceMock_GenerateExchangedToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, actor string, audiences []string, scopes []string, notAfter time.Time)) *TokenServiceMock_GenerateExchangedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		var arg4 []string
+		if args[4] != nil {
+			arg4 = args[4].([]string)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenServiceMock_GenerateExchangedToken_Call) Return(s string, time1 time.Time, err error) *TokenServiceMock_GenerateExchangedToken_Call {
+	_c.Call.Return(s, time1, err)
+	return _c
+}
+
+func (_c *TokenServiceMock_GenerateExchangedToken_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, actor string, audiences []string, scopes []string, notAfter time.Time) (string, time.Time, error)) *TokenServiceMock_GenerateExchangedToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -153,8 +263,8 @@ func (_c *TokenServiceMock_GenerateRefreshToken_Call) RunAndReturn(run func() (s
 }
 
 // HashRefreshToken provides a mock function for the type TokenServiceMock
-func (_mock *TokenServiceMock) HashRefreshToken(refreshToken string) (string, time.Time) {
-	ret := _mock.Called(refreshToken)
+func (_mock *TokenServiceMock) HashRefreshToken(refreshToken string, clientID string) (string, time.Time) {
+	ret := _mock.Called(refreshToken, clientID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HashRefreshToken")
@@ -162,16 +272,16 @@ func (_mock *TokenServiceMock) HashRefreshToken(refreshToken string) (string, ti
 
 	var r0 string
 	var r1 time.Time
-	if returnFunc, ok := ret.Get(0).(func(string) (string, time.Time)); ok {
-		return returnFunc(refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(string, string) (string, time.Time)); ok {
+		return returnFunc(refreshToken, clientID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = returnFunc(refreshToken, clientID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) time.Time); ok {
-		r1 = returnFunc(refreshToken)
+	if returnFunc, ok := ret.Get(1).(func(string, string) time.Time); ok {
+		r1 = returnFunc(refreshToken, clientID)
 	} else {
 		r1 = ret.Get(1).(time.Time)
 	}
@@ -185,18 +295,24 @@ type TokenServiceMock_HashRefreshToken_Call struct {
 
 // HashRefreshToken is a helper method to define mock.On call
 //   - refreshToken string
-func (_e *TokenServiceMock_Expecter) HashRefreshToken(refreshToken interface{}) *TokenServiceMock_HashRefreshToken_Call {
-	return &TokenServiceMock_HashRefreshToken_Call{Call: _e.mock.On("HashRefreshToken", refreshToken)}
+//   - clientID string
+func (_e *TokenServiceMock_Expecter) HashRefreshToken(refreshToken interface{}, clientID interface{}) *TokenServiceMock_HashRefreshToken_Call {
+	return &TokenServiceMock_HashRefreshToken_Call{Call: _e.mock.On("HashRefreshToken", refreshToken, clientID)}
 }
 
-func (_c *TokenServiceMock_HashRefreshToken_Call) Run(run func(refreshToken string)) *TokenServiceMock_HashRefreshToken_Call {
+func (_c *TokenServiceMock_HashRefreshToken_Call) Run(run func(refreshToken string, clientID string)) *TokenServiceMock_HashRefreshToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -207,29 +323,113 @@ func (_c *TokenServiceMock_HashRefreshToken_Call) Return(s string, time1 time.Ti
 	return _c
 }
 
-func (_c *TokenServiceMock_HashRefreshToken_Call) RunAndReturn(run func(refreshToken string) (string, time.Time)) *TokenServiceMock_HashRefreshToken_Call {
+func (_c *TokenServiceMock_HashRefreshToken_Call) RunAndReturn(run func(refreshToken string, clientID string) (string, time.Time)) *TokenServiceMock_HashRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSigningKeys provides a mock function for the type TokenServiceMock
+func (_mock *TokenServiceMock) SetSigningKeys(keys []domain.SigningKey) {
+	_mock.Called(keys)
+	return
+}
+
+// TokenServiceMock_SetSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKeys'
+type TokenServiceMock_SetSigningKeys_Call struct {
+	*mock.Call
+}
+
+// SetSigningKeys is a helper method to define mock.On call
+//   - keys []domain.SigningKey
+func (_e *TokenServiceMock_Expecter) SetSigningKeys(keys interface{}) *TokenServiceMock_SetSigningKeys_Call {
+	return &TokenServiceMock_SetSigningKeys_Call{Call: _e.mock.On("SetSigningKeys", keys)}
+}
+
+func (_c *TokenServiceMock_SetSigningKeys_Call) Run(run func(keys []domain.SigningKey)) *TokenServiceMock_SetSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []domain.SigningKey
+		if args[0] != nil {
+			arg0 = args[0].([]domain.SigningKey)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenServiceMock_SetSigningKeys_Call) Return() *TokenServiceMock_SetSigningKeys_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TokenServiceMock_SetSigningKeys_Call) RunAndReturn(run func(keys []domain.SigningKey)) *TokenServiceMock_SetSigningKeys_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SigningKeyLoaded provides a mock function for the type TokenServiceMock
+func (_mock *TokenServiceMock) SigningKeyLoaded() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeyLoaded")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// TokenServiceMock_SigningKeyLoaded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeyLoaded'
+type TokenServiceMock_SigningKeyLoaded_Call struct {
+	*mock.Call
+}
+
+// SigningKeyLoaded is a helper method to define mock.On call
+func (_e *TokenServiceMock_Expecter) SigningKeyLoaded() *TokenServiceMock_SigningKeyLoaded_Call {
+	return &TokenServiceMock_SigningKeyLoaded_Call{Call: _e.mock.On("SigningKeyLoaded")}
+}
+
+func (_c *TokenServiceMock_SigningKeyLoaded_Call) Run(run func()) *TokenServiceMock_SigningKeyLoaded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenServiceMock_SigningKeyLoaded_Call) Return(b bool) *TokenServiceMock_SigningKeyLoaded_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *TokenServiceMock_SigningKeyLoaded_Call) RunAndReturn(run func() bool) *TokenServiceMock_SigningKeyLoaded_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ValidateAccessToken provides a mock function for the type TokenServiceMock
-func (_mock *TokenServiceMock) ValidateAccessToken(accessToken string) (*jwt.RegisteredClaims, error) {
+func (_mock *TokenServiceMock) ValidateAccessToken(accessToken string) (*usecase.AccessClaims, error) {
 	ret := _mock.Called(accessToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateAccessToken")
 	}
 
-	var r0 *jwt.RegisteredClaims
+	var r0 *usecase.AccessClaims
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*jwt.RegisteredClaims, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(string) (*usecase.AccessClaims, error)); ok {
 		return returnFunc(accessToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *jwt.RegisteredClaims); ok {
+	if returnFunc, ok := ret.Get(0).(func(string) *usecase.AccessClaims); ok {
 		r0 = returnFunc(accessToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*jwt.RegisteredClaims)
+			r0 = ret.Get(0).(*usecase.AccessClaims)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
@@ -264,12 +464,12 @@ func (_c *TokenServiceMock_ValidateAccessToken_Call) Run(run func(accessToken st
 	return _c
 }
 
-func (_c *TokenServiceMock_ValidateAccessToken_Call) Return(registeredClaims *jwt.RegisteredClaims, err error) *TokenServiceMock_ValidateAccessToken_Call {
-	_c.Call.Return(registeredClaims, err)
+func (_c *TokenServiceMock_ValidateAccessToken_Call) Return(accessClaims *usecase.AccessClaims, err error) *TokenServiceMock_ValidateAccessToken_Call {
+	_c.Call.Return(accessClaims, err)
 	return _c
 }
 
-func (_c *TokenServiceMock_ValidateAccessToken_Call) RunAndReturn(run func(accessToken string) (*jwt.RegisteredClaims, error)) *TokenServiceMock_ValidateAccessToken_Call {
+func (_c *TokenServiceMock_ValidateAccessToken_Call) RunAndReturn(run func(accessToken string) (*usecase.AccessClaims, error)) *TokenServiceMock_ValidateAccessToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
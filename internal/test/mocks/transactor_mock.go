@@ -0,0 +1,95 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewTransactorMock creates a new instance of TransactorMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactorMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactorMock {
+	mock := &TransactorMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// TransactorMock is an autogenerated mock type for the Transactor type
+type TransactorMock struct {
+	mock.Mock
+}
+
+type TransactorMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactorMock) EXPECT() *TransactorMock_Expecter {
+	return &TransactorMock_Expecter{mock: &_m.Mock}
+}
+
+// WithinTx provides a mock function for the type TransactorMock
+func (_mock *TransactorMock) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithinTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TransactorMock_WithinTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithinTx'
+type TransactorMock_WithinTx_Call struct {
+	*mock.Call
+}
+
+// WithinTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(ctx context.Context) error
+func (_e *TransactorMock_Expecter) WithinTx(ctx interface{}, fn interface{}) *TransactorMock_WithinTx_Call {
+	return &TransactorMock_WithinTx_Call{Call: _e.mock.On("WithinTx", ctx, fn)}
+}
+
+func (_c *TransactorMock_WithinTx_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *TransactorMock_WithinTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactorMock_WithinTx_Call) Return(err error) *TransactorMock_WithinTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TransactorMock_WithinTx_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *TransactorMock_WithinTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,241 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewUserAttributeRepositoryMock creates a new instance of UserAttributeRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserAttributeRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserAttributeRepositoryMock {
+	mock := &UserAttributeRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// UserAttributeRepositoryMock is an autogenerated mock type for the UserAttributeRepository type
+type UserAttributeRepositoryMock struct {
+	mock.Mock
+}
+
+type UserAttributeRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserAttributeRepositoryMock) EXPECT() *UserAttributeRepositoryMock_Expecter {
+	return &UserAttributeRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteUserAttribute provides a mock function for the type UserAttributeRepositoryMock
+func (_mock *UserAttributeRepositoryMock) DeleteUserAttribute(ctx context.Context, userID uuid.UUID, name string) error {
+	ret := _mock.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserAttribute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserAttributeRepositoryMock_DeleteUserAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserAttribute'
+type UserAttributeRepositoryMock_DeleteUserAttribute_Call struct {
+	*mock.Call
+}
+
+// DeleteUserAttribute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - name string
+func (_e *UserAttributeRepositoryMock_Expecter) DeleteUserAttribute(ctx interface{}, userID interface{}, name interface{}) *UserAttributeRepositoryMock_DeleteUserAttribute_Call {
+	return &UserAttributeRepositoryMock_DeleteUserAttribute_Call{Call: _e.mock.On("DeleteUserAttribute", ctx, userID, name)}
+}
+
+func (_c *UserAttributeRepositoryMock_DeleteUserAttribute_Call) Run(run func(ctx context.Context, userID uuid.UUID, name string)) *UserAttributeRepositoryMock_DeleteUserAttribute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserAttributeRepositoryMock_DeleteUserAttribute_Call) Return(err error) *UserAttributeRepositoryMock_DeleteUserAttribute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserAttributeRepositoryMock_DeleteUserAttribute_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, name string) error) *UserAttributeRepositoryMock_DeleteUserAttribute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserAttributes provides a mock function for the type UserAttributeRepositoryMock
+func (_mock *UserAttributeRepositoryMock) ListUserAttributes(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAttributes")
+	}
+
+	var r0 []domain.UserAttribute
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.UserAttribute, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.UserAttribute); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserAttribute)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserAttributeRepositoryMock_ListUserAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAttributes'
+type UserAttributeRepositoryMock_ListUserAttributes_Call struct {
+	*mock.Call
+}
+
+// ListUserAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *UserAttributeRepositoryMock_Expecter) ListUserAttributes(ctx interface{}, userID interface{}) *UserAttributeRepositoryMock_ListUserAttributes_Call {
+	return &UserAttributeRepositoryMock_ListUserAttributes_Call{Call: _e.mock.On("ListUserAttributes", ctx, userID)}
+}
+
+func (_c *UserAttributeRepositoryMock_ListUserAttributes_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *UserAttributeRepositoryMock_ListUserAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserAttributeRepositoryMock_ListUserAttributes_Call) Return(userAttributes []domain.UserAttribute, err error) *UserAttributeRepositoryMock_ListUserAttributes_Call {
+	_c.Call.Return(userAttributes, err)
+	return _c
+}
+
+func (_c *UserAttributeRepositoryMock_ListUserAttributes_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]domain.UserAttribute, error)) *UserAttributeRepositoryMock_ListUserAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserAttribute provides a mock function for the type UserAttributeRepositoryMock
+func (_mock *UserAttributeRepositoryMock) SetUserAttribute(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error {
+	ret := _mock.Called(ctx, userID, name, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserAttribute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, json.RawMessage) error); ok {
+		r0 = returnFunc(ctx, userID, name, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserAttributeRepositoryMock_SetUserAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserAttribute'
+type UserAttributeRepositoryMock_SetUserAttribute_Call struct {
+	*mock.Call
+}
+
+// SetUserAttribute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - name string
+//   - value json.RawMessage
+func (_e *UserAttributeRepositoryMock_Expecter) SetUserAttribute(ctx interface{}, userID interface{}, name interface{}, value interface{}) *UserAttributeRepositoryMock_SetUserAttribute_Call {
+	return &UserAttributeRepositoryMock_SetUserAttribute_Call{Call: _e.mock.On("SetUserAttribute", ctx, userID, name, value)}
+}
+
+func (_c *UserAttributeRepositoryMock_SetUserAttribute_Call) Run(run func(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage)) *UserAttributeRepositoryMock_SetUserAttribute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 json.RawMessage
+		if args[3] != nil {
+			arg3 = args[3].(json.RawMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *UserAttributeRepositoryMock_SetUserAttribute_Call) Return(err error) *UserAttributeRepositoryMock_SetUserAttribute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserAttributeRepositoryMock_SetUserAttribute_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, name string, value json.RawMessage) error) *UserAttributeRepositoryMock_SetUserAttribute_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,105 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewWebhookClientMock creates a new instance of WebhookClientMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookClientMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookClientMock {
+	mock := &WebhookClientMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// WebhookClientMock is an autogenerated mock type for the WebhookClient type
+type WebhookClientMock struct {
+	mock.Mock
+}
+
+type WebhookClientMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookClientMock) EXPECT() *WebhookClientMock_Expecter {
+	return &WebhookClientMock_Expecter{mock: &_m.Mock}
+}
+
+// Deliver provides a mock function for the type WebhookClientMock
+func (_mock *WebhookClientMock) Deliver(ctx context.Context, delivery domain.PendingWebhookDelivery) (int, error) {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deliver")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PendingWebhookDelivery) (int, error)); ok {
+		return returnFunc(ctx, delivery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PendingWebhookDelivery) int); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PendingWebhookDelivery) error); ok {
+		r1 = returnFunc(ctx, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookClientMock_Deliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliver'
+type WebhookClientMock_Deliver_Call struct {
+	*mock.Call
+}
+
+// Deliver is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery domain.PendingWebhookDelivery
+func (_e *WebhookClientMock_Expecter) Deliver(ctx interface{}, delivery interface{}) *WebhookClientMock_Deliver_Call {
+	return &WebhookClientMock_Deliver_Call{Call: _e.mock.On("Deliver", ctx, delivery)}
+}
+
+func (_c *WebhookClientMock_Deliver_Call) Run(run func(ctx context.Context, delivery domain.PendingWebhookDelivery)) *WebhookClientMock_Deliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PendingWebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(domain.PendingWebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookClientMock_Deliver_Call) Return(n int, err error) *WebhookClientMock_Deliver_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *WebhookClientMock_Deliver_Call) RunAndReturn(run func(ctx context.Context, delivery domain.PendingWebhookDelivery) (int, error)) *WebhookClientMock_Deliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
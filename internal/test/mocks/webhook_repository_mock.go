@@ -0,0 +1,819 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewWebhookRepositoryMock creates a new instance of WebhookRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepositoryMock {
+	mock := &WebhookRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// WebhookRepositoryMock is an autogenerated mock type for the WebhookRepository type
+type WebhookRepositoryMock struct {
+	mock.Mock
+}
+
+type WebhookRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepositoryMock) EXPECT() *WebhookRepositoryMock_Expecter {
+	return &WebhookRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ClaimWebhookDeliveries provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) ClaimWebhookDeliveries(ctx context.Context, batchSize int, leaseUntil time.Time) ([]domain.PendingWebhookDelivery, error) {
+	ret := _mock.Called(ctx, batchSize, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimWebhookDeliveries")
+	}
+
+	var r0 []domain.PendingWebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Time) ([]domain.PendingWebhookDelivery, error)); ok {
+		return returnFunc(ctx, batchSize, leaseUntil)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Time) []domain.PendingWebhookDelivery); ok {
+		r0 = returnFunc(ctx, batchSize, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PendingWebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = returnFunc(ctx, batchSize, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookRepositoryMock_ClaimWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimWebhookDeliveries'
+type WebhookRepositoryMock_ClaimWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchSize int
+//   - leaseUntil time.Time
+func (_e *WebhookRepositoryMock_Expecter) ClaimWebhookDeliveries(ctx interface{}, batchSize interface{}, leaseUntil interface{}) *WebhookRepositoryMock_ClaimWebhookDeliveries_Call {
+	return &WebhookRepositoryMock_ClaimWebhookDeliveries_Call{Call: _e.mock.On("ClaimWebhookDeliveries", ctx, batchSize, leaseUntil)}
+}
+
+func (_c *WebhookRepositoryMock_ClaimWebhookDeliveries_Call) Run(run func(ctx context.Context, batchSize int, leaseUntil time.Time)) *WebhookRepositoryMock_ClaimWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ClaimWebhookDeliveries_Call) Return(pendingWebhookDeliverys []domain.PendingWebhookDelivery, err error) *WebhookRepositoryMock_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(pendingWebhookDeliverys, err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ClaimWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, batchSize int, leaseUntil time.Time) ([]domain.PendingWebhookDelivery, error)) *WebhookRepositoryMock_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookDelivery provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) CreateWebhookDelivery(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID, eventType string, payload []byte) error {
+	ret := _mock.Called(ctx, subscriptionID, eventID, eventType, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, []byte) error); ok {
+		r0 = returnFunc(ctx, subscriptionID, eventID, eventType, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WebhookRepositoryMock_CreateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookDelivery'
+type WebhookRepositoryMock_CreateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID uuid.UUID
+//   - eventID uuid.UUID
+//   - eventType string
+//   - payload []byte
+func (_e *WebhookRepositoryMock_Expecter) CreateWebhookDelivery(ctx interface{}, subscriptionID interface{}, eventID interface{}, eventType interface{}, payload interface{}) *WebhookRepositoryMock_CreateWebhookDelivery_Call {
+	return &WebhookRepositoryMock_CreateWebhookDelivery_Call{Call: _e.mock.On("CreateWebhookDelivery", ctx, subscriptionID, eventID, eventType, payload)}
+}
+
+func (_c *WebhookRepositoryMock_CreateWebhookDelivery_Call) Run(run func(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID, eventType string, payload []byte)) *WebhookRepositoryMock_CreateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []byte
+		if args[4] != nil {
+			arg4 = args[4].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_CreateWebhookDelivery_Call) Return(err error) *WebhookRepositoryMock_CreateWebhookDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_CreateWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, subscriptionID uuid.UUID, eventID uuid.UUID, eventType string, payload []byte) error) *WebhookRepositoryMock_CreateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookSubscription provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) CreateWebhookSubscription(ctx context.Context, url string, secret string, eventTypes []string) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, url, secret, eventTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookSubscription")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, url, secret, eventTypes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, url, secret, eventTypes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = returnFunc(ctx, url, secret, eventTypes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookRepositoryMock_CreateWebhookSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookSubscription'
+type WebhookRepositoryMock_CreateWebhookSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+//   - secret string
+//   - eventTypes []string
+func (_e *WebhookRepositoryMock_Expecter) CreateWebhookSubscription(ctx interface{}, url interface{}, secret interface{}, eventTypes interface{}) *WebhookRepositoryMock_CreateWebhookSubscription_Call {
+	return &WebhookRepositoryMock_CreateWebhookSubscription_Call{Call: _e.mock.On("CreateWebhookSubscription", ctx, url, secret, eventTypes)}
+}
+
+func (_c *WebhookRepositoryMock_CreateWebhookSubscription_Call) Run(run func(ctx context.Context, url string, secret string, eventTypes []string)) *WebhookRepositoryMock_CreateWebhookSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_CreateWebhookSubscription_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *WebhookRepositoryMock_CreateWebhookSubscription_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_CreateWebhookSubscription_Call) RunAndReturn(run func(ctx context.Context, url string, secret string, eventTypes []string) (*domain.WebhookSubscription, error)) *WebhookRepositoryMock_CreateWebhookSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFinishedWebhookDeliveriesBefore provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) DeleteFinishedWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFinishedWebhookDeliveriesBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFinishedWebhookDeliveriesBefore'
+type WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteFinishedWebhookDeliveriesBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *WebhookRepositoryMock_Expecter) DeleteFinishedWebhookDeliveriesBefore(ctx interface{}, before interface{}) *WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call {
+	return &WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call{Call: _e.mock.On("DeleteFinishedWebhookDeliveriesBefore", ctx, before)}
+}
+
+func (_c *WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call) Run(run func(ctx context.Context, before time.Time)) *WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call) Return(n int64, err error) *WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, error)) *WebhookRepositoryMock_DeleteFinishedWebhookDeliveriesBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookDeliveriesByAggregate provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) DeleteWebhookDeliveriesByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	ret := _mock.Called(ctx, aggregateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookDeliveriesByAggregate")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return returnFunc(ctx, aggregateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = returnFunc(ctx, aggregateID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, aggregateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookDeliveriesByAggregate'
+type WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookDeliveriesByAggregate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aggregateID uuid.UUID
+func (_e *WebhookRepositoryMock_Expecter) DeleteWebhookDeliveriesByAggregate(ctx interface{}, aggregateID interface{}) *WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call {
+	return &WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call{Call: _e.mock.On("DeleteWebhookDeliveriesByAggregate", ctx, aggregateID)}
+}
+
+func (_c *WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call) Run(run func(ctx context.Context, aggregateID uuid.UUID)) *WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call) Return(n int64, err error) *WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call) RunAndReturn(run func(ctx context.Context, aggregateID uuid.UUID) (int64, error)) *WebhookRepositoryMock_DeleteWebhookDeliveriesByAggregate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookSubscription provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) DeleteWebhookSubscription(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WebhookRepositoryMock_DeleteWebhookSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookSubscription'
+type WebhookRepositoryMock_DeleteWebhookSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *WebhookRepositoryMock_Expecter) DeleteWebhookSubscription(ctx interface{}, id interface{}) *WebhookRepositoryMock_DeleteWebhookSubscription_Call {
+	return &WebhookRepositoryMock_DeleteWebhookSubscription_Call{Call: _e.mock.On("DeleteWebhookSubscription", ctx, id)}
+}
+
+func (_c *WebhookRepositoryMock_DeleteWebhookSubscription_Call) Run(run func(ctx context.Context, id uuid.UUID)) *WebhookRepositoryMock_DeleteWebhookSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_DeleteWebhookSubscription_Call) Return(err error) *WebhookRepositoryMock_DeleteWebhookSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_DeleteWebhookSubscription_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *WebhookRepositoryMock_DeleteWebhookSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) ListWebhookDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, subscriptionID, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int, int) ([]domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, subscriptionID, status, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int, int) []domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, subscriptionID, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, int, int) error); ok {
+		r1 = returnFunc(ctx, subscriptionID, status, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookRepositoryMock_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookRepositoryMock_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionID uuid.UUID
+//   - status string
+//   - limit int
+//   - offset int
+func (_e *WebhookRepositoryMock_Expecter) ListWebhookDeliveries(ctx interface{}, subscriptionID interface{}, status interface{}, limit interface{}, offset interface{}) *WebhookRepositoryMock_ListWebhookDeliveries_Call {
+	return &WebhookRepositoryMock_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, subscriptionID, status, limit, offset)}
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, subscriptionID uuid.UUID, status string, limit int, offset int)) *WebhookRepositoryMock_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookDeliveries_Call) Return(webhookDeliverys []domain.WebhookDelivery, err error) *WebhookRepositoryMock_ListWebhookDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, subscriptionID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error)) *WebhookRepositoryMock_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookSubscriptions provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) ListWebhookSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookSubscriptions")
+	}
+
+	var r0 []domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookRepositoryMock_ListWebhookSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookSubscriptions'
+type WebhookRepositoryMock_ListWebhookSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListWebhookSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookRepositoryMock_Expecter) ListWebhookSubscriptions(ctx interface{}) *WebhookRepositoryMock_ListWebhookSubscriptions_Call {
+	return &WebhookRepositoryMock_ListWebhookSubscriptions_Call{Call: _e.mock.On("ListWebhookSubscriptions", ctx)}
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookSubscriptions_Call) Run(run func(ctx context.Context)) *WebhookRepositoryMock_ListWebhookSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookSubscriptions_Call) Return(webhookSubscriptions []domain.WebhookSubscription, err error) *WebhookRepositoryMock_ListWebhookSubscriptions_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookSubscriptions_Call) RunAndReturn(run func(ctx context.Context) ([]domain.WebhookSubscription, error)) *WebhookRepositoryMock_ListWebhookSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookSubscriptionsByEvent provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) ListWebhookSubscriptionsByEvent(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, eventType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookSubscriptionsByEvent")
+	}
+
+	var r0 []domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, eventType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, eventType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, eventType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookSubscriptionsByEvent'
+type WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call struct {
+	*mock.Call
+}
+
+// ListWebhookSubscriptionsByEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventType string
+func (_e *WebhookRepositoryMock_Expecter) ListWebhookSubscriptionsByEvent(ctx interface{}, eventType interface{}) *WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call {
+	return &WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call{Call: _e.mock.On("ListWebhookSubscriptionsByEvent", ctx, eventType)}
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call) Run(run func(ctx context.Context, eventType string)) *WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call) Return(webhookSubscriptions []domain.WebhookSubscription, err error) *WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call) RunAndReturn(run func(ctx context.Context, eventType string) ([]domain.WebhookSubscription, error)) *WebhookRepositoryMock_ListWebhookSubscriptionsByEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkWebhookDeliveryFailed provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) MarkWebhookDeliveryFailed(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, statusCode int, lastError string) error {
+	ret := _mock.Called(ctx, id, status, nextAttemptAt, statusCode, lastError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkWebhookDeliveryFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, int, string) error); ok {
+		r0 = returnFunc(ctx, id, status, nextAttemptAt, statusCode, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkWebhookDeliveryFailed'
+type WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call struct {
+	*mock.Call
+}
+
+// MarkWebhookDeliveryFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - status string
+//   - nextAttemptAt time.Time
+//   - statusCode int
+//   - lastError string
+func (_e *WebhookRepositoryMock_Expecter) MarkWebhookDeliveryFailed(ctx interface{}, id interface{}, status interface{}, nextAttemptAt interface{}, statusCode interface{}, lastError interface{}) *WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call {
+	return &WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call{Call: _e.mock.On("MarkWebhookDeliveryFailed", ctx, id, status, nextAttemptAt, statusCode, lastError)}
+}
+
+func (_c *WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call) Run(run func(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, statusCode int, lastError string)) *WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call) Return(err error) *WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, statusCode int, lastError string) error) *WebhookRepositoryMock_MarkWebhookDeliveryFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkWebhookDeliverySucceeded provides a mock function for the type WebhookRepositoryMock
+func (_mock *WebhookRepositoryMock) MarkWebhookDeliverySucceeded(ctx context.Context, id uuid.UUID, statusCode int) error {
+	ret := _mock.Called(ctx, id, statusCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkWebhookDeliverySucceeded")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = returnFunc(ctx, id, statusCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkWebhookDeliverySucceeded'
+type WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call struct {
+	*mock.Call
+}
+
+// MarkWebhookDeliverySucceeded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - statusCode int
+func (_e *WebhookRepositoryMock_Expecter) MarkWebhookDeliverySucceeded(ctx interface{}, id interface{}, statusCode interface{}) *WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call {
+	return &WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call{Call: _e.mock.On("MarkWebhookDeliverySucceeded", ctx, id, statusCode)}
+}
+
+func (_c *WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call) Run(run func(ctx context.Context, id uuid.UUID, statusCode int)) *WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call) Return(err error) *WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, statusCode int) error) *WebhookRepositoryMock_MarkWebhookDeliverySucceeded_Call {
+	_c.Call.Return(run)
+	return _c
+}
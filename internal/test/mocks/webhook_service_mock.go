@@ -0,0 +1,319 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vo1dFl0w/auth-service/internal/app/domain"
+)
+
+// NewWebhookServiceMock creates a new instance of WebhookServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookServiceMock {
+	mock := &WebhookServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// WebhookServiceMock is an autogenerated mock type for the WebhookService type
+type WebhookServiceMock struct {
+	mock.Mock
+}
+
+type WebhookServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookServiceMock) EXPECT() *WebhookServiceMock_Expecter {
+	return &WebhookServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function for the type WebhookServiceMock
+func (_mock *WebhookServiceMock) CreateWebhook(ctx context.Context, url string, eventTypes []string) (*domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, url, eventTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) (*domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, url, eventTypes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) *domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, url, eventTypes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, url, eventTypes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookServiceMock_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookServiceMock_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+//   - eventTypes []string
+func (_e *WebhookServiceMock_Expecter) CreateWebhook(ctx interface{}, url interface{}, eventTypes interface{}) *WebhookServiceMock_CreateWebhook_Call {
+	return &WebhookServiceMock_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, url, eventTypes)}
+}
+
+func (_c *WebhookServiceMock_CreateWebhook_Call) Run(run func(ctx context.Context, url string, eventTypes []string)) *WebhookServiceMock_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookServiceMock_CreateWebhook_Call) Return(webhookSubscription *domain.WebhookSubscription, err error) *WebhookServiceMock_CreateWebhook_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *WebhookServiceMock_CreateWebhook_Call) RunAndReturn(run func(ctx context.Context, url string, eventTypes []string) (*domain.WebhookSubscription, error)) *WebhookServiceMock_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function for the type WebhookServiceMock
+func (_mock *WebhookServiceMock) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WebhookServiceMock_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookServiceMock_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *WebhookServiceMock_Expecter) DeleteWebhook(ctx interface{}, id interface{}) *WebhookServiceMock_DeleteWebhook_Call {
+	return &WebhookServiceMock_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, id)}
+}
+
+func (_c *WebhookServiceMock_DeleteWebhook_Call) Run(run func(ctx context.Context, id uuid.UUID)) *WebhookServiceMock_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookServiceMock_DeleteWebhook_Call) Return(err error) *WebhookServiceMock_DeleteWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WebhookServiceMock_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *WebhookServiceMock_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function for the type WebhookServiceMock
+func (_mock *WebhookServiceMock) ListDeliveries(ctx context.Context, webhookID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, webhookID, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int, int) ([]domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, webhookID, status, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int, int) []domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, webhookID, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, int, int) error); ok {
+		r1 = returnFunc(ctx, webhookID, status, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookServiceMock_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type WebhookServiceMock_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookID uuid.UUID
+//   - status string
+//   - limit int
+//   - offset int
+func (_e *WebhookServiceMock_Expecter) ListDeliveries(ctx interface{}, webhookID interface{}, status interface{}, limit interface{}, offset interface{}) *WebhookServiceMock_ListDeliveries_Call {
+	return &WebhookServiceMock_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", ctx, webhookID, status, limit, offset)}
+}
+
+func (_c *WebhookServiceMock_ListDeliveries_Call) Run(run func(ctx context.Context, webhookID uuid.UUID, status string, limit int, offset int)) *WebhookServiceMock_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookServiceMock_ListDeliveries_Call) Return(webhookDeliverys []domain.WebhookDelivery, err error) *WebhookServiceMock_ListDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *WebhookServiceMock_ListDeliveries_Call) RunAndReturn(run func(ctx context.Context, webhookID uuid.UUID, status string, limit int, offset int) ([]domain.WebhookDelivery, error)) *WebhookServiceMock_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function for the type WebhookServiceMock
+func (_mock *WebhookServiceMock) ListWebhooks(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooks")
+	}
+
+	var r0 []domain.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.WebhookSubscription, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.WebhookSubscription); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WebhookServiceMock_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookServiceMock_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookServiceMock_Expecter) ListWebhooks(ctx interface{}) *WebhookServiceMock_ListWebhooks_Call {
+	return &WebhookServiceMock_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx)}
+}
+
+func (_c *WebhookServiceMock_ListWebhooks_Call) Run(run func(ctx context.Context)) *WebhookServiceMock_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookServiceMock_ListWebhooks_Call) Return(webhookSubscriptions []domain.WebhookSubscription, err error) *WebhookServiceMock_ListWebhooks_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *WebhookServiceMock_ListWebhooks_Call) RunAndReturn(run func(ctx context.Context) ([]domain.WebhookSubscription, error)) *WebhookServiceMock_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1 @@
+DROP TABLE api_keys;
\ No newline at end of file
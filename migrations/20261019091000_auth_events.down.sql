@@ -0,0 +1,4 @@
+DROP TRIGGER auth_events_append_only ON auth_events;
+DROP FUNCTION auth_events_reject_update();
+DROP TABLE auth_events;
+ALTER TABLE users DROP COLUMN role;
\ No newline at end of file
@@ -0,0 +1,26 @@
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'user';
+
+CREATE TABLE auth_events (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    event_type TEXT NOT NULL,
+    user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+    ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    outcome TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX auth_events_user_id_created_at_idx ON auth_events(user_id, created_at DESC);
+CREATE INDEX auth_events_created_at_idx ON auth_events(created_at);
+
+CREATE FUNCTION auth_events_reject_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'auth_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER auth_events_append_only
+BEFORE UPDATE ON auth_events
+FOR EACH ROW EXECUTE FUNCTION auth_events_reject_update();
\ No newline at end of file
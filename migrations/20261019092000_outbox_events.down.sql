@@ -0,0 +1 @@
+DROP TABLE outbox_events;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE outbox_events (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    aggregate_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX outbox_events_pending_idx ON outbox_events(next_attempt_at) WHERE published_at IS NULL;
\ No newline at end of file
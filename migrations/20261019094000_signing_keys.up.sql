@@ -0,0 +1,8 @@
+CREATE TABLE signing_keys (
+    kid TEXT PRIMARY KEY NOT NULL,
+    secret BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    retired_at TIMESTAMPTZ
+);
+
+CREATE INDEX signing_keys_retired_at_idx ON signing_keys (retired_at);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE user_access_token_revocations;
+DROP TABLE revoked_access_tokens;
\ No newline at end of file
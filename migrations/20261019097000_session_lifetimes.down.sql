@@ -0,0 +1,3 @@
+ALTER TABLE tokens
+    DROP COLUMN remember_me,
+    DROP COLUMN session_started_at;
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE tokens
+    ADD COLUMN session_started_at TIMESTAMPTZ,
+    ADD COLUMN remember_me BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE tokens SET session_started_at = created_at;
+
+ALTER TABLE tokens ALTER COLUMN session_started_at SET NOT NULL;
\ No newline at end of file
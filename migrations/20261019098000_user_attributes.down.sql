@@ -0,0 +1 @@
+DROP TABLE user_attributes;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE device_codes;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE device_codes (
+    device_code_hash TEXT PRIMARY KEY,
+    user_code_hash TEXT NOT NULL UNIQUE,
+    client_id TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+    poll_interval INTEGER NOT NULL,
+    last_polled_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX device_codes_expires_at_idx ON device_codes(expires_at);
\ No newline at end of file
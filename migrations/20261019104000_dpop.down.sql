@@ -0,0 +1,3 @@
+DROP TABLE dpop_proofs;
+
+ALTER TABLE tokens DROP COLUMN dpop_jkt;
\ No newline at end of file
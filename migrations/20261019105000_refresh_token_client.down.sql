@@ -0,0 +1 @@
+ALTER TABLE tokens DROP COLUMN client_id;
\ No newline at end of file
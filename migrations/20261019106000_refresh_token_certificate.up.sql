@@ -0,0 +1 @@
+ALTER TABLE tokens ADD COLUMN x5t TEXT NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX outbox_events_published_idx;
\ No newline at end of file
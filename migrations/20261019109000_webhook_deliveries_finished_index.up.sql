@@ -0,0 +1 @@
+CREATE INDEX webhook_deliveries_finished_idx ON webhook_deliveries(updated_at) WHERE status <> 'pending';
\ No newline at end of file
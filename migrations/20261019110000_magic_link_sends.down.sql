@@ -0,0 +1 @@
+DROP TABLE magic_link_sends;
\ No newline at end of file
@@ -0,0 +1,48 @@
+// Package migrations embeds the SQL migrations, so the binary can apply them without
+// the files being present on disk.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+// SQLiteFS holds the same migrations translated for SQLite, under sqlite/. Every
+// Postgres migration has a SQLite counterpart with the same version.
+//
+//go:embed sqlite/*.sql
+var SQLiteFS embed.FS
+
+// Latest returns the version of the newest embedded migration.
+func Latest() (uint, error) {
+	names, err := fs.Glob(FS, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint
+	for _, name := range names {
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return 0, fmt.Errorf("malformed migration name: %s", name)
+		}
+
+		v, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("malformed migration version %s: %w", name, err)
+		}
+		latest = max(latest, uint(v))
+	}
+
+	if latest == 0 {
+		return 0, fmt.Errorf("no migrations embedded")
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,39 @@
+package migrations_test
+
+import (
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vo1dFl0w/auth-service/migrations"
+)
+
+func TestLatest(t *testing.T) {
+	latest, err := migrations.Latest()
+	assert.NoError(t, err)
+	assert.NotZero(t, latest)
+
+	ups, err := fs.Glob(migrations.FS, "*.up.sql")
+	assert.NoError(t, err)
+	downs, err := fs.Glob(migrations.FS, "*.down.sql")
+	assert.NoError(t, err)
+	assert.Len(t, downs, len(ups))
+
+	last := ups[len(ups)-1]
+	assert.True(t, strings.HasPrefix(last, strconv.FormatUint(uint64(latest), 10)+"_"), last)
+}
+
+func TestSQLiteMirrorsPostgres(t *testing.T) {
+	postgres, err := fs.Glob(migrations.FS, "*.sql")
+	assert.NoError(t, err)
+
+	sqlite, err := fs.Glob(migrations.SQLiteFS, "sqlite/*.sql")
+	assert.NoError(t, err)
+	for i := range sqlite {
+		sqlite[i] = strings.TrimPrefix(sqlite[i], "sqlite/")
+	}
+
+	assert.Equal(t, postgres, sqlite)
+}
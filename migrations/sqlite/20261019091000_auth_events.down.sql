@@ -0,0 +1,3 @@
+DROP TRIGGER auth_events_append_only;
+DROP TABLE auth_events;
+ALTER TABLE users DROP COLUMN role;
\ No newline at end of file
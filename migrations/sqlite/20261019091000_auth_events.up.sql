@@ -0,0 +1,22 @@
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'user';
+
+CREATE TABLE auth_events (
+    id TEXT PRIMARY KEY NOT NULL,
+    event_type TEXT NOT NULL,
+    user_id TEXT REFERENCES users(user_id) ON DELETE CASCADE,
+    ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    outcome TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX auth_events_user_id_created_at_idx ON auth_events(user_id, created_at DESC);
+CREATE INDEX auth_events_created_at_idx ON auth_events(created_at);
+
+CREATE TRIGGER auth_events_append_only
+BEFORE UPDATE ON auth_events
+BEGIN
+    SELECT RAISE(ABORT, 'auth_events is append-only');
+END;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX tokens_expires_at_idx ON tokens (expires_at);
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE tokens DROP COLUMN remember_me;
+ALTER TABLE tokens DROP COLUMN session_started_at;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE tokens ADD COLUMN session_started_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00+00:00';
+ALTER TABLE tokens ADD COLUMN remember_me BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE tokens SET session_started_at = created_at;
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE user_attributes (
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    value BLOB NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, name)
+);
\ No newline at end of file
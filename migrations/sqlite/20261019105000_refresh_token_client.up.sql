@@ -0,0 +1 @@
+ALTER TABLE tokens ADD COLUMN client_id TEXT NOT NULL DEFAULT '';
\ No newline at end of file
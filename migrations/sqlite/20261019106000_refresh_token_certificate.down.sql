@@ -0,0 +1 @@
+ALTER TABLE tokens DROP COLUMN x5t;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE otp_requests;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX outbox_events_published_idx ON outbox_events(published_at) WHERE published_at IS NOT NULL;
\ No newline at end of file
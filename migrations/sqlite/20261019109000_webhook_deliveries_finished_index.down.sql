@@ -0,0 +1 @@
+DROP INDEX webhook_deliveries_finished_idx;
\ No newline at end of file
@@ -0,0 +1,6 @@
+CREATE TABLE magic_link_sends (
+    user_id TEXT PRIMARY KEY NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    requests INTEGER NOT NULL DEFAULT 0,
+    window_started_at TIMESTAMP NOT NULL,
+    window_ends_at TIMESTAMP NOT NULL
+);
\ No newline at end of file